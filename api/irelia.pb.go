@@ -8,9 +8,11 @@ package irelia
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -33,6 +35,7 @@ const (
 	InterviewStatus_INTERVIEW_STATUS_PENDING     InterviewStatus = 2
 	InterviewStatus_INTERVIEW_STATUS_FAILED      InterviewStatus = 3
 	InterviewStatus_INTERVIEW_STATUS_COMPLETED   InterviewStatus = 4
+	InterviewStatus_INTERVIEW_STATUS_ABANDONED   InterviewStatus = 5
 )
 
 // Enum value maps for InterviewStatus.
@@ -43,6 +46,7 @@ var (
 		2: "INTERVIEW_STATUS_PENDING",
 		3: "INTERVIEW_STATUS_FAILED",
 		4: "INTERVIEW_STATUS_COMPLETED",
+		5: "INTERVIEW_STATUS_ABANDONED",
 	}
 	InterviewStatus_value = map[string]int32{
 		"INTERVIEW_STATUS_UNKNOWN":     0,
@@ -50,6 +54,7 @@ var (
 		"INTERVIEW_STATUS_PENDING":     2,
 		"INTERVIEW_STATUS_FAILED":      3,
 		"INTERVIEW_STATUS_COMPLETED":   4,
+		"INTERVIEW_STATUS_ABANDONED":   5,
 	}
 )
 
@@ -199,12 +204,193 @@ func (InterviewSortMethod) EnumDescriptor() ([]byte, []int) {
 	return file_api_irelia_proto_rawDescGZIP(), []int{2}
 }
 
+type InterviewEventType int32
+
+const (
+	InterviewEventType_INTERVIEW_EVENT_UNKNOWN              InterviewEventType = 0
+	InterviewEventType_INTERVIEW_EVENT_QUESTION_PREPARING   InterviewEventType = 1
+	InterviewEventType_INTERVIEW_EVENT_QUESTION_READY       InterviewEventType = 2
+	InterviewEventType_INTERVIEW_EVENT_QUESTION_FAILED      InterviewEventType = 3
+	InterviewEventType_INTERVIEW_EVENT_SCORING_STARTED      InterviewEventType = 4
+	InterviewEventType_INTERVIEW_EVENT_SCORING_COMPLETED    InterviewEventType = 5
+	InterviewEventType_INTERVIEW_EVENT_SCORING_FAILED       InterviewEventType = 6
+	InterviewEventType_INTERVIEW_EVENT_QUESTION_STARTED     InterviewEventType = 7
+	InterviewEventType_INTERVIEW_EVENT_QUESTION_TIMEOUT     InterviewEventType = 8
+	InterviewEventType_INTERVIEW_EVENT_QUESTION_AUDIO_READY InterviewEventType = 9
+)
+
+// Enum value maps for InterviewEventType.
+var (
+	InterviewEventType_name = map[int32]string{
+		0: "INTERVIEW_EVENT_UNKNOWN",
+		1: "INTERVIEW_EVENT_QUESTION_PREPARING",
+		2: "INTERVIEW_EVENT_QUESTION_READY",
+		3: "INTERVIEW_EVENT_QUESTION_FAILED",
+		4: "INTERVIEW_EVENT_SCORING_STARTED",
+		5: "INTERVIEW_EVENT_SCORING_COMPLETED",
+		6: "INTERVIEW_EVENT_SCORING_FAILED",
+		7: "INTERVIEW_EVENT_QUESTION_STARTED",
+		8: "INTERVIEW_EVENT_QUESTION_TIMEOUT",
+		9: "INTERVIEW_EVENT_QUESTION_AUDIO_READY",
+	}
+	InterviewEventType_value = map[string]int32{
+		"INTERVIEW_EVENT_UNKNOWN":              0,
+		"INTERVIEW_EVENT_QUESTION_PREPARING":   1,
+		"INTERVIEW_EVENT_QUESTION_READY":       2,
+		"INTERVIEW_EVENT_QUESTION_FAILED":      3,
+		"INTERVIEW_EVENT_SCORING_STARTED":      4,
+		"INTERVIEW_EVENT_SCORING_COMPLETED":    5,
+		"INTERVIEW_EVENT_SCORING_FAILED":       6,
+		"INTERVIEW_EVENT_QUESTION_STARTED":     7,
+		"INTERVIEW_EVENT_QUESTION_TIMEOUT":     8,
+		"INTERVIEW_EVENT_QUESTION_AUDIO_READY": 9,
+	}
+)
+
+func (x InterviewEventType) Enum() *InterviewEventType {
+	p := new(InterviewEventType)
+	*p = x
+	return p
+}
+
+func (x InterviewEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InterviewEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_irelia_proto_enumTypes[3].Descriptor()
+}
+
+func (InterviewEventType) Type() protoreflect.EnumType {
+	return &file_api_irelia_proto_enumTypes[3]
+}
+
+func (x InterviewEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InterviewEventType.Descriptor instead.
+func (InterviewEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{3}
+}
+
+type JobKind int32
+
+const (
+	JobKind_JOB_KIND_UNKNOWN   JobKind = 0
+	JobKind_JOB_KIND_QUESTION  JobKind = 1
+	JobKind_JOB_KIND_SCORING   JobKind = 2
+	JobKind_JOB_KIND_FOLLOW_UP JobKind = 3
+	JobKind_JOB_KIND_LIP_SYNC  JobKind = 4
+)
+
+// Enum value maps for JobKind.
+var (
+	JobKind_name = map[int32]string{
+		0: "JOB_KIND_UNKNOWN",
+		1: "JOB_KIND_QUESTION",
+		2: "JOB_KIND_SCORING",
+		3: "JOB_KIND_FOLLOW_UP",
+		4: "JOB_KIND_LIP_SYNC",
+	}
+	JobKind_value = map[string]int32{
+		"JOB_KIND_UNKNOWN":   0,
+		"JOB_KIND_QUESTION":  1,
+		"JOB_KIND_SCORING":   2,
+		"JOB_KIND_FOLLOW_UP": 3,
+		"JOB_KIND_LIP_SYNC":  4,
+	}
+)
+
+func (x JobKind) Enum() *JobKind {
+	p := new(JobKind)
+	*p = x
+	return p
+}
+
+func (x JobKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_irelia_proto_enumTypes[4].Descriptor()
+}
+
+func (JobKind) Type() protoreflect.EnumType {
+	return &file_api_irelia_proto_enumTypes[4]
+}
+
+func (x JobKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobKind.Descriptor instead.
+func (JobKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{4}
+}
+
+type JobStatus int32
+
+const (
+	JobStatus_JOB_STATUS_UNKNOWN   JobStatus = 0
+	JobStatus_JOB_STATUS_PENDING   JobStatus = 1
+	JobStatus_JOB_STATUS_RUNNING   JobStatus = 2
+	JobStatus_JOB_STATUS_SUCCEEDED JobStatus = 3
+	JobStatus_JOB_STATUS_DEAD      JobStatus = 4
+)
+
+// Enum value maps for JobStatus.
+var (
+	JobStatus_name = map[int32]string{
+		0: "JOB_STATUS_UNKNOWN",
+		1: "JOB_STATUS_PENDING",
+		2: "JOB_STATUS_RUNNING",
+		3: "JOB_STATUS_SUCCEEDED",
+		4: "JOB_STATUS_DEAD",
+	}
+	JobStatus_value = map[string]int32{
+		"JOB_STATUS_UNKNOWN":   0,
+		"JOB_STATUS_PENDING":   1,
+		"JOB_STATUS_RUNNING":   2,
+		"JOB_STATUS_SUCCEEDED": 3,
+		"JOB_STATUS_DEAD":      4,
+	}
+)
+
+func (x JobStatus) Enum() *JobStatus {
+	p := new(JobStatus)
+	*p = x
+	return p
+}
+
+func (x JobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_irelia_proto_enumTypes[5].Descriptor()
+}
+
+func (JobStatus) Type() protoreflect.EnumType {
+	return &file_api_irelia_proto_enumTypes[5]
+}
+
+func (x JobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobStatus.Descriptor instead.
+func (JobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{5}
+}
+
 type BulbasaurRole int32
 
 const (
 	BulbasaurRole_ROLE_UNKNOWN          BulbasaurRole = 0
 	BulbasaurRole_ROLE_CANDIDATE        BulbasaurRole = 1
 	BulbasaurRole_ROLE_BUSINESS_MANAGER BulbasaurRole = 2
+	BulbasaurRole_ROLE_ADMIN            BulbasaurRole = 3
 )
 
 // Enum value maps for BulbasaurRole.
@@ -213,11 +399,13 @@ var (
 		0: "ROLE_UNKNOWN",
 		1: "ROLE_CANDIDATE",
 		2: "ROLE_BUSINESS_MANAGER",
+		3: "ROLE_ADMIN",
 	}
 	BulbasaurRole_value = map[string]int32{
 		"ROLE_UNKNOWN":          0,
 		"ROLE_CANDIDATE":        1,
 		"ROLE_BUSINESS_MANAGER": 2,
+		"ROLE_ADMIN":            3,
 	}
 )
 
@@ -232,11 +420,11 @@ func (x BulbasaurRole) String() string {
 }
 
 func (BulbasaurRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_irelia_proto_enumTypes[3].Descriptor()
+	return file_api_irelia_proto_enumTypes[6].Descriptor()
 }
 
 func (BulbasaurRole) Type() protoreflect.EnumType {
-	return &file_api_irelia_proto_enumTypes[3]
+	return &file_api_irelia_proto_enumTypes[6]
 }
 
 func (x BulbasaurRole) Number() protoreflect.EnumNumber {
@@ -245,7 +433,169 @@ func (x BulbasaurRole) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BulbasaurRole.Descriptor instead.
 func (BulbasaurRole) EnumDescriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{3}
+	return file_api_irelia_proto_rawDescGZIP(), []int{6}
+}
+
+type ModerationStatus int32
+
+const (
+	ModerationStatus_MODERATION_STATUS_UNKNOWN  ModerationStatus = 0
+	ModerationStatus_MODERATION_STATUS_PENDING  ModerationStatus = 1
+	ModerationStatus_MODERATION_STATUS_APPROVED ModerationStatus = 2
+	ModerationStatus_MODERATION_STATUS_REJECTED ModerationStatus = 3
+	ModerationStatus_MODERATION_STATUS_MERGED   ModerationStatus = 4 // folded into another entry
+)
+
+// Enum value maps for ModerationStatus.
+var (
+	ModerationStatus_name = map[int32]string{
+		0: "MODERATION_STATUS_UNKNOWN",
+		1: "MODERATION_STATUS_PENDING",
+		2: "MODERATION_STATUS_APPROVED",
+		3: "MODERATION_STATUS_REJECTED",
+		4: "MODERATION_STATUS_MERGED",
+	}
+	ModerationStatus_value = map[string]int32{
+		"MODERATION_STATUS_UNKNOWN":  0,
+		"MODERATION_STATUS_PENDING":  1,
+		"MODERATION_STATUS_APPROVED": 2,
+		"MODERATION_STATUS_REJECTED": 3,
+		"MODERATION_STATUS_MERGED":   4,
+	}
+)
+
+func (x ModerationStatus) Enum() *ModerationStatus {
+	p := new(ModerationStatus)
+	*p = x
+	return p
+}
+
+func (x ModerationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_irelia_proto_enumTypes[7].Descriptor()
+}
+
+func (ModerationStatus) Type() protoreflect.EnumType {
+	return &file_api_irelia_proto_enumTypes[7]
+}
+
+func (x ModerationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationStatus.Descriptor instead.
+func (ModerationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{7}
+}
+
+type ModerationAction int32
+
+const (
+	ModerationAction_MODERATION_ACTION_UNKNOWN ModerationAction = 0
+	ModerationAction_MODERATION_ACTION_APPROVE ModerationAction = 1
+	ModerationAction_MODERATION_ACTION_EDIT    ModerationAction = 2
+	ModerationAction_MODERATION_ACTION_REJECT  ModerationAction = 3
+	ModerationAction_MODERATION_ACTION_MERGE   ModerationAction = 4
+)
+
+// Enum value maps for ModerationAction.
+var (
+	ModerationAction_name = map[int32]string{
+		0: "MODERATION_ACTION_UNKNOWN",
+		1: "MODERATION_ACTION_APPROVE",
+		2: "MODERATION_ACTION_EDIT",
+		3: "MODERATION_ACTION_REJECT",
+		4: "MODERATION_ACTION_MERGE",
+	}
+	ModerationAction_value = map[string]int32{
+		"MODERATION_ACTION_UNKNOWN": 0,
+		"MODERATION_ACTION_APPROVE": 1,
+		"MODERATION_ACTION_EDIT":    2,
+		"MODERATION_ACTION_REJECT":  3,
+		"MODERATION_ACTION_MERGE":   4,
+	}
+)
+
+func (x ModerationAction) Enum() *ModerationAction {
+	p := new(ModerationAction)
+	*p = x
+	return p
+}
+
+func (x ModerationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_irelia_proto_enumTypes[8].Descriptor()
+}
+
+func (ModerationAction) Type() protoreflect.EnumType {
+	return &file_api_irelia_proto_enumTypes[8]
+}
+
+func (x ModerationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationAction.Descriptor instead.
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{8}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // Markdown
+	ExportFormat_EXPORT_FORMAT_MARKDOWN    ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_HTML        ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_JSON        ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_MARKDOWN",
+		2: "EXPORT_FORMAT_HTML",
+		3: "EXPORT_FORMAT_JSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_MARKDOWN":    1,
+		"EXPORT_FORMAT_HTML":        2,
+		"EXPORT_FORMAT_JSON":        3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_irelia_proto_enumTypes[9].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_api_irelia_proto_enumTypes[9]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{9}
 }
 
 type BaseData struct {
@@ -606,14 +956,25 @@ func (x *Question) GetBaseData() *BaseData {
 }
 
 type PublicQuestion struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Answer        *string                `protobuf:"bytes,2,opt,name=answer,proto3,oneof" json:"answer,omitempty"`
-	Position      string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
-	Experience    string                 `protobuf:"bytes,4,opt,name=experience,proto3" json:"experience,omitempty"`
-	BaseData      *BaseData              `protobuf:"bytes,5,opt,name=base_data,json=baseData,proto3" json:"base_data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Content      string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Answer       *string                `protobuf:"bytes,2,opt,name=answer,proto3,oneof" json:"answer,omitempty"`
+	Position     string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Experience   string                 `protobuf:"bytes,4,opt,name=experience,proto3" json:"experience,omitempty"`
+	BaseData     *BaseData              `protobuf:"bytes,5,opt,name=base_data,json=baseData,proto3" json:"base_data,omitempty"`
+	Id           int32                  `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	Language     string                 `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	Status       ModerationStatus       `protobuf:"varint,8,opt,name=status,proto3,enum=irelia.ModerationStatus" json:"status,omitempty"`
+	Occurrences  int32                  `protobuf:"varint,9,opt,name=occurrences,proto3" json:"occurrences,omitempty"` // times the question was generated
+	FirstSeenAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	LastSeenAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	MergedIntoId int32                  `protobuf:"varint,12,opt,name=merged_into_id,json=mergedIntoId,proto3" json:"merged_into_id,omitempty"`
+	// Provenance of the model answer, "moderator" when a moderator wrote it
+	AnswerGenerator   string                 `protobuf:"bytes,13,opt,name=answer_generator,json=answerGenerator,proto3" json:"answer_generator,omitempty"`
+	AnswerVersion     string                 `protobuf:"bytes,14,opt,name=answer_version,json=answerVersion,proto3" json:"answer_version,omitempty"`
+	AnswerGeneratedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=answer_generated_at,json=answerGeneratedAt,proto3" json:"answer_generated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *PublicQuestion) Reset() {
@@ -681,20 +1042,93 @@ func (x *PublicQuestion) GetBaseData() *BaseData {
 	return nil
 }
 
+func (x *PublicQuestion) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PublicQuestion) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *PublicQuestion) GetStatus() ModerationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ModerationStatus_MODERATION_STATUS_UNKNOWN
+}
+
+func (x *PublicQuestion) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *PublicQuestion) GetFirstSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return nil
+}
+
+func (x *PublicQuestion) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *PublicQuestion) GetMergedIntoId() int32 {
+	if x != nil {
+		return x.MergedIntoId
+	}
+	return 0
+}
+
+func (x *PublicQuestion) GetAnswerGenerator() string {
+	if x != nil {
+		return x.AnswerGenerator
+	}
+	return ""
+}
+
+func (x *PublicQuestion) GetAnswerVersion() string {
+	if x != nil {
+		return x.AnswerVersion
+	}
+	return ""
+}
+
+func (x *PublicQuestion) GetAnswerGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnswerGeneratedAt
+	}
+	return nil
+}
+
 // 1. Start Interview
 type StartInterviewRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Position       string                 `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	Experience     string                 `protobuf:"bytes,2,opt,name=experience,proto3" json:"experience,omitempty"`
-	Language       string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
-	Models         string                 `protobuf:"bytes,4,opt,name=models,proto3" json:"models,omitempty"`
-	Speed          int32                  `protobuf:"varint,5,opt,name=speed,proto3" json:"speed,omitempty"`
-	Skills         []string               `protobuf:"bytes,6,rep,name=skills,proto3" json:"skills,omitempty"`
-	TotalQuestions int32                  `protobuf:"varint,7,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
-	SkipIntro      bool                   `protobuf:"varint,8,opt,name=skip_intro,json=skipIntro,proto3" json:"skip_intro,omitempty"`
-	SkipCode       bool                   `protobuf:"varint,9,opt,name=skip_code,json=skipCode,proto3" json:"skip_code,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Position          string                 `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Experience        string                 `protobuf:"bytes,2,opt,name=experience,proto3" json:"experience,omitempty"`
+	Language          string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Models            string                 `protobuf:"bytes,4,opt,name=models,proto3" json:"models,omitempty"`
+	Speed             int32                  `protobuf:"varint,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	Skills            []string               `protobuf:"bytes,6,rep,name=skills,proto3" json:"skills,omitempty"`
+	TotalQuestions    int32                  `protobuf:"varint,7,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
+	SkipIntro         bool                   `protobuf:"varint,8,opt,name=skip_intro,json=skipIntro,proto3" json:"skip_intro,omitempty"`
+	SkipCode          bool                   `protobuf:"varint,9,opt,name=skip_code,json=skipCode,proto3" json:"skip_code,omitempty"`
+	QuestionTimeLimit *int32                 `protobuf:"varint,10,opt,name=question_time_limit,json=questionTimeLimit,proto3,oneof" json:"question_time_limit,omitempty"` // seconds per question, unset uses the server default, 0 disables
+	FluencyScoring    *bool                  `protobuf:"varint,11,opt,name=fluency_scoring,json=fluencyScoring,proto3,oneof" json:"fluency_scoring,omitempty"`            // score recorded answers for fluency, unset uses the server default
+	TextOnly          bool                   `protobuf:"varint,12,opt,name=text_only,json=textOnly,proto3" json:"text_only,omitempty"`                                    // questions are served as text without audio or lip-sync
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *StartInterviewRequest) Reset() {
@@ -790,6 +1224,27 @@ func (x *StartInterviewRequest) GetSkipCode() bool {
 	return false
 }
 
+func (x *StartInterviewRequest) GetQuestionTimeLimit() int32 {
+	if x != nil && x.QuestionTimeLimit != nil {
+		return *x.QuestionTimeLimit
+	}
+	return 0
+}
+
+func (x *StartInterviewRequest) GetFluencyScoring() bool {
+	if x != nil && x.FluencyScoring != nil {
+		return *x.FluencyScoring
+	}
+	return false
+}
+
+func (x *StartInterviewRequest) GetTextOnly() bool {
+	if x != nil {
+		return x.TextOnly
+	}
+	return false
+}
+
 type StartInterviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
@@ -839,6 +1294,7 @@ type QuestionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
 	QuestionIndex int32                  `protobuf:"varint,2,opt,name=question_index,json=questionIndex,proto3" json:"question_index,omitempty"`
+	SubIndex      int32                  `protobuf:"varint,3,opt,name=sub_index,json=subIndex,proto3" json:"sub_index,omitempty"` // 0 for the main question, 1.. for its follow-ups
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -887,17 +1343,31 @@ func (x *QuestionRequest) GetQuestionIndex() int32 {
 	return 0
 }
 
+func (x *QuestionRequest) GetSubIndex() int32 {
+	if x != nil {
+		return x.SubIndex
+	}
+	return 0
+}
+
 type QuestionResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	QuestionId     int32                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Audio          string                 `protobuf:"bytes,3,opt,name=audio,proto3" json:"audio,omitempty"`
-	Lipsync        *LipSyncData           `protobuf:"bytes,4,opt,name=lipsync,proto3" json:"lipsync,omitempty"`
-	IsLastQuestion bool                   `protobuf:"varint,5,opt,name=is_last_question,json=isLastQuestion,proto3" json:"is_last_question,omitempty"`
-	IsLoading      bool                   `protobuf:"varint,6,opt,name=is_loading,json=isLoading,proto3" json:"is_loading,omitempty"`
-	Timestamp      int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId       int32                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Audio            string                 `protobuf:"bytes,3,opt,name=audio,proto3" json:"audio,omitempty"`
+	Lipsync          *LipSyncData           `protobuf:"bytes,4,opt,name=lipsync,proto3" json:"lipsync,omitempty"`
+	IsLastQuestion   bool                   `protobuf:"varint,5,opt,name=is_last_question,json=isLastQuestion,proto3" json:"is_last_question,omitempty"`
+	IsLoading        bool                   `protobuf:"varint,6,opt,name=is_loading,json=isLoading,proto3" json:"is_loading,omitempty"`
+	Timestamp        int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IsFailed         bool                   `protobuf:"varint,8,opt,name=is_failed,json=isFailed,proto3" json:"is_failed,omitempty"`
+	Error            string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	DeadlineAt       int64                  `protobuf:"varint,10,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"` // unix seconds, 0 when the question is not timed
+	RemainingSeconds int32                  `protobuf:"varint,11,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	SubIndex         int32                  `protobuf:"varint,12,opt,name=sub_index,json=subIndex,proto3" json:"sub_index,omitempty"`
+	AudioPending     bool                   `protobuf:"varint,13,opt,name=audio_pending,json=audioPending,proto3" json:"audio_pending,omitempty"` // the text is ready, audio and lip-sync are still being generated
+	AudioUrl         string                 `protobuf:"bytes,14,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`              // short-lived signed URL of the audio, set instead of audio when it is kept in the blob store
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *QuestionResponse) Reset() {
@@ -979,21 +1449,71 @@ func (x *QuestionResponse) GetTimestamp() int64 {
 	return 0
 }
 
-// 3. Submit Answer
-type SubmitAnswerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
-	RecordProof   string                 `protobuf:"bytes,4,opt,name=record_proof,json=recordProof,proto3" json:"record_proof,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QuestionResponse) GetIsFailed() bool {
+	if x != nil {
+		return x.IsFailed
+	}
+	return false
 }
 
-func (x *SubmitAnswerRequest) Reset() {
-	*x = SubmitAnswerRequest{}
-	mi := &file_api_irelia_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+func (x *QuestionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QuestionResponse) GetDeadlineAt() int64 {
+	if x != nil {
+		return x.DeadlineAt
+	}
+	return 0
+}
+
+func (x *QuestionResponse) GetRemainingSeconds() int32 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *QuestionResponse) GetSubIndex() int32 {
+	if x != nil {
+		return x.SubIndex
+	}
+	return 0
+}
+
+func (x *QuestionResponse) GetAudioPending() bool {
+	if x != nil {
+		return x.AudioPending
+	}
+	return false
+}
+
+func (x *QuestionResponse) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+// 3. Submit Answer
+type SubmitAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	RecordProof   string                 `protobuf:"bytes,4,opt,name=record_proof,json=recordProof,proto3" json:"record_proof,omitempty"`
+	SubIndex      int32                  `protobuf:"varint,5,opt,name=sub_index,json=subIndex,proto3" json:"sub_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAnswerRequest) Reset() {
+	*x = SubmitAnswerRequest{}
+	mi := &file_api_irelia_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
@@ -1048,9 +1568,18 @@ func (x *SubmitAnswerRequest) GetRecordProof() string {
 	return ""
 }
 
+func (x *SubmitAnswerRequest) GetSubIndex() int32 {
+	if x != nil {
+		return x.SubIndex
+	}
+	return 0
+}
+
 type SubmitAnswerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	HasFollowUp   bool                   `protobuf:"varint,2,opt,name=has_follow_up,json=hasFollowUp,proto3" json:"has_follow_up,omitempty"` // a follow-up is being prepared, fetch it at next_sub_index before moving on
+	NextSubIndex  int32                  `protobuf:"varint,3,opt,name=next_sub_index,json=nextSubIndex,proto3" json:"next_sub_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1092,6 +1621,20 @@ func (x *SubmitAnswerResponse) GetMessage() string {
 	return ""
 }
 
+func (x *SubmitAnswerResponse) GetHasFollowUp() bool {
+	if x != nil {
+		return x.HasFollowUp
+	}
+	return false
+}
+
+func (x *SubmitAnswerResponse) GetNextSubIndex() int32 {
+	if x != nil {
+		return x.NextSubIndex
+	}
+	return 0
+}
+
 // 4. Submit Interview
 type SubmitInterviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1252,11 +1795,14 @@ func (x *AnswerData) GetQuestion() string {
 // 5. Get Interview History
 type GetInterviewHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // ignored when page_token is set
 	Sort          InterviewSortMethod    `protobuf:"varint,2,opt,name=sort,proto3,enum=irelia.InterviewSortMethod" json:"sort,omitempty"`
 	Query         *string                `protobuf:"bytes,3,opt,name=query,proto3,oneof" json:"query,omitempty"`
 	En            *bool                  `protobuf:"varint,4,opt,name=en,proto3,oneof" json:"en,omitempty"`
 	Fvr           *bool                  `protobuf:"varint,5,opt,name=fvr,proto3,oneof" json:"fvr,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                 // next_page_token of the previous page, the filters and sort must not change
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                   // defaults to the server page size, capped at the server maximum
+	IncludeTotal  *bool                  `protobuf:"varint,8,opt,name=include_total,json=includeTotal,proto3,oneof" json:"include_total,omitempty"` // counts every match, defaults to true for page numbers and false for page tokens
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1326,12 +1872,35 @@ func (x *GetInterviewHistoryRequest) GetFvr() bool {
 	return false
 }
 
+func (x *GetInterviewHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetInterviewHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetInterviewHistoryRequest) GetIncludeTotal() bool {
+	if x != nil && x.IncludeTotal != nil {
+		return *x.IncludeTotal
+	}
+	return false
+}
+
 type GetInterviewHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
 	TotalPages    int32                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
 	Interviews    []*InterviewSummary    `protobuf:"bytes,4,rep,name=interviews,proto3" json:"interviews,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	TotalCount    int32                  `protobuf:"varint,6,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1394,6 +1963,20 @@ func (x *GetInterviewHistoryResponse) GetInterviews() []*InterviewSummary {
 	return nil
 }
 
+func (x *GetInterviewHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetInterviewHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type InterviewSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
@@ -1524,6 +2107,8 @@ type AnswerResult struct {
 	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
 	Score         string                 `protobuf:"bytes,6,opt,name=score,proto3" json:"score,omitempty"` // "A", "B", "C", "D", "F"
 	Status        QuestionStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=irelia.QuestionStatus" json:"status,omitempty"`
+	SubIndex      int32                  `protobuf:"varint,8,opt,name=sub_index,json=subIndex,proto3" json:"sub_index,omitempty"`
+	FollowUps     []*AnswerResult        `protobuf:"bytes,9,rep,name=follow_ups,json=followUps,proto3" json:"follow_ups,omitempty"` // follow-up turns of a main question, in order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1607,6 +2192,20 @@ func (x *AnswerResult) GetStatus() QuestionStatus {
 	return QuestionStatus_QUESTION_STATUS_UNKNOWN
 }
 
+func (x *AnswerResult) GetSubIndex() int32 {
+	if x != nil {
+		return x.SubIndex
+	}
+	return 0
+}
+
+func (x *AnswerResult) GetFollowUps() []*AnswerResult {
+	if x != nil {
+		return x.FollowUps
+	}
+	return nil
+}
+
 type TotalScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	A             int32                  `protobuf:"varint,1,opt,name=A,proto3" json:"A,omitempty"`
@@ -1614,6 +2213,7 @@ type TotalScore struct {
 	C             int32                  `protobuf:"varint,3,opt,name=C,proto3" json:"C,omitempty"`
 	D             int32                  `protobuf:"varint,4,opt,name=D,proto3" json:"D,omitempty"`
 	F             int32                  `protobuf:"varint,5,opt,name=F,proto3" json:"F,omitempty"`
+	Skipped       int32                  `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"` // skipped questions, reported unless the skip policy excludes them
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1683,6 +2283,13 @@ func (x *TotalScore) GetF() int32 {
 	return 0
 }
 
+func (x *TotalScore) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
 type GetInterviewResponse struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	InterviewId        string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
@@ -1692,6 +2299,8 @@ type GetInterviewResponse struct {
 	PositiveFeedback   string                 `protobuf:"bytes,5,opt,name=positive_feedback,json=positiveFeedback,proto3" json:"positive_feedback,omitempty"`
 	ActionableFeedback string                 `protobuf:"bytes,6,opt,name=actionable_feedback,json=actionableFeedback,proto3" json:"actionable_feedback,omitempty"`
 	FinalComment       string                 `protobuf:"bytes,7,opt,name=final_comment,json=finalComment,proto3" json:"final_comment,omitempty"`
+	Status             InterviewStatus        `protobuf:"varint,8,opt,name=status,proto3,enum=irelia.InterviewStatus" json:"status,omitempty"`
+	FailureReason      string                 `protobuf:"bytes,9,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -1775,11 +2384,26 @@ func (x *GetInterviewResponse) GetFinalComment() string {
 	return ""
 }
 
+func (x *GetInterviewResponse) GetStatus() InterviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InterviewStatus_INTERVIEW_STATUS_UNKNOWN
+}
+
+func (x *GetInterviewResponse) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
 // 6. Generate Next Question
 type QaPair struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
 	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	Skipped       bool                   `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1828,6 +2452,13 @@ func (x *QaPair) GetAnswer() string {
 	return ""
 }
 
+func (x *QaPair) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
 type Context struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Position       string                 `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
@@ -1918,8 +2549,11 @@ type NextQuestionRequest struct {
 	Submissions        []*QaPair              `protobuf:"bytes,2,rep,name=submissions,proto3" json:"submissions,omitempty"`
 	Context            *Context               `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
 	RemainingQuestions int32                  `protobuf:"varint,4,opt,name=remaining_questions,json=remainingQuestions,proto3" json:"remaining_questions,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Admin endpoint only, cleared before the request reaches the question generator
+	QuestionIndex int32 `protobuf:"varint,5,opt,name=question_index,json=questionIndex,proto3" json:"question_index,omitempty"` // question to regenerate, required to persist
+	Persist       bool  `protobuf:"varint,6,opt,name=persist,proto3" json:"persist,omitempty"`                                  // replace the question with the first generated one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NextQuestionRequest) Reset() {
@@ -1980,6 +2614,20 @@ func (x *NextQuestionRequest) GetRemainingQuestions() int32 {
 	return 0
 }
 
+func (x *NextQuestionRequest) GetQuestionIndex() int32 {
+	if x != nil {
+		return x.QuestionIndex
+	}
+	return 0
+}
+
+func (x *NextQuestionRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
 type NextQuestionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Questions     []string               `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
@@ -2024,6 +2672,110 @@ func (x *NextQuestionResponse) GetQuestions() []string {
 	return nil
 }
 
+type FollowUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Context       *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Thread        []*QaPair              `protobuf:"bytes,3,rep,name=thread,proto3" json:"thread,omitempty"` // the main question and the follow-ups asked so far
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUpRequest) Reset() {
+	*x = FollowUpRequest{}
+	mi := &file_api_irelia_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUpRequest) ProtoMessage() {}
+
+func (x *FollowUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUpRequest.ProtoReflect.Descriptor instead.
+func (*FollowUpRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FollowUpRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *FollowUpRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *FollowUpRequest) GetThread() []*QaPair {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+type FollowUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUpResponse) Reset() {
+	*x = FollowUpResponse{}
+	mi := &file_api_irelia_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUpResponse) ProtoMessage() {}
+
+func (x *FollowUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUpResponse.ProtoReflect.Descriptor instead.
+func (*FollowUpResponse) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FollowUpResponse) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
 // 7. Favorite Interview
 type FavoriteInterviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2034,7 +2786,7 @@ type FavoriteInterviewRequest struct {
 
 func (x *FavoriteInterviewRequest) Reset() {
 	*x = FavoriteInterviewRequest{}
-	mi := &file_api_irelia_proto_msgTypes[24]
+	mi := &file_api_irelia_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2046,7 +2798,7 @@ func (x *FavoriteInterviewRequest) String() string {
 func (*FavoriteInterviewRequest) ProtoMessage() {}
 
 func (x *FavoriteInterviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[24]
+	mi := &file_api_irelia_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2059,7 +2811,7 @@ func (x *FavoriteInterviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FavoriteInterviewRequest.ProtoReflect.Descriptor instead.
 func (*FavoriteInterviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{24}
+	return file_api_irelia_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *FavoriteInterviewRequest) GetInterviewId() string {
@@ -2071,17 +2823,19 @@ func (x *FavoriteInterviewRequest) GetInterviewId() string {
 
 // 8. Score Interview
 type ScoreInterviewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
-	Submissions   []*AnswerData          `protobuf:"bytes,2,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	Skills        []string               `protobuf:"bytes,3,rep,name=skills,proto3" json:"skills,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Submissions []*AnswerData          `protobuf:"bytes,2,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	Skills      []string               `protobuf:"bytes,3,rep,name=skills,proto3" json:"skills,omitempty"`
+	// Admin endpoint only, cleared before the request reaches the scorer
+	Persist       bool `protobuf:"varint,4,opt,name=persist,proto3" json:"persist,omitempty"` // re-score the stored answers and save the results on the interview
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScoreInterviewRequest) Reset() {
 	*x = ScoreInterviewRequest{}
-	mi := &file_api_irelia_proto_msgTypes[25]
+	mi := &file_api_irelia_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2093,7 +2847,7 @@ func (x *ScoreInterviewRequest) String() string {
 func (*ScoreInterviewRequest) ProtoMessage() {}
 
 func (x *ScoreInterviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[25]
+	mi := &file_api_irelia_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2106,7 +2860,7 @@ func (x *ScoreInterviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoreInterviewRequest.ProtoReflect.Descriptor instead.
 func (*ScoreInterviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{25}
+	return file_api_irelia_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ScoreInterviewRequest) GetInterviewId() string {
@@ -2130,6 +2884,13 @@ func (x *ScoreInterviewRequest) GetSkills() []string {
 	return nil
 }
 
+func (x *ScoreInterviewRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
 type ScoreFluencyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
@@ -2140,7 +2901,7 @@ type ScoreFluencyRequest struct {
 
 func (x *ScoreFluencyRequest) Reset() {
 	*x = ScoreFluencyRequest{}
-	mi := &file_api_irelia_proto_msgTypes[26]
+	mi := &file_api_irelia_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2152,7 +2913,7 @@ func (x *ScoreFluencyRequest) String() string {
 func (*ScoreFluencyRequest) ProtoMessage() {}
 
 func (x *ScoreFluencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[26]
+	mi := &file_api_irelia_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,7 +2926,7 @@ func (x *ScoreFluencyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoreFluencyRequest.ProtoReflect.Descriptor instead.
 func (*ScoreFluencyRequest) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{26}
+	return file_api_irelia_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ScoreFluencyRequest) GetInterviewId() string {
@@ -2193,7 +2954,7 @@ type AnswerScore struct {
 
 func (x *AnswerScore) Reset() {
 	*x = AnswerScore{}
-	mi := &file_api_irelia_proto_msgTypes[27]
+	mi := &file_api_irelia_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2205,7 +2966,7 @@ func (x *AnswerScore) String() string {
 func (*AnswerScore) ProtoMessage() {}
 
 func (x *AnswerScore) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[27]
+	mi := &file_api_irelia_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +2979,7 @@ func (x *AnswerScore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnswerScore.ProtoReflect.Descriptor instead.
 func (*AnswerScore) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{27}
+	return file_api_irelia_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AnswerScore) GetIndex() int32 {
@@ -2252,7 +3013,7 @@ type SkillScore struct {
 
 func (x *SkillScore) Reset() {
 	*x = SkillScore{}
-	mi := &file_api_irelia_proto_msgTypes[28]
+	mi := &file_api_irelia_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2264,7 +3025,7 @@ func (x *SkillScore) String() string {
 func (*SkillScore) ProtoMessage() {}
 
 func (x *SkillScore) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[28]
+	mi := &file_api_irelia_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,7 +3038,7 @@ func (x *SkillScore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SkillScore.ProtoReflect.Descriptor instead.
 func (*SkillScore) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{28}
+	return file_api_irelia_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SkillScore) GetSkill() string {
@@ -2308,7 +3069,7 @@ type ScoreInterviewResponse struct {
 
 func (x *ScoreInterviewResponse) Reset() {
 	*x = ScoreInterviewResponse{}
-	mi := &file_api_irelia_proto_msgTypes[29]
+	mi := &file_api_irelia_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2320,7 +3081,7 @@ func (x *ScoreInterviewResponse) String() string {
 func (*ScoreInterviewResponse) ProtoMessage() {}
 
 func (x *ScoreInterviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[29]
+	mi := &file_api_irelia_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2333,7 +3094,7 @@ func (x *ScoreInterviewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoreInterviewResponse.ProtoReflect.Descriptor instead.
 func (*ScoreInterviewResponse) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{29}
+	return file_api_irelia_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ScoreInterviewResponse) GetResult() []*AnswerScore {
@@ -2389,7 +3150,7 @@ type ScoreFluencyResponse struct {
 
 func (x *ScoreFluencyResponse) Reset() {
 	*x = ScoreFluencyResponse{}
-	mi := &file_api_irelia_proto_msgTypes[30]
+	mi := &file_api_irelia_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2401,7 +3162,7 @@ func (x *ScoreFluencyResponse) String() string {
 func (*ScoreFluencyResponse) ProtoMessage() {}
 
 func (x *ScoreFluencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[30]
+	mi := &file_api_irelia_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,7 +3175,7 @@ func (x *ScoreFluencyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoreFluencyResponse.ProtoReflect.Descriptor instead.
 func (*ScoreFluencyResponse) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{30}
+	return file_api_irelia_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ScoreFluencyResponse) GetResult() []*AnswerScore {
@@ -2440,18 +3201,22 @@ func (x *ScoreFluencyResponse) GetActionableFeedback() string {
 
 // 9. Generate Lip Sync
 type LipSyncRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	VoiceId       string                 `protobuf:"bytes,3,opt,name=voice_id,json=voiceId,proto3" json:"voice_id,omitempty"`
-	Speed         int32                  `protobuf:"varint,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Content     string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	VoiceId     string                 `protobuf:"bytes,3,opt,name=voice_id,json=voiceId,proto3" json:"voice_id,omitempty"`
+	Speed       int32                  `protobuf:"varint,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Admin endpoint only, cleared before the request reaches the lip-sync backend
+	QuestionIndex int32 `protobuf:"varint,5,opt,name=question_index,json=questionIndex,proto3" json:"question_index,omitempty"` // question to re-render when content is empty, required to persist
+	SubIndex      int32 `protobuf:"varint,6,opt,name=sub_index,json=subIndex,proto3" json:"sub_index,omitempty"`
+	Persist       bool  `protobuf:"varint,7,opt,name=persist,proto3" json:"persist,omitempty"` // store the rendered audio on the question
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LipSyncRequest) Reset() {
 	*x = LipSyncRequest{}
-	mi := &file_api_irelia_proto_msgTypes[31]
+	mi := &file_api_irelia_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2463,7 +3228,7 @@ func (x *LipSyncRequest) String() string {
 func (*LipSyncRequest) ProtoMessage() {}
 
 func (x *LipSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[31]
+	mi := &file_api_irelia_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2476,7 +3241,7 @@ func (x *LipSyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LipSyncRequest.ProtoReflect.Descriptor instead.
 func (*LipSyncRequest) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{31}
+	return file_api_irelia_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *LipSyncRequest) GetInterviewId() string {
@@ -2507,17 +3272,40 @@ func (x *LipSyncRequest) GetSpeed() int32 {
 	return 0
 }
 
+func (x *LipSyncRequest) GetQuestionIndex() int32 {
+	if x != nil {
+		return x.QuestionIndex
+	}
+	return 0
+}
+
+func (x *LipSyncRequest) GetSubIndex() int32 {
+	if x != nil {
+		return x.SubIndex
+	}
+	return 0
+}
+
+func (x *LipSyncRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
 type LipSyncResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Audio         string                 `protobuf:"bytes,1,opt,name=audio,proto3" json:"audio,omitempty"`
 	Lipsync       *LipSyncData           `protobuf:"bytes,2,opt,name=lipsync,proto3" json:"lipsync,omitempty"`
+	AudioKey      string                 `protobuf:"bytes,3,opt,name=audio_key,json=audioKey,proto3" json:"audio_key,omitempty"` // blob store reference replacing the inline audio
+	AudioUrl      string                 `protobuf:"bytes,4,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LipSyncResponse) Reset() {
 	*x = LipSyncResponse{}
-	mi := &file_api_irelia_proto_msgTypes[32]
+	mi := &file_api_irelia_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2529,7 +3317,7 @@ func (x *LipSyncResponse) String() string {
 func (*LipSyncResponse) ProtoMessage() {}
 
 func (x *LipSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[32]
+	mi := &file_api_irelia_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,7 +3330,7 @@ func (x *LipSyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LipSyncResponse.ProtoReflect.Descriptor instead.
 func (*LipSyncResponse) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{32}
+	return file_api_irelia_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *LipSyncResponse) GetAudio() string {
@@ -2559,6 +3347,20 @@ func (x *LipSyncResponse) GetLipsync() *LipSyncData {
 	return nil
 }
 
+func (x *LipSyncResponse) GetAudioKey() string {
+	if x != nil {
+		return x.AudioKey
+	}
+	return ""
+}
+
+func (x *LipSyncResponse) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
 type LipSyncData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metadata      *LipSyncMetadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -2569,7 +3371,7 @@ type LipSyncData struct {
 
 func (x *LipSyncData) Reset() {
 	*x = LipSyncData{}
-	mi := &file_api_irelia_proto_msgTypes[33]
+	mi := &file_api_irelia_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2581,7 +3383,7 @@ func (x *LipSyncData) String() string {
 func (*LipSyncData) ProtoMessage() {}
 
 func (x *LipSyncData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[33]
+	mi := &file_api_irelia_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2594,7 +3396,7 @@ func (x *LipSyncData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LipSyncData.ProtoReflect.Descriptor instead.
 func (*LipSyncData) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{33}
+	return file_api_irelia_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *LipSyncData) GetMetadata() *LipSyncMetadata {
@@ -2621,7 +3423,7 @@ type LipSyncMetadata struct {
 
 func (x *LipSyncMetadata) Reset() {
 	*x = LipSyncMetadata{}
-	mi := &file_api_irelia_proto_msgTypes[34]
+	mi := &file_api_irelia_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2633,7 +3435,7 @@ func (x *LipSyncMetadata) String() string {
 func (*LipSyncMetadata) ProtoMessage() {}
 
 func (x *LipSyncMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[34]
+	mi := &file_api_irelia_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2646,7 +3448,7 @@ func (x *LipSyncMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LipSyncMetadata.ProtoReflect.Descriptor instead.
 func (*LipSyncMetadata) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{34}
+	return file_api_irelia_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *LipSyncMetadata) GetSoundFile() string {
@@ -2674,7 +3476,7 @@ type MouthCue struct {
 
 func (x *MouthCue) Reset() {
 	*x = MouthCue{}
-	mi := &file_api_irelia_proto_msgTypes[35]
+	mi := &file_api_irelia_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2686,7 +3488,7 @@ func (x *MouthCue) String() string {
 func (*MouthCue) ProtoMessage() {}
 
 func (x *MouthCue) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[35]
+	mi := &file_api_irelia_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,7 +3501,7 @@ func (x *MouthCue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MouthCue.ProtoReflect.Descriptor instead.
 func (*MouthCue) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{35}
+	return file_api_irelia_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *MouthCue) GetStart() float32 {
@@ -2733,7 +3535,7 @@ type DemoRequest struct {
 
 func (x *DemoRequest) Reset() {
 	*x = DemoRequest{}
-	mi := &file_api_irelia_proto_msgTypes[36]
+	mi := &file_api_irelia_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2745,7 +3547,7 @@ func (x *DemoRequest) String() string {
 func (*DemoRequest) ProtoMessage() {}
 
 func (x *DemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[36]
+	mi := &file_api_irelia_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2758,7 +3560,7 @@ func (x *DemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DemoRequest.ProtoReflect.Descriptor instead.
 func (*DemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{36}
+	return file_api_irelia_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DemoRequest) GetTopic() string {
@@ -2779,7 +3581,7 @@ type DemoQuestion struct {
 
 func (x *DemoQuestion) Reset() {
 	*x = DemoQuestion{}
-	mi := &file_api_irelia_proto_msgTypes[37]
+	mi := &file_api_irelia_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2791,7 +3593,7 @@ func (x *DemoQuestion) String() string {
 func (*DemoQuestion) ProtoMessage() {}
 
 func (x *DemoQuestion) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[37]
+	mi := &file_api_irelia_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2804,7 +3606,7 @@ func (x *DemoQuestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DemoQuestion.ProtoReflect.Descriptor instead.
 func (*DemoQuestion) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{37}
+	return file_api_irelia_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DemoQuestion) GetContent() string {
@@ -2837,7 +3639,7 @@ type DemoResponse struct {
 
 func (x *DemoResponse) Reset() {
 	*x = DemoResponse{}
-	mi := &file_api_irelia_proto_msgTypes[38]
+	mi := &file_api_irelia_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2849,7 +3651,7 @@ func (x *DemoResponse) String() string {
 func (*DemoResponse) ProtoMessage() {}
 
 func (x *DemoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[38]
+	mi := &file_api_irelia_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2862,7 +3664,7 @@ func (x *DemoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DemoResponse.ProtoReflect.Descriptor instead.
 func (*DemoResponse) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{38}
+	return file_api_irelia_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DemoResponse) GetQuestions() []*QuestionResponse {
@@ -2874,18 +3676,22 @@ func (x *DemoResponse) GetQuestions() []*QuestionResponse {
 
 // 11. Get Public Questions
 type GetPublicQuestionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Pos           *string                `protobuf:"bytes,2,opt,name=pos,proto3,oneof" json:"pos,omitempty"`
-	Exp           *string                `protobuf:"bytes,3,opt,name=exp,proto3,oneof" json:"exp,omitempty"`
-	Lang          *string                `protobuf:"bytes,4,opt,name=lang,proto3,oneof" json:"lang,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // ignored when page_token is set
+	Pos            *string                `protobuf:"bytes,2,opt,name=pos,proto3,oneof" json:"pos,omitempty"`
+	Exp            *string                `protobuf:"bytes,3,opt,name=exp,proto3,oneof" json:"exp,omitempty"`
+	Lang           *string                `protobuf:"bytes,4,opt,name=lang,proto3,oneof" json:"lang,omitempty"`
+	PageToken      string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize       int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeTotal   *bool                  `protobuf:"varint,7,opt,name=include_total,json=includeTotal,proto3,oneof" json:"include_total,omitempty"`
+	IncludeAnswers bool                   `protobuf:"varint,8,opt,name=include_answers,json=includeAnswers,proto3" json:"include_answers,omitempty"` // model answers are hidden unless asked for
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetPublicQuestionRequest) Reset() {
 	*x = GetPublicQuestionRequest{}
-	mi := &file_api_irelia_proto_msgTypes[39]
+	mi := &file_api_irelia_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2897,7 +3703,7 @@ func (x *GetPublicQuestionRequest) String() string {
 func (*GetPublicQuestionRequest) ProtoMessage() {}
 
 func (x *GetPublicQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[39]
+	mi := &file_api_irelia_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2910,7 +3716,7 @@ func (x *GetPublicQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPublicQuestionRequest.ProtoReflect.Descriptor instead.
 func (*GetPublicQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{39}
+	return file_api_irelia_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetPublicQuestionRequest) GetPage() int32 {
@@ -2941,6 +3747,34 @@ func (x *GetPublicQuestionRequest) GetLang() string {
 	return ""
 }
 
+func (x *GetPublicQuestionRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetPublicQuestionRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPublicQuestionRequest) GetIncludeTotal() bool {
+	if x != nil && x.IncludeTotal != nil {
+		return *x.IncludeTotal
+	}
+	return false
+}
+
+func (x *GetPublicQuestionRequest) GetIncludeAnswers() bool {
+	if x != nil {
+		return x.IncludeAnswers
+	}
+	return false
+}
+
 type GetPublicQuestionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
@@ -2948,13 +3782,14 @@ type GetPublicQuestionResponse struct {
 	TotalPages    int32                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
 	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	Questions     []*PublicQuestion      `protobuf:"bytes,5,rep,name=questions,proto3" json:"questions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPublicQuestionResponse) Reset() {
 	*x = GetPublicQuestionResponse{}
-	mi := &file_api_irelia_proto_msgTypes[40]
+	mi := &file_api_irelia_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2966,7 +3801,7 @@ func (x *GetPublicQuestionResponse) String() string {
 func (*GetPublicQuestionResponse) ProtoMessage() {}
 
 func (x *GetPublicQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_irelia_proto_msgTypes[40]
+	mi := &file_api_irelia_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2979,7 +3814,7 @@ func (x *GetPublicQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPublicQuestionResponse.ProtoReflect.Descriptor instead.
 func (*GetPublicQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_api_irelia_proto_rawDescGZIP(), []int{40}
+	return file_api_irelia_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetPublicQuestionResponse) GetPage() int32 {
@@ -3017,166 +3852,1690 @@ func (x *GetPublicQuestionResponse) GetQuestions() []*PublicQuestion {
 	return nil
 }
 
-var File_api_irelia_proto protoreflect.FileDescriptor
+func (x *GetPublicQuestionResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-const file_api_irelia_proto_rawDesc = "" +
-	"\n" +
-	"\x10api/irelia.proto\x12\x06irelia\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x80\x01\n" +
-	"\bBaseData\x129\n" +
-	"\n" +
-	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x93\x05\n" +
-	"\tInterview\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\bposition\x18\x02 \x01(\tR\bposition\x12\x1e\n" +
-	"\n" +
-	"experience\x18\x03 \x01(\tR\n" +
-	"experience\x12\x1a\n" +
-	"\blanguage\x18\x04 \x01(\tR\blanguage\x12\x19\n" +
-	"\bvoice_id\x18\x05 \x01(\tR\avoiceId\x12\x14\n" +
-	"\x05speed\x18\x06 \x01(\x05R\x05speed\x12\x16\n" +
-	"\x06skills\x18\a \x03(\tR\x06skills\x12!\n" +
-	"\fskills_score\x18\b \x03(\tR\vskillsScore\x12\x1b\n" +
-	"\tskip_code\x18\t \x01(\bR\bskipCode\x12'\n" +
-	"\x0ftotal_questions\x18\n" +
-	" \x01(\x05R\x0etotalQuestions\x12/\n" +
-	"\x13remaining_questions\x18\v \x01(\x05R\x12remainingQuestions\x123\n" +
-	"\vtotal_score\x18\f \x01(\v2\x12.irelia.TotalScoreR\n" +
-	"totalScore\x12#\n" +
-	"\roverall_score\x18\r \x01(\x02R\foverallScore\x12+\n" +
-	"\x11positive_feedback\x18\x0e \x01(\tR\x10positiveFeedback\x12/\n" +
-	"\x13actionable_feedback\x18\x0f \x01(\tR\x12actionableFeedback\x12#\n" +
-	"\rfinal_comment\x18\x10 \x01(\tR\ffinalComment\x12/\n" +
-	"\x06status\x18\x11 \x01(\x0e2\x17.irelia.InterviewStatusR\x06status\x12-\n" +
-	"\tbase_data\x18\x12 \x01(\v2\x10.irelia.BaseDataR\bbaseData\"\xec\x02\n" +
-	"\bQuestion\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x14\n" +
-	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x14\n" +
-	"\x05audio\x18\x04 \x01(\tR\x05audio\x12-\n" +
-	"\alipsync\x18\x05 \x01(\v2\x13.irelia.LipSyncDataR\alipsync\x12\x16\n" +
-	"\x06answer\x18\x06 \x01(\tR\x06answer\x12!\n" +
-	"\frecord_proof\x18\a \x01(\tR\vrecordProof\x12\x18\n" +
-	"\acomment\x18\b \x01(\tR\acomment\x12\x14\n" +
-	"\x05score\x18\t \x01(\tR\x05score\x12.\n" +
-	"\x06status\x18\n" +
-	" \x01(\x0e2\x16.irelia.QuestionStatusR\x06status\x12-\n" +
-	"\tbase_data\x18\v \x01(\v2\x10.irelia.BaseDataR\bbaseData\"\xbd\x01\n" +
-	"\x0ePublicQuestion\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1b\n" +
-	"\x06answer\x18\x02 \x01(\tH\x00R\x06answer\x88\x01\x01\x12\x1a\n" +
-	"\bposition\x18\x03 \x01(\tR\bposition\x12\x1e\n" +
-	"\n" +
-	"experience\x18\x04 \x01(\tR\n" +
-	"experience\x12-\n" +
-	"\tbase_data\x18\x05 \x01(\v2\x10.irelia.BaseDataR\bbaseDataB\t\n" +
-	"\a_answer\"\x9a\x02\n" +
-	"\x15StartInterviewRequest\x12\x1a\n" +
-	"\bposition\x18\x01 \x01(\tR\bposition\x12\x1e\n" +
-	"\n" +
-	"experience\x18\x02 \x01(\tR\n" +
-	"experience\x12\x1a\n" +
-	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x16\n" +
-	"\x06models\x18\x04 \x01(\tR\x06models\x12\x14\n" +
-	"\x05speed\x18\x05 \x01(\x05R\x05speed\x12\x16\n" +
-	"\x06skills\x18\x06 \x03(\tR\x06skills\x12'\n" +
-	"\x0ftotal_questions\x18\a \x01(\x05R\x0etotalQuestions\x12\x1d\n" +
-	"\n" +
-	"skip_intro\x18\b \x01(\bR\tskipIntro\x12\x1b\n" +
-	"\tskip_code\x18\t \x01(\bR\bskipCode\";\n" +
-	"\x16StartInterviewResponse\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"[\n" +
-	"\x0fQuestionRequest\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12%\n" +
-	"\x0equestion_index\x18\x02 \x01(\x05R\rquestionIndex\"\xf9\x01\n" +
-	"\x10QuestionResponse\x12\x1f\n" +
-	"\vquestion_id\x18\x01 \x01(\x05R\n" +
-	"questionId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\x14\n" +
-	"\x05audio\x18\x03 \x01(\tR\x05audio\x12-\n" +
-	"\alipsync\x18\x04 \x01(\v2\x13.irelia.LipSyncDataR\alipsync\x12(\n" +
-	"\x10is_last_question\x18\x05 \x01(\bR\x0eisLastQuestion\x12\x1d\n" +
-	"\n" +
-	"is_loading\x18\x06 \x01(\bR\tisLoading\x12\x1c\n" +
-	"\ttimestamp\x18\a \x01(\x03R\ttimestamp\"\x89\x01\n" +
-	"\x13SubmitAnswerRequest\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x14\n" +
-	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x16\n" +
-	"\x06answer\x18\x03 \x01(\tR\x06answer\x12!\n" +
-	"\frecord_proof\x18\x04 \x01(\tR\vrecordProof\"0\n" +
-	"\x14SubmitAnswerResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\";\n" +
-	"\x16SubmitInterviewRequest\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"H\n" +
-	"\x17SubmitInterviewResponse\x12-\n" +
-	"\x05outro\x18\x01 \x01(\v2\x17.irelia.LipSyncResponseR\x05outro\"\xa1\x01\n" +
-	"\n" +
-	"AnswerData\x12\x14\n" +
-	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
-	"\x06answer\x18\x02 \x01(\tR\x06answer\x12&\n" +
-	"\frecord_proof\x18\x03 \x01(\tH\x00R\vrecordProof\x88\x01\x01\x12\x1f\n" +
-	"\bquestion\x18\x04 \x01(\tH\x01R\bquestion\x88\x01\x01B\x0f\n" +
-	"\r_record_proofB\v\n" +
-	"\t_question\"\xc1\x01\n" +
-	"\x1aGetInterviewHistoryRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12/\n" +
-	"\x04sort\x18\x02 \x01(\x0e2\x1b.irelia.InterviewSortMethodR\x04sort\x12\x19\n" +
-	"\x05query\x18\x03 \x01(\tH\x00R\x05query\x88\x01\x01\x12\x13\n" +
-	"\x02en\x18\x04 \x01(\bH\x01R\x02en\x88\x01\x01\x12\x15\n" +
-	"\x03fvr\x18\x05 \x01(\bH\x02R\x03fvr\x88\x01\x01B\b\n" +
-	"\x06_queryB\x05\n" +
-	"\x03_enB\x06\n" +
-	"\x04_fvr\"\xa7\x01\n" +
-	"\x1bGetInterviewHistoryResponse\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1f\n" +
-	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
-	"totalPages\x128\n" +
-	"\n" +
-	"interviews\x18\x04 \x03(\v2\x18.irelia.InterviewSummaryR\n" +
-	"interviews\"\xd5\x01\n" +
-	"\x10InterviewSummary\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x1a\n" +
-	"\bposition\x18\x02 \x01(\tR\bposition\x12\x1e\n" +
-	"\n" +
-	"experience\x18\x03 \x01(\tR\n" +
-	"experience\x123\n" +
-	"\vtotal_score\x18\x04 \x01(\v2\x12.irelia.TotalScoreR\n" +
-	"totalScore\x12-\n" +
-	"\tbase_data\x18\x05 \x01(\v2\x10.irelia.BaseDataR\bbaseData\"8\n" +
-	"\x13GetInterviewRequest\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"\xd9\x01\n" +
-	"\fAnswerResult\x12\x14\n" +
-	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\x16\n" +
-	"\x06answer\x18\x03 \x01(\tR\x06answer\x12!\n" +
-	"\frecord_proof\x18\x04 \x01(\tR\vrecordProof\x12\x18\n" +
-	"\acomment\x18\x05 \x01(\tR\acomment\x12\x14\n" +
-	"\x05score\x18\x06 \x01(\tR\x05score\x12.\n" +
-	"\x06status\x18\a \x01(\x0e2\x16.irelia.QuestionStatusR\x06status\"R\n" +
-	"\n" +
-	"TotalScore\x12\f\n" +
-	"\x01A\x18\x01 \x01(\x05R\x01A\x12\f\n" +
-	"\x01B\x18\x02 \x01(\x05R\x01B\x12\f\n" +
-	"\x01C\x18\x03 \x01(\x05R\x01C\x12\f\n" +
-	"\x01D\x18\x04 \x01(\x05R\x01D\x12\f\n" +
-	"\x01F\x18\x05 \x01(\x05R\x01F\"\xbb\x03\n" +
-	"\x14GetInterviewResponse\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x126\n" +
-	"\vsubmissions\x18\x02 \x03(\v2\x14.irelia.AnswerResultR\vsubmissions\x12P\n" +
-	"\fskills_score\x18\x03 \x03(\v2-.irelia.GetInterviewResponse.SkillsScoreEntryR\vskillsScore\x123\n" +
-	"\vtotal_score\x18\x04 \x01(\v2\x12.irelia.TotalScoreR\n" +
-	"totalScore\x12+\n" +
-	"\x11positive_feedback\x18\x05 \x01(\tR\x10positiveFeedback\x12/\n" +
-	"\x13actionable_feedback\x18\x06 \x01(\tR\x12actionableFeedback\x12#\n" +
-	"\rfinal_comment\x18\a \x01(\tR\ffinalComment\x1a>\n" +
+// 12. Stream Interview
+type StreamInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	FromIndex     int32                  `protobuf:"varint,2,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamInterviewRequest) Reset() {
+	*x = StreamInterviewRequest{}
+	mi := &file_api_irelia_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInterviewRequest) ProtoMessage() {}
+
+func (x *StreamInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInterviewRequest.ProtoReflect.Descriptor instead.
+func (*StreamInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StreamInterviewRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *StreamInterviewRequest) GetFromIndex() int32 {
+	if x != nil {
+		return x.FromIndex
+	}
+	return 0
+}
+
+type InterviewEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Type          InterviewEventType     `protobuf:"varint,2,opt,name=type,proto3,enum=irelia.InterviewEventType" json:"type,omitempty"`
+	QuestionIndex int32                  `protobuf:"varint,3,opt,name=question_index,json=questionIndex,proto3" json:"question_index,omitempty"`
+	Question      *QuestionResponse      `protobuf:"bytes,4,opt,name=question,proto3" json:"question,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SubIndex      int32                  `protobuf:"varint,7,opt,name=sub_index,json=subIndex,proto3" json:"sub_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterviewEvent) Reset() {
+	*x = InterviewEvent{}
+	mi := &file_api_irelia_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterviewEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterviewEvent) ProtoMessage() {}
+
+func (x *InterviewEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterviewEvent.ProtoReflect.Descriptor instead.
+func (*InterviewEvent) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *InterviewEvent) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *InterviewEvent) GetType() InterviewEventType {
+	if x != nil {
+		return x.Type
+	}
+	return InterviewEventType_INTERVIEW_EVENT_UNKNOWN
+}
+
+func (x *InterviewEvent) GetQuestionIndex() int32 {
+	if x != nil {
+		return x.QuestionIndex
+	}
+	return 0
+}
+
+func (x *InterviewEvent) GetQuestion() *QuestionResponse {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *InterviewEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InterviewEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *InterviewEvent) GetSubIndex() int32 {
+	if x != nil {
+		return x.SubIndex
+	}
+	return 0
+}
+
+// 13. Resume Interview
+type ResumeInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeInterviewRequest) Reset() {
+	*x = ResumeInterviewRequest{}
+	mi := &file_api_irelia_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeInterviewRequest) ProtoMessage() {}
+
+func (x *ResumeInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeInterviewRequest.ProtoReflect.Descriptor instead.
+func (*ResumeInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResumeInterviewRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+type ResumeInterviewResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId       string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Status            InterviewStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=irelia.InterviewStatus" json:"status,omitempty"`
+	NextQuestionIndex int32                  `protobuf:"varint,3,opt,name=next_question_index,json=nextQuestionIndex,proto3" json:"next_question_index,omitempty"`
+	TotalQuestions    int32                  `protobuf:"varint,4,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
+	AnsweredQuestions int32                  `protobuf:"varint,5,opt,name=answered_questions,json=answeredQuestions,proto3" json:"answered_questions,omitempty"`
+	IsResumable       bool                   `protobuf:"varint,6,opt,name=is_resumable,json=isResumable,proto3" json:"is_resumable,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResumeInterviewResponse) Reset() {
+	*x = ResumeInterviewResponse{}
+	mi := &file_api_irelia_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeInterviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeInterviewResponse) ProtoMessage() {}
+
+func (x *ResumeInterviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeInterviewResponse.ProtoReflect.Descriptor instead.
+func (*ResumeInterviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ResumeInterviewResponse) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *ResumeInterviewResponse) GetStatus() InterviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InterviewStatus_INTERVIEW_STATUS_UNKNOWN
+}
+
+func (x *ResumeInterviewResponse) GetNextQuestionIndex() int32 {
+	if x != nil {
+		return x.NextQuestionIndex
+	}
+	return 0
+}
+
+func (x *ResumeInterviewResponse) GetTotalQuestions() int32 {
+	if x != nil {
+		return x.TotalQuestions
+	}
+	return 0
+}
+
+func (x *ResumeInterviewResponse) GetAnsweredQuestions() int32 {
+	if x != nil {
+		return x.AnsweredQuestions
+	}
+	return 0
+}
+
+func (x *ResumeInterviewResponse) GetIsResumable() bool {
+	if x != nil {
+		return x.IsResumable
+	}
+	return false
+}
+
+// 14. Abandon Interview
+type AbandonInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbandonInterviewRequest) Reset() {
+	*x = AbandonInterviewRequest{}
+	mi := &file_api_irelia_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbandonInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbandonInterviewRequest) ProtoMessage() {}
+
+func (x *AbandonInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbandonInterviewRequest.ProtoReflect.Descriptor instead.
+func (*AbandonInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AbandonInterviewRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+// 15. Retry Scoring
+type RetryScoringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryScoringRequest) Reset() {
+	*x = RetryScoringRequest{}
+	mi := &file_api_irelia_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryScoringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryScoringRequest) ProtoMessage() {}
+
+func (x *RetryScoringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryScoringRequest.ProtoReflect.Descriptor instead.
+func (*RetryScoringRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RetryScoringRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+type RetryScoringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        InterviewStatus        `protobuf:"varint,1,opt,name=status,proto3,enum=irelia.InterviewStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryScoringResponse) Reset() {
+	*x = RetryScoringResponse{}
+	mi := &file_api_irelia_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryScoringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryScoringResponse) ProtoMessage() {}
+
+func (x *RetryScoringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryScoringResponse.ProtoReflect.Descriptor instead.
+func (*RetryScoringResponse) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RetryScoringResponse) GetStatus() InterviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InterviewStatus_INTERVIEW_STATUS_UNKNOWN
+}
+
+// 16. Skip Question
+type SkipQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	SubIndex      int32                  `protobuf:"varint,3,opt,name=sub_index,json=subIndex,proto3" json:"sub_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipQuestionRequest) Reset() {
+	*x = SkipQuestionRequest{}
+	mi := &file_api_irelia_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipQuestionRequest) ProtoMessage() {}
+
+func (x *SkipQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipQuestionRequest.ProtoReflect.Descriptor instead.
+func (*SkipQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SkipQuestionRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *SkipQuestionRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SkipQuestionRequest) GetSubIndex() int32 {
+	if x != nil {
+		return x.SubIndex
+	}
+	return 0
+}
+
+type SkipQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipQuestionResponse) Reset() {
+	*x = SkipQuestionResponse{}
+	mi := &file_api_irelia_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipQuestionResponse) ProtoMessage() {}
+
+func (x *SkipQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipQuestionResponse.ProtoReflect.Descriptor instead.
+func (*SkipQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SkipQuestionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 17. Usage
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // only admins may read another user's usage, defaults to the caller
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`                   // days of upstream calls to aggregate, defaults to 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_api_irelia_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetUsageRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUsageRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type UsageQuota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Used          int32                  `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`   // since the start of the day (UTC)
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 is unlimited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageQuota) Reset() {
+	*x = UsageQuota{}
+	mi := &file_api_irelia_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageQuota) ProtoMessage() {}
+
+func (x *UsageQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageQuota.ProtoReflect.Descriptor instead.
+func (*UsageQuota) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UsageQuota) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *UsageQuota) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type OperationUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Upstream       string                 `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`   // darius, karma or local
+	Operation      string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"` // generate, follow_up, score, fluency or lip_sync
+	Calls          int64                  `protobuf:"varint,3,opt,name=calls,proto3" json:"calls,omitempty"`
+	Failures       int64                  `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	TotalLatencyMs int64                  `protobuf:"varint,5,opt,name=total_latency_ms,json=totalLatencyMs,proto3" json:"total_latency_ms,omitempty"`
+	RequestBytes   int64                  `protobuf:"varint,6,opt,name=request_bytes,json=requestBytes,proto3" json:"request_bytes,omitempty"`
+	ResponseBytes  int64                  `protobuf:"varint,7,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OperationUsage) Reset() {
+	*x = OperationUsage{}
+	mi := &file_api_irelia_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationUsage) ProtoMessage() {}
+
+func (x *OperationUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationUsage.ProtoReflect.Descriptor instead.
+func (*OperationUsage) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *OperationUsage) GetUpstream() string {
+	if x != nil {
+		return x.Upstream
+	}
+	return ""
+}
+
+func (x *OperationUsage) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *OperationUsage) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *OperationUsage) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *OperationUsage) GetTotalLatencyMs() int64 {
+	if x != nil {
+		return x.TotalLatencyMs
+	}
+	return 0
+}
+
+func (x *OperationUsage) GetRequestBytes() int64 {
+	if x != nil {
+		return x.RequestBytes
+	}
+	return 0
+}
+
+func (x *OperationUsage) GetResponseBytes() int64 {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return 0
+}
+
+type GetUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Interviews    *UsageQuota            `protobuf:"bytes,2,opt,name=interviews,proto3" json:"interviews,omitempty"`
+	Questions     *UsageQuota            `protobuf:"bytes,3,opt,name=questions,proto3" json:"questions,omitempty"`
+	Operations    []*OperationUsage      `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_api_irelia_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetUsageResponse) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetInterviews() *UsageQuota {
+	if x != nil {
+		return x.Interviews
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetQuestions() *UsageQuota {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetOperations() []*OperationUsage {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// 18. Delete Interview
+type DeleteInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInterviewRequest) Reset() {
+	*x = DeleteInterviewRequest{}
+	mi := &file_api_irelia_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInterviewRequest) ProtoMessage() {}
+
+func (x *DeleteInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInterviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteInterviewRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+// 19. Export Interview
+type ExportInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=irelia.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportInterviewRequest) Reset() {
+	*x = ExportInterviewRequest{}
+	mi := &file_api_irelia_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInterviewRequest) ProtoMessage() {}
+
+func (x *ExportInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInterviewRequest.ProtoReflect.Descriptor instead.
+func (*ExportInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportInterviewRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *ExportInterviewRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+// 20. Public Question Moderation
+type ListModerationQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ModerationStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=irelia.ModerationStatus" json:"status,omitempty"` // defaults to pending
+	Pos           *string                `protobuf:"bytes,2,opt,name=pos,proto3,oneof" json:"pos,omitempty"`
+	Lang          *string                `protobuf:"bytes,3,opt,name=lang,proto3,oneof" json:"lang,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeTotal  *bool                  `protobuf:"varint,6,opt,name=include_total,json=includeTotal,proto3,oneof" json:"include_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueRequest) Reset() {
+	*x = ListModerationQueueRequest{}
+	mi := &file_api_irelia_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListModerationQueueRequest) GetStatus() ModerationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ModerationStatus_MODERATION_STATUS_UNKNOWN
+}
+
+func (x *ListModerationQueueRequest) GetPos() string {
+	if x != nil && x.Pos != nil {
+		return *x.Pos
+	}
+	return ""
+}
+
+func (x *ListModerationQueueRequest) GetLang() string {
+	if x != nil && x.Lang != nil {
+		return *x.Lang
+	}
+	return ""
+}
+
+func (x *ListModerationQueueRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListModerationQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModerationQueueRequest) GetIncludeTotal() bool {
+	if x != nil && x.IncludeTotal != nil {
+		return *x.IncludeTotal
+	}
+	return false
+}
+
+type ListModerationQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*PublicQuestion      `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueResponse) Reset() {
+	*x = ListModerationQueueResponse{}
+	mi := &file_api_irelia_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueResponse) ProtoMessage() {}
+
+func (x *ListModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListModerationQueueResponse) GetQuestions() []*PublicQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ListModerationQueueResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListModerationQueueResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ModeratePublicQuestionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action ModerationAction       `protobuf:"varint,2,opt,name=action,proto3,enum=irelia.ModerationAction" json:"action,omitempty"`
+	// Edit replaces the fields that are set
+	Content       *string `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Answer        *string `protobuf:"bytes,4,opt,name=answer,proto3,oneof" json:"answer,omitempty"`
+	Position      *string `protobuf:"bytes,5,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	Experience    *string `protobuf:"bytes,6,opt,name=experience,proto3,oneof" json:"experience,omitempty"`
+	MergeIntoId   int32   `protobuf:"varint,7,opt,name=merge_into_id,json=mergeIntoId,proto3" json:"merge_into_id,omitempty"` // the entry a merge folds this one into
+	Note          string  `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`                                     // reason kept in the audit trail
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModeratePublicQuestionRequest) Reset() {
+	*x = ModeratePublicQuestionRequest{}
+	mi := &file_api_irelia_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModeratePublicQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModeratePublicQuestionRequest) ProtoMessage() {}
+
+func (x *ModeratePublicQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModeratePublicQuestionRequest.ProtoReflect.Descriptor instead.
+func (*ModeratePublicQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ModeratePublicQuestionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModeratePublicQuestionRequest) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_MODERATION_ACTION_UNKNOWN
+}
+
+func (x *ModeratePublicQuestionRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *ModeratePublicQuestionRequest) GetAnswer() string {
+	if x != nil && x.Answer != nil {
+		return *x.Answer
+	}
+	return ""
+}
+
+func (x *ModeratePublicQuestionRequest) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+func (x *ModeratePublicQuestionRequest) GetExperience() string {
+	if x != nil && x.Experience != nil {
+		return *x.Experience
+	}
+	return ""
+}
+
+func (x *ModeratePublicQuestionRequest) GetMergeIntoId() int32 {
+	if x != nil {
+		return x.MergeIntoId
+	}
+	return 0
+}
+
+func (x *ModeratePublicQuestionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ListModerationAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationAuditRequest) Reset() {
+	*x = ListModerationAuditRequest{}
+	mi := &file_api_irelia_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationAuditRequest) ProtoMessage() {}
+
+func (x *ListModerationAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationAuditRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationAuditRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListModerationAuditRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ModerationAuditEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId      int32                  `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ModeratorId     uint64                 `protobuf:"varint,3,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Action          ModerationAction       `protobuf:"varint,4,opt,name=action,proto3,enum=irelia.ModerationAction" json:"action,omitempty"`
+	PreviousStatus  ModerationStatus       `protobuf:"varint,5,opt,name=previous_status,json=previousStatus,proto3,enum=irelia.ModerationStatus" json:"previous_status,omitempty"`
+	Status          ModerationStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=irelia.ModerationStatus" json:"status,omitempty"`
+	MergedIntoId    int32                  `protobuf:"varint,7,opt,name=merged_into_id,json=mergedIntoId,proto3" json:"merged_into_id,omitempty"`
+	PreviousContent string                 `protobuf:"bytes,8,opt,name=previous_content,json=previousContent,proto3" json:"previous_content,omitempty"`
+	Content         string                 `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`
+	Note            string                 `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PreviousAnswer  string                 `protobuf:"bytes,12,opt,name=previous_answer,json=previousAnswer,proto3" json:"previous_answer,omitempty"` // the model answer before an edit
+	Answer          string                 `protobuf:"bytes,13,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModerationAuditEntry) Reset() {
+	*x = ModerationAuditEntry{}
+	mi := &file_api_irelia_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationAuditEntry) ProtoMessage() {}
+
+func (x *ModerationAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationAuditEntry.ProtoReflect.Descriptor instead.
+func (*ModerationAuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ModerationAuditEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerationAuditEntry) GetQuestionId() int32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ModerationAuditEntry) GetModeratorId() uint64 {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return 0
+}
+
+func (x *ModerationAuditEntry) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_MODERATION_ACTION_UNKNOWN
+}
+
+func (x *ModerationAuditEntry) GetPreviousStatus() ModerationStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ModerationStatus_MODERATION_STATUS_UNKNOWN
+}
+
+func (x *ModerationAuditEntry) GetStatus() ModerationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ModerationStatus_MODERATION_STATUS_UNKNOWN
+}
+
+func (x *ModerationAuditEntry) GetMergedIntoId() int32 {
+	if x != nil {
+		return x.MergedIntoId
+	}
+	return 0
+}
+
+func (x *ModerationAuditEntry) GetPreviousContent() string {
+	if x != nil {
+		return x.PreviousContent
+	}
+	return ""
+}
+
+func (x *ModerationAuditEntry) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ModerationAuditEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ModerationAuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModerationAuditEntry) GetPreviousAnswer() string {
+	if x != nil {
+		return x.PreviousAnswer
+	}
+	return ""
+}
+
+func (x *ModerationAuditEntry) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type ListModerationAuditResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entries       []*ModerationAuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationAuditResponse) Reset() {
+	*x = ListModerationAuditResponse{}
+	mi := &file_api_irelia_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationAuditResponse) ProtoMessage() {}
+
+func (x *ListModerationAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationAuditResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationAuditResponse) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListModerationAuditResponse) GetEntries() []*ModerationAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// 21. Model Answer (Irelia to Darius)
+type ModelAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Position      string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Experience    string                 `protobuf:"bytes,3,opt,name=experience,proto3" json:"experience,omitempty"`
+	Language      string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelAnswerRequest) Reset() {
+	*x = ModelAnswerRequest{}
+	mi := &file_api_irelia_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelAnswerRequest) ProtoMessage() {}
+
+func (x *ModelAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelAnswerRequest.ProtoReflect.Descriptor instead.
+func (*ModelAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ModelAnswerRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *ModelAnswerRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ModelAnswerRequest) GetExperience() string {
+	if x != nil {
+		return x.Experience
+	}
+	return ""
+}
+
+func (x *ModelAnswerRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ModelAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answer        string                 `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // model or prompt version that wrote the answer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelAnswerResponse) Reset() {
+	*x = ModelAnswerResponse{}
+	mi := &file_api_irelia_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelAnswerResponse) ProtoMessage() {}
+
+func (x *ModelAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_irelia_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelAnswerResponse.ProtoReflect.Descriptor instead.
+func (*ModelAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_api_irelia_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ModelAnswerResponse) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *ModelAnswerResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+var File_api_irelia_proto protoreflect.FileDescriptor
+
+const file_api_irelia_proto_rawDesc = "" +
+	"\n" +
+	"\x10api/irelia.proto\x12\x06irelia\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x19google/api/httpbody.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x80\x01\n" +
+	"\bBaseData\x129\n" +
+	"\n" +
+	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x93\x05\n" +
+	"\tInterview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\tR\bposition\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x03 \x01(\tR\n" +
+	"experience\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x12\x19\n" +
+	"\bvoice_id\x18\x05 \x01(\tR\avoiceId\x12\x14\n" +
+	"\x05speed\x18\x06 \x01(\x05R\x05speed\x12\x16\n" +
+	"\x06skills\x18\a \x03(\tR\x06skills\x12!\n" +
+	"\fskills_score\x18\b \x03(\tR\vskillsScore\x12\x1b\n" +
+	"\tskip_code\x18\t \x01(\bR\bskipCode\x12'\n" +
+	"\x0ftotal_questions\x18\n" +
+	" \x01(\x05R\x0etotalQuestions\x12/\n" +
+	"\x13remaining_questions\x18\v \x01(\x05R\x12remainingQuestions\x123\n" +
+	"\vtotal_score\x18\f \x01(\v2\x12.irelia.TotalScoreR\n" +
+	"totalScore\x12#\n" +
+	"\roverall_score\x18\r \x01(\x02R\foverallScore\x12+\n" +
+	"\x11positive_feedback\x18\x0e \x01(\tR\x10positiveFeedback\x12/\n" +
+	"\x13actionable_feedback\x18\x0f \x01(\tR\x12actionableFeedback\x12#\n" +
+	"\rfinal_comment\x18\x10 \x01(\tR\ffinalComment\x12/\n" +
+	"\x06status\x18\x11 \x01(\x0e2\x17.irelia.InterviewStatusR\x06status\x12-\n" +
+	"\tbase_data\x18\x12 \x01(\v2\x10.irelia.BaseDataR\bbaseData\"\xec\x02\n" +
+	"\bQuestion\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x14\n" +
+	"\x05audio\x18\x04 \x01(\tR\x05audio\x12-\n" +
+	"\alipsync\x18\x05 \x01(\v2\x13.irelia.LipSyncDataR\alipsync\x12\x16\n" +
+	"\x06answer\x18\x06 \x01(\tR\x06answer\x12!\n" +
+	"\frecord_proof\x18\a \x01(\tR\vrecordProof\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\x12\x14\n" +
+	"\x05score\x18\t \x01(\tR\x05score\x12.\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x16.irelia.QuestionStatusR\x06status\x12-\n" +
+	"\tbase_data\x18\v \x01(\v2\x10.irelia.BaseDataR\bbaseData\"\xff\x04\n" +
+	"\x0ePublicQuestion\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1b\n" +
+	"\x06answer\x18\x02 \x01(\tH\x00R\x06answer\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\tR\bposition\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x04 \x01(\tR\n" +
+	"experience\x12-\n" +
+	"\tbase_data\x18\x05 \x01(\v2\x10.irelia.BaseDataR\bbaseData\x12\x0e\n" +
+	"\x02id\x18\x06 \x01(\x05R\x02id\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x120\n" +
+	"\x06status\x18\b \x01(\x0e2\x18.irelia.ModerationStatusR\x06status\x12 \n" +
+	"\voccurrences\x18\t \x01(\x05R\voccurrences\x12>\n" +
+	"\rfirst_seen_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vfirstSeenAt\x12<\n" +
+	"\flast_seen_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x12$\n" +
+	"\x0emerged_into_id\x18\f \x01(\x05R\fmergedIntoId\x12)\n" +
+	"\x10answer_generator\x18\r \x01(\tR\x0fanswerGenerator\x12%\n" +
+	"\x0eanswer_version\x18\x0e \x01(\tR\ranswerVersion\x12J\n" +
+	"\x13answer_generated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x11answerGeneratedAtB\t\n" +
+	"\a_answer\"\xc6\x03\n" +
+	"\x15StartInterviewRequest\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\tR\bposition\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x02 \x01(\tR\n" +
+	"experience\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x16\n" +
+	"\x06models\x18\x04 \x01(\tR\x06models\x12\x14\n" +
+	"\x05speed\x18\x05 \x01(\x05R\x05speed\x12\x16\n" +
+	"\x06skills\x18\x06 \x03(\tR\x06skills\x12'\n" +
+	"\x0ftotal_questions\x18\a \x01(\x05R\x0etotalQuestions\x12\x1d\n" +
+	"\n" +
+	"skip_intro\x18\b \x01(\bR\tskipIntro\x12\x1b\n" +
+	"\tskip_code\x18\t \x01(\bR\bskipCode\x123\n" +
+	"\x13question_time_limit\x18\n" +
+	" \x01(\x05H\x00R\x11questionTimeLimit\x88\x01\x01\x12,\n" +
+	"\x0ffluency_scoring\x18\v \x01(\bH\x01R\x0efluencyScoring\x88\x01\x01\x12\x1b\n" +
+	"\ttext_only\x18\f \x01(\bR\btextOnlyB\x16\n" +
+	"\x14_question_time_limitB\x12\n" +
+	"\x10_fluency_scoring\";\n" +
+	"\x16StartInterviewResponse\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"x\n" +
+	"\x0fQuestionRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12%\n" +
+	"\x0equestion_index\x18\x02 \x01(\x05R\rquestionIndex\x12\x1b\n" +
+	"\tsub_index\x18\x03 \x01(\x05R\bsubIndex\"\xd9\x03\n" +
+	"\x10QuestionResponse\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x05R\n" +
+	"questionId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x14\n" +
+	"\x05audio\x18\x03 \x01(\tR\x05audio\x12-\n" +
+	"\alipsync\x18\x04 \x01(\v2\x13.irelia.LipSyncDataR\alipsync\x12(\n" +
+	"\x10is_last_question\x18\x05 \x01(\bR\x0eisLastQuestion\x12\x1d\n" +
+	"\n" +
+	"is_loading\x18\x06 \x01(\bR\tisLoading\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x03R\ttimestamp\x12\x1b\n" +
+	"\tis_failed\x18\b \x01(\bR\bisFailed\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1f\n" +
+	"\vdeadline_at\x18\n" +
+	" \x01(\x03R\n" +
+	"deadlineAt\x12+\n" +
+	"\x11remaining_seconds\x18\v \x01(\x05R\x10remainingSeconds\x12\x1b\n" +
+	"\tsub_index\x18\f \x01(\x05R\bsubIndex\x12#\n" +
+	"\raudio_pending\x18\r \x01(\bR\faudioPending\x12\x1b\n" +
+	"\taudio_url\x18\x0e \x01(\tR\baudioUrl\"\xa6\x01\n" +
+	"\x13SubmitAnswerRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\x12!\n" +
+	"\frecord_proof\x18\x04 \x01(\tR\vrecordProof\x12\x1b\n" +
+	"\tsub_index\x18\x05 \x01(\x05R\bsubIndex\"z\n" +
+	"\x14SubmitAnswerResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\"\n" +
+	"\rhas_follow_up\x18\x02 \x01(\bR\vhasFollowUp\x12$\n" +
+	"\x0enext_sub_index\x18\x03 \x01(\x05R\fnextSubIndex\";\n" +
+	"\x16SubmitInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"H\n" +
+	"\x17SubmitInterviewResponse\x12-\n" +
+	"\x05outro\x18\x01 \x01(\v2\x17.irelia.LipSyncResponseR\x05outro\"\xa1\x01\n" +
+	"\n" +
+	"AnswerData\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12&\n" +
+	"\frecord_proof\x18\x03 \x01(\tH\x00R\vrecordProof\x88\x01\x01\x12\x1f\n" +
+	"\bquestion\x18\x04 \x01(\tH\x01R\bquestion\x88\x01\x01B\x0f\n" +
+	"\r_record_proofB\v\n" +
+	"\t_question\"\xb9\x02\n" +
+	"\x1aGetInterviewHistoryRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12/\n" +
+	"\x04sort\x18\x02 \x01(\x0e2\x1b.irelia.InterviewSortMethodR\x04sort\x12\x19\n" +
+	"\x05query\x18\x03 \x01(\tH\x00R\x05query\x88\x01\x01\x12\x13\n" +
+	"\x02en\x18\x04 \x01(\bH\x01R\x02en\x88\x01\x01\x12\x15\n" +
+	"\x03fvr\x18\x05 \x01(\bH\x02R\x03fvr\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12(\n" +
+	"\rinclude_total\x18\b \x01(\bH\x03R\fincludeTotal\x88\x01\x01B\b\n" +
+	"\x06_queryB\x05\n" +
+	"\x03_enB\x06\n" +
+	"\x04_fvrB\x10\n" +
+	"\x0e_include_total\"\xf0\x01\n" +
+	"\x1bGetInterviewHistoryResponse\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1f\n" +
+	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
+	"totalPages\x128\n" +
+	"\n" +
+	"interviews\x18\x04 \x03(\v2\x18.irelia.InterviewSummaryR\n" +
+	"interviews\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x06 \x01(\x05R\n" +
+	"totalCount\"\xd5\x01\n" +
+	"\x10InterviewSummary\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\tR\bposition\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x03 \x01(\tR\n" +
+	"experience\x123\n" +
+	"\vtotal_score\x18\x04 \x01(\v2\x12.irelia.TotalScoreR\n" +
+	"totalScore\x12-\n" +
+	"\tbase_data\x18\x05 \x01(\v2\x10.irelia.BaseDataR\bbaseData\"8\n" +
+	"\x13GetInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"\xab\x02\n" +
+	"\fAnswerResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\x12!\n" +
+	"\frecord_proof\x18\x04 \x01(\tR\vrecordProof\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\tR\x05score\x12.\n" +
+	"\x06status\x18\a \x01(\x0e2\x16.irelia.QuestionStatusR\x06status\x12\x1b\n" +
+	"\tsub_index\x18\b \x01(\x05R\bsubIndex\x123\n" +
+	"\n" +
+	"follow_ups\x18\t \x03(\v2\x14.irelia.AnswerResultR\tfollowUps\"l\n" +
+	"\n" +
+	"TotalScore\x12\f\n" +
+	"\x01A\x18\x01 \x01(\x05R\x01A\x12\f\n" +
+	"\x01B\x18\x02 \x01(\x05R\x01B\x12\f\n" +
+	"\x01C\x18\x03 \x01(\x05R\x01C\x12\f\n" +
+	"\x01D\x18\x04 \x01(\x05R\x01D\x12\f\n" +
+	"\x01F\x18\x05 \x01(\x05R\x01F\x12\x18\n" +
+	"\askipped\x18\x06 \x01(\x05R\askipped\"\x93\x04\n" +
+	"\x14GetInterviewResponse\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x126\n" +
+	"\vsubmissions\x18\x02 \x03(\v2\x14.irelia.AnswerResultR\vsubmissions\x12P\n" +
+	"\fskills_score\x18\x03 \x03(\v2-.irelia.GetInterviewResponse.SkillsScoreEntryR\vskillsScore\x123\n" +
+	"\vtotal_score\x18\x04 \x01(\v2\x12.irelia.TotalScoreR\n" +
+	"totalScore\x12+\n" +
+	"\x11positive_feedback\x18\x05 \x01(\tR\x10positiveFeedback\x12/\n" +
+	"\x13actionable_feedback\x18\x06 \x01(\tR\x12actionableFeedback\x12#\n" +
+	"\rfinal_comment\x18\a \x01(\tR\ffinalComment\x12/\n" +
+	"\x06status\x18\b \x01(\x0e2\x17.irelia.InterviewStatusR\x06status\x12%\n" +
+	"\x0efailure_reason\x18\t \x01(\tR\rfailureReason\x1a>\n" +
 	"\x10SkillsScoreEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"V\n" +
 	"\x06QaPair\x12\x1a\n" +
 	"\bquestion\x18\x01 \x01(\tR\bquestion\x12\x16\n" +
-	"\x06answer\x18\x02 \x01(\tR\x06answer\"\xbf\x01\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\bR\askipped\"\xbf\x01\n" +
 	"\aContext\x12\x1a\n" +
 	"\bposition\x18\x01 \x01(\tR\bposition\x12\x1e\n" +
 	"\n" +
@@ -3185,20 +5544,29 @@ const file_api_irelia_proto_rawDesc = "" +
 	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x16\n" +
 	"\x06skills\x18\x04 \x03(\tR\x06skills\x12'\n" +
 	"\x0ftotal_questions\x18\x05 \x01(\x05R\x0etotalQuestions\x12\x1b\n" +
-	"\tskip_code\x18\x06 \x01(\bR\bskipCode\"\xc6\x01\n" +
+	"\tskip_code\x18\x06 \x01(\bR\bskipCode\"\x87\x02\n" +
 	"\x13NextQuestionRequest\x12!\n" +
 	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x120\n" +
 	"\vsubmissions\x18\x02 \x03(\v2\x0e.irelia.QaPairR\vsubmissions\x12)\n" +
 	"\acontext\x18\x03 \x01(\v2\x0f.irelia.ContextR\acontext\x12/\n" +
-	"\x13remaining_questions\x18\x04 \x01(\x05R\x12remainingQuestions\"4\n" +
+	"\x13remaining_questions\x18\x04 \x01(\x05R\x12remainingQuestions\x12%\n" +
+	"\x0equestion_index\x18\x05 \x01(\x05R\rquestionIndex\x12\x18\n" +
+	"\apersist\x18\x06 \x01(\bR\apersist\"4\n" +
 	"\x14NextQuestionResponse\x12\x1c\n" +
-	"\tquestions\x18\x01 \x03(\tR\tquestions\"=\n" +
+	"\tquestions\x18\x01 \x03(\tR\tquestions\"\x87\x01\n" +
+	"\x0fFollowUpRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12)\n" +
+	"\acontext\x18\x02 \x01(\v2\x0f.irelia.ContextR\acontext\x12&\n" +
+	"\x06thread\x18\x03 \x03(\v2\x0e.irelia.QaPairR\x06thread\".\n" +
+	"\x10FollowUpResponse\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\tR\bquestion\"=\n" +
 	"\x18FavoriteInterviewRequest\x12!\n" +
-	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"\x88\x01\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"\xa2\x01\n" +
 	"\x15ScoreInterviewRequest\x12!\n" +
 	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x124\n" +
 	"\vsubmissions\x18\x02 \x03(\v2\x12.irelia.AnswerDataR\vsubmissions\x12\x16\n" +
-	"\x06skills\x18\x03 \x03(\tR\x06skills\"n\n" +
+	"\x06skills\x18\x03 \x03(\tR\x06skills\x12\x18\n" +
+	"\apersist\x18\x04 \x01(\bR\apersist\"n\n" +
 	"\x13ScoreFluencyRequest\x12!\n" +
 	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x124\n" +
 	"\vsubmissions\x18\x02 \x03(\v2\x12.irelia.AnswerDataR\vsubmissions\"S\n" +
@@ -3224,15 +5592,20 @@ const file_api_irelia_proto_rawDesc = "" +
 	"\x13actionable_feedback\x18\x03 \x01(\tR\x12actionableFeedback\x1a9\n" +
 	"\vSkillsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"~\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdc\x01\n" +
 	"\x0eLipSyncRequest\x12!\n" +
 	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x19\n" +
 	"\bvoice_id\x18\x03 \x01(\tR\avoiceId\x12\x14\n" +
-	"\x05speed\x18\x04 \x01(\x05R\x05speed\"V\n" +
+	"\x05speed\x18\x04 \x01(\x05R\x05speed\x12%\n" +
+	"\x0equestion_index\x18\x05 \x01(\x05R\rquestionIndex\x12\x1b\n" +
+	"\tsub_index\x18\x06 \x01(\x05R\bsubIndex\x12\x18\n" +
+	"\apersist\x18\a \x01(\bR\apersist\"\x90\x01\n" +
 	"\x0fLipSyncResponse\x12\x14\n" +
 	"\x05audio\x18\x01 \x01(\tR\x05audio\x12-\n" +
-	"\alipsync\x18\x02 \x01(\v2\x13.irelia.LipSyncDataR\alipsync\"s\n" +
+	"\alipsync\x18\x02 \x01(\v2\x13.irelia.LipSyncDataR\alipsync\x12\x1b\n" +
+	"\taudio_key\x18\x03 \x01(\tR\baudioKey\x12\x1b\n" +
+	"\taudio_url\x18\x04 \x01(\tR\baudioUrl\"s\n" +
 	"\vLipSyncData\x123\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x17.irelia.LipSyncMetadataR\bmetadata\x12/\n" +
 	"\n" +
@@ -3252,15 +5625,21 @@ const file_api_irelia_proto_rawDesc = "" +
 	"\x05audio\x18\x02 \x01(\tR\x05audio\x12-\n" +
 	"\alipsync\x18\x03 \x01(\v2\x13.irelia.LipSyncDataR\alipsync\"F\n" +
 	"\fDemoResponse\x126\n" +
-	"\tquestions\x18\x01 \x03(\v2\x18.irelia.QuestionResponseR\tquestions\"\x8e\x01\n" +
+	"\tquestions\x18\x01 \x03(\v2\x18.irelia.QuestionResponseR\tquestions\"\xaf\x02\n" +
 	"\x18GetPublicQuestionRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x15\n" +
 	"\x03pos\x18\x02 \x01(\tH\x00R\x03pos\x88\x01\x01\x12\x15\n" +
 	"\x03exp\x18\x03 \x01(\tH\x01R\x03exp\x88\x01\x01\x12\x17\n" +
-	"\x04lang\x18\x04 \x01(\tH\x02R\x04lang\x88\x01\x01B\x06\n" +
+	"\x04lang\x18\x04 \x01(\tH\x02R\x04lang\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12(\n" +
+	"\rinclude_total\x18\a \x01(\bH\x03R\fincludeTotal\x88\x01\x01\x12'\n" +
+	"\x0finclude_answers\x18\b \x01(\bR\x0eincludeAnswersB\x06\n" +
 	"\x04_posB\x06\n" +
 	"\x04_expB\a\n" +
-	"\x05_lang\"\xc2\x01\n" +
+	"\x05_langB\x10\n" +
+	"\x0e_include_total\"\xea\x01\n" +
 	"\x19GetPublicQuestionResponse\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
 	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1f\n" +
@@ -3268,13 +5647,140 @@ const file_api_irelia_proto_rawDesc = "" +
 	"totalPages\x12\x1f\n" +
 	"\vtotal_count\x18\x04 \x01(\x05R\n" +
 	"totalCount\x124\n" +
-	"\tquestions\x18\x05 \x03(\v2\x16.irelia.PublicQuestionR\tquestions*\xac\x01\n" +
+	"\tquestions\x18\x05 \x03(\v2\x16.irelia.PublicQuestionR\tquestions\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"Z\n" +
+	"\x16StreamInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x1d\n" +
+	"\n" +
+	"from_index\x18\x02 \x01(\x05R\tfromIndex\"\x95\x02\n" +
+	"\x0eInterviewEvent\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.irelia.InterviewEventTypeR\x04type\x12%\n" +
+	"\x0equestion_index\x18\x03 \x01(\x05R\rquestionIndex\x124\n" +
+	"\bquestion\x18\x04 \x01(\v2\x18.irelia.QuestionResponseR\bquestion\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12\x1b\n" +
+	"\tsub_index\x18\a \x01(\x05R\bsubIndex\";\n" +
+	"\x16ResumeInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"\x98\x02\n" +
+	"\x17ResumeInterviewResponse\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.irelia.InterviewStatusR\x06status\x12.\n" +
+	"\x13next_question_index\x18\x03 \x01(\x05R\x11nextQuestionIndex\x12'\n" +
+	"\x0ftotal_questions\x18\x04 \x01(\x05R\x0etotalQuestions\x12-\n" +
+	"\x12answered_questions\x18\x05 \x01(\x05R\x11answeredQuestions\x12!\n" +
+	"\fis_resumable\x18\x06 \x01(\bR\visResumable\"<\n" +
+	"\x17AbandonInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"8\n" +
+	"\x13RetryScoringRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"G\n" +
+	"\x14RetryScoringResponse\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.irelia.InterviewStatusR\x06status\"k\n" +
+	"\x13SkipQuestionRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x1b\n" +
+	"\tsub_index\x18\x03 \x01(\x05R\bsubIndex\"0\n" +
+	"\x14SkipQuestionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\">\n" +
+	"\x0fGetUsageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"6\n" +
+	"\n" +
+	"UsageQuota\x12\x12\n" +
+	"\x04used\x18\x01 \x01(\x05R\x04used\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xf2\x01\n" +
+	"\x0eOperationUsage\x12\x1a\n" +
+	"\bupstream\x18\x01 \x01(\tR\bupstream\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x14\n" +
+	"\x05calls\x18\x03 \x01(\x03R\x05calls\x12\x1a\n" +
+	"\bfailures\x18\x04 \x01(\x03R\bfailures\x12(\n" +
+	"\x10total_latency_ms\x18\x05 \x01(\x03R\x0etotalLatencyMs\x12#\n" +
+	"\rrequest_bytes\x18\x06 \x01(\x03R\frequestBytes\x12%\n" +
+	"\x0eresponse_bytes\x18\a \x01(\x03R\rresponseBytes\"\xc9\x01\n" +
+	"\x10GetUsageResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x122\n" +
+	"\n" +
+	"interviews\x18\x02 \x01(\v2\x12.irelia.UsageQuotaR\n" +
+	"interviews\x120\n" +
+	"\tquestions\x18\x03 \x01(\v2\x12.irelia.UsageQuotaR\tquestions\x126\n" +
+	"\n" +
+	"operations\x18\x04 \x03(\v2\x16.irelia.OperationUsageR\n" +
+	"operations\";\n" +
+	"\x16DeleteInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"i\n" +
+	"\x16ExportInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.irelia.ExportFormatR\x06format\"\x87\x02\n" +
+	"\x1aListModerationQueueRequest\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.irelia.ModerationStatusR\x06status\x12\x15\n" +
+	"\x03pos\x18\x02 \x01(\tH\x00R\x03pos\x88\x01\x01\x12\x17\n" +
+	"\x04lang\x18\x03 \x01(\tH\x01R\x04lang\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12(\n" +
+	"\rinclude_total\x18\x06 \x01(\bH\x02R\fincludeTotal\x88\x01\x01B\x06\n" +
+	"\x04_posB\a\n" +
+	"\x05_langB\x10\n" +
+	"\x0e_include_total\"\x9c\x01\n" +
+	"\x1bListModerationQueueResponse\x124\n" +
+	"\tquestions\x18\x01 \x03(\v2\x16.irelia.PublicQuestionR\tquestions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xce\x02\n" +
+	"\x1dModeratePublicQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x120\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x18.irelia.ModerationActionR\x06action\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\tH\x00R\acontent\x88\x01\x01\x12\x1b\n" +
+	"\x06answer\x18\x04 \x01(\tH\x01R\x06answer\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x05 \x01(\tH\x02R\bposition\x88\x01\x01\x12#\n" +
+	"\n" +
+	"experience\x18\x06 \x01(\tH\x03R\n" +
+	"experience\x88\x01\x01\x12\"\n" +
+	"\rmerge_into_id\x18\a \x01(\x05R\vmergeIntoId\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04noteB\n" +
+	"\n" +
+	"\b_contentB\t\n" +
+	"\a_answerB\v\n" +
+	"\t_positionB\r\n" +
+	"\v_experience\",\n" +
+	"\x1aListModerationAuditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x8c\x04\n" +
+	"\x14ModerationAuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\x05R\n" +
+	"questionId\x12!\n" +
+	"\fmoderator_id\x18\x03 \x01(\x04R\vmoderatorId\x120\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x18.irelia.ModerationActionR\x06action\x12A\n" +
+	"\x0fprevious_status\x18\x05 \x01(\x0e2\x18.irelia.ModerationStatusR\x0epreviousStatus\x120\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x18.irelia.ModerationStatusR\x06status\x12$\n" +
+	"\x0emerged_into_id\x18\a \x01(\x05R\fmergedIntoId\x12)\n" +
+	"\x10previous_content\x18\b \x01(\tR\x0fpreviousContent\x12\x18\n" +
+	"\acontent\x18\t \x01(\tR\acontent\x12\x12\n" +
+	"\x04note\x18\n" +
+	" \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\x0fprevious_answer\x18\f \x01(\tR\x0epreviousAnswer\x12\x16\n" +
+	"\x06answer\x18\r \x01(\tR\x06answer\"U\n" +
+	"\x1bListModerationAuditResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.irelia.ModerationAuditEntryR\aentries\"\x88\x01\n" +
+	"\x12ModelAnswerRequest\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\tR\bquestion\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\tR\bposition\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x03 \x01(\tR\n" +
+	"experience\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\"G\n" +
+	"\x13ModelAnswerResponse\x12\x16\n" +
+	"\x06answer\x18\x01 \x01(\tR\x06answer\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion*\xcc\x01\n" +
 	"\x0fInterviewStatus\x12\x1c\n" +
 	"\x18INTERVIEW_STATUS_UNKNOWN\x10\x00\x12 \n" +
 	"\x1cINTERVIEW_STATUS_IN_PROGRESS\x10\x01\x12\x1c\n" +
 	"\x18INTERVIEW_STATUS_PENDING\x10\x02\x12\x1b\n" +
 	"\x17INTERVIEW_STATUS_FAILED\x10\x03\x12\x1e\n" +
-	"\x1aINTERVIEW_STATUS_COMPLETED\x10\x04*\xb8\x01\n" +
+	"\x1aINTERVIEW_STATUS_COMPLETED\x10\x04\x12\x1e\n" +
+	"\x1aINTERVIEW_STATUS_ABANDONED\x10\x05*\xb8\x01\n" +
 	"\x0eQuestionStatus\x12\x1b\n" +
 	"\x17QUESTION_STATUS_UNKNOWN\x10\x00\x12\x17\n" +
 	"\x13QUESTION_STATUS_NEW\x10\x01\x12\x1c\n" +
@@ -3289,21 +5795,75 @@ const file_api_irelia_proto_rawDesc = "" +
 	"\x14MOST_TOTAL_QUESTIONS\x10\x03\x12\x1a\n" +
 	"\x16FEWEST_TOTAL_QUESTIONS\x10\x04\x12\r\n" +
 	"\tMAX_SCORE\x10\x05\x12\r\n" +
-	"\tMIN_SCORE\x10\x06*P\n" +
+	"\tMIN_SCORE\x10\x06*\x88\x03\n" +
+	"\x12InterviewEventType\x12\x1b\n" +
+	"\x17INTERVIEW_EVENT_UNKNOWN\x10\x00\x12&\n" +
+	"\"INTERVIEW_EVENT_QUESTION_PREPARING\x10\x01\x12\"\n" +
+	"\x1eINTERVIEW_EVENT_QUESTION_READY\x10\x02\x12#\n" +
+	"\x1fINTERVIEW_EVENT_QUESTION_FAILED\x10\x03\x12#\n" +
+	"\x1fINTERVIEW_EVENT_SCORING_STARTED\x10\x04\x12%\n" +
+	"!INTERVIEW_EVENT_SCORING_COMPLETED\x10\x05\x12\"\n" +
+	"\x1eINTERVIEW_EVENT_SCORING_FAILED\x10\x06\x12$\n" +
+	" INTERVIEW_EVENT_QUESTION_STARTED\x10\a\x12$\n" +
+	" INTERVIEW_EVENT_QUESTION_TIMEOUT\x10\b\x12(\n" +
+	"$INTERVIEW_EVENT_QUESTION_AUDIO_READY\x10\t*{\n" +
+	"\aJobKind\x12\x14\n" +
+	"\x10JOB_KIND_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11JOB_KIND_QUESTION\x10\x01\x12\x14\n" +
+	"\x10JOB_KIND_SCORING\x10\x02\x12\x16\n" +
+	"\x12JOB_KIND_FOLLOW_UP\x10\x03\x12\x15\n" +
+	"\x11JOB_KIND_LIP_SYNC\x10\x04*\x82\x01\n" +
+	"\tJobStatus\x12\x16\n" +
+	"\x12JOB_STATUS_UNKNOWN\x10\x00\x12\x16\n" +
+	"\x12JOB_STATUS_PENDING\x10\x01\x12\x16\n" +
+	"\x12JOB_STATUS_RUNNING\x10\x02\x12\x18\n" +
+	"\x14JOB_STATUS_SUCCEEDED\x10\x03\x12\x13\n" +
+	"\x0fJOB_STATUS_DEAD\x10\x04*`\n" +
 	"\rBulbasaurRole\x12\x10\n" +
 	"\fROLE_UNKNOWN\x10\x00\x12\x12\n" +
 	"\x0eROLE_CANDIDATE\x10\x01\x12\x19\n" +
-	"\x15ROLE_BUSINESS_MANAGER\x10\x022\xc1\v\n" +
+	"\x15ROLE_BUSINESS_MANAGER\x10\x02\x12\x0e\n" +
+	"\n" +
+	"ROLE_ADMIN\x10\x03*\xae\x01\n" +
+	"\x10ModerationStatus\x12\x1d\n" +
+	"\x19MODERATION_STATUS_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19MODERATION_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aMODERATION_STATUS_APPROVED\x10\x02\x12\x1e\n" +
+	"\x1aMODERATION_STATUS_REJECTED\x10\x03\x12\x1c\n" +
+	"\x18MODERATION_STATUS_MERGED\x10\x04*\xa7\x01\n" +
+	"\x10ModerationAction\x12\x1d\n" +
+	"\x19MODERATION_ACTION_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19MODERATION_ACTION_APPROVE\x10\x01\x12\x1a\n" +
+	"\x16MODERATION_ACTION_EDIT\x10\x02\x12\x1c\n" +
+	"\x18MODERATION_ACTION_REJECT\x10\x03\x12\x1b\n" +
+	"\x17MODERATION_ACTION_MERGE\x10\x04*y\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16EXPORT_FORMAT_MARKDOWN\x10\x01\x12\x16\n" +
+	"\x12EXPORT_FORMAT_HTML\x10\x02\x12\x16\n" +
+	"\x12EXPORT_FORMAT_JSON\x10\x032\xef\x16\n" +
 	"\x06Irelia\x12m\n" +
 	"\x0eStartInterview\x12\x1d.irelia.StartInterviewRequest\x1a\x1e.irelia.StartInterviewResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/interviews/start\x12\x83\x01\n" +
-	"\x0fGetNextQuestion\x12\x17.irelia.QuestionRequest\x1a\x18.irelia.QuestionResponse\"=\x82\xd3\xe4\x93\x027\x125/interviews/{interview_id}/questions/{question_index}\x12w\n" +
-	"\fSubmitAnswer\x12\x1b.irelia.SubmitAnswerRequest\x1a\x1c.irelia.SubmitAnswerResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/interviews/{interview_id}/answer\x12}\n" +
-	"\x0fSubmitInterview\x12\x1e.irelia.SubmitInterviewRequest\x1a\x1f.irelia.SubmitInterviewResponse\")\x82\xd3\xe4\x93\x02#\x12!/interviews/{interview_id}/submit\x12{\n" +
+	"\x0fGetNextQuestion\x12\x17.irelia.QuestionRequest\x1a\x18.irelia.QuestionResponse\"=\x82\xd3\xe4\x93\x027\x125/interviews/{interview_id}/questions/{question_index}\x12v\n" +
+	"\x0fStreamInterview\x12\x1e.irelia.StreamInterviewRequest\x1a\x16.irelia.InterviewEvent\")\x82\xd3\xe4\x93\x02#\x12!/interviews/{interview_id}/stream0\x01\x12w\n" +
+	"\fSubmitAnswer\x12\x1b.irelia.SubmitAnswerRequest\x1a\x1c.irelia.SubmitAnswerResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/interviews/{interview_id}/answer\x12\x87\x01\n" +
+	"\fSkipQuestion\x12\x1b.irelia.SkipQuestionRequest\x1a\x1c.irelia.SkipQuestionResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/interviews/{interview_id}/questions/{index}/skip\x12}\n" +
+	"\x0fResumeInterview\x12\x1e.irelia.ResumeInterviewRequest\x1a\x1f.irelia.ResumeInterviewResponse\")\x82\xd3\xe4\x93\x02#\x12!/interviews/{interview_id}/resume\x12z\n" +
+	"\x10AbandonInterview\x12\x1f.irelia.AbandonInterviewRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/interviews/{interview_id}/abandon\x12}\n" +
+	"\x0fSubmitInterview\x12\x1e.irelia.SubmitInterviewRequest\x1a\x1f.irelia.SubmitInterviewResponse\")\x82\xd3\xe4\x93\x02#\x12!/interviews/{interview_id}/submit\x12~\n" +
+	"\fRetryScoring\x12\x1b.irelia.RetryScoringRequest\x1a\x1c.irelia.RetryScoringResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/interviews/{interview_id}/retry-scoring\x12{\n" +
 	"\x13GetInterviewHistory\x12\".irelia.GetInterviewHistoryRequest\x1a#.irelia.GetInterviewHistoryResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/interviews/history\x12u\n" +
 	"\fGetInterview\x12\x1b.irelia.GetInterviewRequest\x1a\x1c.irelia.GetInterviewResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/interviews/history/{interview_id}\x12}\n" +
-	"\x11FavoriteInterview\x12 .irelia.FavoriteInterviewRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(:\x01*\"#/interviews/{interview_id}/favorite\x12\\\n" +
+	"\x11FavoriteInterview\x12 .irelia.FavoriteInterviewRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(:\x01*\"#/interviews/{interview_id}/favorite\x12m\n" +
+	"\x0fDeleteInterview\x12\x1e.irelia.DeleteInterviewRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/interviews/{interview_id}\x12r\n" +
+	"\x0fExportInterview\x12\x1e.irelia.ExportInterviewRequest\x1a\x14.google.api.HttpBody\")\x82\xd3\xe4\x93\x02#\x12!/interviews/{interview_id}/export\x12\\\n" +
 	"\rDemoInterview\x12\x13.irelia.DemoRequest\x1a\x14.irelia.DemoResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/interviews/demo/{topic}\x12~\n" +
-	"\x11GetPublicQuestion\x12 .irelia.GetPublicQuestionRequest\x1a!.irelia.GetPublicQuestionResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/interviews/public-questions\x12\x86\x01\n" +
+	"\x11GetPublicQuestion\x12 .irelia.GetPublicQuestionRequest\x1a!.irelia.GetPublicQuestionResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/interviews/public-questions\x12M\n" +
+	"\bGetUsage\x12\x17.irelia.GetUsageRequest\x1a\x18.irelia.GetUsageResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/usage\x12\\\n" +
+	"\x11GetServiceMetrics\x12\x16.google.protobuf.Empty\x1a\x17.google.protobuf.Struct\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/admin/metrics\x12\x84\x01\n" +
+	"\x13ListModerationQueue\x12\".irelia.ListModerationQueueRequest\x1a#.irelia.ListModerationQueueResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/moderation/public-questions\x12\x85\x01\n" +
+	"\x16ModeratePublicQuestion\x12%.irelia.ModeratePublicQuestionRequest\x1a\x16.irelia.PublicQuestion\",\x82\xd3\xe4\x93\x02&:\x01*\"!/moderation/public-questions/{id}\x12\x8f\x01\n" +
+	"\x13ListModerationAudit\x12\".irelia.ListModerationAuditRequest\x1a#.irelia.ListModerationAuditResponse\"/\x82\xd3\xe4\x93\x02)\x12'/moderation/public-questions/{id}/audit\x12\x86\x01\n" +
 	"\x14GenerateNextQuestion\x12\x1b.irelia.NextQuestionRequest\x1a\x1c.irelia.NextQuestionResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/interviews/{interview_id}/next-question\x12|\n" +
 	"\x0eScoreInterview\x12\x1d.irelia.ScoreInterviewRequest\x1a\x1e.irelia.ScoreInterviewResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /interviews/{interview_id}/score\x12r\n" +
 	"\x0fGenerateLipSync\x12\x16.irelia.LipSyncRequest\x1a\x17.irelia.LipSyncResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/interviews/{interview_id}/lip-syncB\x13Z\x11irelia/api;ireliab\x06proto3"
@@ -3320,123 +5880,204 @@ func file_api_irelia_proto_rawDescGZIP() []byte {
 	return file_api_irelia_proto_rawDescData
 }
 
-var file_api_irelia_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_irelia_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_api_irelia_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_api_irelia_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_api_irelia_proto_goTypes = []any{
-	(InterviewStatus)(0),                // 0: irelia.InterviewStatus
-	(QuestionStatus)(0),                 // 1: irelia.QuestionStatus
-	(InterviewSortMethod)(0),            // 2: irelia.InterviewSortMethod
-	(BulbasaurRole)(0),                  // 3: irelia.BulbasaurRole
-	(*BaseData)(nil),                    // 4: irelia.BaseData
-	(*Interview)(nil),                   // 5: irelia.Interview
-	(*Question)(nil),                    // 6: irelia.Question
-	(*PublicQuestion)(nil),              // 7: irelia.PublicQuestion
-	(*StartInterviewRequest)(nil),       // 8: irelia.StartInterviewRequest
-	(*StartInterviewResponse)(nil),      // 9: irelia.StartInterviewResponse
-	(*QuestionRequest)(nil),             // 10: irelia.QuestionRequest
-	(*QuestionResponse)(nil),            // 11: irelia.QuestionResponse
-	(*SubmitAnswerRequest)(nil),         // 12: irelia.SubmitAnswerRequest
-	(*SubmitAnswerResponse)(nil),        // 13: irelia.SubmitAnswerResponse
-	(*SubmitInterviewRequest)(nil),      // 14: irelia.SubmitInterviewRequest
-	(*SubmitInterviewResponse)(nil),     // 15: irelia.SubmitInterviewResponse
-	(*AnswerData)(nil),                  // 16: irelia.AnswerData
-	(*GetInterviewHistoryRequest)(nil),  // 17: irelia.GetInterviewHistoryRequest
-	(*GetInterviewHistoryResponse)(nil), // 18: irelia.GetInterviewHistoryResponse
-	(*InterviewSummary)(nil),            // 19: irelia.InterviewSummary
-	(*GetInterviewRequest)(nil),         // 20: irelia.GetInterviewRequest
-	(*AnswerResult)(nil),                // 21: irelia.AnswerResult
-	(*TotalScore)(nil),                  // 22: irelia.TotalScore
-	(*GetInterviewResponse)(nil),        // 23: irelia.GetInterviewResponse
-	(*QaPair)(nil),                      // 24: irelia.QaPair
-	(*Context)(nil),                     // 25: irelia.Context
-	(*NextQuestionRequest)(nil),         // 26: irelia.NextQuestionRequest
-	(*NextQuestionResponse)(nil),        // 27: irelia.NextQuestionResponse
-	(*FavoriteInterviewRequest)(nil),    // 28: irelia.FavoriteInterviewRequest
-	(*ScoreInterviewRequest)(nil),       // 29: irelia.ScoreInterviewRequest
-	(*ScoreFluencyRequest)(nil),         // 30: irelia.ScoreFluencyRequest
-	(*AnswerScore)(nil),                 // 31: irelia.AnswerScore
-	(*SkillScore)(nil),                  // 32: irelia.SkillScore
-	(*ScoreInterviewResponse)(nil),      // 33: irelia.ScoreInterviewResponse
-	(*ScoreFluencyResponse)(nil),        // 34: irelia.ScoreFluencyResponse
-	(*LipSyncRequest)(nil),              // 35: irelia.LipSyncRequest
-	(*LipSyncResponse)(nil),             // 36: irelia.LipSyncResponse
-	(*LipSyncData)(nil),                 // 37: irelia.LipSyncData
-	(*LipSyncMetadata)(nil),             // 38: irelia.LipSyncMetadata
-	(*MouthCue)(nil),                    // 39: irelia.MouthCue
-	(*DemoRequest)(nil),                 // 40: irelia.DemoRequest
-	(*DemoQuestion)(nil),                // 41: irelia.DemoQuestion
-	(*DemoResponse)(nil),                // 42: irelia.DemoResponse
-	(*GetPublicQuestionRequest)(nil),    // 43: irelia.GetPublicQuestionRequest
-	(*GetPublicQuestionResponse)(nil),   // 44: irelia.GetPublicQuestionResponse
-	nil,                                 // 45: irelia.GetInterviewResponse.SkillsScoreEntry
-	nil,                                 // 46: irelia.ScoreFluencyResponse.SkillsEntry
-	(*timestamppb.Timestamp)(nil),       // 47: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 48: google.protobuf.Empty
+	(InterviewStatus)(0),                  // 0: irelia.InterviewStatus
+	(QuestionStatus)(0),                   // 1: irelia.QuestionStatus
+	(InterviewSortMethod)(0),              // 2: irelia.InterviewSortMethod
+	(InterviewEventType)(0),               // 3: irelia.InterviewEventType
+	(JobKind)(0),                          // 4: irelia.JobKind
+	(JobStatus)(0),                        // 5: irelia.JobStatus
+	(BulbasaurRole)(0),                    // 6: irelia.BulbasaurRole
+	(ModerationStatus)(0),                 // 7: irelia.ModerationStatus
+	(ModerationAction)(0),                 // 8: irelia.ModerationAction
+	(ExportFormat)(0),                     // 9: irelia.ExportFormat
+	(*BaseData)(nil),                      // 10: irelia.BaseData
+	(*Interview)(nil),                     // 11: irelia.Interview
+	(*Question)(nil),                      // 12: irelia.Question
+	(*PublicQuestion)(nil),                // 13: irelia.PublicQuestion
+	(*StartInterviewRequest)(nil),         // 14: irelia.StartInterviewRequest
+	(*StartInterviewResponse)(nil),        // 15: irelia.StartInterviewResponse
+	(*QuestionRequest)(nil),               // 16: irelia.QuestionRequest
+	(*QuestionResponse)(nil),              // 17: irelia.QuestionResponse
+	(*SubmitAnswerRequest)(nil),           // 18: irelia.SubmitAnswerRequest
+	(*SubmitAnswerResponse)(nil),          // 19: irelia.SubmitAnswerResponse
+	(*SubmitInterviewRequest)(nil),        // 20: irelia.SubmitInterviewRequest
+	(*SubmitInterviewResponse)(nil),       // 21: irelia.SubmitInterviewResponse
+	(*AnswerData)(nil),                    // 22: irelia.AnswerData
+	(*GetInterviewHistoryRequest)(nil),    // 23: irelia.GetInterviewHistoryRequest
+	(*GetInterviewHistoryResponse)(nil),   // 24: irelia.GetInterviewHistoryResponse
+	(*InterviewSummary)(nil),              // 25: irelia.InterviewSummary
+	(*GetInterviewRequest)(nil),           // 26: irelia.GetInterviewRequest
+	(*AnswerResult)(nil),                  // 27: irelia.AnswerResult
+	(*TotalScore)(nil),                    // 28: irelia.TotalScore
+	(*GetInterviewResponse)(nil),          // 29: irelia.GetInterviewResponse
+	(*QaPair)(nil),                        // 30: irelia.QaPair
+	(*Context)(nil),                       // 31: irelia.Context
+	(*NextQuestionRequest)(nil),           // 32: irelia.NextQuestionRequest
+	(*NextQuestionResponse)(nil),          // 33: irelia.NextQuestionResponse
+	(*FollowUpRequest)(nil),               // 34: irelia.FollowUpRequest
+	(*FollowUpResponse)(nil),              // 35: irelia.FollowUpResponse
+	(*FavoriteInterviewRequest)(nil),      // 36: irelia.FavoriteInterviewRequest
+	(*ScoreInterviewRequest)(nil),         // 37: irelia.ScoreInterviewRequest
+	(*ScoreFluencyRequest)(nil),           // 38: irelia.ScoreFluencyRequest
+	(*AnswerScore)(nil),                   // 39: irelia.AnswerScore
+	(*SkillScore)(nil),                    // 40: irelia.SkillScore
+	(*ScoreInterviewResponse)(nil),        // 41: irelia.ScoreInterviewResponse
+	(*ScoreFluencyResponse)(nil),          // 42: irelia.ScoreFluencyResponse
+	(*LipSyncRequest)(nil),                // 43: irelia.LipSyncRequest
+	(*LipSyncResponse)(nil),               // 44: irelia.LipSyncResponse
+	(*LipSyncData)(nil),                   // 45: irelia.LipSyncData
+	(*LipSyncMetadata)(nil),               // 46: irelia.LipSyncMetadata
+	(*MouthCue)(nil),                      // 47: irelia.MouthCue
+	(*DemoRequest)(nil),                   // 48: irelia.DemoRequest
+	(*DemoQuestion)(nil),                  // 49: irelia.DemoQuestion
+	(*DemoResponse)(nil),                  // 50: irelia.DemoResponse
+	(*GetPublicQuestionRequest)(nil),      // 51: irelia.GetPublicQuestionRequest
+	(*GetPublicQuestionResponse)(nil),     // 52: irelia.GetPublicQuestionResponse
+	(*StreamInterviewRequest)(nil),        // 53: irelia.StreamInterviewRequest
+	(*InterviewEvent)(nil),                // 54: irelia.InterviewEvent
+	(*ResumeInterviewRequest)(nil),        // 55: irelia.ResumeInterviewRequest
+	(*ResumeInterviewResponse)(nil),       // 56: irelia.ResumeInterviewResponse
+	(*AbandonInterviewRequest)(nil),       // 57: irelia.AbandonInterviewRequest
+	(*RetryScoringRequest)(nil),           // 58: irelia.RetryScoringRequest
+	(*RetryScoringResponse)(nil),          // 59: irelia.RetryScoringResponse
+	(*SkipQuestionRequest)(nil),           // 60: irelia.SkipQuestionRequest
+	(*SkipQuestionResponse)(nil),          // 61: irelia.SkipQuestionResponse
+	(*GetUsageRequest)(nil),               // 62: irelia.GetUsageRequest
+	(*UsageQuota)(nil),                    // 63: irelia.UsageQuota
+	(*OperationUsage)(nil),                // 64: irelia.OperationUsage
+	(*GetUsageResponse)(nil),              // 65: irelia.GetUsageResponse
+	(*DeleteInterviewRequest)(nil),        // 66: irelia.DeleteInterviewRequest
+	(*ExportInterviewRequest)(nil),        // 67: irelia.ExportInterviewRequest
+	(*ListModerationQueueRequest)(nil),    // 68: irelia.ListModerationQueueRequest
+	(*ListModerationQueueResponse)(nil),   // 69: irelia.ListModerationQueueResponse
+	(*ModeratePublicQuestionRequest)(nil), // 70: irelia.ModeratePublicQuestionRequest
+	(*ListModerationAuditRequest)(nil),    // 71: irelia.ListModerationAuditRequest
+	(*ModerationAuditEntry)(nil),          // 72: irelia.ModerationAuditEntry
+	(*ListModerationAuditResponse)(nil),   // 73: irelia.ListModerationAuditResponse
+	(*ModelAnswerRequest)(nil),            // 74: irelia.ModelAnswerRequest
+	(*ModelAnswerResponse)(nil),           // 75: irelia.ModelAnswerResponse
+	nil,                                   // 76: irelia.GetInterviewResponse.SkillsScoreEntry
+	nil,                                   // 77: irelia.ScoreFluencyResponse.SkillsEntry
+	(*timestamppb.Timestamp)(nil),         // 78: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 79: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),             // 80: google.api.HttpBody
+	(*structpb.Struct)(nil),               // 81: google.protobuf.Struct
 }
 var file_api_irelia_proto_depIdxs = []int32{
-	47, // 0: irelia.BaseData.created_at:type_name -> google.protobuf.Timestamp
-	47, // 1: irelia.BaseData.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 2: irelia.Interview.total_score:type_name -> irelia.TotalScore
+	78, // 0: irelia.BaseData.created_at:type_name -> google.protobuf.Timestamp
+	78, // 1: irelia.BaseData.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 2: irelia.Interview.total_score:type_name -> irelia.TotalScore
 	0,  // 3: irelia.Interview.status:type_name -> irelia.InterviewStatus
-	4,  // 4: irelia.Interview.base_data:type_name -> irelia.BaseData
-	37, // 5: irelia.Question.lipsync:type_name -> irelia.LipSyncData
+	10, // 4: irelia.Interview.base_data:type_name -> irelia.BaseData
+	45, // 5: irelia.Question.lipsync:type_name -> irelia.LipSyncData
 	1,  // 6: irelia.Question.status:type_name -> irelia.QuestionStatus
-	4,  // 7: irelia.Question.base_data:type_name -> irelia.BaseData
-	4,  // 8: irelia.PublicQuestion.base_data:type_name -> irelia.BaseData
-	37, // 9: irelia.QuestionResponse.lipsync:type_name -> irelia.LipSyncData
-	36, // 10: irelia.SubmitInterviewResponse.outro:type_name -> irelia.LipSyncResponse
-	2,  // 11: irelia.GetInterviewHistoryRequest.sort:type_name -> irelia.InterviewSortMethod
-	19, // 12: irelia.GetInterviewHistoryResponse.interviews:type_name -> irelia.InterviewSummary
-	22, // 13: irelia.InterviewSummary.total_score:type_name -> irelia.TotalScore
-	4,  // 14: irelia.InterviewSummary.base_data:type_name -> irelia.BaseData
-	1,  // 15: irelia.AnswerResult.status:type_name -> irelia.QuestionStatus
-	21, // 16: irelia.GetInterviewResponse.submissions:type_name -> irelia.AnswerResult
-	45, // 17: irelia.GetInterviewResponse.skills_score:type_name -> irelia.GetInterviewResponse.SkillsScoreEntry
-	22, // 18: irelia.GetInterviewResponse.total_score:type_name -> irelia.TotalScore
-	24, // 19: irelia.NextQuestionRequest.submissions:type_name -> irelia.QaPair
-	25, // 20: irelia.NextQuestionRequest.context:type_name -> irelia.Context
-	16, // 21: irelia.ScoreInterviewRequest.submissions:type_name -> irelia.AnswerData
-	16, // 22: irelia.ScoreFluencyRequest.submissions:type_name -> irelia.AnswerData
-	31, // 23: irelia.ScoreInterviewResponse.result:type_name -> irelia.AnswerScore
-	22, // 24: irelia.ScoreInterviewResponse.total_score:type_name -> irelia.TotalScore
-	32, // 25: irelia.ScoreInterviewResponse.skills:type_name -> irelia.SkillScore
-	31, // 26: irelia.ScoreFluencyResponse.result:type_name -> irelia.AnswerScore
-	46, // 27: irelia.ScoreFluencyResponse.skills:type_name -> irelia.ScoreFluencyResponse.SkillsEntry
-	37, // 28: irelia.LipSyncResponse.lipsync:type_name -> irelia.LipSyncData
-	38, // 29: irelia.LipSyncData.metadata:type_name -> irelia.LipSyncMetadata
-	39, // 30: irelia.LipSyncData.mouth_cues:type_name -> irelia.MouthCue
-	37, // 31: irelia.DemoQuestion.lipsync:type_name -> irelia.LipSyncData
-	11, // 32: irelia.DemoResponse.questions:type_name -> irelia.QuestionResponse
-	7,  // 33: irelia.GetPublicQuestionResponse.questions:type_name -> irelia.PublicQuestion
-	8,  // 34: irelia.Irelia.StartInterview:input_type -> irelia.StartInterviewRequest
-	10, // 35: irelia.Irelia.GetNextQuestion:input_type -> irelia.QuestionRequest
-	12, // 36: irelia.Irelia.SubmitAnswer:input_type -> irelia.SubmitAnswerRequest
-	14, // 37: irelia.Irelia.SubmitInterview:input_type -> irelia.SubmitInterviewRequest
-	17, // 38: irelia.Irelia.GetInterviewHistory:input_type -> irelia.GetInterviewHistoryRequest
-	20, // 39: irelia.Irelia.GetInterview:input_type -> irelia.GetInterviewRequest
-	28, // 40: irelia.Irelia.FavoriteInterview:input_type -> irelia.FavoriteInterviewRequest
-	40, // 41: irelia.Irelia.DemoInterview:input_type -> irelia.DemoRequest
-	43, // 42: irelia.Irelia.GetPublicQuestion:input_type -> irelia.GetPublicQuestionRequest
-	26, // 43: irelia.Irelia.GenerateNextQuestion:input_type -> irelia.NextQuestionRequest
-	29, // 44: irelia.Irelia.ScoreInterview:input_type -> irelia.ScoreInterviewRequest
-	35, // 45: irelia.Irelia.GenerateLipSync:input_type -> irelia.LipSyncRequest
-	9,  // 46: irelia.Irelia.StartInterview:output_type -> irelia.StartInterviewResponse
-	11, // 47: irelia.Irelia.GetNextQuestion:output_type -> irelia.QuestionResponse
-	13, // 48: irelia.Irelia.SubmitAnswer:output_type -> irelia.SubmitAnswerResponse
-	15, // 49: irelia.Irelia.SubmitInterview:output_type -> irelia.SubmitInterviewResponse
-	18, // 50: irelia.Irelia.GetInterviewHistory:output_type -> irelia.GetInterviewHistoryResponse
-	23, // 51: irelia.Irelia.GetInterview:output_type -> irelia.GetInterviewResponse
-	48, // 52: irelia.Irelia.FavoriteInterview:output_type -> google.protobuf.Empty
-	42, // 53: irelia.Irelia.DemoInterview:output_type -> irelia.DemoResponse
-	44, // 54: irelia.Irelia.GetPublicQuestion:output_type -> irelia.GetPublicQuestionResponse
-	27, // 55: irelia.Irelia.GenerateNextQuestion:output_type -> irelia.NextQuestionResponse
-	33, // 56: irelia.Irelia.ScoreInterview:output_type -> irelia.ScoreInterviewResponse
-	36, // 57: irelia.Irelia.GenerateLipSync:output_type -> irelia.LipSyncResponse
-	46, // [46:58] is the sub-list for method output_type
-	34, // [34:46] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	10, // 7: irelia.Question.base_data:type_name -> irelia.BaseData
+	10, // 8: irelia.PublicQuestion.base_data:type_name -> irelia.BaseData
+	7,  // 9: irelia.PublicQuestion.status:type_name -> irelia.ModerationStatus
+	78, // 10: irelia.PublicQuestion.first_seen_at:type_name -> google.protobuf.Timestamp
+	78, // 11: irelia.PublicQuestion.last_seen_at:type_name -> google.protobuf.Timestamp
+	78, // 12: irelia.PublicQuestion.answer_generated_at:type_name -> google.protobuf.Timestamp
+	45, // 13: irelia.QuestionResponse.lipsync:type_name -> irelia.LipSyncData
+	44, // 14: irelia.SubmitInterviewResponse.outro:type_name -> irelia.LipSyncResponse
+	2,  // 15: irelia.GetInterviewHistoryRequest.sort:type_name -> irelia.InterviewSortMethod
+	25, // 16: irelia.GetInterviewHistoryResponse.interviews:type_name -> irelia.InterviewSummary
+	28, // 17: irelia.InterviewSummary.total_score:type_name -> irelia.TotalScore
+	10, // 18: irelia.InterviewSummary.base_data:type_name -> irelia.BaseData
+	1,  // 19: irelia.AnswerResult.status:type_name -> irelia.QuestionStatus
+	27, // 20: irelia.AnswerResult.follow_ups:type_name -> irelia.AnswerResult
+	27, // 21: irelia.GetInterviewResponse.submissions:type_name -> irelia.AnswerResult
+	76, // 22: irelia.GetInterviewResponse.skills_score:type_name -> irelia.GetInterviewResponse.SkillsScoreEntry
+	28, // 23: irelia.GetInterviewResponse.total_score:type_name -> irelia.TotalScore
+	0,  // 24: irelia.GetInterviewResponse.status:type_name -> irelia.InterviewStatus
+	30, // 25: irelia.NextQuestionRequest.submissions:type_name -> irelia.QaPair
+	31, // 26: irelia.NextQuestionRequest.context:type_name -> irelia.Context
+	31, // 27: irelia.FollowUpRequest.context:type_name -> irelia.Context
+	30, // 28: irelia.FollowUpRequest.thread:type_name -> irelia.QaPair
+	22, // 29: irelia.ScoreInterviewRequest.submissions:type_name -> irelia.AnswerData
+	22, // 30: irelia.ScoreFluencyRequest.submissions:type_name -> irelia.AnswerData
+	39, // 31: irelia.ScoreInterviewResponse.result:type_name -> irelia.AnswerScore
+	28, // 32: irelia.ScoreInterviewResponse.total_score:type_name -> irelia.TotalScore
+	40, // 33: irelia.ScoreInterviewResponse.skills:type_name -> irelia.SkillScore
+	39, // 34: irelia.ScoreFluencyResponse.result:type_name -> irelia.AnswerScore
+	77, // 35: irelia.ScoreFluencyResponse.skills:type_name -> irelia.ScoreFluencyResponse.SkillsEntry
+	45, // 36: irelia.LipSyncResponse.lipsync:type_name -> irelia.LipSyncData
+	46, // 37: irelia.LipSyncData.metadata:type_name -> irelia.LipSyncMetadata
+	47, // 38: irelia.LipSyncData.mouth_cues:type_name -> irelia.MouthCue
+	45, // 39: irelia.DemoQuestion.lipsync:type_name -> irelia.LipSyncData
+	17, // 40: irelia.DemoResponse.questions:type_name -> irelia.QuestionResponse
+	13, // 41: irelia.GetPublicQuestionResponse.questions:type_name -> irelia.PublicQuestion
+	3,  // 42: irelia.InterviewEvent.type:type_name -> irelia.InterviewEventType
+	17, // 43: irelia.InterviewEvent.question:type_name -> irelia.QuestionResponse
+	0,  // 44: irelia.ResumeInterviewResponse.status:type_name -> irelia.InterviewStatus
+	0,  // 45: irelia.RetryScoringResponse.status:type_name -> irelia.InterviewStatus
+	63, // 46: irelia.GetUsageResponse.interviews:type_name -> irelia.UsageQuota
+	63, // 47: irelia.GetUsageResponse.questions:type_name -> irelia.UsageQuota
+	64, // 48: irelia.GetUsageResponse.operations:type_name -> irelia.OperationUsage
+	9,  // 49: irelia.ExportInterviewRequest.format:type_name -> irelia.ExportFormat
+	7,  // 50: irelia.ListModerationQueueRequest.status:type_name -> irelia.ModerationStatus
+	13, // 51: irelia.ListModerationQueueResponse.questions:type_name -> irelia.PublicQuestion
+	8,  // 52: irelia.ModeratePublicQuestionRequest.action:type_name -> irelia.ModerationAction
+	8,  // 53: irelia.ModerationAuditEntry.action:type_name -> irelia.ModerationAction
+	7,  // 54: irelia.ModerationAuditEntry.previous_status:type_name -> irelia.ModerationStatus
+	7,  // 55: irelia.ModerationAuditEntry.status:type_name -> irelia.ModerationStatus
+	78, // 56: irelia.ModerationAuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	72, // 57: irelia.ListModerationAuditResponse.entries:type_name -> irelia.ModerationAuditEntry
+	14, // 58: irelia.Irelia.StartInterview:input_type -> irelia.StartInterviewRequest
+	16, // 59: irelia.Irelia.GetNextQuestion:input_type -> irelia.QuestionRequest
+	53, // 60: irelia.Irelia.StreamInterview:input_type -> irelia.StreamInterviewRequest
+	18, // 61: irelia.Irelia.SubmitAnswer:input_type -> irelia.SubmitAnswerRequest
+	60, // 62: irelia.Irelia.SkipQuestion:input_type -> irelia.SkipQuestionRequest
+	55, // 63: irelia.Irelia.ResumeInterview:input_type -> irelia.ResumeInterviewRequest
+	57, // 64: irelia.Irelia.AbandonInterview:input_type -> irelia.AbandonInterviewRequest
+	20, // 65: irelia.Irelia.SubmitInterview:input_type -> irelia.SubmitInterviewRequest
+	58, // 66: irelia.Irelia.RetryScoring:input_type -> irelia.RetryScoringRequest
+	23, // 67: irelia.Irelia.GetInterviewHistory:input_type -> irelia.GetInterviewHistoryRequest
+	26, // 68: irelia.Irelia.GetInterview:input_type -> irelia.GetInterviewRequest
+	36, // 69: irelia.Irelia.FavoriteInterview:input_type -> irelia.FavoriteInterviewRequest
+	66, // 70: irelia.Irelia.DeleteInterview:input_type -> irelia.DeleteInterviewRequest
+	67, // 71: irelia.Irelia.ExportInterview:input_type -> irelia.ExportInterviewRequest
+	48, // 72: irelia.Irelia.DemoInterview:input_type -> irelia.DemoRequest
+	51, // 73: irelia.Irelia.GetPublicQuestion:input_type -> irelia.GetPublicQuestionRequest
+	62, // 74: irelia.Irelia.GetUsage:input_type -> irelia.GetUsageRequest
+	79, // 75: irelia.Irelia.GetServiceMetrics:input_type -> google.protobuf.Empty
+	68, // 76: irelia.Irelia.ListModerationQueue:input_type -> irelia.ListModerationQueueRequest
+	70, // 77: irelia.Irelia.ModeratePublicQuestion:input_type -> irelia.ModeratePublicQuestionRequest
+	71, // 78: irelia.Irelia.ListModerationAudit:input_type -> irelia.ListModerationAuditRequest
+	32, // 79: irelia.Irelia.GenerateNextQuestion:input_type -> irelia.NextQuestionRequest
+	37, // 80: irelia.Irelia.ScoreInterview:input_type -> irelia.ScoreInterviewRequest
+	43, // 81: irelia.Irelia.GenerateLipSync:input_type -> irelia.LipSyncRequest
+	15, // 82: irelia.Irelia.StartInterview:output_type -> irelia.StartInterviewResponse
+	17, // 83: irelia.Irelia.GetNextQuestion:output_type -> irelia.QuestionResponse
+	54, // 84: irelia.Irelia.StreamInterview:output_type -> irelia.InterviewEvent
+	19, // 85: irelia.Irelia.SubmitAnswer:output_type -> irelia.SubmitAnswerResponse
+	61, // 86: irelia.Irelia.SkipQuestion:output_type -> irelia.SkipQuestionResponse
+	56, // 87: irelia.Irelia.ResumeInterview:output_type -> irelia.ResumeInterviewResponse
+	79, // 88: irelia.Irelia.AbandonInterview:output_type -> google.protobuf.Empty
+	21, // 89: irelia.Irelia.SubmitInterview:output_type -> irelia.SubmitInterviewResponse
+	59, // 90: irelia.Irelia.RetryScoring:output_type -> irelia.RetryScoringResponse
+	24, // 91: irelia.Irelia.GetInterviewHistory:output_type -> irelia.GetInterviewHistoryResponse
+	29, // 92: irelia.Irelia.GetInterview:output_type -> irelia.GetInterviewResponse
+	79, // 93: irelia.Irelia.FavoriteInterview:output_type -> google.protobuf.Empty
+	79, // 94: irelia.Irelia.DeleteInterview:output_type -> google.protobuf.Empty
+	80, // 95: irelia.Irelia.ExportInterview:output_type -> google.api.HttpBody
+	50, // 96: irelia.Irelia.DemoInterview:output_type -> irelia.DemoResponse
+	52, // 97: irelia.Irelia.GetPublicQuestion:output_type -> irelia.GetPublicQuestionResponse
+	65, // 98: irelia.Irelia.GetUsage:output_type -> irelia.GetUsageResponse
+	81, // 99: irelia.Irelia.GetServiceMetrics:output_type -> google.protobuf.Struct
+	69, // 100: irelia.Irelia.ListModerationQueue:output_type -> irelia.ListModerationQueueResponse
+	13, // 101: irelia.Irelia.ModeratePublicQuestion:output_type -> irelia.PublicQuestion
+	73, // 102: irelia.Irelia.ListModerationAudit:output_type -> irelia.ListModerationAuditResponse
+	33, // 103: irelia.Irelia.GenerateNextQuestion:output_type -> irelia.NextQuestionResponse
+	41, // 104: irelia.Irelia.ScoreInterview:output_type -> irelia.ScoreInterviewResponse
+	44, // 105: irelia.Irelia.GenerateLipSync:output_type -> irelia.LipSyncResponse
+	82, // [82:106] is the sub-list for method output_type
+	58, // [58:82] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_api_irelia_proto_init() }
@@ -3445,16 +6086,19 @@ func file_api_irelia_proto_init() {
 		return
 	}
 	file_api_irelia_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_irelia_proto_msgTypes[4].OneofWrappers = []any{}
 	file_api_irelia_proto_msgTypes[12].OneofWrappers = []any{}
 	file_api_irelia_proto_msgTypes[13].OneofWrappers = []any{}
-	file_api_irelia_proto_msgTypes[39].OneofWrappers = []any{}
+	file_api_irelia_proto_msgTypes[41].OneofWrappers = []any{}
+	file_api_irelia_proto_msgTypes[58].OneofWrappers = []any{}
+	file_api_irelia_proto_msgTypes[60].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_irelia_proto_rawDesc), len(file_api_irelia_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   43,
+			NumEnums:      10,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
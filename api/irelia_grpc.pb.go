@@ -8,10 +8,12 @@ package irelia
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,18 +22,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Irelia_StartInterview_FullMethodName       = "/irelia.Irelia/StartInterview"
-	Irelia_GetNextQuestion_FullMethodName      = "/irelia.Irelia/GetNextQuestion"
-	Irelia_SubmitAnswer_FullMethodName         = "/irelia.Irelia/SubmitAnswer"
-	Irelia_SubmitInterview_FullMethodName      = "/irelia.Irelia/SubmitInterview"
-	Irelia_GetInterviewHistory_FullMethodName  = "/irelia.Irelia/GetInterviewHistory"
-	Irelia_GetInterview_FullMethodName         = "/irelia.Irelia/GetInterview"
-	Irelia_FavoriteInterview_FullMethodName    = "/irelia.Irelia/FavoriteInterview"
-	Irelia_DemoInterview_FullMethodName        = "/irelia.Irelia/DemoInterview"
-	Irelia_GetPublicQuestion_FullMethodName    = "/irelia.Irelia/GetPublicQuestion"
-	Irelia_GenerateNextQuestion_FullMethodName = "/irelia.Irelia/GenerateNextQuestion"
-	Irelia_ScoreInterview_FullMethodName       = "/irelia.Irelia/ScoreInterview"
-	Irelia_GenerateLipSync_FullMethodName      = "/irelia.Irelia/GenerateLipSync"
+	Irelia_StartInterview_FullMethodName         = "/irelia.Irelia/StartInterview"
+	Irelia_GetNextQuestion_FullMethodName        = "/irelia.Irelia/GetNextQuestion"
+	Irelia_StreamInterview_FullMethodName        = "/irelia.Irelia/StreamInterview"
+	Irelia_SubmitAnswer_FullMethodName           = "/irelia.Irelia/SubmitAnswer"
+	Irelia_SkipQuestion_FullMethodName           = "/irelia.Irelia/SkipQuestion"
+	Irelia_ResumeInterview_FullMethodName        = "/irelia.Irelia/ResumeInterview"
+	Irelia_AbandonInterview_FullMethodName       = "/irelia.Irelia/AbandonInterview"
+	Irelia_SubmitInterview_FullMethodName        = "/irelia.Irelia/SubmitInterview"
+	Irelia_RetryScoring_FullMethodName           = "/irelia.Irelia/RetryScoring"
+	Irelia_GetInterviewHistory_FullMethodName    = "/irelia.Irelia/GetInterviewHistory"
+	Irelia_GetInterview_FullMethodName           = "/irelia.Irelia/GetInterview"
+	Irelia_FavoriteInterview_FullMethodName      = "/irelia.Irelia/FavoriteInterview"
+	Irelia_DeleteInterview_FullMethodName        = "/irelia.Irelia/DeleteInterview"
+	Irelia_ExportInterview_FullMethodName        = "/irelia.Irelia/ExportInterview"
+	Irelia_DemoInterview_FullMethodName          = "/irelia.Irelia/DemoInterview"
+	Irelia_GetPublicQuestion_FullMethodName      = "/irelia.Irelia/GetPublicQuestion"
+	Irelia_GetUsage_FullMethodName               = "/irelia.Irelia/GetUsage"
+	Irelia_GetServiceMetrics_FullMethodName      = "/irelia.Irelia/GetServiceMetrics"
+	Irelia_ListModerationQueue_FullMethodName    = "/irelia.Irelia/ListModerationQueue"
+	Irelia_ModeratePublicQuestion_FullMethodName = "/irelia.Irelia/ModeratePublicQuestion"
+	Irelia_ListModerationAudit_FullMethodName    = "/irelia.Irelia/ListModerationAudit"
+	Irelia_GenerateNextQuestion_FullMethodName   = "/irelia.Irelia/GenerateNextQuestion"
+	Irelia_ScoreInterview_FullMethodName         = "/irelia.Irelia/ScoreInterview"
+	Irelia_GenerateLipSync_FullMethodName        = "/irelia.Irelia/GenerateLipSync"
 )
 
 // IreliaClient is the client API for Irelia service.
@@ -41,13 +55,27 @@ type IreliaClient interface {
 	// Frontend to Irelia
 	StartInterview(ctx context.Context, in *StartInterviewRequest, opts ...grpc.CallOption) (*StartInterviewResponse, error)
 	GetNextQuestion(ctx context.Context, in *QuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error)
+	StreamInterview(ctx context.Context, in *StreamInterviewRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterviewEvent], error)
 	SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest, opts ...grpc.CallOption) (*SubmitAnswerResponse, error)
+	SkipQuestion(ctx context.Context, in *SkipQuestionRequest, opts ...grpc.CallOption) (*SkipQuestionResponse, error)
+	ResumeInterview(ctx context.Context, in *ResumeInterviewRequest, opts ...grpc.CallOption) (*ResumeInterviewResponse, error)
+	AbandonInterview(ctx context.Context, in *AbandonInterviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SubmitInterview(ctx context.Context, in *SubmitInterviewRequest, opts ...grpc.CallOption) (*SubmitInterviewResponse, error)
+	RetryScoring(ctx context.Context, in *RetryScoringRequest, opts ...grpc.CallOption) (*RetryScoringResponse, error)
 	GetInterviewHistory(ctx context.Context, in *GetInterviewHistoryRequest, opts ...grpc.CallOption) (*GetInterviewHistoryResponse, error)
 	GetInterview(ctx context.Context, in *GetInterviewRequest, opts ...grpc.CallOption) (*GetInterviewResponse, error)
 	FavoriteInterview(ctx context.Context, in *FavoriteInterviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteInterview(ctx context.Context, in *DeleteInterviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportInterview(ctx context.Context, in *ExportInterviewRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	DemoInterview(ctx context.Context, in *DemoRequest, opts ...grpc.CallOption) (*DemoResponse, error)
 	GetPublicQuestion(ctx context.Context, in *GetPublicQuestionRequest, opts ...grpc.CallOption) (*GetPublicQuestionResponse, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	// Upstream, worker and cache counters of the replica serving the request, for admins
+	GetServiceMetrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*structpb.Struct, error)
+	// Public question moderation, by business managers and admins
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error)
+	ModeratePublicQuestion(ctx context.Context, in *ModeratePublicQuestionRequest, opts ...grpc.CallOption) (*PublicQuestion, error)
+	ListModerationAudit(ctx context.Context, in *ListModerationAuditRequest, opts ...grpc.CallOption) (*ListModerationAuditResponse, error)
 	// Irelia to Darius (Question Generator)
 	GenerateNextQuestion(ctx context.Context, in *NextQuestionRequest, opts ...grpc.CallOption) (*NextQuestionResponse, error)
 	ScoreInterview(ctx context.Context, in *ScoreInterviewRequest, opts ...grpc.CallOption) (*ScoreInterviewResponse, error)
@@ -83,6 +111,25 @@ func (c *ireliaClient) GetNextQuestion(ctx context.Context, in *QuestionRequest,
 	return out, nil
 }
 
+func (c *ireliaClient) StreamInterview(ctx context.Context, in *StreamInterviewRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterviewEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Irelia_ServiceDesc.Streams[0], Irelia_StreamInterview_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamInterviewRequest, InterviewEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Irelia_StreamInterviewClient = grpc.ServerStreamingClient[InterviewEvent]
+
 func (c *ireliaClient) SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest, opts ...grpc.CallOption) (*SubmitAnswerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubmitAnswerResponse)
@@ -93,6 +140,36 @@ func (c *ireliaClient) SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest
 	return out, nil
 }
 
+func (c *ireliaClient) SkipQuestion(ctx context.Context, in *SkipQuestionRequest, opts ...grpc.CallOption) (*SkipQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipQuestionResponse)
+	err := c.cc.Invoke(ctx, Irelia_SkipQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ireliaClient) ResumeInterview(ctx context.Context, in *ResumeInterviewRequest, opts ...grpc.CallOption) (*ResumeInterviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeInterviewResponse)
+	err := c.cc.Invoke(ctx, Irelia_ResumeInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ireliaClient) AbandonInterview(ctx context.Context, in *AbandonInterviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Irelia_AbandonInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ireliaClient) SubmitInterview(ctx context.Context, in *SubmitInterviewRequest, opts ...grpc.CallOption) (*SubmitInterviewResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubmitInterviewResponse)
@@ -103,6 +180,16 @@ func (c *ireliaClient) SubmitInterview(ctx context.Context, in *SubmitInterviewR
 	return out, nil
 }
 
+func (c *ireliaClient) RetryScoring(ctx context.Context, in *RetryScoringRequest, opts ...grpc.CallOption) (*RetryScoringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryScoringResponse)
+	err := c.cc.Invoke(ctx, Irelia_RetryScoring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ireliaClient) GetInterviewHistory(ctx context.Context, in *GetInterviewHistoryRequest, opts ...grpc.CallOption) (*GetInterviewHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetInterviewHistoryResponse)
@@ -133,6 +220,26 @@ func (c *ireliaClient) FavoriteInterview(ctx context.Context, in *FavoriteInterv
 	return out, nil
 }
 
+func (c *ireliaClient) DeleteInterview(ctx context.Context, in *DeleteInterviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Irelia_DeleteInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ireliaClient) ExportInterview(ctx context.Context, in *ExportInterviewRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, Irelia_ExportInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ireliaClient) DemoInterview(ctx context.Context, in *DemoRequest, opts ...grpc.CallOption) (*DemoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DemoResponse)
@@ -153,6 +260,56 @@ func (c *ireliaClient) GetPublicQuestion(ctx context.Context, in *GetPublicQuest
 	return out, nil
 }
 
+func (c *ireliaClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, Irelia_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ireliaClient) GetServiceMetrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Irelia_GetServiceMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ireliaClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationQueueResponse)
+	err := c.cc.Invoke(ctx, Irelia_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ireliaClient) ModeratePublicQuestion(ctx context.Context, in *ModeratePublicQuestionRequest, opts ...grpc.CallOption) (*PublicQuestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublicQuestion)
+	err := c.cc.Invoke(ctx, Irelia_ModeratePublicQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ireliaClient) ListModerationAudit(ctx context.Context, in *ListModerationAuditRequest, opts ...grpc.CallOption) (*ListModerationAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationAuditResponse)
+	err := c.cc.Invoke(ctx, Irelia_ListModerationAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ireliaClient) GenerateNextQuestion(ctx context.Context, in *NextQuestionRequest, opts ...grpc.CallOption) (*NextQuestionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(NextQuestionResponse)
@@ -190,13 +347,27 @@ type IreliaServer interface {
 	// Frontend to Irelia
 	StartInterview(context.Context, *StartInterviewRequest) (*StartInterviewResponse, error)
 	GetNextQuestion(context.Context, *QuestionRequest) (*QuestionResponse, error)
+	StreamInterview(*StreamInterviewRequest, grpc.ServerStreamingServer[InterviewEvent]) error
 	SubmitAnswer(context.Context, *SubmitAnswerRequest) (*SubmitAnswerResponse, error)
+	SkipQuestion(context.Context, *SkipQuestionRequest) (*SkipQuestionResponse, error)
+	ResumeInterview(context.Context, *ResumeInterviewRequest) (*ResumeInterviewResponse, error)
+	AbandonInterview(context.Context, *AbandonInterviewRequest) (*emptypb.Empty, error)
 	SubmitInterview(context.Context, *SubmitInterviewRequest) (*SubmitInterviewResponse, error)
+	RetryScoring(context.Context, *RetryScoringRequest) (*RetryScoringResponse, error)
 	GetInterviewHistory(context.Context, *GetInterviewHistoryRequest) (*GetInterviewHistoryResponse, error)
 	GetInterview(context.Context, *GetInterviewRequest) (*GetInterviewResponse, error)
 	FavoriteInterview(context.Context, *FavoriteInterviewRequest) (*emptypb.Empty, error)
+	DeleteInterview(context.Context, *DeleteInterviewRequest) (*emptypb.Empty, error)
+	ExportInterview(context.Context, *ExportInterviewRequest) (*httpbody.HttpBody, error)
 	DemoInterview(context.Context, *DemoRequest) (*DemoResponse, error)
 	GetPublicQuestion(context.Context, *GetPublicQuestionRequest) (*GetPublicQuestionResponse, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	// Upstream, worker and cache counters of the replica serving the request, for admins
+	GetServiceMetrics(context.Context, *emptypb.Empty) (*structpb.Struct, error)
+	// Public question moderation, by business managers and admins
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error)
+	ModeratePublicQuestion(context.Context, *ModeratePublicQuestionRequest) (*PublicQuestion, error)
+	ListModerationAudit(context.Context, *ListModerationAuditRequest) (*ListModerationAuditResponse, error)
 	// Irelia to Darius (Question Generator)
 	GenerateNextQuestion(context.Context, *NextQuestionRequest) (*NextQuestionResponse, error)
 	ScoreInterview(context.Context, *ScoreInterviewRequest) (*ScoreInterviewResponse, error)
@@ -217,12 +388,27 @@ func (UnimplementedIreliaServer) StartInterview(context.Context, *StartInterview
 func (UnimplementedIreliaServer) GetNextQuestion(context.Context, *QuestionRequest) (*QuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNextQuestion not implemented")
 }
+func (UnimplementedIreliaServer) StreamInterview(*StreamInterviewRequest, grpc.ServerStreamingServer[InterviewEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamInterview not implemented")
+}
 func (UnimplementedIreliaServer) SubmitAnswer(context.Context, *SubmitAnswerRequest) (*SubmitAnswerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnswer not implemented")
 }
+func (UnimplementedIreliaServer) SkipQuestion(context.Context, *SkipQuestionRequest) (*SkipQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipQuestion not implemented")
+}
+func (UnimplementedIreliaServer) ResumeInterview(context.Context, *ResumeInterviewRequest) (*ResumeInterviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeInterview not implemented")
+}
+func (UnimplementedIreliaServer) AbandonInterview(context.Context, *AbandonInterviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonInterview not implemented")
+}
 func (UnimplementedIreliaServer) SubmitInterview(context.Context, *SubmitInterviewRequest) (*SubmitInterviewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitInterview not implemented")
 }
+func (UnimplementedIreliaServer) RetryScoring(context.Context, *RetryScoringRequest) (*RetryScoringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryScoring not implemented")
+}
 func (UnimplementedIreliaServer) GetInterviewHistory(context.Context, *GetInterviewHistoryRequest) (*GetInterviewHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInterviewHistory not implemented")
 }
@@ -232,12 +418,33 @@ func (UnimplementedIreliaServer) GetInterview(context.Context, *GetInterviewRequ
 func (UnimplementedIreliaServer) FavoriteInterview(context.Context, *FavoriteInterviewRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FavoriteInterview not implemented")
 }
+func (UnimplementedIreliaServer) DeleteInterview(context.Context, *DeleteInterviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInterview not implemented")
+}
+func (UnimplementedIreliaServer) ExportInterview(context.Context, *ExportInterviewRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInterview not implemented")
+}
 func (UnimplementedIreliaServer) DemoInterview(context.Context, *DemoRequest) (*DemoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DemoInterview not implemented")
 }
 func (UnimplementedIreliaServer) GetPublicQuestion(context.Context, *GetPublicQuestionRequest) (*GetPublicQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublicQuestion not implemented")
 }
+func (UnimplementedIreliaServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedIreliaServer) GetServiceMetrics(context.Context, *emptypb.Empty) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceMetrics not implemented")
+}
+func (UnimplementedIreliaServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedIreliaServer) ModeratePublicQuestion(context.Context, *ModeratePublicQuestionRequest) (*PublicQuestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModeratePublicQuestion not implemented")
+}
+func (UnimplementedIreliaServer) ListModerationAudit(context.Context, *ListModerationAuditRequest) (*ListModerationAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationAudit not implemented")
+}
 func (UnimplementedIreliaServer) GenerateNextQuestion(context.Context, *NextQuestionRequest) (*NextQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateNextQuestion not implemented")
 }
@@ -303,6 +510,17 @@ func _Irelia_GetNextQuestion_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Irelia_StreamInterview_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamInterviewRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IreliaServer).StreamInterview(m, &grpc.GenericServerStream[StreamInterviewRequest, InterviewEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Irelia_StreamInterviewServer = grpc.ServerStreamingServer[InterviewEvent]
+
 func _Irelia_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitAnswerRequest)
 	if err := dec(in); err != nil {
@@ -321,6 +539,60 @@ func _Irelia_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Irelia_SkipQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).SkipQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_SkipQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).SkipQuestion(ctx, req.(*SkipQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irelia_ResumeInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).ResumeInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_ResumeInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).ResumeInterview(ctx, req.(*ResumeInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irelia_AbandonInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).AbandonInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_AbandonInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).AbandonInterview(ctx, req.(*AbandonInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Irelia_SubmitInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitInterviewRequest)
 	if err := dec(in); err != nil {
@@ -339,6 +611,24 @@ func _Irelia_SubmitInterview_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Irelia_RetryScoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryScoringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).RetryScoring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_RetryScoring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).RetryScoring(ctx, req.(*RetryScoringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Irelia_GetInterviewHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInterviewHistoryRequest)
 	if err := dec(in); err != nil {
@@ -393,6 +683,42 @@ func _Irelia_FavoriteInterview_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Irelia_DeleteInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).DeleteInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_DeleteInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).DeleteInterview(ctx, req.(*DeleteInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irelia_ExportInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).ExportInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_ExportInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).ExportInterview(ctx, req.(*ExportInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Irelia_DemoInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DemoRequest)
 	if err := dec(in); err != nil {
@@ -429,6 +755,96 @@ func _Irelia_GetPublicQuestion_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Irelia_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irelia_GetServiceMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).GetServiceMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_GetServiceMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).GetServiceMetrics(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irelia_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irelia_ModeratePublicQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeratePublicQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).ModeratePublicQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_ModeratePublicQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).ModeratePublicQuestion(ctx, req.(*ModeratePublicQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irelia_ListModerationAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IreliaServer).ListModerationAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Irelia_ListModerationAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IreliaServer).ListModerationAudit(ctx, req.(*ListModerationAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Irelia_GenerateNextQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NextQuestionRequest)
 	if err := dec(in); err != nil {
@@ -502,10 +918,26 @@ var Irelia_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitAnswer",
 			Handler:    _Irelia_SubmitAnswer_Handler,
 		},
+		{
+			MethodName: "SkipQuestion",
+			Handler:    _Irelia_SkipQuestion_Handler,
+		},
+		{
+			MethodName: "ResumeInterview",
+			Handler:    _Irelia_ResumeInterview_Handler,
+		},
+		{
+			MethodName: "AbandonInterview",
+			Handler:    _Irelia_AbandonInterview_Handler,
+		},
 		{
 			MethodName: "SubmitInterview",
 			Handler:    _Irelia_SubmitInterview_Handler,
 		},
+		{
+			MethodName: "RetryScoring",
+			Handler:    _Irelia_RetryScoring_Handler,
+		},
 		{
 			MethodName: "GetInterviewHistory",
 			Handler:    _Irelia_GetInterviewHistory_Handler,
@@ -518,6 +950,14 @@ var Irelia_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FavoriteInterview",
 			Handler:    _Irelia_FavoriteInterview_Handler,
 		},
+		{
+			MethodName: "DeleteInterview",
+			Handler:    _Irelia_DeleteInterview_Handler,
+		},
+		{
+			MethodName: "ExportInterview",
+			Handler:    _Irelia_ExportInterview_Handler,
+		},
 		{
 			MethodName: "DemoInterview",
 			Handler:    _Irelia_DemoInterview_Handler,
@@ -526,6 +966,26 @@ var Irelia_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicQuestion",
 			Handler:    _Irelia_GetPublicQuestion_Handler,
 		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Irelia_GetUsage_Handler,
+		},
+		{
+			MethodName: "GetServiceMetrics",
+			Handler:    _Irelia_GetServiceMetrics_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _Irelia_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ModeratePublicQuestion",
+			Handler:    _Irelia_ModeratePublicQuestion_Handler,
+		},
+		{
+			MethodName: "ListModerationAudit",
+			Handler:    _Irelia_ListModerationAudit_Handler,
+		},
 		{
 			MethodName: "GenerateNextQuestion",
 			Handler:    _Irelia_GenerateNextQuestion_Handler,
@@ -539,6 +999,12 @@ var Irelia_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Irelia_GenerateLipSync_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamInterview",
+			Handler:       _Irelia_StreamInterview_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/irelia.proto",
 }
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+    "context"
+
+    "go.uber.org/zap"
+
+    repo "irelia/internal/repo"
+    "irelia/pkg/database/client"
+    "irelia/pkg/ent"
+    "irelia/pkg/ent/migrate"
+)
+
+// dedupePublicQuestions collapses the public questions stored repeatedly before the bank was deduplicated
+func dedupePublicQuestions(logger *zap.Logger) {
+    drv, err := client.Open("mysql_irelia", client.ReadConfig())
+    if err != nil {
+        logger.Fatal("Failed to initialize Ent driver", zap.Error(err))
+    }
+    entClient := ent.NewClient(ent.Driver(drv))
+    defer entClient.Close()
+
+    // The migration adds the hash and occurrence columns the deduplication fills
+    ctx := context.Background()
+    if err := entClient.Schema.Create(ctx, migrate.WithDropIndex(true)); err != nil {
+        logger.Fatal("can not init my database", zap.Error(err))
+    }
+
+    removed, err := repo.NewPublicQuestionRepository(entClient).Dedupe(ctx, 1000)
+    if err != nil {
+        logger.Fatal("Public question deduplication failed", zap.Int("removed", removed), zap.Error(err))
+    }
+    logger.Info("Public question deduplication completed", zap.Int("removed", removed))
+}
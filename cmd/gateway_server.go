@@ -15,6 +15,11 @@ import (
     "google.golang.org/grpc/credentials/insecure"
 
     api "irelia/api"
+    feat "irelia/internal/features"
+    "irelia/internal/utils/auth"
+    "irelia/internal/utils/blob"
+    "irelia/internal/utils/broker"
+    "irelia/internal/utils/sse"
 )
 
 func maxBytesMiddleware(limit int64, next http.Handler) http.Handler {
@@ -24,13 +29,22 @@ func maxBytesMiddleware(limit int64, next http.Handler) http.Handler {
     })
 }
 
-func startGateway(logger *zap.Logger) {
+// outgoingHeaderMatcher passes the file name of downloads through as is, other gRPC headers keep the default prefix
+func outgoingHeaderMatcher(key string) (string, bool) {
+    if key == "content-disposition" {
+        return "Content-Disposition", true
+    }
+    return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
+func startGateway(logger *zap.Logger, broker broker.Broker, authorizer *auth.Authorizer, blobs blob.Store) {
     const maxSize = 10 * 1024 * 1024 // 10 MB
     ctx, cancel := context.WithCancel(context.Background())
     defer cancel()
 
     mux := runtime.NewServeMux(
         runtime.WithMetadata(customMetadataAnnotator),
+        runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
     )
     opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
     err := api.RegisterIreliaHandlerFromEndpoint(
@@ -43,6 +57,20 @@ func startGateway(logger *zap.Logger) {
         logger.Fatal("Failed to register gateway handler", zap.Error(err))
     }
 
+    // Interview events for every tab of the user, delivered from any replica through the broker
+    events := sse.New(broker, authorizer, customMetadataAnnotator, feat.UserTopic,
+        []api.BulbasaurRole{api.BulbasaurRole_ROLE_CANDIDATE}, viper.GetInt("sse.heartbeat"), logger)
+    if err := mux.HandlePath(http.MethodGet, "/events", events.ServeHTTP); err != nil {
+        logger.Fatal("Failed to register SSE handler", zap.Error(err))
+    }
+
+    // Filesystem blobs are downloaded through the gateway, S3 serves its own presigned URLs
+    if local, ok := blobs.(*blob.Local); ok {
+        if err := mux.HandlePath(http.MethodGet, "/blobs/{key=**}", local.ServeHTTP); err != nil {
+            logger.Fatal("Failed to register blob handler", zap.Error(err))
+        }
+    }
+
     handler := maxBytesMiddleware(maxSize, mux)
 
     httpServer := &http.Server{
@@ -19,6 +19,9 @@ import (
 	feat "irelia/internal/features"
 	repo "irelia/internal/repo"
 	rb "irelia/pkg/rabbit/pkg"
+	"irelia/internal/utils/auth"
+	"irelia/internal/utils/blob"
+	"irelia/internal/utils/broker"
 	"irelia/internal/utils/redis"
 	"irelia/pkg/database/client"
 	"irelia/pkg/ent"
@@ -38,7 +41,7 @@ func customMetadataAnnotator(ctx context.Context, req *http.Request) metadata.MD
 	return md
 }
 
-func startGRPC(logger *zap.Logger) {
+func startGRPC(logger *zap.Logger, broker broker.Broker, authorizer *auth.Authorizer, blobs blob.Store) {
 	dbconfig := client.ReadConfig()
 	rbconfig := rb.ReadConfig()
 	rdsconfig := redis.ReadConfig()
@@ -64,12 +67,15 @@ func startGRPC(logger *zap.Logger) {
     repository := repo.New(entClient)
 
 	// Start consuming messages from RabbitMQ
-	irelia := feat.New(repository, rabbitMQ, logger, redis)
+	irelia := feat.New(repository, rabbitMQ, logger, redis, broker, blobs)
 	// go rabbitMQ.Consume(context.Background(), irelia.ReceiveScore)
 
 
 	// Start gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authorizer.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(authorizer.StreamServerInterceptor()),
+	)
 	api.RegisterIreliaServer(grpcServer, irelia)
 	reflection.Register(grpcServer)
 
@@ -93,4 +99,5 @@ func startGRPC(logger *zap.Logger) {
 	logger.Info("Shutting down gRPC server...")
 	grpcServer.GracefulStop()
 	logger.Info("gRPC server stopped")
+	irelia.Stop()
 }
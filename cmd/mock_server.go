@@ -0,0 +1,30 @@
+package cmd
+
+import (
+    "fmt"
+    "net/http"
+
+    "github.com/spf13/viper"
+    "go.uber.org/zap"
+
+    "irelia/internal/service/mock"
+)
+
+// startMockUpstreams serves deterministic Darius and Karma endpoints for offline development
+func startMockUpstreams(logger *zap.Logger) {
+    port := viper.GetString("mock.port")
+    logger.Info("Starting mock upstreams server", zap.String("port", port))
+    if err := http.ListenAndServe(fmt.Sprintf(":%s", port), mock.New(logger).Handler()); err != nil {
+        logger.Fatal("Failed to serve mock upstreams", zap.Error(err))
+    }
+}
+
+// embedMockUpstreams serves the mock upstreams in-process and points the Darius and Karma URLs at them
+func embedMockUpstreams(logger *zap.Logger) {
+    base := fmt.Sprintf("http://localhost:%s", viper.GetString("mock.port"))
+    for key, route := range mock.ConfigKeys {
+        viper.Set(key, base+route)
+    }
+    logger.Warn("Darius and Karma are replaced by embedded mock upstreams", zap.String("baseURL", base))
+    go startMockUpstreams(logger)
+}
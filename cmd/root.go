@@ -7,6 +7,13 @@ import (
     "github.com/joho/godotenv"
     "github.com/spf13/viper"
     
+    ireliaApi "irelia/api"
+    feat "irelia/internal/features"
+    "irelia/internal/utils/auth"
+    "irelia/internal/utils/blob"
+    "irelia/internal/utils/broker"
+    ext "irelia/internal/utils/extractor"
+    "irelia/internal/utils/redis"
     api "irelia/pkg/logger/api"
     "irelia/pkg/logger/pkg"
 )
@@ -30,6 +37,9 @@ func Execute() {
     viper.BindEnv("rabbitmq.password", "RABBITMQ_PASSWORD")
     viper.BindEnv("redis.address", "REDIS_ADDRESS")
     viper.BindEnv("redis.namespace", "REDIS_NAMESPACE")
+    viper.BindEnv("blob.local.secret", "BLOB_SECRET")
+    viper.BindEnv("blob.s3.access_key", "S3_ACCESS_KEY")
+    viper.BindEnv("blob.s3.secret_key", "S3_SECRET_KEY")
 
     viper.SetConfigFile(*configPath)
     if err := viper.ReadInConfig(); err != nil {
@@ -45,7 +55,42 @@ func Execute() {
     }
     logger := logging.Logger(context.TODO())
 
-    // startSSE()
-	go startGRPC(logger)
-	startGateway(logger)
+    // `mock-upstreams` only serves fake Darius and Karma endpoints for other local instances
+    if flag.Arg(0) == "mock-upstreams" {
+        startMockUpstreams(logger)
+        return
+    }
+    // `dedupe-public-questions` collapses the duplicates stored in the public question bank, then exits
+    if flag.Arg(0) == "dedupe-public-questions" {
+        dedupePublicQuestions(logger)
+        return
+    }
+    if viper.GetBool("mock.embedded") {
+        embedMockUpstreams(logger)
+    }
+
+    // Shared by the gRPC server publishing events and the gateway streaming them over SSE
+    broker := broker.New(viper.GetString("broker.backend"), redis.ReadConfig())
+    authorizer := auth.New(ext.New(), ireliaApi.Irelia_ServiceDesc.ServiceName, feat.AccessPolicy)
+
+    // Question audio is kept out of the database when a blob store is configured
+    blobs, err := blob.New(blob.ReadConfig())
+    if err != nil {
+        log.Fatalf("Failed to initialize blob store: %v", err)
+    }
+
+    // `warmup-lipsync` fills the lip sync cache with the intro and outro of every voice, then exits
+    if flag.Arg(0) == "warmup-lipsync" {
+        warmupLipSync(logger, blobs)
+        return
+    }
+
+	grpcDone := make(chan struct{})
+	go func() {
+		defer close(grpcDone)
+		startGRPC(logger, broker, authorizer, blobs)
+	}()
+	startGateway(logger, broker, authorizer, blobs)
+	// Both servers stop on the same signal, the gRPC one still has to drain its calls and flush the usage ledger
+	<-grpcDone
 }
\ No newline at end of file
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+    "context"
+
+    "github.com/spf13/viper"
+    "go.uber.org/zap"
+
+    feat "irelia/internal/features"
+    sv "irelia/internal/service"
+    "irelia/internal/utils/blob"
+    "irelia/internal/utils/redis"
+)
+
+// warmupLipSync pre-renders the fixed intro and outro utterances of every configured voice into the lip sync cache
+func warmupLipSync(logger *zap.Logger, blobs blob.Store) {
+    var voices []feat.Voice
+    if err := viper.UnmarshalKey("lipsync.voices", &voices); err != nil {
+        logger.Fatal("Failed to read lip sync voices", zap.Error(err))
+    }
+    speeds := []int32{}
+    for _, speed := range viper.GetIntSlice("lipsync.warmup_speeds") {
+        speeds = append(speeds, int32(speed))
+    }
+    if len(speeds) == 0 {
+        speeds = []int32{0}
+    }
+
+    cache := feat.NewLipSyncCache(redis.New(true, redis.ReadConfig()), logger)
+    renderer := feat.NewLipSyncRenderer(sv.NewKarmaClient(logger), cache, blobs, nil, logger)
+
+    rendered, err := feat.WarmLipSync(context.Background(), renderer, voices, speeds)
+    if err != nil {
+        logger.Fatal("Lip sync warmup failed", zap.Int("rendered", rendered), zap.Error(err))
+    }
+    logger.Info("Lip sync warmup completed", zap.Int("rendered", rendered), zap.Any("cache", cache.GetMetrics()))
+}
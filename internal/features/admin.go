@@ -0,0 +1,274 @@
+package features
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "irelia/api"
+	"irelia/pkg/ent"
+)
+
+/*
+* ADMIN FUNCTIONS
+ */
+
+// GenerateNextQuestion runs the question generator for an interview, submissions and context default to the stored ones.
+// With persist it replaces a question the candidate has not answered yet with the first generated one
+func (s *Irelia) GenerateNextQuestion(ctx context.Context, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error) {
+	adminID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+
+	var question *ent.Question
+	if req.Persist {
+		if req.QuestionIndex <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "A question index is required to persist a generated question")
+		}
+		question, err = s.repo.Question.Get(ctx, interview.ID, req.QuestionIndex)
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "Question %d not found", req.QuestionIndex)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to retrieve question: %v", err)
+		}
+		if question.Status != pb.QuestionStatus_QUESTION_STATUS_NEW {
+			return nil, status.Errorf(codes.FailedPrecondition, "Question %d has already been answered", req.QuestionIndex)
+		}
+	}
+
+	generatorReq := &pb.NextQuestionRequest{
+		InterviewId:        interview.ID,
+		Submissions:        req.Submissions,
+		Context:            req.Context,
+		RemainingQuestions: req.RemainingQuestions,
+	}
+	if generatorReq.Context == nil {
+		generatorReq.Context, err = s.generationContext(ctx, interview.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to retrieve interview context: %v", err)
+		}
+	}
+	if len(generatorReq.Submissions) == 0 {
+		generatorReq.Submissions, err = s.repo.Question.GetQaPair(ctx, interview.ID, viper.GetInt("context_qa_length"))
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.Internal, "Failed to retrieve submissions: %v", err)
+		}
+	}
+	if generatorReq.RemainingQuestions <= 0 {
+		generatorReq.RemainingQuestions = 1
+	}
+
+	resp, err := s.generateQuestions(ctx, adminID, generatorReq)
+	if err != nil {
+		s.logger.Error("Failed to generate questions", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Unavailable, "Failed to generate questions: %v", err)
+	}
+	s.logger.Info("Questions generated on admin request", zap.Uint64("adminId", adminID),
+		zap.String("interviewId", interview.ID),
+		zap.Strings("questions", resp.Questions))
+
+	if question == nil {
+		return resp, nil
+	}
+	if len(resp.Questions) == 0 {
+		return nil, status.Errorf(codes.Unavailable, "No question generated")
+	}
+
+	// The previous audio belongs to the old content
+	question.Content = resp.Questions[0]
+	question.Audio = ""
+	question.AudioKey = ""
+	question.Lipsync = nil
+	question.AudioPending = false
+	question = s.renderQuestion(ctx, question, interview, false)
+	if err := s.repo.Question.Update(ctx, interview.UserID, question); err != nil {
+		s.logger.Error("Failed to replace question", zap.String("interviewId", interview.ID), zap.Int32("questionIndex", question.QuestionIndex), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to replace question: %v", err)
+	}
+	s.logger.Info("Question replaced on admin request", zap.Uint64("adminId", adminID),
+		zap.String("interviewId", interview.ID),
+		zap.Int32("questionIndex", question.QuestionIndex))
+
+	s.publishEvent(ctx, interview.UserID, s.questionReadyEvent(question, interview))
+	if question.AudioPending {
+		s.enqueueLipSync(interview.UserID, interview, question.QuestionIndex, question.SubIndex)
+	}
+	return resp, nil
+}
+
+// ScoreInterview runs the content scorer for an interview, submissions and skills default to the stored ones.
+// With persist it re-scores the stored answers with every configured scorer and saves the results like a submission would
+func (s *Irelia) ScoreInterview(ctx context.Context, req *pb.ScoreInterviewRequest) (*pb.ScoreInterviewResponse, error) {
+	adminID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+
+	if req.Persist {
+		if interview.Status == pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+			return nil, status.Errorf(codes.FailedPrecondition, "Interview has not been submitted yet")
+		}
+		job, err := s.repo.Job.Get(ctx, pb.JobKind_JOB_KIND_SCORING, interview.ID, 0, 0)
+		if err == nil && (job.Status == pb.JobStatus_JOB_STATUS_PENDING || job.Status == pb.JobStatus_JOB_STATUS_RUNNING) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Interview is already being scored")
+		}
+
+		// Fluency is scored again too, the saved result would otherwise be reused
+		interview.Skills = contentSkills(interview)
+		interview.FluencyResult = nil
+		resp, err := s.scoreInterview(ctx, interview.UserID, interview)
+		if err != nil {
+			s.logger.Error("Failed to re-score interview", zap.String("interviewId", interview.ID), zap.Error(err))
+			return nil, status.Errorf(codes.Unavailable, "Failed to score interview: %v", err)
+		}
+		s.logger.Info("Interview re-scored on admin request", zap.Uint64("adminId", adminID),
+			zap.String("interviewId", interview.ID),
+			zap.Float64("overallScore", interview.OverallScore))
+		return resp, nil
+	}
+
+	scorerReq := &pb.ScoreInterviewRequest{
+		InterviewId: interview.ID,
+		Submissions: req.Submissions,
+		Skills:      req.Skills,
+	}
+	if len(scorerReq.Submissions) == 0 {
+		answers, err := s.repo.Question.GetAnswers(ctx, interview.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to retrieve answers: %v", err)
+		}
+		scorerReq.Submissions, _, _ = scoringSubmissions(answers)
+	}
+	if len(scorerReq.Skills) == 0 {
+		scorerReq.Skills = interview.Skills
+	}
+	if len(scorerReq.Submissions) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Interview has no answers to score")
+	}
+
+	resp, err := s.callDariusForScore(ctx, adminID, scorerReq)
+	if err != nil {
+		s.logger.Error("Failed to score interview", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Unavailable, "Failed to score interview: %v", err)
+	}
+	s.logger.Info("Interview scored on admin request", zap.Uint64("adminId", adminID),
+		zap.String("interviewId", interview.ID),
+		zap.Any("totalScore", resp.TotalScore))
+	return resp, nil
+}
+
+// GenerateLipSync renders arbitrary content or a question of an interview with the interview's voice unless another
+// is given. With persist the rendered audio replaces the question's audio
+func (s *Irelia) GenerateLipSync(ctx context.Context, req *pb.LipSyncRequest) (*pb.LipSyncResponse, error) {
+	adminID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+	if req.VoiceId != "" {
+		interview.VoiceID = req.VoiceId
+	}
+	if req.Speed != 0 {
+		interview.Speed = req.Speed
+	}
+
+	if req.Content != "" && !req.Persist {
+		resp, err := s.lipSync.Render(ctx, adminID, interview.ID, req.Content, interview.VoiceID, interview.Language, interview.Speed)
+		if err != nil {
+			s.logger.Error("Failed to generate lip sync", zap.String("interviewId", interview.ID), zap.Error(err))
+			return nil, status.Errorf(codes.Unavailable, "Failed to generate lip sync: %v", err)
+		}
+		s.logger.Info("Lip sync rendered on admin request", zap.Uint64("adminId", adminID), zap.String("interviewId", interview.ID))
+		resp.AudioUrl = s.audioURL(resp.AudioKey)
+		return resp, nil
+	}
+
+	if req.QuestionIndex <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Either content or a question index is required, persisting requires a question index")
+	}
+	question, err := s.repo.Question.GetTurn(ctx, interview.ID, req.QuestionIndex, req.SubIndex)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "Question %d.%d not found", req.QuestionIndex, req.SubIndex)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve question: %v", err)
+	}
+	if req.Content != "" {
+		question.Content = req.Content
+	}
+
+	question, err = s.prepareLipSync(ctx, question, interview, false, false)
+	if err != nil {
+		s.logger.Error("Failed to generate lip sync", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Unavailable, "Failed to generate lip sync: %v", err)
+	}
+	s.logger.Info("Question lip sync rendered on admin request", zap.Uint64("adminId", adminID),
+		zap.String("interviewId", interview.ID),
+		zap.Int32("questionIndex", question.QuestionIndex),
+		zap.Int32("subIndex", question.SubIndex))
+
+	if req.Persist {
+		question.AudioPending = false
+		if err := s.repo.Question.Update(ctx, interview.UserID, question); err != nil {
+			s.logger.Error("Failed to save question audio", zap.String("interviewId", interview.ID), zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "Failed to save question audio: %v", err)
+		}
+		s.publishEvent(ctx, interview.UserID, &pb.InterviewEvent{
+			InterviewId:   interview.ID,
+			Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_AUDIO_READY,
+			QuestionIndex: question.QuestionIndex,
+			SubIndex:      question.SubIndex,
+			Question:      s.toQuestionResponse(question, interview),
+		})
+	}
+
+	return &pb.LipSyncResponse{
+		Audio:    question.Audio,
+		Lipsync:  question.Lipsync,
+		AudioKey: question.AudioKey,
+		AudioUrl: s.audioURL(question.AudioKey),
+	}, nil
+}
+
+// GetServiceMetrics reports the counters of the replica serving the request: its upstreams with their circuit
+// breakers, worker pools, lip sync cache and background jobs
+func (s *Irelia) GetServiceMetrics(ctx context.Context, req *emptypb.Empty) (*structpb.Struct, error) {
+	metrics, err := structpb.NewStruct(map[string]interface{}{
+		"darius":        s.dariusClient.GetMetrics(),
+		"karma":         s.karmaClient.GetMetrics(),
+		"question_pool": s.questionWorkerPool.GetMetrics(),
+		"scoring_pool":  s.scoringWorkerPool.GetMetrics(),
+		"lipsync_cache": s.lipSync.cache.GetMetrics(),
+		"usage":         s.usage.GetMetrics(),
+		"model_answers": s.answerWorker.GetMetrics(),
+	})
+	if err != nil {
+		s.logger.Error("Failed to encode service metrics", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to encode service metrics: %v", err)
+	}
+	return metrics, nil
+}
@@ -0,0 +1,206 @@
+package features
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	pb "irelia/api"
+	repo "irelia/internal/repo"
+	sv "irelia/internal/service"
+)
+
+// AnswerGenerator writes the model answer of a public bank question, the version it reports is stored
+// as the answer's provenance
+type AnswerGenerator interface {
+	Name() string
+	Answer(ctx context.Context, req *pb.ModelAnswerRequest) (*pb.ModelAnswerResponse, error)
+}
+
+// DariusAnswerGenerator writes model answers through the Darius service
+type DariusAnswerGenerator struct {
+	client *sv.DariusClient
+}
+
+func NewDariusAnswerGenerator(client *sv.DariusClient) *DariusAnswerGenerator {
+	return &DariusAnswerGenerator{client: client}
+}
+
+func (g *DariusAnswerGenerator) Name() string {
+	return "darius"
+}
+
+// Answer calls Darius on behalf of the system, there is no user behind a background generation
+func (g *DariusAnswerGenerator) Answer(ctx context.Context, req *pb.ModelAnswerRequest) (*pb.ModelAnswerResponse, error) {
+	return g.client.Answer(ctx, "0", req)
+}
+
+// newAnswerGenerator returns the configured answer generator, nil when model answers are disabled
+func newAnswerGenerator(logger *zap.Logger, darius *sv.DariusClient) AnswerGenerator {
+	switch name := viper.GetString("answers.generator"); name {
+	case "", "darius":
+		return NewDariusAnswerGenerator(darius)
+	case "none":
+		return nil
+	default:
+		logger.Warn("Unknown answer generator, disabling model answers", zap.String("generator", name))
+		return nil
+	}
+}
+
+// ModelAnswerWorker periodically writes the model answers of the public questions that have none yet. Every replica
+// runs one, a question is leased before its generation so that it is generated once. A question is left to the
+// moderators once its generation has failed max attempts times
+type ModelAnswerWorker struct {
+	questions   repo.IPublicQuestion
+	generator   AnswerGenerator
+	usage       *UsageLedger
+	logger      *zap.Logger
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	lease       time.Duration
+	owner       string
+	ctx         context.Context
+	cancel      context.CancelFunc
+	// Metrics
+	generated int64
+	failed    int64
+}
+
+// NewModelAnswerWorker creates a worker, a nil generator disables it
+func NewModelAnswerWorker(questions repo.IPublicQuestion, generator AnswerGenerator, usage *UsageLedger, logger *zap.Logger, interval, batchSize, maxAttempts, lease int) *ModelAnswerWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+	if interval <= 0 {
+		interval = 600
+	}
+	if batchSize <= 0 {
+		batchSize = 20
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	if lease <= 0 {
+		lease = 300
+	}
+	hostname, _ := os.Hostname()
+	return &ModelAnswerWorker{
+		questions:   questions,
+		generator:   generator,
+		usage:       usage,
+		logger:      logger,
+		interval:    time.Duration(interval) * time.Second,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		lease:       time.Duration(lease) * time.Second,
+		owner:       fmt.Sprintf("%s:%d:answers", hostname, os.Getpid()),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+func (w *ModelAnswerWorker) Start() {
+	if w.generator == nil {
+		w.logger.Info("Model answer worker disabled")
+		return
+	}
+
+	w.logger.Info("Starting model answer worker",
+		zap.String("generator", w.generator.Name()),
+		zap.Duration("interval", w.interval),
+		zap.Int("batchSize", w.batchSize))
+
+	go w.run()
+}
+
+func (w *ModelAnswerWorker) Stop() {
+	w.cancel()
+}
+
+func (w *ModelAnswerWorker) run() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.answer()
+		case <-w.ctx.Done():
+			w.logger.Info("Model answer worker stopped")
+			return
+		}
+	}
+}
+
+func (w *ModelAnswerWorker) answer() {
+	ctx, cancel := context.WithTimeout(w.ctx, w.interval)
+	defer cancel()
+
+	questions, err := w.questions.MissingAnswers(ctx, w.maxAttempts, w.batchSize)
+	if err != nil {
+		w.logger.Error("Failed to list public questions without a model answer", zap.Error(err))
+		return
+	}
+
+	for _, question := range questions {
+		if ctx.Err() != nil {
+			return
+		}
+		claimed, err := w.questions.ClaimAnswer(ctx, question.ID, w.owner, w.lease)
+		if err != nil {
+			w.logger.Error("Failed to lease public question for a model answer", zap.Int("questionId", question.ID), zap.Error(err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		req := &pb.ModelAnswerRequest{
+			Question:   question.Content,
+			Position:   question.Position,
+			Experience: question.Experience,
+			Language:   question.Language,
+		}
+		started := time.Now()
+		resp, err := w.generator.Answer(ctx, req)
+		if err == nil && strings.TrimSpace(resp.Answer) == "" {
+			err = errors.New("empty model answer")
+		}
+		w.usage.Record(0, "", w.generator.Name(), "answer", started, req, resp, err)
+		if err != nil {
+			atomic.AddInt64(&w.failed, 1)
+			w.logger.Warn("Failed to generate model answer", zap.Int("questionId", question.ID), zap.Error(err))
+			if err := w.questions.AnswerFailed(ctx, question.ID); err != nil {
+				w.logger.Error("Failed to record model answer failure", zap.Int("questionId", question.ID), zap.Error(err))
+			}
+			continue
+		}
+
+		saved, err := w.questions.SaveAnswer(ctx, question.ID, w.owner, strings.TrimSpace(resp.Answer), w.generator.Name(), resp.Version, time.Now())
+		if err != nil {
+			w.logger.Error("Failed to save model answer", zap.Int("questionId", question.ID), zap.Error(err))
+			continue
+		}
+		if saved {
+			atomic.AddInt64(&w.generated, 1)
+		}
+	}
+	if len(questions) > 0 {
+		w.logger.Info("Model answers generated", zap.Int("questions", len(questions)), zap.Any("metrics", w.GetMetrics()))
+	}
+}
+
+// GetMetrics returns model answer worker metrics
+func (w *ModelAnswerWorker) GetMetrics() map[string]interface{} {
+	return map[string]interface{}{
+		"generated": atomic.LoadInt64(&w.generated),
+		"failed":    atomic.LoadInt64(&w.failed),
+	}
+}
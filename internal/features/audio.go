@@ -0,0 +1,122 @@
+package features
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	pb "irelia/api"
+	"irelia/pkg/ent"
+)
+
+// renderQuestion attaches audio and lip-sync to a question, when Karma is unavailable the question
+// is kept as text marked audio pending so the candidate is not blocked, and its audio is backfilled later
+func (s *Irelia) renderQuestion(ctx context.Context, question *ent.Question, interview *ent.Interview, isTimeout bool) *ent.Question {
+	if interview.TextOnly {
+		return question
+	}
+
+	rendered, err := s.prepareLipSync(ctx, question, interview, false, isTimeout)
+	if err == nil {
+		return rendered
+	}
+	s.logger.Warn("Failed to prepare lip sync, saving the question as text", zap.String("interviewID", interview.ID),
+		zap.Int32("questionID", question.QuestionIndex),
+		zap.Int32("subIndex", question.SubIndex),
+		zap.Error(err))
+	question.AudioPending = true
+	return question
+}
+
+// enqueueLipSync schedules the audio of a question saved as text to be backfilled
+func (s *Irelia) enqueueLipSync(userID uint64, interview *ent.Interview, index int32, subIndex int32) {
+	job := &ent.Job{
+		Kind:          pb.JobKind_JOB_KIND_LIP_SYNC,
+		InterviewID:   interview.ID,
+		QuestionIndex: index,
+		SubIndex:      subIndex,
+		UserID:        userID,
+	}
+
+	s.ensureQuestionWorkerPool()
+	if !s.questionWorkerPool.Enqueue(context.Background(), s.logger, job) {
+		s.logger.Warn("Failed to enqueue lip sync job",
+			zap.String("interviewID", job.InterviewID),
+			zap.Int32("questionID", job.QuestionIndex),
+			zap.Int32("subIndex", job.SubIndex))
+	}
+}
+
+// runLipSyncJob backfills the audio of a question saved as text and pushes it to the candidate
+func (s *Irelia) runLipSyncJob(ctx context.Context, job *ent.Job) error {
+	jobKey := fmt.Sprintf("%s:%d.%d", job.InterviewID, job.QuestionIndex, job.SubIndex)
+
+	interview, err := s.repo.Interview.Get(ctx, job.InterviewID)
+	if ent.IsNotFound(err) {
+		s.logger.Warn("Interview no longer exists, dropping lip sync job", zap.String("jobKey", jobKey))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve interview: %w", err)
+	}
+	if interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		s.logger.Info("Interview is no longer in progress, skipping lip sync", zap.String("jobKey", jobKey),
+			zap.String("status", interview.Status.String()))
+		return nil
+	}
+
+	question, err := s.repo.Question.GetTurn(ctx, job.InterviewID, job.QuestionIndex, job.SubIndex)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve question: %w", err)
+	}
+	if !question.AudioPending {
+		return nil
+	}
+
+	question, err = s.prepareLipSync(ctx, question, interview, false, false)
+	if err != nil {
+		return fmt.Errorf("failed to backfill lip sync: %w", err)
+	}
+	filled, err := s.repo.Question.FillAudio(ctx, question)
+	if err != nil {
+		return fmt.Errorf("failed to save backfilled lip sync: %w", err)
+	}
+	if !filled {
+		return nil
+	}
+
+	question.AudioPending = false
+	s.logger.Info("Backfilled lip sync", zap.String("jobKey", jobKey))
+	s.publishEvent(ctx, job.UserID, &pb.InterviewEvent{
+		InterviewId:   interview.ID,
+		Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_AUDIO_READY,
+		QuestionIndex: question.QuestionIndex,
+		SubIndex:      question.SubIndex,
+		Question:      s.toQuestionResponse(question, interview),
+	})
+	return nil
+}
+
+// audioURL signs a short-lived download URL for stored audio, it is empty for inline audio
+func (s *Irelia) audioURL(key string) string {
+	if s.blobs == nil || key == "" {
+		return ""
+	}
+
+	ttl := time.Duration(viper.GetInt("blob.url_ttl")) * time.Second
+	if ttl <= 0 {
+		ttl = 15 * time.Minute
+	}
+	url, err := s.blobs.SignedURL(context.Background(), key, ttl)
+	if err != nil {
+		s.logger.Error("Failed to sign audio URL", zap.String("key", key), zap.Error(err))
+		return ""
+	}
+	return url
+}
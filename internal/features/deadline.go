@@ -0,0 +1,193 @@
+package features
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	pb "irelia/api"
+	repo "irelia/internal/repo"
+	"irelia/pkg/ent"
+)
+
+// DeadlineSweeper periodically skips questions whose answer deadline has passed.
+// Deadlines live in the database so any replica can enforce them, including after a restart.
+type DeadlineSweeper struct {
+	repo      repo.IQuestion
+	logger    *zap.Logger
+	interval  time.Duration
+	onExpired func(question *ent.Question)
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+// NewDeadlineSweeper creates a sweeper calling onExpired for every question it skips
+func NewDeadlineSweeper(questions repo.IQuestion, logger *zap.Logger, interval int, onExpired func(question *ent.Question)) *DeadlineSweeper {
+	ctx, cancel := context.WithCancel(context.Background())
+	if interval <= 0 {
+		interval = 5
+	}
+	return &DeadlineSweeper{
+		repo:      questions,
+		logger:    logger,
+		interval:  time.Duration(interval) * time.Second,
+		onExpired: onExpired,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (d *DeadlineSweeper) Start() {
+	d.logger.Info("Starting question deadline sweeper", zap.Duration("interval", d.interval))
+	go d.run()
+}
+
+func (d *DeadlineSweeper) Stop() {
+	d.cancel()
+}
+
+func (d *DeadlineSweeper) run() {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.sweep()
+		case <-d.ctx.Done():
+			d.logger.Info("Question deadline sweeper stopped")
+			return
+		}
+	}
+}
+
+func (d *DeadlineSweeper) sweep() {
+	ctx, cancel := context.WithTimeout(d.ctx, d.interval)
+	defer cancel()
+
+	expired, err := d.repo.ExpireDeadlines(ctx, time.Now())
+	if err != nil {
+		d.logger.Error("Failed to expire question deadlines", zap.Error(err))
+	}
+	for _, question := range expired {
+		d.onExpired(question)
+	}
+}
+
+// resolveTimeLimit returns the per-question time limit in seconds for a new interview
+func resolveTimeLimit(requested *int32) int32 {
+	if requested != nil {
+		return *requested
+	}
+	return int32(viper.GetInt("question_timeout"))
+}
+
+// startDeadline starts the answer clock of a question the candidate can now see.
+// It is a no-op for untimed interviews and for questions already running or settled.
+func (s *Irelia) startDeadline(ctx context.Context, interview *ent.Interview, question *ent.Question) *ent.Question {
+	if interview.QuestionTimeLimit <= 0 || interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS ||
+		question.Status != pb.QuestionStatus_QUESTION_STATUS_NEW || question.DeadlineAt != nil {
+		return question
+	}
+
+	deadline := time.Now().Add(time.Duration(interview.QuestionTimeLimit) * time.Second)
+	started, err := s.repo.Question.StartDeadline(ctx, interview.ID, question.QuestionIndex, question.SubIndex, deadline)
+	if err != nil {
+		s.logger.Error("Failed to start question deadline", zap.String("interviewID", interview.ID),
+			zap.Int32("questionIndex", question.QuestionIndex),
+			zap.Int32("subIndex", question.SubIndex),
+			zap.Error(err))
+		return question
+	}
+
+	s.publishEvent(ctx, interview.UserID, &pb.InterviewEvent{
+		InterviewId:   interview.ID,
+		Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_STARTED,
+		QuestionIndex: started.QuestionIndex,
+		SubIndex:      started.SubIndex,
+		Question:      s.toQuestionResponse(started, interview),
+	})
+	return started
+}
+
+// startDeadlineAt starts the answer clock of a question once it has become the current one
+func (s *Irelia) startDeadlineAt(ctx context.Context, interview *ent.Interview, index int32) {
+	if interview.QuestionTimeLimit <= 0 || index > interview.TotalQuestions {
+		return
+	}
+	question, err := s.repo.Question.Get(ctx, interview.ID, index)
+	if err != nil {
+		// Not prepared yet, the clock starts once it is saved or served
+		return
+	}
+	s.startDeadline(ctx, interview, question)
+}
+
+// startDeadlineIfCurrent starts the clock of a freshly prepared question when the candidate is already waiting on it,
+// that is once they are done with the previous question and its follow-ups
+func (s *Irelia) startDeadlineIfCurrent(ctx context.Context, interview *ent.Interview, index int32) {
+	if interview.QuestionTimeLimit <= 0 || index <= 1 {
+		return
+	}
+	if !s.threadFinished(ctx, interview.ID, index-1) {
+		return
+	}
+	s.startDeadlineAt(ctx, interview, index)
+}
+
+// threadFinished reports whether the candidate is done with a main question and its follow-ups. A thread whose last
+// answer calls for a follow-up is not, the follow-up is still being prepared
+func (s *Irelia) threadFinished(ctx context.Context, interviewID string, index int32) bool {
+	thread, err := s.repo.Question.GetThread(ctx, interviewID, index)
+	if err != nil || len(thread) == 0 {
+		return false
+	}
+	last := thread[len(thread)-1]
+	switch last.Status {
+	case pb.QuestionStatus_QUESTION_STATUS_NEW:
+		return false
+	case pb.QuestionStatus_QUESTION_STATUS_ANSWERED:
+		return !s.needsFollowUp(last)
+	default:
+		return true
+	}
+}
+
+// handleExpiredQuestion notifies the candidate of a skipped question and moves the clock to the next one
+func (s *Irelia) handleExpiredQuestion(question *ent.Question) {
+	ctx := context.Background()
+	s.logger.Info("Question deadline passed, question skipped",
+		zap.String("interviewID", question.InterviewID),
+		zap.Int32("questionIndex", question.QuestionIndex))
+
+	interview, err := s.repo.Interview.Get(ctx, question.InterviewID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve interview of expired question", zap.String("interviewID", question.InterviewID), zap.Error(err))
+		return
+	}
+	if interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		return
+	}
+
+	s.publishEvent(ctx, interview.UserID, &pb.InterviewEvent{
+		InterviewId:   question.InterviewID,
+		Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_TIMEOUT,
+		QuestionIndex: question.QuestionIndex,
+		SubIndex:      question.SubIndex,
+	})
+	s.startDeadlineAt(ctx, interview, question.QuestionIndex+1)
+}
+
+// questionDeadline returns the deadline of a question in unix seconds and the time left to answer it
+func questionDeadline(question *ent.Question) (int64, int32) {
+	if question.DeadlineAt == nil {
+		return 0, 0
+	}
+	remaining := time.Until(*question.DeadlineAt)
+	if remaining < 0 || question.Status != pb.QuestionStatus_QUESTION_STATUS_NEW {
+		remaining = 0
+	}
+	return question.DeadlineAt.Unix(), int32(remaining.Round(time.Second) / time.Second)
+}
@@ -0,0 +1,74 @@
+package features
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "irelia/api"
+	"irelia/pkg/ent"
+)
+
+// interviewTopic returns the broker topic carrying events of an interview
+func interviewTopic(interviewID string) string {
+	return fmt.Sprintf("interview:%s", interviewID)
+}
+
+// UserTopic returns the broker topic carrying events of every interview of a user
+func UserTopic(userID uint64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// publishEvent stamps and broadcasts an interview event to the subscribers of the interview and of its owner
+func (s *Irelia) publishEvent(ctx context.Context, userID uint64, event *pb.InterviewEvent) {
+	event.Timestamp = time.Now().Unix()
+
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		s.logger.Error("Failed to marshal interview event", zap.String("interviewID", event.InterviewId), zap.Error(err))
+		return
+	}
+
+	for _, topic := range []string{interviewTopic(event.InterviewId), UserTopic(userID)} {
+		if err := s.broker.Publish(ctx, topic, payload); err != nil {
+			s.logger.Warn("Failed to publish interview event",
+				zap.String("interviewID", event.InterviewId),
+				zap.String("topic", topic),
+				zap.String("type", event.Type.String()),
+				zap.Error(err))
+		}
+	}
+}
+
+// questionReadyEvent builds the event pushed once a question has been saved
+func (s *Irelia) questionReadyEvent(question *ent.Question, interview *ent.Interview) *pb.InterviewEvent {
+	return &pb.InterviewEvent{
+		InterviewId:   interview.ID,
+		Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_READY,
+		QuestionIndex: question.QuestionIndex,
+		SubIndex:      question.SubIndex,
+		Question:      s.toQuestionResponse(question, interview),
+	}
+}
+
+// toQuestionResponse converts a saved question to the response served to the frontend
+func (s *Irelia) toQuestionResponse(question *ent.Question, interview *ent.Interview) *pb.QuestionResponse {
+	deadlineAt, remaining := questionDeadline(question)
+	return &pb.QuestionResponse{
+		QuestionId:       question.QuestionIndex,
+		Content:          question.Content,
+		Audio:            question.Audio,
+		Lipsync:          question.Lipsync,
+		IsLastQuestion:   question.QuestionIndex == interview.TotalQuestions,
+		IsLoading:        false,
+		Timestamp:        time.Now().Unix(),
+		DeadlineAt:       deadlineAt,
+		RemainingSeconds: remaining,
+		SubIndex:         question.SubIndex,
+		AudioPending:     question.AudioPending,
+		AudioUrl:         s.audioURL(question.AudioKey),
+	}
+}
@@ -0,0 +1,269 @@
+package features
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "irelia/api"
+	"irelia/pkg/ent"
+)
+
+//go:embed templates/*.tmpl
+var defaultReportTemplates embed.FS
+
+// exportFormat describes the file produced for an export format
+type exportFormat struct {
+	template    string
+	contentType string
+	extension   string
+	html        bool
+}
+
+var exportFormats = map[pb.ExportFormat]exportFormat{
+	pb.ExportFormat_EXPORT_FORMAT_MARKDOWN: {template: "report.md.tmpl", contentType: "text/markdown; charset=utf-8", extension: "md"},
+	pb.ExportFormat_EXPORT_FORMAT_HTML:     {template: "report.html.tmpl", contentType: "text/html; charset=utf-8", extension: "html", html: true},
+	pb.ExportFormat_EXPORT_FORMAT_JSON:     {template: "report.json.tmpl", contentType: "application/json", extension: "json"},
+}
+
+// Report is the data every report template is executed with, its JSON encoding is the canonical export
+type Report struct {
+	InterviewID        string           `json:"interview_id"`
+	Position           string           `json:"position"`
+	Experience         string           `json:"experience,omitempty"`
+	Language           string           `json:"language"`
+	Status             string           `json:"status"`
+	FailureReason      string           `json:"failure_reason,omitempty"`
+	CreatedAt          time.Time        `json:"created_at"`
+	Questions          []ReportQuestion `json:"questions"`
+	Skills             []ReportSkill    `json:"skills"`
+	TotalScore         *ReportScore     `json:"total_score,omitempty"`
+	PositiveFeedback   string           `json:"positive_feedback,omitempty"`
+	ActionableFeedback string           `json:"actionable_feedback,omitempty"`
+	FinalComment       string           `json:"final_comment,omitempty"`
+}
+
+// ReportQuestion is a question of the transcript, follow-ups are listed under their main question
+type ReportQuestion struct {
+	Index     int32            `json:"index"`
+	SubIndex  int32            `json:"sub_index"`
+	Question  string           `json:"question"`
+	Answer    string           `json:"answer"`
+	Status    string           `json:"status"`
+	Grade     string           `json:"grade,omitempty"`
+	Comment   string           `json:"comment,omitempty"`
+	FollowUps []ReportQuestion `json:"follow_ups,omitempty"`
+}
+
+type ReportSkill struct {
+	Skill string `json:"skill"`
+	Score string `json:"score"`
+}
+
+type ReportScore struct {
+	A       int32 `json:"A"`
+	B       int32 `json:"B"`
+	C       int32 `json:"C"`
+	D       int32 `json:"D"`
+	F       int32 `json:"F"`
+	Skipped int32 `json:"skipped"`
+}
+
+// newReport collects the report of an interview from its stored results
+func newReport(interview *ent.Interview, answers []*pb.AnswerResult) *Report {
+	report := &Report{
+		InterviewID:        interview.ID,
+		Position:           interview.Position,
+		Experience:         interview.Experience,
+		Language:           interview.Language,
+		Status:             enumLabel(interview.Status.String(), "INTERVIEW_STATUS_"),
+		FailureReason:      interview.FailureReason,
+		CreatedAt:          interview.CreatedAt,
+		Questions:          reportQuestions(answers),
+		Skills:             make([]ReportSkill, 0, len(interview.Skills)),
+		PositiveFeedback:   interview.PositiveFeedback,
+		ActionableFeedback: interview.ActionableFeedback,
+		FinalComment:       interview.FinalComment,
+	}
+
+	// Skills keep the order the candidate chose, scores are only known once the interview is scored
+	for i, skill := range interview.Skills {
+		var score string
+		if len(interview.SkillsScore) == len(interview.Skills) {
+			score = interview.SkillsScore[i]
+		}
+		report.Skills = append(report.Skills, ReportSkill{Skill: skill, Score: score})
+	}
+	if total := interview.TotalScore; total != nil {
+		report.TotalScore = &ReportScore{A: total.A, B: total.B, C: total.C, D: total.D, F: total.F, Skipped: total.Skipped}
+	}
+	return report
+}
+
+func reportQuestions(answers []*pb.AnswerResult) []ReportQuestion {
+	questions := make([]ReportQuestion, 0, len(answers))
+	for _, answer := range answers {
+		questions = append(questions, ReportQuestion{
+			Index:     answer.Index,
+			SubIndex:  answer.SubIndex,
+			Question:  answer.Content,
+			Answer:    answer.Answer,
+			Status:    enumLabel(answer.Status.String(), "QUESTION_STATUS_"),
+			Grade:     answer.Score,
+			Comment:   answer.Comment,
+			FollowUps: reportQuestions(answer.FollowUps),
+		})
+	}
+	return questions
+}
+
+// enumLabel turns INTERVIEW_STATUS_IN_PROGRESS into "in progress"
+func enumLabel(name, prefix string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(name, prefix)), "_", " ")
+}
+
+// reportTemplate is implemented by both text and HTML templates
+type reportTemplate interface {
+	Execute(w io.Writer, data any) error
+}
+
+var reportFuncs = map[string]any{
+	// json encodes a value as indented JSON with the fields in declaration order and map keys sorted
+	"json": func(v any) (string, error) {
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(v); err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(buf.String(), "\n"), nil
+	},
+	// cell escapes text for a Markdown table cell
+	"cell": func(s string) string {
+		return strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>").Replace(s)
+	},
+	// quote renders text as a Markdown blockquote
+	"quote": func(s string) string {
+		return "> " + strings.ReplaceAll(strings.TrimSpace(s), "\n", "\n> ")
+	},
+}
+
+// ReportExporter renders interview reports, a deployment overrides a default template by placing a file
+// of the same name in export.templates_dir
+type ReportExporter struct {
+	templates map[pb.ExportFormat]reportTemplate
+}
+
+func NewReportExporter(logger *zap.Logger) *ReportExporter {
+	dir := viper.GetString("export.templates_dir")
+	exporter := &ReportExporter{templates: make(map[pb.ExportFormat]reportTemplate, len(exportFormats))}
+	for format, settings := range exportFormats {
+		if dir != "" {
+			source, err := os.ReadFile(filepath.Join(dir, settings.template))
+			if err == nil {
+				tmpl, err := parseReportTemplate(settings, source)
+				if err == nil {
+					logger.Info("Using report template override", zap.String("template", settings.template), zap.String("dir", dir))
+					exporter.templates[format] = tmpl
+					continue
+				}
+				logger.Error("Failed to parse report template override, using the default", zap.String("template", settings.template), zap.Error(err))
+			} else if !os.IsNotExist(err) {
+				logger.Error("Failed to read report template override, using the default", zap.String("template", settings.template), zap.Error(err))
+			}
+		}
+
+		source, err := defaultReportTemplates.ReadFile("templates/" + settings.template)
+		if err != nil {
+			logger.Fatal("Missing default report template", zap.String("template", settings.template), zap.Error(err))
+		}
+		tmpl, err := parseReportTemplate(settings, source)
+		if err != nil {
+			logger.Fatal("Failed to parse default report template", zap.String("template", settings.template), zap.Error(err))
+		}
+		exporter.templates[format] = tmpl
+	}
+	return exporter
+}
+
+func parseReportTemplate(settings exportFormat, source []byte) (reportTemplate, error) {
+	// HTML templates escape everything the candidate or the scorer wrote
+	if settings.html {
+		return htmltemplate.New(settings.template).Funcs(reportFuncs).Parse(string(source))
+	}
+	return texttemplate.New(settings.template).Funcs(reportFuncs).Parse(string(source))
+}
+
+// Render executes the template of a format, unspecified is Markdown
+func (e *ReportExporter) Render(format pb.ExportFormat, report *Report) ([]byte, exportFormat, error) {
+	if format == pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		format = pb.ExportFormat_EXPORT_FORMAT_MARKDOWN
+	}
+	settings, ok := exportFormats[format]
+	if !ok {
+		return nil, settings, fmt.Errorf("unsupported export format %s", format)
+	}
+
+	var buf bytes.Buffer
+	if err := e.templates[format].Execute(&buf, report); err != nil {
+		return nil, settings, fmt.Errorf("failed to render %s report: %w", settings.extension, err)
+	}
+	return buf.Bytes(), settings, nil
+}
+
+// ExportInterview renders the report of an interview as a downloadable file
+func (s *Irelia) ExportInterview(ctx context.Context, req *pb.ExportInterviewRequest) (*httpbody.HttpBody, error) {
+	if _, ok := exportFormats[req.Format]; !ok && req.Format != pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported export format: %s", req.Format)
+	}
+
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+	if err != nil {
+		s.logger.Error("Failed to retrieve interview", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve interview: %v", err)
+	}
+
+	answers, err := s.repo.Question.List(ctx, interview.ID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve questions", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve questions: %v", err)
+	}
+
+	data, settings, err := s.exporter.Render(req.Format, newReport(interview, answers))
+	if err != nil {
+		s.logger.Error("Failed to render interview report", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to render interview report: %v", err)
+	}
+
+	// The gateway turns this header into the download's file name
+	filename := fmt.Sprintf("interview-%s.%s", interview.ID, settings.extension)
+	if err := grpc.SetHeader(ctx, metadata.Pairs("content-disposition", fmt.Sprintf("attachment; filename=%q", filename))); err != nil {
+		s.logger.Warn("Failed to set export file name", zap.String("interviewId", interview.ID), zap.Error(err))
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: settings.contentType,
+		Data:        data,
+	}, nil
+}
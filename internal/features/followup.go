@@ -0,0 +1,173 @@
+package features
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	pb "irelia/api"
+	"irelia/pkg/ent"
+)
+
+// needsFollowUp reports whether an answer is too thin to move on without probing it further
+func (s *Irelia) needsFollowUp(question *ent.Question) bool {
+	if question.SubIndex >= viper.GetInt32("follow_up.max_per_question") {
+		return false
+	}
+	return len(strings.Fields(question.Answer)) < viper.GetInt("follow_up.min_answer_words")
+}
+
+// enqueueFollowUp schedules a follow-up question threaded under a main question
+func (s *Irelia) enqueueFollowUp(userID uint64, interview *ent.Interview, index int32, subIndex int32) {
+	job := &ent.Job{
+		Kind:          pb.JobKind_JOB_KIND_FOLLOW_UP,
+		InterviewID:   interview.ID,
+		QuestionIndex: index,
+		SubIndex:      subIndex,
+		UserID:        userID,
+	}
+
+	s.ensureQuestionWorkerPool()
+	if !s.questionWorkerPool.Enqueue(context.Background(), s.logger, job) {
+		s.logger.Warn("Failed to enqueue follow-up job",
+			zap.String("interviewID", job.InterviewID),
+			zap.Int32("questionID", job.QuestionIndex),
+			zap.Int32("subIndex", job.SubIndex))
+	}
+}
+
+// runPreparationJob dispatches the jobs of the question worker pool by kind
+func (s *Irelia) runPreparationJob(ctx context.Context, job *ent.Job) error {
+	switch job.Kind {
+	case pb.JobKind_JOB_KIND_FOLLOW_UP:
+		return s.runFollowUpJob(ctx, job)
+	case pb.JobKind_JOB_KIND_LIP_SYNC:
+		return s.runLipSyncJob(ctx, job)
+	default:
+		return s.runQuestionJob(ctx, job)
+	}
+}
+
+// runFollowUpJob generates, renders and saves a follow-up question, then starts its clock since the candidate is waiting on it
+func (s *Irelia) runFollowUpJob(ctx context.Context, job *ent.Job) error {
+	jobKey := fmt.Sprintf("%s:%d.%d", job.InterviewID, job.QuestionIndex, job.SubIndex)
+
+	interview, err := s.repo.Interview.Get(ctx, job.InterviewID)
+	if ent.IsNotFound(err) {
+		s.logger.Warn("Interview no longer exists, dropping follow-up job", zap.String("jobKey", jobKey))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve interview: %w", err)
+	}
+	if interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		s.logger.Info("Interview is no longer in progress, skipping follow-up", zap.String("jobKey", jobKey),
+			zap.String("status", interview.Status.String()))
+		return nil
+	}
+
+	err = s.prepareFollowUp(ctx, job, interview)
+	if err != nil {
+		s.logger.Error("Follow-up preparation failed", zap.String("jobKey", jobKey),
+			zap.Int32("attempt", job.Attempts),
+			zap.Error(err))
+		if job.Attempts >= job.MaxAttempts {
+			s.publishEvent(context.Background(), job.UserID, &pb.InterviewEvent{
+				InterviewId:   job.InterviewID,
+				Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_FAILED,
+				QuestionIndex: job.QuestionIndex,
+				SubIndex:      job.SubIndex,
+				Message:       err.Error(),
+			})
+		}
+		return err
+	}
+
+	s.logger.Info("Follow-up preparation completed successfully", zap.String("jobKey", jobKey))
+	return nil
+}
+
+func (s *Irelia) prepareFollowUp(ctx context.Context, job *ent.Job, interview *ent.Interview) error {
+	_, err := s.repo.Question.GetTurn(ctx, job.InterviewID, job.QuestionIndex, job.SubIndex)
+	if err == nil {
+		s.logger.Info("Follow-up already exists, skipping generation", zap.String("interviewID", job.InterviewID),
+			zap.Int32("questionID", job.QuestionIndex),
+			zap.Int32("subIndex", job.SubIndex))
+		return nil
+	}
+	if !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to check follow-up existence: %w", err)
+	}
+
+	turns, err := s.repo.Question.GetThread(ctx, job.InterviewID, job.QuestionIndex)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve question thread: %w", err)
+	}
+	thread := []*pb.QaPair{}
+	for _, turn := range turns {
+		if turn.SubIndex >= job.SubIndex {
+			break
+		}
+		thread = append(thread, &pb.QaPair{
+			Question: turn.Content,
+			Answer:   turn.Answer,
+			Skipped:  turn.Status == pb.QuestionStatus_QUESTION_STATUS_SKIPPED,
+		})
+	}
+	if len(thread) == 0 {
+		return fmt.Errorf("question %d has no thread to follow up on", job.QuestionIndex)
+	}
+
+	interviewContext, err := s.repo.Interview.GetContext(ctx, job.InterviewID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve interview context: %w", err)
+	}
+
+	resp, err := s.generateFollowUp(ctx, job.UserID, &pb.FollowUpRequest{
+		InterviewId: job.InterviewID,
+		Context: &pb.Context{
+			Position:       interviewContext.Position,
+			Experience:     interviewContext.Experience,
+			Language:       interviewContext.Language,
+			Skills:         interviewContext.Skills,
+			TotalQuestions: interviewContext.TotalQuestions,
+			SkipCode:       interviewContext.SkipCode,
+		},
+		Thread: thread,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate follow-up: %w", err)
+	}
+	if strings.TrimSpace(resp.Question) == "" {
+		return fmt.Errorf("no follow-up generated")
+	}
+
+	question := s.renderQuestion(ctx, &ent.Question{
+		InterviewID:   job.InterviewID,
+		QuestionIndex: job.QuestionIndex,
+		SubIndex:      job.SubIndex,
+		Content:       resp.Question,
+	}, interview, false)
+
+	if err := s.repo.Question.Create(ctx, job.UserID, question); err != nil {
+		if ent.IsConstraintError(err) {
+			// Another worker saved the same follow-up first
+			return nil
+		}
+		return fmt.Errorf("failed to save follow-up: %w", err)
+	}
+
+	saved, err := s.repo.Question.GetTurn(ctx, job.InterviewID, job.QuestionIndex, job.SubIndex)
+	if err != nil {
+		return fmt.Errorf("failed to reload follow-up: %w", err)
+	}
+	s.publishEvent(ctx, job.UserID, s.questionReadyEvent(saved, interview))
+	s.startDeadline(ctx, interview, saved)
+	if saved.AudioPending {
+		s.enqueueLipSync(job.UserID, interview, saved.QuestionIndex, saved.SubIndex)
+	}
+	return nil
+}
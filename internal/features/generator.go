@@ -0,0 +1,183 @@
+package features
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	pb "irelia/api"
+	repo "irelia/internal/repo"
+	sv "irelia/internal/service"
+)
+
+// QuestionGenerator produces the questions and follow-ups asked during an interview
+type QuestionGenerator interface {
+	Name() string
+	Generate(ctx context.Context, userID uint64, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error)
+	FollowUp(ctx context.Context, userID uint64, req *pb.FollowUpRequest) (*pb.FollowUpResponse, error)
+}
+
+// DariusGenerator generates questions through the Darius service
+type DariusGenerator struct {
+	client *sv.DariusClient
+}
+
+func NewDariusGenerator(client *sv.DariusClient) *DariusGenerator {
+	return &DariusGenerator{client: client}
+}
+
+func (g *DariusGenerator) Name() string {
+	return "darius"
+}
+
+func (g *DariusGenerator) Generate(ctx context.Context, userID uint64, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error) {
+	return g.client.Generate(ctx, fmt.Sprintf("%d", userID), req)
+}
+
+func (g *DariusGenerator) FollowUp(ctx context.Context, userID uint64, req *pb.FollowUpRequest) (*pb.FollowUpResponse, error) {
+	return g.client.FollowUp(ctx, fmt.Sprintf("%d", userID), req)
+}
+
+// LocalGenerator generates questions offline from the public question bank, falling back to the built-in templates
+type LocalGenerator struct {
+	questions repo.IPublicQuestion
+}
+
+func NewLocalGenerator(questions repo.IPublicQuestion) *LocalGenerator {
+	return &LocalGenerator{questions: questions}
+}
+
+func (g *LocalGenerator) Name() string {
+	return "local"
+}
+
+func (g *LocalGenerator) Generate(ctx context.Context, userID uint64, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error) {
+	position, language := req.Context.GetPosition(), req.Context.GetLanguage()
+
+	// Questions already asked in the interview are never repeated
+	asked := make(map[string]bool, len(req.Submissions))
+	for _, submission := range req.Submissions {
+		asked[submission.Question] = true
+	}
+
+	bank, err := g.questions.Sample(ctx, position, language, len(asked)+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample public questions: %w", err)
+	}
+	for _, question := range bank {
+		if !asked[question.Content] {
+			return &pb.NextQuestionResponse{Questions: []string{question.Content}}, nil
+		}
+	}
+
+	// The templates are few, so a repeat is only avoided on a best-effort basis
+	content := generatePositionSpecificQuestions(position, language)
+	for i := 0; i < 5 && asked[content]; i++ {
+		content = generatePositionSpecificQuestions(position, language)
+	}
+	return &pb.NextQuestionResponse{Questions: []string{content}}, nil
+}
+
+func (g *LocalGenerator) FollowUp(ctx context.Context, userID uint64, req *pb.FollowUpRequest) (*pb.FollowUpResponse, error) {
+	var questions []string
+	if req.Context.GetLanguage() == "Vietnamese" {
+		questions = []string{
+			"Bạn có thể nói rõ hơn về câu trả lời vừa rồi không?",
+			"Bạn có thể đưa ra một ví dụ cụ thể cho điều đó không?",
+			"Kết quả cuối cùng của việc đó là gì, và bạn học được gì từ nó?",
+		}
+	} else {
+		questions = []string{
+			"Could you elaborate a bit more on your answer?",
+			"Can you give a concrete example of that?",
+			"What was the outcome, and what did you learn from it?",
+		}
+	}
+	return &pb.FollowUpResponse{Question: questions[rand.Intn(len(questions))]}, nil
+}
+
+// ChainGenerator tries its generators in order and returns the first successful result
+type ChainGenerator struct {
+	generators []QuestionGenerator
+	logger     *zap.Logger
+}
+
+func NewChainGenerator(logger *zap.Logger, generators ...QuestionGenerator) *ChainGenerator {
+	return &ChainGenerator{generators: generators, logger: logger}
+}
+
+func (g *ChainGenerator) Name() string {
+	names := make([]string, len(g.generators))
+	for i, generator := range g.generators {
+		names[i] = generator.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+func (g *ChainGenerator) Generate(ctx context.Context, userID uint64, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error) {
+	var errs []error
+	for _, generator := range g.generators {
+		resp, err := generator.Generate(ctx, userID, req)
+		if err == nil && len(resp.Questions) > 0 {
+			return resp, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("no question generated")
+		}
+		g.logger.Warn("Question generator failed, trying the next one", zap.String("generator", generator.Name()),
+			zap.String("interviewID", req.InterviewId),
+			zap.Error(err))
+		errs = append(errs, fmt.Errorf("%s: %w", generator.Name(), err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (g *ChainGenerator) FollowUp(ctx context.Context, userID uint64, req *pb.FollowUpRequest) (*pb.FollowUpResponse, error) {
+	var errs []error
+	for _, generator := range g.generators {
+		resp, err := generator.FollowUp(ctx, userID, req)
+		if err == nil && strings.TrimSpace(resp.Question) != "" {
+			return resp, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("no follow-up generated")
+		}
+		g.logger.Warn("Follow-up generator failed, trying the next one", zap.String("generator", generator.Name()),
+			zap.String("interviewID", req.InterviewId),
+			zap.Error(err))
+		errs = append(errs, fmt.Errorf("%s: %w", generator.Name(), err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+// newQuestionGenerator builds the generator chain listed under generator.chain, defaulting to Darius then local
+func newQuestionGenerator(logger *zap.Logger, darius *sv.DariusClient, questions repo.IPublicQuestion, usage *UsageLedger) QuestionGenerator {
+	names := viper.GetStringSlice("generator.chain")
+	if len(names) == 0 {
+		names = []string{"darius", "local"}
+	}
+
+	var generators []QuestionGenerator
+	for _, name := range names {
+		switch name {
+		case "darius":
+			generators = append(generators, NewMeteredGenerator(NewDariusGenerator(darius), usage))
+		case "local":
+			generators = append(generators, NewLocalGenerator(questions))
+		default:
+			logger.Warn("Unknown question generator, ignoring it", zap.String("generator", name))
+		}
+	}
+	if len(generators) == 0 {
+		generators = append(generators, NewLocalGenerator(questions))
+	}
+	if len(generators) == 1 {
+		return generators[0]
+	}
+	return NewChainGenerator(logger, generators...)
+}
@@ -3,21 +3,20 @@ package features
 import (
 	"bufio"
 	"context"
-	"crypto/md5"
 	"fmt"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"strings"
-	"sync/atomic"
 	"time"
+	"unicode"
+	"unicode/utf8"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	pb "irelia/api"
-	chk "irelia/internal/utils/checker"
-	"irelia/internal/utils/sse"
+	"irelia/internal/utils/auth"
 	"irelia/pkg/ent"
 )
 
@@ -25,12 +24,11 @@ import (
 * SERVICE FUNCTIONS
  */
 
-func (s *Irelia) callDariusForGenerate(ctx context.Context, userID uint64, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error) {
+func (s *Irelia) generateQuestions(ctx context.Context, userID uint64, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error) {
 	// Log the request for debugging
-	s.logger.Info("Sending request to Darius for question generation", zap.Any("request", req))
+	s.logger.Info("Sending request for question generation", zap.String("generator", s.generator.Name()), zap.Any("request", req))
 
-	// Call the Darius service
-	return s.dariusClient.Generate(ctx, fmt.Sprintf("%d", userID), req)
+	return s.generator.Generate(ctx, userID, req)
 }
 
 func (s *Irelia) callDariusForScore(ctx context.Context, userID uint64, req *pb.ScoreInterviewRequest) (*pb.ScoreInterviewResponse, error) {
@@ -38,32 +36,36 @@ func (s *Irelia) callDariusForScore(ctx context.Context, userID uint64, req *pb.
 	s.logger.Info("Sending request to Darius for scoring", zap.Any("request", req))
 
 	// Call the Darius service
-	return s.dariusClient.Score(ctx, fmt.Sprintf("%d", userID), req)
+	started := time.Now()
+	resp, err := s.dariusClient.Score(ctx, fmt.Sprintf("%d", userID), req)
+	s.usage.Record(userID, req.InterviewId, "darius", "score", started, req, resp, err)
+	return resp, err
 }
 
-func (s *Irelia) callKarmaForLipSync(ctx context.Context, req *pb.LipSyncRequest) (*pb.LipSyncResponse, error) {
+func (s *Irelia) generateFollowUp(ctx context.Context, userID uint64, req *pb.FollowUpRequest) (*pb.FollowUpResponse, error) {
 	// Log the request for debugging
-	s.logger.Info("Sending request to Karma for lip-sync generation", zap.Any("request", req))
+	s.logger.Info("Sending request for follow-up generation", zap.String("generator", s.generator.Name()), zap.Any("request", req))
 
-	// Call the Karma service
-	return s.karmaClient.LipSync(ctx, req)
+	return s.generator.FollowUp(ctx, userID, req)
 }
 
-func (s *Irelia) callKarmaForScore(ctx context.Context, req *pb.ScoreFluencyRequest) (*pb.ScoreFluencyResponse, error) {
+func (s *Irelia) callKarmaForScore(ctx context.Context, userID uint64, req *pb.ScoreFluencyRequest) (*pb.ScoreFluencyResponse, error) {
 	// Log the request for debugging
 	s.logger.Info("Sending request to Karma for fluency scoring", zap.Any("request", req))
 
 	// Call the Karma service
-	return s.karmaClient.Score(ctx, req)
+	started := time.Now()
+	resp, err := s.karmaClient.Score(ctx, req)
+	s.usage.Record(userID, req.InterviewId, "karma", "fluency", started, req, resp, err)
+	return resp, err
 }
 
-// Generates the next question using Darius and saves it in the database
-func (s *Irelia) prepareContent(ctx context.Context, userID uint64, interviewID string, submissions []*pb.QaPair, remainingQuestions int32, questionIndex int32) ([]*ent.Question, error) {
+// generationContext returns the interview context sent to the question generator, empty fields get defaults
+func (s *Irelia) generationContext(ctx context.Context, interviewID string) (*pb.Context, error) {
 	// Retrieve the interview context
 	interviewContext, err := s.repo.Interview.GetContext(ctx, interviewID)
 	if err != nil {
-		s.logger.Error("Failed to retrieve interview context", zap.String("interviewId", interviewID), zap.Error(err))
-		return nil, fmt.Errorf("failed to retrieve interview context: %v", err)
+		return nil, err
 	}
 
 	// Provide default values for empty fields in the context
@@ -80,31 +82,42 @@ func (s *Irelia) prepareContent(ctx context.Context, userID uint64, interviewID
 		interviewContext.Skills = []string{"English skills"}
 	}
 
+	return &pb.Context{
+		Position:       interviewContext.Position,
+		Experience:     interviewContext.Experience,
+		Language:       interviewContext.Language,
+		Skills:         interviewContext.Skills,
+		TotalQuestions: interviewContext.TotalQuestions,
+		SkipCode:       interviewContext.SkipCode,
+	}, nil
+}
+
+// Generates the next question using Darius and saves it in the database
+func (s *Irelia) prepareContent(ctx context.Context, userID uint64, interviewID string, submissions []*pb.QaPair, remainingQuestions int32, questionIndex int32) ([]*ent.Question, error) {
+	generationContext, err := s.generationContext(ctx, interviewID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve interview context", zap.String("interviewId", interviewID), zap.Error(err))
+		return nil, fmt.Errorf("failed to retrieve interview context: %v", err)
+	}
+
 	// Prepare the Darius request
 	dariusReq := &pb.NextQuestionRequest{
 		InterviewId:        interviewID,
 		Submissions:        submissions,
 		RemainingQuestions: remainingQuestions,
-		Context: &pb.Context{
-			Position:       interviewContext.Position,
-			Experience:     interviewContext.Experience,
-			Language:       interviewContext.Language,
-			Skills:         interviewContext.Skills,
-			TotalQuestions: interviewContext.TotalQuestions,
-			SkipCode:       interviewContext.SkipCode,
-		},
+		Context:            generationContext,
 	}
 
 	var dariusResp *pb.NextQuestionResponse
 
-	dariusResp, err = s.callDariusForGenerate(context.Background(), userID, dariusReq)
+	dariusResp, err = s.generateQuestions(context.Background(), userID, dariusReq)
 	if err != nil {
 		s.logger.Error("Failed to generate questions", zap.String("interviewId", interviewID))
 		return nil, fmt.Errorf("failed to generate questions: %v", err)
 	}
 
 	if len(dariusResp.Questions) == 0 {
-		s.logger.Error("No questions generated by Darius", zap.String("interviewId", interviewID))
+		s.logger.Error("No questions generated", zap.String("interviewId", interviewID))
 		return nil, fmt.Errorf("no next question generated")
 	}
 
@@ -112,7 +125,7 @@ func (s *Irelia) prepareContent(ctx context.Context, userID uint64, interviewID
 	questions := []*ent.Question{}
 	for i, content := range dariusResp.Questions {
 		index := questionIndex + int32(i)
-		if index > interviewContext.TotalQuestions {
+		if index > generationContext.TotalQuestions {
 			break
 		}
 		question := &ent.Question{
@@ -135,107 +148,88 @@ func (s *Irelia) prepareLipSync(ctx context.Context, question *ent.Question, int
 
 	s.logger.Info("Preparing lip sync", zap.String("interviewId", interview.ID), zap.String("content", question.Content))
 
-	// Prepare the full string for lip-sync
-	var fullString string
-	var cacheKind string
-	if isOutro {
-		fullString = question.Content
-		cacheKind = "outro"
-	} else if question.QuestionIndex == 1 {
-		fullString = s.addTransitionsToQuestion(question, interview.VoiceID, interview.Language, isTimeout)
-		cacheKind = "intro"
-	} else {
-		fullString = s.addTransitionsToQuestion(question, interview.VoiceID, interview.Language, isTimeout)
-		cacheKind = ""
-	}
-
-	// Only cache for intro, position, outro
-	var cacheKey string
-	if cacheKind != "" && s.redis != nil {
-		cacheKey = lipSyncCacheKey(cacheKind, interview.VoiceID, interview.Language, fullString, interview.Speed)
-		// Try to get from cache
-		val, err := s.redis.Get(ctx, cacheKey)
-		if err == nil && val != nil {
-			var cachedResp pb.LipSyncResponse
-			if err := protojson.Unmarshal([]byte(val), &cachedResp); err == nil {
-				question.Audio = cachedResp.Audio
-				question.Lipsync = cachedResp.Lipsync
-				return question, nil
-			}
-		}
-	}
-
-	// Call the Karma service to generate lip-sync data
-	karmaReq := &pb.LipSyncRequest{
-		InterviewId: interview.ID,
-		Content:     fullString,
-		VoiceId:     interview.VoiceID,
-		Speed:       interview.Speed,
+	// Split the spoken text so its sentences render in parallel, the transition phrase is its own
+	// segment since it is shared across questions and usually cached
+	segments := []string{question.Content}
+	if !isOutro {
+		segments = append([]string{transitionPhrase(question, interview.VoiceID, interview.Language, isTimeout)},
+			splitSentences(question.Content, viper.GetInt("lipsync.min_sentence_chars"))...)
 	}
 
-	karmaResp, err := s.callKarmaForLipSync(ctx, karmaReq)
+	resp, err := s.lipSync.RenderSegments(ctx, interview.UserID, interview.ID, segments, interview.VoiceID, interview.Language, interview.Speed)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate lip sync: %v", err)
 	}
 
-	// Update the question with the generated lip-sync data
-	question.Audio = karmaResp.Audio
-	question.Lipsync = karmaResp.Lipsync
-
-	// Save to cache if intro/position/outro
-	if cacheKind != "" && s.redis != nil {
-		s.redis.Set(ctx, cacheKey, karmaResp, 1*time.Hour)
-		s.logger.Info("Cached lip sync data", zap.String("cacheKey", cacheKey))
-	}
-
+	question.Audio = resp.Audio
+	question.AudioKey = resp.AudioKey
+	question.Lipsync = resp.Lipsync
 	return question, nil
 }
 
-func (s *Irelia) prepareQuestionSafe(job QuestionPreparationJob) {
-	jobKey := fmt.Sprintf("%s:%d", job.InterviewID, job.NextQuestionID)
+// runQuestionJob prepares the question of a claimed job, an error leaves the job to be retried
+func (s *Irelia) runQuestionJob(ctx context.Context, job *ent.Job) error {
+	jobKey := fmt.Sprintf("%s:%d", job.InterviewID, job.QuestionIndex)
 
-	// Check if already in progress with better logging
-	s.preparationMutex.Lock()
-	if s.preparationStatus[job.InterviewID] == nil {
-		s.preparationStatus[job.InterviewID] = make(map[int32]bool)
+	interview, err := s.repo.Interview.Get(ctx, job.InterviewID)
+	if ent.IsNotFound(err) {
+		s.logger.Warn("Interview no longer exists, dropping question job", zap.String("jobKey", jobKey))
+		return nil
 	}
-	if s.preparationStatus[job.InterviewID][job.NextQuestionID] {
-		s.preparationMutex.Unlock()
-		s.logger.Info("Question preparation already in progress, skipping", zap.String("jobKey", jobKey),
-			zap.String("interviewID", job.InterviewID),
-			zap.Int32("questionID", job.NextQuestionID))
-		return
+	if err != nil {
+		return fmt.Errorf("failed to retrieve interview: %w", err)
+	}
+	if interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		s.logger.Info("Interview is no longer in progress, skipping question preparation", zap.String("jobKey", jobKey),
+			zap.String("status", interview.Status.String()))
+		return nil
 	}
-	s.preparationStatus[job.InterviewID][job.NextQuestionID] = true
-	s.preparationMutex.Unlock()
 
 	s.logger.Info("Starting question preparation", zap.String("jobKey", jobKey),
 		zap.String("interviewID", job.InterviewID),
-		zap.Int32("questionID", job.NextQuestionID),
-		zap.Uint64("userID", job.UserID))
-
-	// Cleanup status when done
-	defer func() {
-		s.preparationMutex.Lock()
-		delete(s.preparationStatus[job.InterviewID], job.NextQuestionID)
-		if len(s.preparationStatus[job.InterviewID]) == 0 {
-			delete(s.preparationStatus, job.InterviewID)
+		zap.Int32("questionID", job.QuestionIndex),
+		zap.Uint64("userID", job.UserID),
+		zap.Int32("attempt", job.Attempts))
+	s.publishEvent(ctx, job.UserID, &pb.InterviewEvent{
+		InterviewId:   job.InterviewID,
+		Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_PREPARING,
+		QuestionIndex: job.QuestionIndex,
+	})
+
+	err = s.prepareQuestion(ctx, QuestionPreparationJob{
+		InterviewID:    job.InterviewID,
+		UserID:         job.UserID,
+		NextQuestionID: job.QuestionIndex,
+		Interview:      interview,
+		Questions:      nil,
+	})
+	if err == nil {
+		// prepareQuestion skips questions it could not render, which must not count as done
+		exists, existsErr := s.repo.Question.Exists(ctx, job.InterviewID, job.QuestionIndex)
+		if existsErr != nil {
+			err = fmt.Errorf("failed to check question existence: %w", existsErr)
+		} else if !exists {
+			err = fmt.Errorf("question %d was not saved", job.QuestionIndex)
 		}
-		s.preparationMutex.Unlock()
-
-		s.logger.Info("Finished question preparation cleanup", zap.String("jobKey", jobKey))
-	}()
-
-	// Add timeout context for the preparation
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-	defer cancel()
-
-	if err := s.prepareQuestion(ctx, job); err != nil {
+	}
+	if err != nil {
 		s.logger.Error("Question preparation failed", zap.String("jobKey", jobKey),
+			zap.Int32("attempt", job.Attempts),
 			zap.Error(err))
-	} else {
-		s.logger.Info("Question preparation completed successfully", zap.String("jobKey", jobKey))
+		if job.Attempts >= job.MaxAttempts {
+			s.publishEvent(context.Background(), job.UserID, &pb.InterviewEvent{
+				InterviewId:   job.InterviewID,
+				Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_FAILED,
+				QuestionIndex: job.QuestionIndex,
+				Message:       err.Error(),
+			})
+		}
+		return err
 	}
+
+	s.logger.Info("Question preparation completed successfully", zap.String("jobKey", jobKey))
+	s.startDeadlineIfCurrent(ctx, interview, job.QuestionIndex)
+	return nil
 }
 
 func (s *Irelia) prepareQuestion(ctx context.Context, job QuestionPreparationJob) error {
@@ -306,14 +300,8 @@ func (s *Irelia) prepareQuestion(ctx context.Context, job QuestionPreparationJob
 		s.logger.Debug("Preparing lip sync for question", zap.String("interviewID", job.InterviewID),
 			zap.Int32("questionID", job.NextQuestionID+int32(i)))
 
-		var err error
-		questions[i], err = s.prepareLipSync(ctx, question, job.Interview, false, isTimeout)
-		if err != nil {
-			s.logger.Error("Failed to prepare lip sync for question", zap.String("interviewID", job.InterviewID),
-				zap.Int32("questionID", job.NextQuestionID+int32(i)),
-				zap.Error(err))
-			continue
-		}
+		question = s.renderQuestion(ctx, question, job.Interview, isTimeout)
+		questions[i] = question
 		if err := s.repo.Question.Create(ctx, job.UserID, question); err != nil {
 			if ent.IsConstraintError(err) {
 				s.logger.Warn("Duplicate question detected, skipping creation",
@@ -326,10 +314,14 @@ func (s *Irelia) prepareQuestion(ctx context.Context, job QuestionPreparationJob
 				zap.Error(err))
 			continue
 		}
+		s.publishEvent(ctx, job.UserID, s.questionReadyEvent(question, job.Interview))
+		if question.AudioPending {
+			s.enqueueLipSync(job.UserID, job.Interview, question.QuestionIndex, question.SubIndex)
+		}
 	}
 	// Save public questions if any
 	if len(publicQuestions) > 0 {
-		if err := s.repo.PublicQuestion.CreateBulk(ctx, publicQuestions); err != nil {
+		if err := s.repo.PublicQuestion.Upsert(ctx, publicQuestions); err != nil {
 			s.logger.Error("Failed to save public questions", zap.String("interviewID", job.InterviewID),
 				zap.Error(err))
 			return fmt.Errorf("failed to save public questions: %w", err)
@@ -344,122 +336,84 @@ func (s *Irelia) prepareQuestion(ctx context.Context, job QuestionPreparationJob
 	return nil
 }
 
-func (s *Irelia) ensureQuestionWorkerPool() {
-	if atomic.LoadInt64(&s.questionWorkerPool.activeWorkers) == 0 {
-		s.logger.Warn("All question workers have exited, restarting worker pool")
-		s.questionWorkerPool.Start(s)
+// enqueueQuestionPreparation schedules a question of the interview to be prepared in the background
+func (s *Irelia) enqueueQuestionPreparation(userID uint64, interview *ent.Interview, index int32) {
+	job := &ent.Job{
+		Kind:          pb.JobKind_JOB_KIND_QUESTION,
+		InterviewID:   interview.ID,
+		QuestionIndex: index,
+		UserID:        userID,
 	}
-}
 
-func (s *Irelia) getUserID(ctx context.Context) (uint64, error) {
-	roleIds := s.extractor.GetRoleIDs(ctx)
-	if err := chk.CheckRole(ctx, fmt.Sprintf("%v", int32(pb.BulbasaurRole_ROLE_CANDIDATE)), roleIds); err != nil {
-		return 0, err
-	}
-
-	bmId, err := s.extractor.GetUserID(ctx)
-	if err != nil {
-		return 0, err
+	s.ensureQuestionWorkerPool()
+	if !s.questionWorkerPool.Enqueue(context.Background(), s.logger, job) {
+		s.logger.Warn("Failed to enqueue question preparation job",
+			zap.String("interviewID", job.InterviewID),
+			zap.Int32("nextQuestionID", job.QuestionIndex))
 	}
-	return uint64(bmId), nil
 }
 
-/*
-* HELPER FUNCTIONS
- */
-
-// handleQuestionTimeout handles timeout for a question
-func (s *Irelia) handleQuestionTimeout(interviewID string, questionIndex int32, userID uint64) {
-	s.logger.Info("Handling question timeout",
-		zap.String("interviewID", interviewID),
-		zap.Int32("questionIndex", questionIndex),
-		zap.Uint64("userID", userID))
-
-	// Send notification to frontend through your preferred method
-	// This could be WebSocket, gRPC stream, or message queue
-	s.sendTimeoutNotification(userID, interviewID, questionIndex)
-
-	// Optionally, you can automatically submit an empty answer
-	// or mark the question as timed out
-	go s.handleTimeoutSubmission(interviewID, questionIndex, userID)
+func (s *Irelia) ensureQuestionWorkerPool() {
+	s.questionWorkerPool.Ensure(s.logger)
 }
 
-// sendTimeoutNotification sends a notification to the frontend
-func (s *Irelia) sendTimeoutNotification(userID uint64, interviewID string, questionIndex int32) {
-	notification := map[string]interface{}{
-		"type":          "question_timeout",
-		"interviewID":   interviewID,
-		"questionIndex": questionIndex,
-		"timestamp":     time.Now().Unix(),
-	}
-
-	s.logger.Info("Sending timeout notification",
-		zap.Uint64("userID", userID),
-		zap.Any("notification", notification))
-
-	if sse.SendToUser(userID, notification) {
-		s.logger.Info("Timeout notification sent via SSE",
-			zap.Uint64("userID", userID))
-		return
+// getPrincipal returns the caller authorized by the auth interceptor
+func (s *Irelia) getPrincipal(ctx context.Context) (*auth.Principal, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("request has not been authorized")
 	}
-
-	// Fallback options if SSE is not available
-	s.logger.Warn("SSE not available for user, using fallback methods",
-		zap.Uint64("userID", userID))
+	return principal, nil
 }
 
-// handleTimeoutSubmission automatically handles timeout submission
-func (s *Irelia) handleTimeoutSubmission(interviewID string, questionIndex int32, userID uint64) {
-	ctx := context.Background()
-
-	question, err := s.repo.Question.Get(ctx, interviewID, questionIndex)
+func (s *Irelia) getUserID(ctx context.Context) (uint64, error) {
+	principal, err := s.getPrincipal(ctx)
 	if err != nil {
-		s.logger.Error("Failed to retrieve question for timeout handling",
-			zap.String("interviewID", interviewID),
-			zap.Int32("questionIndex", questionIndex),
-			zap.Error(err))
-		return
+		return 0, err
 	}
+	return principal.UserID, nil
+}
 
-	// Only handle if question is still unanswered
-	if question.Status == pb.QuestionStatus_QUESTION_STATUS_NEW {
-		question.Answer = "" // Empty answer for timeout
-		question.Status = pb.QuestionStatus_QUESTION_STATUS_SKIPPED
-
-		if err := s.repo.Question.Update(ctx, userID, question); err != nil {
-			s.logger.Error("Failed to update question on timeout",
-				zap.String("interviewID", interviewID),
-				zap.Int32("questionIndex", questionIndex),
-				zap.Error(err))
-		}
+// getInterview retrieves an interview visible to the caller, interviews of other candidates are not found
+func (s *Irelia) getInterview(ctx context.Context, interviewID string) (*ent.Interview, error) {
+	principal, err := s.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
 	}
+	return s.repo.Interview.GetScoped(ctx, interviewID, principal.Scope())
 }
 
 /*
 * HELPER FUNCTIONS
  */
 
-// Store the intro question in a slice
-func (s *Irelia) generateIntroQuestion(language string) string {
-	var questions []string
+/*
+* HELPER FUNCTIONS
+ */
 
+// Store the intro question in a slice
+func introQuestions(language string) []string {
 	if language == "Vietnamese" {
-		questions = []string{
+		return []string{
 			"Bạn có thể giới thiệu về bản thân mình không?",
 			"Bạn có thể cho tôi biết một chút về bản thân mình được không?",
 			"Bạn có thể giới thiệu ngắn gọn về bản thân mình không?",
 			"Bạn có thể chia sẻ một chút về bạn không?",
 			"Bạn có thể giới thiệu về bản thân mình được không?",
 		}
-	} else {
-		questions = []string{
-			"Can you introduce yourself?",
-			"Could you tell me a bit about yourself?",
-			"Please introduce yourself briefly.",
-			"Can you share a little about who you are?",
-			"Would you mind introducing yourself?",
-		}
 	}
+	return []string{
+		"Can you introduce yourself?",
+		"Could you tell me a bit about yourself?",
+		"Please introduce yourself briefly.",
+		"Can you share a little about who you are?",
+		"Would you mind introducing yourself?",
+	}
+}
+
+// Pick one of the intro questions at random
+func (s *Irelia) generateIntroQuestion(language string) string {
+	questions := introQuestions(language)
 
 	// Seed the random number generator
 	rand.Seed(time.Now().UnixNano())
@@ -469,7 +423,7 @@ func (s *Irelia) generateIntroQuestion(language string) string {
 }
 
 // Store the position-specific questions in a slice
-func (s *Irelia) generatePositionSpecificQuestions(position string, language string) string {
+func generatePositionSpecificQuestions(position string, language string) string {
 	var questions []string
 
 	if language == "Vietnamese" {
@@ -528,7 +482,7 @@ func (s *Irelia) generatePositionSpecificQuestions(position string, language str
 }
 
 // Extract the substring after the last dot or dash in a string
-func (s *Irelia) substringAfterLastDotOrDash(str string) string {
+func substringAfterLastDotOrDash(str string) string {
 	lastDot := strings.LastIndex(str, ".")
 	lastDash := strings.LastIndex(str, "-")
 
@@ -547,8 +501,8 @@ func (s *Irelia) substringAfterLastDotOrDash(str string) string {
 	}
 }
 
-// Add transitions to the question content
-func (s *Irelia) addTransitionsToQuestion(question *ent.Question, voiceID string, language string, isTimeout bool) string {
+// Every response token and transition sentence pair spoken between questions
+func transitionPhrases(language string, isTimeout bool) []string {
 	var responseTokens []string
 	var transitionSentences []string
 
@@ -582,32 +536,73 @@ func (s *Irelia) addTransitionsToQuestion(question *ent.Question, voiceID string
 		}
 	}
 
-	rand.Seed(time.Now().UnixNano())
+	phrases := make([]string, 0, len(responseTokens)*len(transitionSentences))
+	for _, responseToken := range responseTokens {
+		for _, transition := range transitionSentences {
+			phrases = append(phrases, responseToken+" "+transition)
+		}
+	}
+	return phrases
+}
+
+// Pick the phrase spoken before a question, the first question is introduced by the interviewer instead
+func transitionPhrase(question *ent.Question, voiceID string, language string, isTimeout bool) string {
 	if question.QuestionIndex == 1 {
-		// Add an intro for the first question
-		var intro string
-		if language == "Vietnamese" {
-			var str string
-			if voiceID == "vi-VN-HoaiMyNeural" || strings.Contains(voiceID, "HoaiMy") {
-				str = "Hoài My"
-			} else {
-				str = "Nam Minh"
-			}
-			intro = fmt.Sprintf("Cảm ơn bạn đã tham gia buổi phỏng vấn hôm nay. Tôi là %s, rất vui được gặp bạn. Để bắt đầu, tôi sẽ hỏi bạn một số câu hỏi.", str)
+		return introGreeting(voiceID, language)
+	}
+
+	phrases := transitionPhrases(language, isTimeout)
+	return phrases[rand.Intn(len(phrases))]
+}
+
+// Split text into sentences, fragments shorter than minChars are kept with the sentence before them
+func splitSentences(text string, minChars int) []string {
+	var sentences []string
+	start := 0
+	runes := []rune(text)
+	for i, r := range runes {
+		if r != '.' && r != '?' && r != '!' {
+			continue
+		}
+		// A sentence ends at punctuation followed by whitespace, so "fmt.Println" or "3.5" stay whole
+		if i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			continue
+		}
+		sentences = appendSentence(sentences, string(runes[start:i+1]), minChars)
+		start = i + 1
+	}
+	sentences = appendSentence(sentences, string(runes[start:]), minChars)
+	return sentences
+}
+
+func appendSentence(sentences []string, sentence string, minChars int) []string {
+	sentence = strings.TrimSpace(sentence)
+	if sentence == "" {
+		return sentences
+	}
+	if len(sentences) > 0 && utf8.RuneCountInString(sentence) < minChars {
+		sentences[len(sentences)-1] += " " + sentence
+		return sentences
+	}
+	return append(sentences, sentence)
+}
+
+// Introduce the interviewer by the name of its voice
+func introGreeting(voiceID string, language string) string {
+	if language == "Vietnamese" {
+		var str string
+		if voiceID == "vi-VN-HoaiMyNeural" || strings.Contains(voiceID, "HoaiMy") {
+			str = "Hoài My"
 		} else {
-			intro = fmt.Sprintf("Thanks for joining this interview session today. I'm %s, nice to meet you. To begin with, let me ask you some questions.", s.substringAfterLastDotOrDash(voiceID))
+			str = "Nam Minh"
 		}
-		fullString := intro + " " + question.Content
-		return fullString
+		return fmt.Sprintf("Cảm ơn bạn đã tham gia buổi phỏng vấn hôm nay. Tôi là %s, rất vui được gặp bạn. Để bắt đầu, tôi sẽ hỏi bạn một số câu hỏi.", str)
 	}
-	responseToken := responseTokens[rand.Intn(len(responseTokens))]
-	transition := transitionSentences[rand.Intn(len(transitionSentences))]
-	fullString := responseToken + " " + transition + " " + question.Content
-	return fullString
+	return fmt.Sprintf("Thanks for joining this interview session today. I'm %s, nice to meet you. To begin with, let me ask you some questions.", substringAfterLastDotOrDash(voiceID))
 }
 
 // Prepare the outro message based on the language
-func (s *Irelia) prepareOutro(language string) string {
+func prepareOutro(language string) string {
 	if language == "Vietnamese" {
 		return "Bạn đã hoàn thành buổi phỏng vấn. Bạn có thể kiểm tra kết quả sau vài phút. Hẹn gặp lại bạn trong một buổi phỏng vấn khác!"
 	}
@@ -663,7 +658,7 @@ func (s *Irelia) loadDemoQuestions(topic string) ([]*pb.DemoQuestion, error) {
 }
 
 // Calculate the overall score based on the total score data
-func getOverallScore(scoreData *pb.TotalScore) float64 {
+func getOverallScore(scoreData *pb.TotalScore, policy SkipPolicy) float64 {
 	// Grade weights (A=4.0, B=3.0, C=2.0, D=1.0, F=0.0)
 	weights := map[string]float64{
 		"A": 4.0,
@@ -674,6 +669,10 @@ func getOverallScore(scoreData *pb.TotalScore) float64 {
 	}
 
 	totalQuestions := scoreData.A + scoreData.B + scoreData.C + scoreData.D + scoreData.F
+	// Skipped questions only weigh on the average when they are graded F
+	if policy == SkipPolicyFail {
+		totalQuestions += scoreData.Skipped
+	}
 	if totalQuestions == 0 {
 		return 0.0
 	}
@@ -686,16 +685,3 @@ func getOverallScore(scoreData *pb.TotalScore) float64 {
 
 	return weightedSum / float64(totalQuestions)
 }
-
-// Generate a unique cache key for lip-sync data
-func md5sum(s string) string {
-	h := md5.New()
-	h.Write([]byte(s))
-	return fmt.Sprintf("%x", h.Sum(nil))
-}
-
-// Generate a cache key for lip-sync data based on kind, voiceID, language, and content
-func lipSyncCacheKey(kind, voiceID, language, content string, speed int32) string {
-	// kind: "intro", "position", "outro"
-	return fmt.Sprintf("lipsync:%s:%s:%s:%d:%x", kind, voiceID, language, speed, md5sum(content))
-}
@@ -2,20 +2,24 @@ package features
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"sync"
 	"time"
 
 	pb "irelia/api"
 	repo "irelia/internal/repo"
 	sv "irelia/internal/service"
-	ext "irelia/internal/utils/extractor"
+	"irelia/internal/utils/blob"
+	"irelia/internal/utils/broker"
 	gen "irelia/internal/utils/generator"
 	"irelia/internal/utils/redis"
 	"irelia/pkg/ent"
@@ -26,35 +30,54 @@ type IIrelia interface {
 	StartInterview(ctx context.Context, req *pb.StartInterviewRequest) (*pb.StartInterviewResponse, error)
 	SubmitAnswer(ctx context.Context, req *pb.SubmitAnswerRequest) (*pb.SubmitAnswerResponse, error)
 	GetNextQuestion(ctx context.Context, req *pb.QuestionRequest) (*pb.QuestionResponse, error)
+	StreamInterview(req *pb.StreamInterviewRequest, stream pb.Irelia_StreamInterviewServer) error
+	SkipQuestion(ctx context.Context, req *pb.SkipQuestionRequest) (*pb.SkipQuestionResponse, error)
+	ResumeInterview(ctx context.Context, req *pb.ResumeInterviewRequest) (*pb.ResumeInterviewResponse, error)
+	AbandonInterview(ctx context.Context, req *pb.AbandonInterviewRequest) (*emptypb.Empty, error)
 	SubmitInterview(ctx context.Context, req *pb.SubmitInterviewRequest) (*pb.SubmitInterviewResponse, error)
+	RetryScoring(ctx context.Context, req *pb.RetryScoringRequest) (*pb.RetryScoringResponse, error)
 	GetInterview(ctx context.Context, req *pb.GetInterviewRequest) (*pb.GetInterviewResponse, error)
 	GetInterviewHistory(ctx context.Context, req *pb.GetInterviewHistoryRequest) (*pb.GetInterviewHistoryResponse, error)
 	FavoriteInterview(ctx context.Context, req *pb.FavoriteInterviewRequest) (*emptypb.Empty, error)
+	DeleteInterview(ctx context.Context, req *pb.DeleteInterviewRequest) (*emptypb.Empty, error)
+	GenerateNextQuestion(ctx context.Context, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error)
+	ScoreInterview(ctx context.Context, req *pb.ScoreInterviewRequest) (*pb.ScoreInterviewResponse, error)
+	GenerateLipSync(ctx context.Context, req *pb.LipSyncRequest) (*pb.LipSyncResponse, error)
+	GetUsage(ctx context.Context, req *pb.GetUsageRequest) (*pb.GetUsageResponse, error)
+	GetServiceMetrics(ctx context.Context, req *emptypb.Empty) (*structpb.Struct, error)
+	ExportInterview(ctx context.Context, req *pb.ExportInterviewRequest) (*httpbody.HttpBody, error)
+	ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueRequest) (*pb.ListModerationQueueResponse, error)
+	ModeratePublicQuestion(ctx context.Context, req *pb.ModeratePublicQuestionRequest) (*pb.PublicQuestion, error)
+	ListModerationAudit(ctx context.Context, req *pb.ListModerationAuditRequest) (*pb.ListModerationAuditResponse, error)
 }
 
 // Irelia implements the InterviewService gRPC interface for Frontend to Irelia communication
 type Irelia struct {
 	pb.UnimplementedIreliaServer
 	dariusClient       sv.DariusClient
+	generator          QuestionGenerator
 	karmaClient        sv.KarmaClient
 	repo               repo.Repository
 	rabbit             rabbit.Rabbit
 	logger             *zap.Logger
-	extractor          ext.Extractor
 	redis              redis.Redis
-	questionWorkerPool *QuestionWorkerPool
-	preparationMutex   sync.RWMutex
-	preparationStatus  map[string]map[int32]bool
-	timerManager       *QuestionTimerManager
+	broker             broker.Broker
+	blobs              blob.Store
+	lipSync            *LipSyncRenderer
+	usage              *UsageLedger
+	exporter           *ReportExporter
+	questionWorkerPool *WorkerPool
+	scoringWorkerPool  *WorkerPool
+	deadlineSweeper    *DeadlineSweeper
+	reaper             *InterviewReaper
+	purger             *InterviewPurger
+	answerWorker       *ModelAnswerWorker
 }
 
 // NewIrelia creates a new gRPC service for Frontend to Irelia communication
-func New(repo *repo.Repository, rabbit rabbit.Rabbit, logger *zap.Logger, redis redis.Redis) *Irelia {
-	ext := ext.New()
+func New(repo *repo.Repository, rabbit rabbit.Rabbit, logger *zap.Logger, redis redis.Redis, broker broker.Broker, blobs blob.Store) *Irelia {
 	dariusClient := sv.NewDariusClient(logger)
 	karmaClient := sv.NewKarmaClient(logger)
-	questionTimeout := viper.GetInt("question_timeout")
-	timer := NewQuestionTimerManager(logger, time.Duration(questionTimeout)*time.Second)
 
 	irelia := &Irelia{
 		dariusClient: *dariusClient,
@@ -62,20 +85,67 @@ func New(repo *repo.Repository, rabbit rabbit.Rabbit, logger *zap.Logger, redis
 		repo:         *repo,
 		rabbit:       rabbit,
 		logger:       logger,
-		extractor:    ext,
 		redis:        redis,
-		timerManager: timer,
-	}
+		broker:       broker,
+		blobs:        blobs,
+	}
+	irelia.usage = NewUsageLedger(irelia.repo.Usage, logger)
+	irelia.usage.Start()
+	irelia.generator = newQuestionGenerator(logger, dariusClient, irelia.repo.PublicQuestion, irelia.usage)
+	irelia.lipSync = NewLipSyncRenderer(karmaClient, NewLipSyncCache(redis, logger), blobs, irelia.usage, logger)
+	irelia.exporter = NewReportExporter(logger)
 	size := viper.GetInt("worker.size")
-	maxTasksPerWorker := viper.GetInt("worker.max_tasks_per_worker")
 	maxIdleTime := viper.GetInt("worker.max_idle_time")
-	maxTaskWaitTime := viper.GetInt("worker.max_task_wait_time")
-	irelia.questionWorkerPool = NewQuestionWorkerPool(size, maxTasksPerWorker, maxIdleTime, maxTaskWaitTime)
-	irelia.preparationStatus = make(map[string]map[int32]bool)
-	irelia.questionWorkerPool.Start(irelia)
+	pollInterval := viper.GetInt("worker.poll_interval")
+	lease := viper.GetInt("worker.lease")
+	retry := RetryPolicy{
+		MaxAttempts:    viper.GetInt("worker.max_attempts"),
+		RetryBaseDelay: viper.GetInt("worker.retry_base_delay"),
+		RetryMaxDelay:  viper.GetInt("worker.retry_max_delay"),
+	}
+	irelia.questionWorkerPool = NewWorkerPool("question", irelia.repo.Job, []pb.JobKind{pb.JobKind_JOB_KIND_QUESTION, pb.JobKind_JOB_KIND_FOLLOW_UP, pb.JobKind_JOB_KIND_LIP_SYNC},
+		irelia.runPreparationJob, size, maxIdleTime, pollInterval, lease, retry)
+	irelia.questionWorkerPool.Start(logger)
+
+	scoringRetry := RetryPolicy{
+		MaxAttempts:    viper.GetInt("scoring.max_attempts"),
+		RetryBaseDelay: viper.GetInt("scoring.retry_base_delay"),
+		RetryMaxDelay:  viper.GetInt("scoring.retry_max_delay"),
+	}
+	irelia.scoringWorkerPool = NewWorkerPool("scoring", irelia.repo.Job, []pb.JobKind{pb.JobKind_JOB_KIND_SCORING},
+		irelia.runScoringJob, viper.GetInt("scoring.size"), maxIdleTime, pollInterval, lease, scoringRetry)
+	irelia.scoringWorkerPool.Start(logger)
+
+	idleTimeout := viper.GetInt("reaper.idle_timeout")
+	reapInterval := viper.GetInt("reaper.interval")
+	irelia.reaper = NewInterviewReaper(irelia.repo.Interview, logger, idleTimeout, reapInterval)
+	irelia.reaper.Start()
+
+	irelia.purger = NewInterviewPurger(irelia.repo.Interview, irelia.repo.Question, blobs, logger,
+		viper.GetInt("retention.deleted_ttl"), viper.GetInt("retention.interval"), viper.GetInt("retention.batch_size"))
+	irelia.purger.Start()
+
+	irelia.answerWorker = NewModelAnswerWorker(irelia.repo.PublicQuestion, newAnswerGenerator(logger, dariusClient), irelia.usage, logger,
+		viper.GetInt("answers.interval"), viper.GetInt("answers.batch_size"), viper.GetInt("answers.max_attempts"), viper.GetInt("answers.lease"))
+	irelia.answerWorker.Start()
+
+	irelia.deadlineSweeper = NewDeadlineSweeper(irelia.repo.Question, logger, viper.GetInt("deadline.sweep_interval"), irelia.handleExpiredQuestion)
+	irelia.deadlineSweeper.Start()
 	return irelia
 }
 
+// Stop halts the background workers and flushes the usage ledger last, so the calls they made are recorded.
+// Jobs interrupted in flight are queued again for the next replica to claim
+func (s *Irelia) Stop() {
+	s.deadlineSweeper.Stop()
+	s.reaper.Stop()
+	s.purger.Stop()
+	s.answerWorker.Stop()
+	s.questionWorkerPool.Stop()
+	s.scoringWorkerPool.Stop()
+	s.usage.Stop()
+}
+
 // StartInterview initializes a new interview session
 func (s *Irelia) StartInterview(ctx context.Context, req *pb.StartInterviewRequest) (*pb.StartInterviewResponse, error) {
 	userID, err := s.getUserID(ctx)
@@ -84,6 +154,11 @@ func (s *Irelia) StartInterview(ctx context.Context, req *pb.StartInterviewReque
 		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
 	}
 
+	questionTimeLimit := resolveTimeLimit(req.QuestionTimeLimit)
+	if questionTimeLimit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Question time limit must not be negative: %d", questionTimeLimit)
+	}
+
 	interview := &ent.Interview{
 		Position:           req.Position,
 		Experience:         req.Experience,
@@ -94,6 +169,12 @@ func (s *Irelia) StartInterview(ctx context.Context, req *pb.StartInterviewReque
 		SkipCode:           req.SkipCode,
 		TotalQuestions:     req.TotalQuestions,
 		RemainingQuestions: req.TotalQuestions,
+		QuestionTimeLimit:  questionTimeLimit,
+		FluencyScoring:     viper.GetBool("scoring.fluency"),
+		TextOnly:           req.TextOnly,
+	}
+	if req.FluencyScoring != nil {
+		interview.FluencyScoring = *req.FluencyScoring
 	}
 
 	// Generate a unique interview ID
@@ -112,8 +193,13 @@ func (s *Irelia) StartInterview(ctx context.Context, req *pb.StartInterviewReque
 	}
 	interview.ID = interviewID
 
+	day, err := s.reserveQuota(ctx, userID, req.TotalQuestions)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.repo.Interview.Create(ctx, userID, interview); err != nil {
 		s.logger.Error("Failed to create interview", zap.Error(err))
+		s.releaseQuota(userID, day, req.TotalQuestions)
 		return nil, err
 	}
 
@@ -148,21 +234,7 @@ func (s *Irelia) StartInterview(ctx context.Context, req *pb.StartInterviewReque
 	}
 
 	// Prepare additional questions based on configuration
-	nextJob := QuestionPreparationJob{
-		InterviewID:    interviewID,
-		UserID:         userID,
-		NextQuestionID: int32(len(questions) + 1),
-		Interview:      interview,
-		Questions:      nil,
-	}
-
-	s.ensureQuestionWorkerPool()
-	enqueued := s.questionWorkerPool.EnqueueJob(s.logger, nextJob)
-	if !enqueued {
-		s.logger.Warn("Failed to enqueue question preparation job",
-			zap.String("interviewID", nextJob.InterviewID),
-			zap.Int32("nextQuestionID", nextJob.NextQuestionID))
-	}
+	s.enqueueQuestionPreparation(userID, interview, int32(len(questions)+1))
 
 	return &pb.StartInterviewResponse{
 		InterviewId: interview.ID,
@@ -177,15 +249,28 @@ func (s *Irelia) SubmitAnswer(ctx context.Context, req *pb.SubmitAnswerRequest)
 		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
 	}
 
-	// timerKey := fmt.Sprintf("%s:%d", req.InterviewId, req.Index)
-	// s.timerManager.cancelTimer(timerKey)
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		s.logger.Error("Interview not found", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+	if interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		s.logger.Warn("Interview is no longer in progress", zap.String("interviewId", req.InterviewId), zap.String("status", interview.Status.String()))
+		return nil, status.Errorf(codes.FailedPrecondition, "Interview is no longer in progress: %s", interview.Status)
+	}
 
-	question, err := s.repo.Question.Get(ctx, req.InterviewId, req.Index)
+	question, err := s.repo.Question.GetTurn(ctx, req.InterviewId, req.Index, req.SubIndex)
 	if err != nil {
-		s.logger.Error("Failed to retrieve question", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", req.Index), zap.Error(err))
+		s.logger.Error("Failed to retrieve question", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", req.Index),
+			zap.Int32("subIndex", req.SubIndex),
+			zap.Error(err))
 		return nil, status.Errorf(codes.NotFound, "Question not found: %v", err)
 	}
 
+	if question.Status == pb.QuestionStatus_QUESTION_STATUS_SKIPPED {
+		s.logger.Warn("Question was skipped", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", req.Index))
+		return nil, status.Errorf(codes.FailedPrecondition, "Answer deadline has passed")
+	}
 	if question.Status != pb.QuestionStatus_QUESTION_STATUS_NEW {
 		s.logger.Warn("Question already answered", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", req.Index))
 		return &pb.SubmitAnswerResponse{Message: "Question already answered"}, nil
@@ -197,16 +282,96 @@ func (s *Irelia) SubmitAnswer(ctx context.Context, req *pb.SubmitAnswerRequest)
 
 	question.Answer = req.Answer
 	question.RecordProof = req.RecordProof
-	question.Status = pb.QuestionStatus_QUESTION_STATUS_ANSWERED
 
-	if err := s.repo.Question.Update(ctx, userID, question); err != nil {
+	// The deadline is checked by the database so a late answer cannot race the sweeper
+	cutoff := time.Now().Add(-time.Duration(viper.GetInt("deadline.grace_period")) * time.Second)
+	accepted, err := s.repo.Question.Answer(ctx, question, cutoff)
+	if err != nil {
 		s.logger.Warn("Failed to save answer", zap.Error(err))
 		return &pb.SubmitAnswerResponse{Message: "Failed to save answer"}, nil
 	}
+	if !accepted {
+		s.logger.Warn("Answer rejected after deadline", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", req.Index))
+		return nil, status.Errorf(codes.FailedPrecondition, "Answer deadline has passed")
+	}
+
+	if err := s.repo.Interview.Touch(ctx, interview.ID); err != nil {
+		s.logger.Warn("Failed to refresh interview activity", zap.String("interviewId", interview.ID), zap.Error(err))
+	}
+
+	// Keep one question ahead of the candidate, streaming clients never call GetNextQuestion
+	if req.Index+2 <= interview.TotalQuestions {
+		s.enqueueQuestionPreparation(userID, interview, req.Index+2)
+	}
+
+	// A vague answer is probed with a follow-up before moving on to the next question
+	if s.needsFollowUp(question) {
+		s.enqueueFollowUp(userID, interview, req.Index, req.SubIndex+1)
+		return &pb.SubmitAnswerResponse{
+			Message:      "Answer submitted successfully",
+			HasFollowUp:  true,
+			NextSubIndex: req.SubIndex + 1,
+		}, nil
+	}
+
+	// The next question becomes current now, its clock starts right away if it is ready
+	s.startDeadlineAt(ctx, interview, req.Index+1)
 
 	return &pb.SubmitAnswerResponse{Message: "Answer submitted successfully"}, nil
 }
 
+// SkipQuestion lets the candidate pass on a question and moves on to the next one
+func (s *Irelia) SkipQuestion(ctx context.Context, req *pb.SkipQuestionRequest) (*pb.SkipQuestionResponse, error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		s.logger.Error("Interview not found", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+	if interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		return nil, status.Errorf(codes.FailedPrecondition, "Interview is no longer in progress: %s", interview.Status)
+	}
+
+	question, err := s.repo.Question.GetTurn(ctx, req.InterviewId, req.Index, req.SubIndex)
+	if err != nil {
+		s.logger.Error("Failed to retrieve question", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", req.Index),
+			zap.Int32("subIndex", req.SubIndex),
+			zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "Question not found: %v", err)
+	}
+	if question.Status == pb.QuestionStatus_QUESTION_STATUS_SKIPPED {
+		return &pb.SkipQuestionResponse{Message: "Question already skipped"}, nil
+	}
+
+	skipped, err := s.repo.Question.Skip(ctx, req.InterviewId, req.Index, req.SubIndex)
+	if err != nil {
+		s.logger.Error("Failed to skip question", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", req.Index), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to skip question: %v", err)
+	}
+	if !skipped {
+		return nil, status.Errorf(codes.FailedPrecondition, "Question already answered")
+	}
+	s.logger.Info("Question skipped", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", req.Index), zap.Int32("subIndex", req.SubIndex))
+
+	if err := s.repo.Interview.Touch(ctx, interview.ID); err != nil {
+		s.logger.Warn("Failed to refresh interview activity", zap.String("interviewId", interview.ID), zap.Error(err))
+	}
+
+	s.startDeadlineAt(ctx, interview, req.Index+1)
+	for _, next := range []int32{req.Index + 1, req.Index + 2} {
+		if next <= interview.TotalQuestions {
+			s.enqueueQuestionPreparation(userID, interview, next)
+		}
+	}
+
+	return &pb.SkipQuestionResponse{Message: "Question skipped successfully"}, nil
+}
+
 // GetNextQuestion retrieves the next question for an interview
 func (s *Irelia) GetNextQuestion(ctx context.Context, req *pb.QuestionRequest) (*pb.QuestionResponse, error) {
 	userID, err := s.getUserID(ctx)
@@ -218,7 +383,7 @@ func (s *Irelia) GetNextQuestion(ctx context.Context, req *pb.QuestionRequest) (
 	s.logger.Info("Retrieving next question", zap.String("interviewId", req.InterviewId), zap.Int32("index", req.QuestionIndex))
 
 	// Retrieve the interview
-	interview, err := s.repo.Interview.Get(ctx, req.InterviewId)
+	interview, err := s.getInterview(ctx, req.InterviewId)
 	if err != nil {
 		s.logger.Error("Interview not found", zap.String("interviewId", req.InterviewId), zap.Error(err))
 		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
@@ -228,36 +393,43 @@ func (s *Irelia) GetNextQuestion(ctx context.Context, req *pb.QuestionRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "Question index out of range: %d", req.QuestionIndex)
 	}
 
+	if interview.Status == pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		if err := s.repo.Interview.Touch(ctx, interview.ID); err != nil {
+			s.logger.Warn("Failed to refresh interview activity", zap.String("interviewId", interview.ID), zap.Error(err))
+		}
+	}
+
 	// Retrieve the next question from the database
-	question, err := s.repo.Question.Get(ctx, req.InterviewId, req.QuestionIndex)
-	if err != nil {
-		s.logger.Warn("Failed to retrieve next question", zap.String("interviewId", req.InterviewId), zap.Int32("index", req.QuestionIndex), zap.Error(err))
-
-        // Check if a preparation job is already running for this question
-        s.preparationMutex.RLock()
-        preparing := s.preparationStatus[req.InterviewId] != nil && s.preparationStatus[req.InterviewId][req.QuestionIndex]
-        s.preparationMutex.RUnlock()
-
-        if !preparing {
-            // Start a preparation job if not already running
-            job := QuestionPreparationJob{
-                InterviewID:    req.InterviewId,
-                UserID:         userID,
-                NextQuestionID: req.QuestionIndex,
-                Interview:      interview,
-                Questions:      nil,
-            }
-            s.ensureQuestionWorkerPool()
-            enqueued := s.questionWorkerPool.EnqueueJob(s.logger, job)
-            if !enqueued {
-                s.logger.Warn("Failed to enqueue question preparation job",
-                    zap.String("interviewID", job.InterviewID),
-                    zap.Int32("nextQuestionID", job.NextQuestionID))
-            }
-        }
+	question, err := s.repo.Question.GetTurn(ctx, req.InterviewId, req.QuestionIndex, req.SubIndex)
+	if err != nil {
+		s.logger.Warn("Failed to retrieve next question", zap.String("interviewId", req.InterviewId), zap.Int32("index", req.QuestionIndex),
+			zap.Int32("subIndex", req.SubIndex),
+			zap.Error(err))
+
+		// A question whose preparation job ran out of attempts will not show up, report it instead of loading forever
+		kind := pb.JobKind_JOB_KIND_QUESTION
+		if req.SubIndex > 0 {
+			kind = pb.JobKind_JOB_KIND_FOLLOW_UP
+		}
+		job, jobErr := s.repo.Job.Get(ctx, kind, req.InterviewId, req.QuestionIndex, req.SubIndex)
+		if jobErr == nil && job.Status == pb.JobStatus_JOB_STATUS_DEAD {
+			return &pb.QuestionResponse{
+				QuestionId: req.QuestionIndex,
+				SubIndex:   req.SubIndex,
+				IsLoading:  false,
+				IsFailed:   true,
+				Error:      job.LastError,
+				Timestamp:  time.Now().Unix(),
+			}, nil
+		}
+		// Follow-ups are only ever requested by SubmitAnswer
+		if ent.IsNotFound(jobErr) && req.SubIndex == 0 {
+			s.enqueueQuestionPreparation(userID, interview, req.QuestionIndex)
+		}
 
 		return &pb.QuestionResponse{
 			QuestionId:     req.QuestionIndex,
+			SubIndex:       req.SubIndex,
 			Content:        "",
 			Audio:          "",
 			Lipsync:        nil,
@@ -270,39 +442,193 @@ func (s *Irelia) GetNextQuestion(ctx context.Context, req *pb.QuestionRequest) (
 	// Determine if this is the last question
 	isLastQuestion := req.QuestionIndex == interview.TotalQuestions
 
-	// s.timerManager.startTimer(req.InterviewId, req.QuestionIndex, userID, s.handleQuestionTimeout)
+	// The answer clock starts the first time the question is served
+	question = s.startDeadline(ctx, interview, question)
 
 	if !isLastQuestion {
 		// Prepare additional questions based on configuration
-		job := QuestionPreparationJob{
-			InterviewID:    req.InterviewId,
-			UserID:         userID,
-			NextQuestionID: req.QuestionIndex + 1,
-			Interview:      interview,
-			Questions:      nil,
+		s.enqueueQuestionPreparation(userID, interview, req.QuestionIndex+1)
+	}
+
+	// Return the next question
+	return s.toQuestionResponse(question, interview), nil
+}
+
+// StreamInterview pushes every question of an interview as soon as it is saved, along with status events
+func (s *Irelia) StreamInterview(req *pb.StreamInterviewRequest, stream pb.Irelia_StreamInterviewServer) error {
+	ctx := stream.Context()
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		s.logger.Error("Interview not found", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+
+	// Subscribe before catching up so no question saved in between is missed
+	events, unsubscribe, err := s.broker.Subscribe(ctx, interviewTopic(interview.ID))
+	if err != nil {
+		s.logger.Error("Failed to subscribe to interview events", zap.String("interviewId", interview.ID), zap.Error(err))
+		return status.Errorf(codes.Unavailable, "Failed to subscribe to interview events: %v", err)
+	}
+	defer unsubscribe()
+
+	sent := make(map[[2]int32]bool)
+	index := req.FromIndex
+	if index < 1 {
+		index = 1
+	}
+	for ; index <= interview.TotalQuestions; index++ {
+		thread, err := s.repo.Question.GetThread(ctx, interview.ID, index)
+		if err != nil || len(thread) == 0 {
+			break
+		}
+		for _, question := range thread {
+			if err := stream.Send(s.questionReadyEvent(question, interview)); err != nil {
+				return err
+			}
+			sent[[2]int32{question.QuestionIndex, question.SubIndex}] = true
+		}
+	}
+
+	switch interview.Status {
+	case pb.InterviewStatus_INTERVIEW_STATUS_COMPLETED:
+		return stream.Send(&pb.InterviewEvent{
+			InterviewId: interview.ID,
+			Type:        pb.InterviewEventType_INTERVIEW_EVENT_SCORING_COMPLETED,
+			Timestamp:   time.Now().Unix(),
+		})
+	case pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS:
+		if index > interview.TotalQuestions {
+			break
 		}
+		job, err := s.repo.Job.Get(ctx, pb.JobKind_JOB_KIND_QUESTION, interview.ID, index, 0)
+		if err == nil && job.Status == pb.JobStatus_JOB_STATUS_DEAD {
+			if err := stream.Send(&pb.InterviewEvent{
+				InterviewId:   interview.ID,
+				Type:          pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_FAILED,
+				QuestionIndex: index,
+				Message:       job.LastError,
+				Timestamp:     time.Now().Unix(),
+			}); err != nil {
+				return err
+			}
+		} else if ent.IsNotFound(err) {
+			s.enqueueQuestionPreparation(userID, interview, index)
+		}
+	}
 
-		s.ensureQuestionWorkerPool()
-		enqueued := s.questionWorkerPool.EnqueueJob(s.logger, job)
-		if !enqueued {
-			s.logger.Warn("Failed to enqueue question preparation job",
-				zap.String("interviewID", job.InterviewID),
-				zap.Int32("nextQuestionID", job.NextQuestionID))
+	if interview.Status == pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		// The current question is visible once streamed, its clock starts now
+		if current, _, err := s.repo.Question.GetProgress(ctx, interview.ID); err == nil {
+			s.startDeadlineAt(ctx, interview, current)
 		}
 	}
 
-	// Return the next question
-	return &pb.QuestionResponse{
-		QuestionId:     question.QuestionIndex,
-		Content:        question.Content,
-		Audio:          question.Audio,
-		Lipsync:        question.Lipsync,
-		IsLastQuestion: isLastQuestion,
-		IsLoading:      false,
-		Timestamp:      time.Now().Unix(),
+	s.logger.Info("Streaming interview events", zap.String("interviewId", interview.ID), zap.Int32("fromIndex", index))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case payload := <-events:
+			var event pb.InterviewEvent
+			if err := protojson.Unmarshal(payload, &event); err != nil {
+				s.logger.Warn("Dropping malformed interview event", zap.String("interviewId", interview.ID), zap.Error(err))
+				continue
+			}
+			// Questions already delivered during catch-up are not sent twice
+			if event.Type == pb.InterviewEventType_INTERVIEW_EVENT_QUESTION_READY && sent[[2]int32{event.QuestionIndex, event.SubIndex}] {
+				continue
+			}
+			if err := stream.Send(&event); err != nil {
+				return err
+			}
+			switch event.Type {
+			case pb.InterviewEventType_INTERVIEW_EVENT_SCORING_COMPLETED, pb.InterviewEventType_INTERVIEW_EVENT_SCORING_FAILED:
+				return nil
+			}
+		}
+	}
+}
+
+// ResumeInterview reports where a candidate left off and reopens the question flow from there
+func (s *Irelia) ResumeInterview(ctx context.Context, req *pb.ResumeInterviewRequest) (*pb.ResumeInterviewResponse, error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		s.logger.Error("Interview not found", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+
+	nextIndex, answered, err := s.repo.Question.GetProgress(ctx, interview.ID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve interview progress", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve interview progress: %v", err)
+	}
+
+	resumable := interview.Status == pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS && nextIndex <= interview.TotalQuestions
+	if resumable {
+		if err := s.repo.Interview.Touch(ctx, interview.ID); err != nil {
+			s.logger.Warn("Failed to refresh interview activity", zap.String("interviewId", interview.ID), zap.Error(err))
+		}
+		// The question may never have been prepared if the previous session died mid-way
+		exists, err := s.repo.Question.Exists(ctx, interview.ID, nextIndex)
+		if err == nil && !exists {
+			s.enqueueQuestionPreparation(userID, interview, nextIndex)
+		}
+	}
+
+	s.logger.Info("Resuming interview", zap.String("interviewId", interview.ID),
+		zap.Int32("nextQuestionIndex", nextIndex),
+		zap.Bool("resumable", resumable))
+
+	return &pb.ResumeInterviewResponse{
+		InterviewId:       interview.ID,
+		Status:            interview.Status,
+		NextQuestionIndex: nextIndex,
+		TotalQuestions:    interview.TotalQuestions,
+		AnsweredQuestions: answered,
+		IsResumable:       resumable,
 	}, nil
 }
 
+// AbandonInterview stops an in-progress interview without scoring it
+func (s *Irelia) AbandonInterview(ctx context.Context, req *pb.AbandonInterviewRequest) (*emptypb.Empty, error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		s.logger.Error("Interview not found", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+	if interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS {
+		return nil, status.Errorf(codes.FailedPrecondition, "Only in-progress interviews can be abandoned: %s", interview.Status)
+	}
+
+	interview.Status = pb.InterviewStatus_INTERVIEW_STATUS_ABANDONED
+	if err := s.repo.Interview.Update(ctx, userID, interview); err != nil {
+		s.logger.Error("Failed to abandon interview", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to abandon interview: %v", err)
+	}
+
+	s.logger.Info("Interview abandoned", zap.String("interviewId", interview.ID))
+	return &emptypb.Empty{}, nil
+}
+
 // SubmitInterview handles the submission of the entire interview
 func (s *Irelia) SubmitInterview(ctx context.Context, req *pb.SubmitInterviewRequest) (*pb.SubmitInterviewResponse, error) {
 	userID, err := s.getUserID(ctx)
@@ -311,9 +637,10 @@ func (s *Irelia) SubmitInterview(ctx context.Context, req *pb.SubmitInterviewReq
 		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
 	}
 
-	// s.timerManager.cleanupInterviewTimers(req.InterviewId)
-
-	interview, err := s.repo.Interview.Get(ctx, req.InterviewId)
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
 	if err != nil {
 		s.logger.Error("Failed to retrieve interview", zap.String("interviewId", req.InterviewId), zap.Error(err))
 		return nil, fmt.Errorf("failed to retrieve interview: %v", err)
@@ -322,142 +649,111 @@ func (s *Irelia) SubmitInterview(ctx context.Context, req *pb.SubmitInterviewReq
 		s.logger.Error("Interview already submitted", zap.String("interviewId", req.InterviewId))
 		return nil, status.Errorf(codes.FailedPrecondition, "Interview already submitted: %v", err)
 	}
-
-	// Get all questions' AnswerData in interview
-	answers, err := s.repo.Question.GetAnswers(ctx, req.InterviewId)
-	if err != nil {
-		s.logger.Error("Failed to retrieve answers", zap.Error(err))
+	if interview.Status == pb.InterviewStatus_INTERVIEW_STATUS_ABANDONED {
+		s.logger.Error("Interview was abandoned", zap.String("interviewId", req.InterviewId))
+		return nil, status.Errorf(codes.FailedPrecondition, "Interview was abandoned")
+	}
+	// Expired interviews cannot be submitted, one whose scoring failed is scored again through RetryScoring
+	if interview.Status == pb.InterviewStatus_INTERVIEW_STATUS_FAILED {
+		s.logger.Error("Interview has failed", zap.String("interviewId", req.InterviewId), zap.String("reason", interview.FailureReason))
+		return nil, status.Errorf(codes.FailedPrecondition, "Interview has failed: %s", interview.FailureReason)
 	}
 
 	// Save the interview status
 	interview.Status = pb.InterviewStatus_INTERVIEW_STATUS_PENDING
+	interview.FailureReason = ""
 	if err := s.repo.Interview.Update(ctx, userID, interview); err != nil {
 		s.logger.Error("Failed to save interview status", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "Failed to save interview status: %v", err)
 	}
 
-	outro := &ent.Question{
-		Content: s.prepareOutro(interview.Language),
-	}
+	// Scoring runs as a durable job so it survives upstream failures and restarts
+	s.enqueueScoring(userID, interview)
+
+	s.publishEvent(ctx, userID, &pb.InterviewEvent{
+		InterviewId: interview.ID,
+		Type:        pb.InterviewEventType_INTERVIEW_EVENT_SCORING_STARTED,
+	})
 
-	// Prepare lip-sync data for the outro
-	if outro, err = s.prepareLipSync(ctx, outro, interview, true, false); err != nil {
-		s.logger.Error("Failed to prepare lip sync for the outro", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to prepare lip sync for the outro: %v", err)
+	outro := &ent.Question{
+		Content: prepareOutro(interview.Language),
 	}
 
-	// Get submissions from answers
-	submissionsForDarius := make([]*pb.AnswerData, len(answers))
-	// submissionsForKarma := make([]*pb.AnswerData, len(answers))
-	for i, answer := range answers {
-		submissionsForDarius[i] = &pb.AnswerData{
-			Index:    answer.Index,
-			Question: &answer.Content,
-			Answer:   answer.Answer,
+	// Prepare lip-sync data for the outro, the interview is already submitted so a missing outro is not an error
+	if !interview.TextOnly {
+		if rendered, err := s.prepareLipSync(ctx, outro, interview, true, false); err != nil {
+			s.logger.Warn("Failed to prepare lip sync for the outro, returning it without audio", zap.String("interviewId", interview.ID), zap.Error(err))
+		} else {
+			outro = rendered
 		}
-		// submissionsForKarma[i] = &pb.AnswerData{
-		// 	Index:       answer.Index,
-		// 	Answer:      answer.Answer,
-		// 	RecordProof: &answer.RecordProof,
-		// }
 	}
 
-	// Get both request
-	dariusReq := &pb.ScoreInterviewRequest{
-		InterviewId: interview.ID,
-		Submissions: submissionsForDarius,
-		Skills:      interview.Skills,
+	return &pb.SubmitInterviewResponse{
+		Outro: &pb.LipSyncResponse{
+			Audio:    outro.Audio,
+			Lipsync:  outro.Lipsync,
+			AudioKey: outro.AudioKey,
+			AudioUrl: s.audioURL(outro.AudioKey),
+		},
+	}, nil
+}
+
+// RetryScoring re-runs scoring for an interview whose scoring has failed
+func (s *Irelia) RetryScoring(ctx context.Context, req *pb.RetryScoringRequest) (*pb.RetryScoringResponse, error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
 	}
-	// karmaReq := &pb.ScoreFluencyRequest{
-	// 	InterviewId: interview.ID,
-	// 	Submissions: submissionsForKarma,
-	// }
 
-	go func() {
-		bgCtx := context.Background()
-		dariusResp, err := s.callDariusForScore(bgCtx, userID, dariusReq)
-		if err != nil {
-			s.logger.Error("Failed to score by Darius", zap.Error(err))
-			return
-		}
-		// karmaResp, err := s.callKarmaForScore(bgCtx, karmaReq)
-		// if err != nil {
-		// 	s.logger.Error("Failed to score by Karma", zap.Error(err))
-		// 	return
-		// }
-
-		// Update the database with scoring results
-		interview.Status = pb.InterviewStatus_INTERVIEW_STATUS_PENDING
-		for _, submission := range dariusResp.Result {
-			question, err := s.repo.Question.Get(bgCtx, req.InterviewId, submission.Index)
-			if ent.IsNotSingular(err) {
-				s.logger.Error("Multiple questions found for the same index, skipping update",
-					zap.String("interviewId", req.InterviewId),
-					zap.Int32("questionIndex", submission.Index),
-					zap.Error(err))
-				continue
-			}
-			if err != nil {
-				s.logger.Error("Failed to retrieve question", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", submission.Index), zap.Error(err))
-				continue
-			}
-			question.Comment = submission.Comment
-			question.Score = submission.Score
-			if question.Score == "" {
-				question.Status = pb.QuestionStatus_QUESTION_STATUS_FAILED
-			} else {
-				question.Status = pb.QuestionStatus_QUESTION_STATUS_RATED
-			}
-			// Store question update list
-			err = s.repo.Question.Update(bgCtx, userID, question)
-			if err != nil {
-				s.logger.Error("Failed to update question with score", zap.String("interviewId", req.InterviewId), zap.Int32("questionIndex", submission.Index), zap.Error(err))
-				continue
-			}
-		}
+	interview, err := s.getInterview(ctx, req.InterviewId)
+	if err != nil {
+		s.logger.Error("Failed to retrieve interview", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+	if interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_PENDING &&
+		interview.Status != pb.InterviewStatus_INTERVIEW_STATUS_FAILED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Interview is not awaiting scoring: %s", interview.Status)
+	}
 
-		// Update the interview with feedback and total score
-		totalLength := len(dariusResp.Skills) //+ len(karmaResp.Skills)
-		skills := make([]string, 0, totalLength)
-		skillsScore := make([]string, 0, totalLength)
+	job, err := s.repo.Job.Get(ctx, pb.JobKind_JOB_KIND_SCORING, interview.ID, 0, 0)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Interview has never been submitted")
+	}
+	if err != nil {
+		s.logger.Error("Failed to retrieve scoring job", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve scoring job: %v", err)
+	}
+	if job.Status == pb.JobStatus_JOB_STATUS_PENDING || job.Status == pb.JobStatus_JOB_STATUS_RUNNING {
+		return nil, status.Errorf(codes.FailedPrecondition, "Interview is already being scored")
+	}
 
-		for _, ele := range dariusResp.Skills {
-			skills = append(skills, ele.Skill)
-			skillsScore = append(skillsScore, ele.Score)
-		}
+	interview.Status = pb.InterviewStatus_INTERVIEW_STATUS_PENDING
+	interview.FailureReason = ""
+	if err := s.repo.Interview.Update(ctx, userID, interview); err != nil {
+		s.logger.Error("Failed to update interview", zap.String("interviewId", interview.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to update interview: %v", err)
+	}
 
-		// for skill, score := range karmaResp.Skills {
-		// 	skills = append(skills, skill)
-		// 	skillsScore = append(skillsScore, score)
-		// }
-
-		interview.Skills = skills
-		interview.SkillsScore = skillsScore
-		interview.TotalScore = dariusResp.TotalScore
-		interview.PositiveFeedback = dariusResp.PositiveFeedback
-		interview.ActionableFeedback = dariusResp.ActionableFeedback //+ " " + karmaResp.ActionableFeedback
-		interview.FinalComment = dariusResp.FinalComment
-		interview.Status = pb.InterviewStatus_INTERVIEW_STATUS_COMPLETED
-		interview.OverallScore = getOverallScore(interview.TotalScore)
-
-		if err := s.repo.Interview.Update(bgCtx, userID, interview); err != nil {
-			s.logger.Error("Failed to save interview feedback", zap.Error(err))
-			return
-		}
-		s.logger.Info("Interview feedback saved successfully", zap.String("interviewId", interview.ID))
-	}()
+	if !s.enqueueScoring(userID, interview) {
+		return nil, status.Errorf(codes.Internal, "Failed to schedule scoring")
+	}
+	s.publishEvent(ctx, userID, &pb.InterviewEvent{
+		InterviewId: interview.ID,
+		Type:        pb.InterviewEventType_INTERVIEW_EVENT_SCORING_STARTED,
+	})
 
-	return &pb.SubmitInterviewResponse{
-		Outro: &pb.LipSyncResponse{
-			Audio:   outro.Audio,
-			Lipsync: outro.Lipsync,
-		},
+	return &pb.RetryScoringResponse{
+		Status: interview.Status,
 	}, nil
 }
 
 // GetInterview retrieves the details of a specific interview
 func (s *Irelia) GetInterview(ctx context.Context, req *pb.GetInterviewRequest) (*pb.GetInterviewResponse, error) {
-	entInterview, err := s.repo.Interview.Get(ctx, req.InterviewId)
+	entInterview, err := s.getInterview(ctx, req.InterviewId)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
 	if err != nil {
 		s.logger.Error("Failed to retrieve interview", zap.String("interviewId", req.InterviewId), zap.Error(err))
 		return nil, fmt.Errorf("failed to retrieve interview: %v", err)
@@ -485,23 +781,23 @@ func (s *Irelia) GetInterview(ctx context.Context, req *pb.GetInterviewRequest)
 		PositiveFeedback:   entInterview.PositiveFeedback,
 		ActionableFeedback: entInterview.ActionableFeedback,
 		FinalComment:       entInterview.FinalComment,
+		Status:             entInterview.Status,
+		FailureReason:      entInterview.FailureReason,
 	}, nil
 }
 
 // GetInterviewHistory retrieves the history of interviews
 func (s *Irelia) GetInterviewHistory(ctx context.Context, req *pb.GetInterviewHistoryRequest) (*pb.GetInterviewHistoryResponse, error) {
-	var convertedUserId *uint64 = nil
-	userID, err := s.getUserID(ctx)
+	principal, err := s.getPrincipal(ctx)
 	if err != nil {
 		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
 		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
 	}
-	if userID != 0 {
-		temp := uint64(userID)
-		convertedUserId = &temp
+	// Candidates only see their own history, business managers see every candidate's
+	interviews, page, err := s.repo.Interview.List(ctx, req, principal.UserID, principal.Scope())
+	if errors.Is(err, repo.ErrInvalidPageToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token")
 	}
-
-	interviews, _, size, totalPages, err := s.repo.Interview.List(ctx, req, convertedUserId)
 	if err != nil {
 		s.logger.Error("Failed to retrieve interview history", zap.Error(err))
 		return nil, fmt.Errorf("failed to retrieve interview history: %v", err)
@@ -523,10 +819,12 @@ func (s *Irelia) GetInterviewHistory(ctx context.Context, req *pb.GetInterviewHi
 	}
 
 	return &pb.GetInterviewHistoryResponse{
-		Page:       req.Page,
-		PerPage:    size,
-		TotalPages: totalPages,
-		Interviews: history,
+		Page:          req.Page,
+		PerPage:       page.Size,
+		TotalPages:    page.TotalPages,
+		Interviews:    history,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -538,9 +836,36 @@ func (s *Irelia) FavoriteInterview(ctx context.Context, req *pb.FavoriteIntervie
 		return &emptypb.Empty{}, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
 	}
 
+	if _, err := s.getInterview(ctx, req.InterviewId); err != nil {
+		s.logger.Error("Interview not found", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return &emptypb.Empty{}, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+
 	return &emptypb.Empty{}, s.repo.Interview.Favorite(ctx, uint64(userID), req.InterviewId)
 }
 
+// DeleteInterview hides an interview of the candidate, it is purged for good once the retention period has passed
+func (s *Irelia) DeleteInterview(ctx context.Context, req *pb.DeleteInterviewRequest) (*emptypb.Empty, error) {
+	userID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return &emptypb.Empty{}, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	err = s.repo.Interview.Delete(ctx, userID, req.InterviewId)
+	if ent.IsNotFound(err) {
+		return &emptypb.Empty{}, status.Errorf(codes.NotFound, "Interview not found: %v", err)
+	}
+	if err != nil {
+		s.logger.Error("Failed to delete interview", zap.String("interviewId", req.InterviewId), zap.Error(err))
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "Failed to delete interview: %v", err)
+	}
+
+	// Pending jobs and deadlines of the interview find it gone and stop on their own
+	s.logger.Info("Deleted interview", zap.String("interviewId", req.InterviewId), zap.Uint64("userId", userID))
+	return &emptypb.Empty{}, nil
+}
+
 // DemoInterview is a demo method to start an interview with predefined parameters
 func (s *Irelia) DemoInterview(ctx context.Context, req *pb.DemoRequest) (*pb.DemoResponse, error) {
 	topic := req.Topic
@@ -573,29 +898,38 @@ func (s *Irelia) DemoInterview(ctx context.Context, req *pb.DemoRequest) (*pb.De
 }
 
 func (s *Irelia) GetPublicQuestion(ctx context.Context, req *pb.GetPublicQuestionRequest) (*pb.GetPublicQuestionResponse, error) {
-	questions, totalCount, size, totalPages, err := s.repo.PublicQuestion.List(ctx, req)
+	questions, page, err := s.repo.PublicQuestion.List(ctx, req)
+	if errors.Is(err, repo.ErrInvalidPageToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token")
+	}
 	if err != nil {
 		s.logger.Error("Failed to get public questions", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "Failed to get public questions: %v", err)
 	}
 	var pbQuestions []*pb.PublicQuestion
 	for _, q := range questions {
-		pbQuestions = append(pbQuestions, &pb.PublicQuestion{
+		question := &pb.PublicQuestion{
 			Content:    q.Content,
-			Answer:     &q.Answer,
 			Position:   q.Position,
 			Experience: q.Experience,
 			BaseData: &pb.BaseData{
 				CreatedAt: timestamppb.New(q.CreatedAt),
 				UpdatedAt: timestamppb.New(q.UpdatedAt),
 			},
-		})
+		}
+		// Candidates practising on the bank see the model answers only when they ask for them
+		if req.IncludeAnswers {
+			question.Answer = &q.Answer
+			setAnswerProvenance(question, q)
+		}
+		pbQuestions = append(pbQuestions, question)
 	}
 	return &pb.GetPublicQuestionResponse{
-		Page:       req.Page,
-		PerPage:    size,
-		TotalPages: totalPages,
-		TotalCount: totalCount,
-		Questions:  pbQuestions,
+		Page:          req.Page,
+		PerPage:       page.Size,
+		TotalPages:    page.TotalPages,
+		TotalCount:    page.TotalCount,
+		Questions:     pbQuestions,
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
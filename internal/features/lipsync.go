@@ -0,0 +1,358 @@
+package features
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	pb "irelia/api"
+	sv "irelia/internal/service"
+	"irelia/internal/utils/blob"
+	"irelia/internal/utils/redis"
+	"irelia/internal/utils/wav"
+)
+
+// lipSyncIndex is the sorted set ranking cached utterances by last use, it bounds the cache size
+const lipSyncIndex = "lipsync:index"
+
+// LipSyncCache stores rendered utterances keyed by voice, speed, language and text hash
+type LipSyncCache struct {
+	redis         redis.Redis
+	logger        *zap.Logger
+	ttl           time.Duration
+	maxEntries    int64
+	maxEntryBytes int
+	// Metrics
+	hits      int64
+	misses    int64
+	writes    int64
+	evictions int64
+}
+
+// NewLipSyncCache configures a cache from the lipsync.cache config section,
+// a max entries of zero leaves its size bounded by the TTL only
+func NewLipSyncCache(redis redis.Redis, logger *zap.Logger) *LipSyncCache {
+	ttl := viper.GetInt("lipsync.cache.ttl")
+	if ttl <= 0 {
+		ttl = 7 * 24 * 3600
+	}
+	return &LipSyncCache{
+		redis:         redis,
+		logger:        logger,
+		ttl:           time.Duration(ttl) * time.Second,
+		maxEntries:    viper.GetInt64("lipsync.cache.max_entries"),
+		maxEntryBytes: viper.GetInt("lipsync.cache.max_entry_bytes"),
+	}
+}
+
+// Key returns the cache key of an utterance
+func (c *LipSyncCache) Key(voiceID, language string, speed int32, text string) string {
+	return fmt.Sprintf("lipsync:%s:%s:%d:%x", voiceID, language, speed, sha256.Sum256([]byte(text)))
+}
+
+// Get returns a cached utterance, refreshing its rank so the least recently used ones are evicted first
+func (c *LipSyncCache) Get(ctx context.Context, key string) (*pb.LipSyncResponse, bool) {
+	val, err := c.redis.Get(ctx, key)
+	if err != nil || val == nil {
+		atomic.AddInt64(&c.misses, 1)
+		return nil, false
+	}
+
+	var resp pb.LipSyncResponse
+	if err := proto.Unmarshal(val, &resp); err != nil {
+		atomic.AddInt64(&c.misses, 1)
+		c.logger.Warn("Dropping undecodable lip sync cache entry", zap.String("cacheKey", key), zap.Error(err))
+		return nil, false
+	}
+
+	atomic.AddInt64(&c.hits, 1)
+	if c.maxEntries > 0 {
+		// The entry lives a full TTL past its last use, so its rank tells when it expires
+		if _, err := c.redis.Expire(ctx, key, c.ttl); err != nil {
+			c.logger.Debug("Failed to refresh lip sync cache entry", zap.String("cacheKey", key), zap.Error(err))
+		} else if err := c.redis.ZAdd(ctx, lipSyncIndex, float64(time.Now().Unix()), key); err != nil {
+			c.logger.Debug("Failed to refresh lip sync cache entry", zap.String("cacheKey", key), zap.Error(err))
+		}
+	}
+	return &resp, true
+}
+
+// Set caches an utterance and evicts the least recently used ones beyond the size limit
+func (c *LipSyncCache) Set(ctx context.Context, key string, resp *pb.LipSyncResponse) {
+	val, err := proto.Marshal(resp)
+	if err != nil {
+		c.logger.Warn("Failed to encode lip sync cache entry", zap.String("cacheKey", key), zap.Error(err))
+		return
+	}
+	if c.maxEntryBytes > 0 && len(val) > c.maxEntryBytes {
+		c.logger.Debug("Lip sync entry too large to cache", zap.String("cacheKey", key), zap.Int("bytes", len(val)))
+		return
+	}
+	if ok, err := c.redis.SetBytes(ctx, key, val, c.ttl); err != nil || !ok {
+		return
+	}
+	atomic.AddInt64(&c.writes, 1)
+
+	if c.maxEntries <= 0 {
+		return
+	}
+	now := time.Now()
+	if err := c.redis.ZAdd(ctx, lipSyncIndex, float64(now.Unix()), key); err != nil {
+		c.logger.Warn("Failed to index lip sync cache entry", zap.String("cacheKey", key), zap.Error(err))
+		return
+	}
+	// Entries unused for a TTL have expired, their members must not count towards the limit
+	if _, err := c.redis.ZRemRangeByScore(ctx, lipSyncIndex, float64(now.Add(-c.ttl).Unix())); err != nil {
+		c.logger.Warn("Failed to prune expired lip sync cache entries", zap.Error(err))
+		return
+	}
+	size, err := c.redis.ZCard(ctx, lipSyncIndex)
+	if err != nil || size <= c.maxEntries {
+		return
+	}
+	evicted, err := c.redis.ZPopMin(ctx, lipSyncIndex, size-c.maxEntries)
+	if err != nil {
+		c.logger.Warn("Failed to evict lip sync cache entries", zap.Error(err))
+		return
+	}
+	for _, member := range evicted {
+		_, _ = c.redis.Delete(ctx, member)
+	}
+	atomic.AddInt64(&c.evictions, int64(len(evicted)))
+}
+
+// GetMetrics returns lip sync cache metrics
+func (c *LipSyncCache) GetMetrics() map[string]interface{} {
+	hits, misses := atomic.LoadInt64(&c.hits), atomic.LoadInt64(&c.misses)
+	hitRatio := 0.0
+	if hits+misses > 0 {
+		hitRatio = float64(hits) / float64(hits+misses)
+	}
+	return map[string]interface{}{
+		"hits":      hits,
+		"misses":    misses,
+		"hit_ratio": hitRatio,
+		"writes":    atomic.LoadInt64(&c.writes),
+		"evictions": atomic.LoadInt64(&c.evictions),
+	}
+}
+
+// LipSyncRenderer turns an utterance into audio and lip-sync data through the cache, Karma and the blob store
+type LipSyncRenderer struct {
+	karma  *sv.KarmaClient
+	cache  *LipSyncCache
+	blobs  blob.Store
+	usage  *UsageLedger
+	logger *zap.Logger
+}
+
+// NewLipSyncRenderer creates a renderer, a nil blob store keeps the audio inline and a nil ledger records nothing
+func NewLipSyncRenderer(karma *sv.KarmaClient, cache *LipSyncCache, blobs blob.Store, usage *UsageLedger, logger *zap.Logger) *LipSyncRenderer {
+	return &LipSyncRenderer{karma: karma, cache: cache, blobs: blobs, usage: usage, logger: logger}
+}
+
+// Render returns the lip-sync of an utterance, rendering it with Karma only on a cache miss
+func (r *LipSyncRenderer) Render(ctx context.Context, userID uint64, interviewID, text, voiceID, language string, speed int32) (*pb.LipSyncResponse, error) {
+	key := r.cache.Key(voiceID, language, speed, text)
+	if cached, ok := r.cache.Get(ctx, key); ok && r.audioAvailable(ctx, cached) {
+		r.logger.Debug("Lip sync cache hit", zap.String("cacheKey", key))
+		return cached, nil
+	}
+
+	// Log the request for debugging
+	r.logger.Info("Sending request to Karma for lip-sync generation", zap.String("interviewId", interviewID), zap.String("content", text))
+	req := &pb.LipSyncRequest{
+		InterviewId: interviewID,
+		Content:     text,
+		VoiceId:     voiceID,
+		Speed:       speed,
+	}
+	started := time.Now()
+	resp, err := r.karma.LipSync(ctx, req)
+	r.usage.Record(userID, interviewID, "karma", "lip_sync", started, req, resp, err)
+	if err != nil {
+		return nil, err
+	}
+
+	// The audio itself goes to the blob store, the cache only keeps its key
+	r.storeAudio(ctx, resp)
+	r.cache.Set(ctx, key, resp)
+	return resp, nil
+}
+
+// RenderSegments renders the segments of an utterance in parallel and stitches them into one clip, each segment's
+// mouth cues are shifted by the length of the audio before it. Segments that cannot be stitched, such as non-WAV
+// audio, fall back to rendering the whole utterance at once
+func (r *LipSyncRenderer) RenderSegments(ctx context.Context, userID uint64, interviewID string, segments []string, voiceID, language string, speed int32) (*pb.LipSyncResponse, error) {
+	if len(segments) == 1 {
+		return r.Render(ctx, userID, interviewID, segments[0], voiceID, language, speed)
+	}
+
+	parallel := viper.GetInt("lipsync.max_parallel")
+	if parallel <= 0 {
+		parallel = 4
+	}
+
+	responses := make([]*pb.LipSyncResponse, len(segments))
+	errs := make([]error, len(segments))
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i, segment := range segments {
+		wg.Add(1)
+		go func(i int, segment string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			responses[i], errs[i] = r.Render(ctx, userID, interviewID, segment, voiceID, language, speed)
+		}(i, segment)
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	stitched, err := r.stitch(ctx, responses)
+	if err != nil {
+		r.logger.Warn("Failed to stitch lip sync segments, rendering the utterance whole", zap.String("interviewId", interviewID),
+			zap.Int("segments", len(segments)),
+			zap.Error(err))
+		return r.Render(ctx, userID, interviewID, strings.Join(segments, " "), voiceID, language, speed)
+	}
+	return stitched, nil
+}
+
+// stitch concatenates the audio of rendered segments and merges their lip-sync timelines
+func (r *LipSyncRenderer) stitch(ctx context.Context, responses []*pb.LipSyncResponse) (*pb.LipSyncResponse, error) {
+	clips := make([]*wav.Clip, 0, len(responses))
+	merged := &pb.LipSyncData{Metadata: &pb.LipSyncMetadata{}}
+	var offset float32
+	for _, resp := range responses {
+		data, err := r.audio(ctx, resp)
+		if err != nil {
+			return nil, err
+		}
+		clip, err := wav.Parse(data)
+		if err != nil {
+			return nil, err
+		}
+		clips = append(clips, clip)
+
+		lipsync := resp.GetLipsync()
+		if merged.Metadata.SoundFile == "" {
+			merged.Metadata.SoundFile = lipsync.GetMetadata().GetSoundFile()
+		}
+		for _, cue := range lipsync.GetMouthCues() {
+			merged.MouthCues = append(merged.MouthCues, &pb.MouthCue{
+				Start: cue.Start + offset,
+				End:   cue.End + offset,
+				Value: cue.Value,
+			})
+		}
+		// The audio length is what the player follows, the reported duration may be rounded
+		offset += clip.Duration()
+	}
+	merged.Metadata.Duration = offset
+
+	joined, err := wav.Concat(clips)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.LipSyncResponse{
+		Audio:   base64.StdEncoding.EncodeToString(joined.Encode()),
+		Lipsync: merged,
+	}
+	r.storeAudio(ctx, resp)
+	return resp, nil
+}
+
+// audio returns the raw audio of a rendered segment, reading it back from the blob store when it is not inline
+func (r *LipSyncRenderer) audio(ctx context.Context, resp *pb.LipSyncResponse) ([]byte, error) {
+	if resp.Audio != "" {
+		return base64.StdEncoding.DecodeString(resp.Audio)
+	}
+	if r.blobs == nil || resp.AudioKey == "" {
+		return nil, fmt.Errorf("segment has no audio")
+	}
+	data, _, err := r.blobs.Get(ctx, resp.AudioKey)
+	return data, err
+}
+
+// audioAvailable reports whether the stored audio of a cached render still exists, the purger may have deleted it
+func (r *LipSyncRenderer) audioAvailable(ctx context.Context, resp *pb.LipSyncResponse) bool {
+	if r.blobs == nil || resp.AudioKey == "" {
+		return true
+	}
+	exists, err := r.blobs.Exists(ctx, resp.AudioKey)
+	if err != nil || exists {
+		return true
+	}
+	r.logger.Debug("Cached lip sync audio is gone, rendering it again", zap.String("key", resp.AudioKey))
+	return false
+}
+
+// storeAudio moves the base64 audio of a lip-sync response to the blob store and keeps only its
+// content-addressed key, the audio stays inline when no store is configured or the upload fails
+func (r *LipSyncRenderer) storeAudio(ctx context.Context, resp *pb.LipSyncResponse) {
+	if r.blobs == nil || resp.Audio == "" {
+		return
+	}
+
+	data, err := base64.StdEncoding.DecodeString(resp.Audio)
+	if err != nil {
+		r.logger.Warn("Lip sync audio is not base64, keeping it inline", zap.Error(err))
+		return
+	}
+
+	key := blob.Key("audio", data)
+	exists, err := r.blobs.Exists(ctx, key)
+	if err == nil && !exists {
+		err = r.blobs.Put(ctx, key, data, http.DetectContentType(data))
+	}
+	if err != nil {
+		r.logger.Warn("Failed to store audio, keeping it inline", zap.String("key", key), zap.Error(err))
+		return
+	}
+
+	resp.AudioKey = key
+	resp.Audio = ""
+}
+
+// Voice is an interviewer voice offered to candidates
+type Voice struct {
+	ID       string `mapstructure:"id"`
+	Language string `mapstructure:"language"`
+}
+
+// WarmLipSync pre-renders the greeting, intro questions, transitions and outro of each voice at each speed,
+// it returns how many utterances were rendered and stops at the first failure
+func WarmLipSync(ctx context.Context, renderer *LipSyncRenderer, voices []Voice, speeds []int32) (int, error) {
+	rendered := 0
+	for _, voice := range voices {
+		utterances := []string{introGreeting(voice.ID, voice.Language), prepareOutro(voice.Language)}
+		utterances = append(utterances, introQuestions(voice.Language)...)
+		utterances = append(utterances, transitionPhrases(voice.Language, false)...)
+		utterances = append(utterances, transitionPhrases(voice.Language, true)...)
+
+		for _, speed := range speeds {
+			for _, text := range utterances {
+				if _, err := renderer.Render(ctx, 0, "warmup", text, voice.ID, voice.Language, speed); err != nil {
+					return rendered, fmt.Errorf("failed to render %q for voice %s: %w", text, voice.ID, err)
+				}
+				rendered++
+			}
+		}
+		renderer.logger.Info("Warmed lip sync for voice", zap.String("voiceID", voice.ID), zap.Int("utterances", len(utterances)*len(speeds)))
+	}
+	return rendered, nil
+}
@@ -0,0 +1,246 @@
+package features
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "irelia/api"
+	repo "irelia/internal/repo"
+	"irelia/pkg/ent"
+)
+
+/*
+* PUBLIC QUESTION MODERATION
+ */
+
+// ListModerationQueue lists the public questions in a moderation status, pending ones unless another is asked for
+func (s *Irelia) ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueRequest) (*pb.ListModerationQueueResponse, error) {
+	if req.Status == pb.ModerationStatus_MODERATION_STATUS_UNKNOWN {
+		req.Status = pb.ModerationStatus_MODERATION_STATUS_PENDING
+	}
+
+	questions, page, err := s.repo.PublicQuestion.ListQueue(ctx, req)
+	if errors.Is(err, repo.ErrInvalidPageToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token")
+	}
+	if err != nil {
+		s.logger.Error("Failed to list moderation queue", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to list moderation queue: %v", err)
+	}
+
+	pbQuestions := make([]*pb.PublicQuestion, 0, len(questions))
+	for _, q := range questions {
+		pbQuestions = append(pbQuestions, toPublicQuestion(q))
+	}
+	return &pb.ListModerationQueueResponse{
+		Questions:     pbQuestions,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+// ModeratePublicQuestion approves, edits, rejects or merges a public question and records the action in its audit trail.
+// Merged questions are final, their occurrences count towards the question they were merged into
+func (s *Irelia) ModeratePublicQuestion(ctx context.Context, req *pb.ModeratePublicQuestionRequest) (*pb.PublicQuestion, error) {
+	moderatorID, err := s.getUserID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	question, err := s.repo.PublicQuestion.Get(ctx, int(req.Id))
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "Public question %d not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve public question: %v", err)
+	}
+	if question.Status == pb.ModerationStatus_MODERATION_STATUS_MERGED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Public question %d has been merged into %d", question.ID, *question.MergedIntoID)
+	}
+
+	audit := &ent.ModerationAudit{
+		QuestionID:     question.ID,
+		ModeratorID:    moderatorID,
+		Action:         req.Action,
+		PreviousStatus: question.Status,
+		Note:           req.Note,
+	}
+	moderation := &repo.Moderation{ModeratedBy: moderatorID, ModeratedAt: time.Now()}
+
+	switch req.Action {
+	case pb.ModerationAction_MODERATION_ACTION_APPROVE:
+		approved := pb.ModerationStatus_MODERATION_STATUS_APPROVED
+		moderation.Status = &approved
+	case pb.ModerationAction_MODERATION_ACTION_REJECT:
+		rejected := pb.ModerationStatus_MODERATION_STATUS_REJECTED
+		moderation.Status = &rejected
+	case pb.ModerationAction_MODERATION_ACTION_EDIT:
+		if err := editPublicQuestion(moderation, req); err != nil {
+			return nil, err
+		}
+	case pb.ModerationAction_MODERATION_ACTION_MERGE:
+		question.ModeratedBy = &moderatorID
+		question.ModeratedAt = &moderation.ModeratedAt
+		return s.mergePublicQuestion(ctx, question, req, audit)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported moderation action: %s", req.Action)
+	}
+
+	question, err = s.repo.PublicQuestion.Moderate(ctx, question.ID, moderation, audit)
+	if err != nil {
+		s.logger.Error("Failed to moderate public question", zap.Int32("questionId", req.Id), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to moderate public question: %v", err)
+	}
+	s.logger.Info("Public question moderated", zap.Uint64("moderatorId", moderatorID),
+		zap.Int("questionId", question.ID),
+		zap.String("action", req.Action.String()),
+		zap.String("status", question.Status.String()))
+	return toPublicQuestion(question), nil
+}
+
+// editPublicQuestion collects the fields an edit sets. The content hash is kept so that the question generated
+// again still counts towards the reviewed entry instead of entering the queue anew. An edited answer is the
+// moderator's, it is no longer regenerated
+func editPublicQuestion(moderation *repo.Moderation, req *pb.ModeratePublicQuestionRequest) error {
+	if req.Content == nil && req.Answer == nil && req.Position == nil && req.Experience == nil {
+		return status.Errorf(codes.InvalidArgument, "An edit requires content, answer, position or experience")
+	}
+	if req.Content != nil {
+		content := strings.TrimSpace(*req.Content)
+		if content == "" {
+			return status.Errorf(codes.InvalidArgument, "Content must not be empty")
+		}
+		moderation.Content = &content
+	}
+	if req.Answer != nil {
+		answer := strings.TrimSpace(*req.Answer)
+		moderation.Answer = &answer
+		moderation.AnswerGenerator = repo.ModeratorAnswer
+	}
+	if req.Position != nil {
+		position := strings.TrimSpace(*req.Position)
+		if position == "" {
+			return status.Errorf(codes.InvalidArgument, "Position must not be empty")
+		}
+		moderation.Position = &position
+	}
+	if req.Experience != nil {
+		experience := strings.TrimSpace(*req.Experience)
+		if experience == "" {
+			return status.Errorf(codes.InvalidArgument, "Experience must not be empty")
+		}
+		moderation.Experience = &experience
+	}
+	return nil
+}
+
+// mergePublicQuestion folds a duplicate the normalized hash missed into another question of the same language
+func (s *Irelia) mergePublicQuestion(ctx context.Context, question *ent.PublicQuestion, req *pb.ModeratePublicQuestionRequest, audit *ent.ModerationAudit) (*pb.PublicQuestion, error) {
+	if req.MergeIntoId == 0 || int(req.MergeIntoId) == question.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "A merge requires another question to merge into")
+	}
+	target, err := s.repo.PublicQuestion.Get(ctx, int(req.MergeIntoId))
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "Public question %d not found", req.MergeIntoId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve public question: %v", err)
+	}
+	if target.Status == pb.ModerationStatus_MODERATION_STATUS_MERGED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Public question %d has been merged into %d", target.ID, *target.MergedIntoID)
+	}
+	if target.Language != question.Language {
+		return nil, status.Errorf(codes.FailedPrecondition, "Questions in %s cannot be merged into questions in %s", question.Language, target.Language)
+	}
+
+	question.Status = pb.ModerationStatus_MODERATION_STATUS_MERGED
+	question.MergedIntoID = &target.ID
+	audit.Status = question.Status
+	audit.MergedIntoID = &target.ID
+	audit.Content = question.Content
+	if err := s.repo.PublicQuestion.Merge(ctx, question, target, audit); err != nil {
+		s.logger.Error("Failed to merge public question", zap.Int("questionId", question.ID), zap.Int("targetId", target.ID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to merge public question: %v", err)
+	}
+	s.logger.Info("Public question merged", zap.Uint64("moderatorId", audit.ModeratorID),
+		zap.Int("questionId", question.ID),
+		zap.Int("targetId", target.ID))
+	return toPublicQuestion(question), nil
+}
+
+// ListModerationAudit returns the audit trail of a public question
+func (s *Irelia) ListModerationAudit(ctx context.Context, req *pb.ListModerationAuditRequest) (*pb.ListModerationAuditResponse, error) {
+	entries, err := s.repo.PublicQuestion.Audit(ctx, int(req.Id))
+	if err != nil {
+		s.logger.Error("Failed to retrieve moderation audit", zap.Int32("questionId", req.Id), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve moderation audit: %v", err)
+	}
+
+	resp := &pb.ListModerationAuditResponse{Entries: make([]*pb.ModerationAuditEntry, 0, len(entries))}
+	for _, entry := range entries {
+		pbEntry := &pb.ModerationAuditEntry{
+			Id:              int32(entry.ID),
+			QuestionId:      int32(entry.QuestionID),
+			ModeratorId:     entry.ModeratorID,
+			Action:          entry.Action,
+			PreviousStatus:  entry.PreviousStatus,
+			Status:          entry.Status,
+			PreviousContent: entry.PreviousContent,
+			Content:         entry.Content,
+			PreviousAnswer:  entry.PreviousAnswer,
+			Answer:          entry.Answer,
+			Note:            entry.Note,
+			CreatedAt:       timestamppb.New(entry.CreatedAt),
+		}
+		if entry.MergedIntoID != nil {
+			pbEntry.MergedIntoId = int32(*entry.MergedIntoID)
+		}
+		resp.Entries = append(resp.Entries, pbEntry)
+	}
+	return resp, nil
+}
+
+func toPublicQuestion(q *ent.PublicQuestion) *pb.PublicQuestion {
+	question := &pb.PublicQuestion{
+		Id:          int32(q.ID),
+		Content:     q.Content,
+		Answer:      &q.Answer,
+		Position:    q.Position,
+		Experience:  q.Experience,
+		Language:    q.Language,
+		Status:      q.Status,
+		Occurrences: int32(q.Occurrences),
+		BaseData: &pb.BaseData{
+			CreatedAt: timestamppb.New(q.CreatedAt),
+			UpdatedAt: timestamppb.New(q.UpdatedAt),
+		},
+	}
+	if !q.FirstSeenAt.IsZero() {
+		question.FirstSeenAt = timestamppb.New(q.FirstSeenAt)
+	}
+	if !q.LastSeenAt.IsZero() {
+		question.LastSeenAt = timestamppb.New(q.LastSeenAt)
+	}
+	if q.MergedIntoID != nil {
+		question.MergedIntoId = int32(*q.MergedIntoID)
+	}
+	setAnswerProvenance(question, q)
+	return question
+}
+
+// setAnswerProvenance tells who wrote the model answer of a question and when
+func setAnswerProvenance(question *pb.PublicQuestion, q *ent.PublicQuestion) {
+	question.AnswerGenerator = q.AnswerGenerator
+	question.AnswerVersion = q.AnswerVersion
+	if q.AnswerGeneratedAt != nil {
+		question.AnswerGeneratedAt = timestamppb.New(*q.AnswerGeneratedAt)
+	}
+}
@@ -0,0 +1,47 @@
+package features
+
+import (
+	pb "irelia/api"
+	"irelia/internal/utils/auth"
+)
+
+var (
+	candidateOnly = []pb.BulbasaurRole{pb.BulbasaurRole_ROLE_CANDIDATE}
+	readers       = []pb.BulbasaurRole{pb.BulbasaurRole_ROLE_CANDIDATE, pb.BulbasaurRole_ROLE_BUSINESS_MANAGER}
+	adminOnly     = []pb.BulbasaurRole{pb.BulbasaurRole_ROLE_ADMIN}
+	usageReaders  = []pb.BulbasaurRole{pb.BulbasaurRole_ROLE_CANDIDATE, pb.BulbasaurRole_ROLE_ADMIN}
+	moderators    = []pb.BulbasaurRole{pb.BulbasaurRole_ROLE_BUSINESS_MANAGER, pb.BulbasaurRole_ROLE_ADMIN}
+	public        = []pb.BulbasaurRole{}
+)
+
+// AccessPolicy lists the roles allowed on each Irelia RPC.
+// Candidates drive and read their own interviews, business managers may read any interview
+// but never change one. Business managers and admins moderate the public question bank.
+// Admins call the generator, scorer and lip-sync backends directly to debug or repair
+// an interview. RPCs left out of the policy are rejected.
+var AccessPolicy = auth.Policy{
+	pb.Irelia_StartInterview_FullMethodName:         candidateOnly,
+	pb.Irelia_GetNextQuestion_FullMethodName:        candidateOnly,
+	pb.Irelia_StreamInterview_FullMethodName:        candidateOnly,
+	pb.Irelia_SubmitAnswer_FullMethodName:           candidateOnly,
+	pb.Irelia_SkipQuestion_FullMethodName:           candidateOnly,
+	pb.Irelia_ResumeInterview_FullMethodName:        candidateOnly,
+	pb.Irelia_AbandonInterview_FullMethodName:       candidateOnly,
+	pb.Irelia_SubmitInterview_FullMethodName:        candidateOnly,
+	pb.Irelia_RetryScoring_FullMethodName:           candidateOnly,
+	pb.Irelia_FavoriteInterview_FullMethodName:      candidateOnly,
+	pb.Irelia_DeleteInterview_FullMethodName:        candidateOnly,
+	pb.Irelia_GetInterviewHistory_FullMethodName:    readers,
+	pb.Irelia_GetInterview_FullMethodName:           readers,
+	pb.Irelia_ExportInterview_FullMethodName:        readers,
+	pb.Irelia_DemoInterview_FullMethodName:          public,
+	pb.Irelia_GetPublicQuestion_FullMethodName:      public,
+	pb.Irelia_GenerateNextQuestion_FullMethodName:   adminOnly,
+	pb.Irelia_ScoreInterview_FullMethodName:         adminOnly,
+	pb.Irelia_GenerateLipSync_FullMethodName:        adminOnly,
+	pb.Irelia_GetUsage_FullMethodName:               usageReaders,
+	pb.Irelia_GetServiceMetrics_FullMethodName:      adminOnly,
+	pb.Irelia_ListModerationQueue_FullMethodName:    moderators,
+	pb.Irelia_ModeratePublicQuestion_FullMethodName: moderators,
+	pb.Irelia_ListModerationAudit_FullMethodName:    moderators,
+}
@@ -0,0 +1,116 @@
+package features
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	repo "irelia/internal/repo"
+	"irelia/internal/utils/blob"
+)
+
+// InterviewPurger periodically hard-deletes interviews soft-deleted longer ago than the retention period,
+// together with their questions, favorites, jobs and the stored audio no other question uses
+type InterviewPurger struct {
+	interviews repo.IInterview
+	questions  repo.IQuestion
+	blobs      blob.Store
+	logger     *zap.Logger
+	retention  time.Duration
+	interval   time.Duration
+	batchSize  int
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+// NewInterviewPurger creates a purger, a retention of zero disables it
+func NewInterviewPurger(interviews repo.IInterview, questions repo.IQuestion, blobs blob.Store, logger *zap.Logger, retention, interval, batchSize int) *InterviewPurger {
+	ctx, cancel := context.WithCancel(context.Background())
+	if interval <= 0 {
+		interval = 3600
+	}
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &InterviewPurger{
+		interviews: interviews,
+		questions:  questions,
+		blobs:      blobs,
+		logger:     logger,
+		retention:  time.Duration(retention) * time.Second,
+		interval:   time.Duration(interval) * time.Second,
+		batchSize:  batchSize,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+func (p *InterviewPurger) Start() {
+	if p.retention <= 0 {
+		p.logger.Info("Interview purger disabled")
+		return
+	}
+
+	p.logger.Info("Starting interview purger",
+		zap.Duration("retention", p.retention),
+		zap.Duration("interval", p.interval))
+
+	go p.run()
+}
+
+func (p *InterviewPurger) Stop() {
+	p.cancel()
+}
+
+func (p *InterviewPurger) run() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.purge()
+		case <-p.ctx.Done():
+			p.logger.Info("Interview purger stopped")
+			return
+		}
+	}
+}
+
+func (p *InterviewPurger) purge() {
+	ctx, cancel := context.WithTimeout(p.ctx, p.interval)
+	defer cancel()
+
+	deletedBefore := time.Now().Add(-p.retention)
+	for ctx.Err() == nil {
+		purged, audioKeys, err := p.interviews.Purge(ctx, deletedBefore, p.batchSize)
+		if err != nil {
+			p.logger.Error("Failed to purge deleted interviews", zap.Error(err))
+			return
+		}
+		if purged == 0 {
+			return
+		}
+		p.logger.Info("Purged deleted interviews", zap.Int("count", purged), zap.Time("deletedBefore", deletedBefore))
+		p.deleteAudio(ctx, audioKeys)
+	}
+}
+
+// deleteAudio removes the purged audio, identical audio may still be used by the questions of other interviews
+func (p *InterviewPurger) deleteAudio(ctx context.Context, audioKeys []string) {
+	if p.blobs == nil || len(audioKeys) == 0 {
+		return
+	}
+
+	unreferenced, err := p.questions.UnreferencedAudio(ctx, audioKeys)
+	if err != nil {
+		p.logger.Error("Failed to check purged audio references", zap.Error(err))
+		return
+	}
+	for _, key := range unreferenced {
+		if err := p.blobs.Delete(ctx, key); err != nil {
+			p.logger.Warn("Failed to delete purged audio", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package features
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	repo "irelia/internal/repo"
+)
+
+// InterviewReaper periodically fails in-progress interviews that have been idle for too long
+type InterviewReaper struct {
+	repo        repo.IInterview
+	logger      *zap.Logger
+	idleTimeout time.Duration
+	interval    time.Duration
+	ctx         context.Context
+	cancel      context.CancelFunc
+}
+
+// NewInterviewReaper creates a reaper, an idle timeout of zero disables it
+func NewInterviewReaper(interviews repo.IInterview, logger *zap.Logger, idleTimeout, interval int) *InterviewReaper {
+	ctx, cancel := context.WithCancel(context.Background())
+	if interval <= 0 {
+		interval = 60
+	}
+	return &InterviewReaper{
+		repo:        interviews,
+		logger:      logger,
+		idleTimeout: time.Duration(idleTimeout) * time.Second,
+		interval:    time.Duration(interval) * time.Second,
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+func (r *InterviewReaper) Start() {
+	if r.idleTimeout <= 0 {
+		r.logger.Info("Interview reaper disabled")
+		return
+	}
+
+	r.logger.Info("Starting interview reaper",
+		zap.Duration("idleTimeout", r.idleTimeout),
+		zap.Duration("interval", r.interval))
+
+	go r.run()
+}
+
+func (r *InterviewReaper) Stop() {
+	r.cancel()
+}
+
+func (r *InterviewReaper) run() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.reap()
+		case <-r.ctx.Done():
+			r.logger.Info("Interview reaper stopped")
+			return
+		}
+	}
+}
+
+func (r *InterviewReaper) reap() {
+	ctx, cancel := context.WithTimeout(r.ctx, r.interval)
+	defer cancel()
+
+	idleSince := time.Now().Add(-r.idleTimeout)
+	expired, err := r.repo.ExpireStale(ctx, idleSince)
+	if err != nil {
+		r.logger.Error("Failed to expire stale interviews", zap.Error(err))
+		return
+	}
+	if expired > 0 {
+		r.logger.Info("Expired stale interviews", zap.Int("count", expired), zap.Time("idleSince", idleSince))
+	}
+}
@@ -0,0 +1,373 @@
+package features
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	pb "irelia/api"
+	"irelia/pkg/ent"
+)
+
+// errContentScoring marks a scoring run that failed because Darius could not score the content of the answers
+var errContentScoring = errors.New("failed to score by Darius")
+
+// enqueueScoring schedules a submitted interview to be scored in the background
+func (s *Irelia) enqueueScoring(userID uint64, interview *ent.Interview) bool {
+	job := &ent.Job{
+		Kind:        pb.JobKind_JOB_KIND_SCORING,
+		InterviewID: interview.ID,
+		UserID:      userID,
+	}
+
+	s.scoringWorkerPool.Ensure(s.logger)
+	if !s.scoringWorkerPool.Enqueue(context.Background(), s.logger, job) {
+		s.logger.Error("Failed to enqueue scoring job", zap.String("interviewID", interview.ID))
+		return false
+	}
+	return true
+}
+
+// runScoringJob scores the interview of a claimed job, an error leaves the job to be retried
+func (s *Irelia) runScoringJob(ctx context.Context, job *ent.Job) error {
+	interview, err := s.repo.Interview.Get(ctx, job.InterviewID)
+	if ent.IsNotFound(err) {
+		s.logger.Warn("Interview no longer exists, dropping scoring job", zap.String("interviewID", job.InterviewID))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve interview: %w", err)
+	}
+	if interview.Status == pb.InterviewStatus_INTERVIEW_STATUS_COMPLETED {
+		s.logger.Info("Interview already scored, skipping", zap.String("interviewID", interview.ID))
+		return nil
+	}
+
+	s.logger.Info("Scoring interview", zap.String("interviewID", interview.ID), zap.Int32("attempt", job.Attempts))
+
+	_, err = s.scoreInterview(ctx, job.UserID, interview)
+	if err == nil {
+		return nil
+	}
+
+	s.logger.Error("Failed to score interview", zap.String("interviewID", interview.ID),
+		zap.Int32("attempt", job.Attempts),
+		zap.Error(err))
+
+	// Out of attempts, the interview is parked in a terminal state until RetryScoring is called. A run cut off
+	// by shutdown is queued again instead
+	if job.Attempts >= job.MaxAttempts && !errors.Is(ctx.Err(), context.Canceled) {
+		interview.Status = pb.InterviewStatus_INTERVIEW_STATUS_FAILED
+		interview.FailureReason = err.Error()
+		// The fluency score is still shown, the content skills are left unscored
+		if errors.Is(err, errContentScoring) && interview.FluencyResult != nil {
+			interview.Skills, interview.SkillsScore = mergeSkills(unscoredSkills(contentSkills(interview)), interview.FluencyResult)
+			interview.ActionableFeedback = interview.FluencyResult.ActionableFeedback
+			interview.FailureReason = "content score missing: " + err.Error()
+		}
+		if updateErr := s.repo.Interview.Update(context.Background(), job.UserID, interview); updateErr != nil {
+			s.logger.Error("Failed to mark interview scoring as failed", zap.String("interviewID", interview.ID), zap.Error(updateErr))
+		}
+		s.publishEvent(context.Background(), job.UserID, &pb.InterviewEvent{
+			InterviewId: interview.ID,
+			Type:        pb.InterviewEventType_INTERVIEW_EVENT_SCORING_FAILED,
+			Message:     err.Error(),
+		})
+	}
+	return err
+}
+
+// scoreInterview asks Darius to score the content of every answer and Karma to score the fluency of the recordings,
+// then stores and returns the merged feedback
+func (s *Irelia) scoreInterview(ctx context.Context, userID uint64, interview *ent.Interview) (*pb.ScoreInterviewResponse, error) {
+	// Get all questions' AnswerData in interview
+	answers, err := s.repo.Question.GetAnswers(ctx, interview.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve answers: %w", err)
+	}
+
+	// Get submissions from answers, skipped questions have nothing to score
+	policy := skipPolicy()
+	submissionsForDarius, submissionsForKarma, skipped := scoringSubmissions(answers)
+
+	// Content and fluency are scored concurrently
+	dariusReq := &pb.ScoreInterviewRequest{
+		InterviewId: interview.ID,
+		Submissions: submissionsForDarius,
+		Skills:      contentSkills(interview),
+	}
+	karmaReq := &pb.ScoreFluencyRequest{
+		InterviewId: interview.ID,
+		Submissions: submissionsForKarma,
+	}
+
+	dariusResp := &pb.ScoreInterviewResponse{TotalScore: &pb.TotalScore{}}
+	var dariusErr error
+	// A fluency result saved by an earlier attempt is reused
+	karmaResp := interview.FluencyResult
+	var karmaErr error
+
+	var wg sync.WaitGroup
+	if len(submissionsForDarius) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dariusResp, dariusErr = s.callDariusForScore(ctx, userID, dariusReq)
+		}()
+	}
+	if interview.FluencyScoring && karmaResp == nil && len(submissionsForKarma) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			karmaResp, karmaErr = s.callKarmaForScore(ctx, userID, karmaReq)
+		}()
+	}
+	wg.Wait()
+
+	// Whichever scorer succeeded keeps its result even if the other one failed
+	if karmaErr != nil {
+		s.logger.Warn("Failed to score fluency by Karma, completing with content scores only",
+			zap.String("interviewId", interview.ID), zap.Error(karmaErr))
+		karmaResp = nil
+	} else if karmaResp != nil && interview.FluencyResult == nil {
+		if err := s.repo.Interview.SaveFluency(ctx, interview.ID, karmaResp); err != nil {
+			s.logger.Error("Failed to save fluency result", zap.String("interviewId", interview.ID), zap.Error(err))
+		} else {
+			interview.FluencyResult = karmaResp
+		}
+	}
+	if dariusErr != nil {
+		return nil, fmt.Errorf("%w: %w", errContentScoring, dariusErr)
+	}
+
+	// Update the database with scoring results
+	for _, submission := range dariusResp.Result {
+		question, err := s.repo.Question.Get(ctx, interview.ID, submission.Index)
+		if ent.IsNotSingular(err) {
+			s.logger.Error("Multiple questions found for the same index, skipping update",
+				zap.String("interviewId", interview.ID),
+				zap.Int32("questionIndex", submission.Index),
+				zap.Error(err))
+			continue
+		}
+		if err != nil {
+			s.logger.Error("Failed to retrieve question", zap.String("interviewId", interview.ID), zap.Int32("questionIndex", submission.Index), zap.Error(err))
+			continue
+		}
+		question.Comment = submission.Comment
+		question.Score = submission.Score
+		if question.Score == "" {
+			question.Status = pb.QuestionStatus_QUESTION_STATUS_FAILED
+		} else {
+			question.Status = pb.QuestionStatus_QUESTION_STATUS_RATED
+		}
+		// Store question update list
+		err = s.repo.Question.Update(ctx, userID, question)
+		if err != nil {
+			s.logger.Error("Failed to update question with score", zap.String("interviewId", interview.ID), zap.Int32("questionIndex", submission.Index), zap.Error(err))
+			continue
+		}
+		s.rateFollowUps(ctx, userID, question)
+	}
+
+	// Skipped questions keep their status, under the fail policy they are graded F
+	for _, answer := range skipped {
+		if policy != SkipPolicyFail {
+			continue
+		}
+		question, err := s.repo.Question.Get(ctx, interview.ID, answer.Index)
+		if err != nil {
+			s.logger.Error("Failed to retrieve skipped question", zap.String("interviewId", interview.ID), zap.Int32("questionIndex", answer.Index), zap.Error(err))
+			continue
+		}
+		question.Score = "F"
+		question.Comment = "Question skipped"
+		if err := s.repo.Question.Update(ctx, userID, question); err != nil {
+			s.logger.Error("Failed to grade skipped question", zap.String("interviewId", interview.ID), zap.Int32("questionIndex", answer.Index), zap.Error(err))
+		}
+	}
+
+	// Update the interview with feedback and total score
+	skills, skillsScore := mergeSkills(dariusResp.Skills, karmaResp)
+
+	actionableFeedback := dariusResp.ActionableFeedback
+	if feedback := karmaResp.GetActionableFeedback(); feedback != "" {
+		actionableFeedback = strings.TrimSpace(actionableFeedback + " " + feedback)
+	}
+
+	interview.Skills = skills
+	interview.SkillsScore = skillsScore
+	interview.TotalScore = applySkipPolicy(dariusResp.TotalScore, int32(len(skipped)), policy)
+	interview.PositiveFeedback = dariusResp.PositiveFeedback
+	interview.ActionableFeedback = actionableFeedback
+	interview.FinalComment = dariusResp.FinalComment
+	interview.Status = pb.InterviewStatus_INTERVIEW_STATUS_COMPLETED
+	interview.FailureReason = ""
+	interview.OverallScore = getOverallScore(interview.TotalScore, policy)
+
+	if err := s.repo.Interview.Update(ctx, userID, interview); err != nil {
+		return nil, fmt.Errorf("failed to save interview feedback: %w", err)
+	}
+	s.logger.Info("Interview feedback saved successfully", zap.String("interviewId", interview.ID))
+	s.publishEvent(ctx, userID, &pb.InterviewEvent{
+		InterviewId: interview.ID,
+		Type:        pb.InterviewEventType_INTERVIEW_EVENT_SCORING_COMPLETED,
+	})
+
+	merged := make([]*pb.SkillScore, 0, len(skills))
+	for i, skill := range skills {
+		merged = append(merged, &pb.SkillScore{Skill: skill, Score: skillsScore[i]})
+	}
+	return &pb.ScoreInterviewResponse{
+		Result:             dariusResp.Result,
+		TotalScore:         interview.TotalScore,
+		Skills:             merged,
+		PositiveFeedback:   interview.PositiveFeedback,
+		ActionableFeedback: interview.ActionableFeedback,
+		FinalComment:       interview.FinalComment,
+	}, nil
+}
+
+// mergeSkills lists the content skills followed by the fluency skills, the skills and their scores share an index
+func mergeSkills(content []*pb.SkillScore, fluency *pb.ScoreFluencyResponse) ([]string, []string) {
+	totalLength := len(content) + len(fluency.GetSkills())
+	skills := make([]string, 0, totalLength)
+	skillsScore := make([]string, 0, totalLength)
+
+	for _, ele := range content {
+		skills = append(skills, ele.Skill)
+		skillsScore = append(skillsScore, ele.Score)
+	}
+
+	// Map order is random, fluency skills are listed alphabetically after the content skills
+	fluencySkills := make([]string, 0, len(fluency.GetSkills()))
+	for skill := range fluency.GetSkills() {
+		fluencySkills = append(fluencySkills, skill)
+	}
+	sort.Strings(fluencySkills)
+	for _, skill := range fluencySkills {
+		skills = append(skills, skill)
+		skillsScore = append(skillsScore, fluency.Skills[skill])
+	}
+	return skills, skillsScore
+}
+
+// contentSkills returns the skills of an interview scored by Darius, a partial score stores the fluency skills among them
+func contentSkills(interview *ent.Interview) []string {
+	skills := make([]string, 0, len(interview.Skills))
+	for _, skill := range interview.Skills {
+		if _, ok := interview.FluencyResult.GetSkills()[skill]; ok {
+			continue
+		}
+		skills = append(skills, skill)
+	}
+	return skills
+}
+
+// unscoredSkills pairs every skill with an empty score
+func unscoredSkills(skills []string) []*pb.SkillScore {
+	scores := make([]*pb.SkillScore, 0, len(skills))
+	for _, skill := range skills {
+		scores = append(scores, &pb.SkillScore{Skill: skill})
+	}
+	return scores
+}
+
+// scoringSubmissions threads the answers of an interview into the submissions scored for content and fluency,
+// skipped main questions are returned apart
+func scoringSubmissions(answers []*pb.AnswerResult) ([]*pb.AnswerData, []*pb.AnswerData, []*pb.AnswerResult) {
+	submissionsForDarius := make([]*pb.AnswerData, 0, len(answers))
+	submissionsForKarma := make([]*pb.AnswerData, 0, len(answers))
+	var skipped []*pb.AnswerResult
+	// Follow-ups are scored as part of their main question's thread
+	threads := make(map[int32]*pb.AnswerData)
+	for _, answer := range answers {
+		if answer.SubIndex > 0 {
+			thread, ok := threads[answer.Index]
+			if ok && answer.Status == pb.QuestionStatus_QUESTION_STATUS_ANSWERED {
+				thread.Answer += fmt.Sprintf("\n\nFollow-up: %s\nAnswer: %s", answer.Content, answer.Answer)
+			}
+			continue
+		}
+		if answer.Status == pb.QuestionStatus_QUESTION_STATUS_SKIPPED {
+			skipped = append(skipped, answer)
+			continue
+		}
+		thread := &pb.AnswerData{
+			Index:    answer.Index,
+			Question: &answer.Content,
+			Answer:   answer.Answer,
+		}
+		threads[answer.Index] = thread
+		submissionsForDarius = append(submissionsForDarius, thread)
+		if answer.RecordProof != "" {
+			submissionsForKarma = append(submissionsForKarma, &pb.AnswerData{
+				Index:       answer.Index,
+				Answer:      answer.Answer,
+				RecordProof: &answer.RecordProof,
+			})
+		}
+	}
+	return submissionsForDarius, submissionsForKarma, skipped
+}
+
+// rateFollowUps gives the answered follow-ups of a question the grade of their thread
+func (s *Irelia) rateFollowUps(ctx context.Context, userID uint64, question *ent.Question) {
+	thread, err := s.repo.Question.GetThread(ctx, question.InterviewID, question.QuestionIndex)
+	if err != nil {
+		s.logger.Error("Failed to retrieve question thread", zap.String("interviewId", question.InterviewID), zap.Int32("questionIndex", question.QuestionIndex), zap.Error(err))
+		return
+	}
+	for _, followUp := range thread {
+		if followUp.SubIndex == 0 || followUp.Status != pb.QuestionStatus_QUESTION_STATUS_ANSWERED {
+			continue
+		}
+		followUp.Score = question.Score
+		followUp.Status = question.Status
+		if err := s.repo.Question.Update(ctx, userID, followUp); err != nil {
+			s.logger.Error("Failed to update follow-up with score", zap.String("interviewId", question.InterviewID),
+				zap.Int32("questionIndex", followUp.QuestionIndex),
+				zap.Int32("subIndex", followUp.SubIndex),
+				zap.Error(err))
+		}
+	}
+}
+
+// SkipPolicy decides how skipped questions weigh on the interview score
+type SkipPolicy string
+
+const (
+	// SkipPolicyExclude leaves skipped questions out of the score entirely
+	SkipPolicyExclude SkipPolicy = "exclude"
+	// SkipPolicyFail grades every skipped question as F
+	SkipPolicyFail SkipPolicy = "fail"
+	// SkipPolicySeparate reports skipped questions on their own without affecting the grade average
+	SkipPolicySeparate SkipPolicy = "separate"
+)
+
+// skipPolicy returns the configured skip policy, defaulting to exclude
+func skipPolicy() SkipPolicy {
+	switch policy := SkipPolicy(viper.GetString("scoring.skip_policy")); policy {
+	case SkipPolicyFail, SkipPolicySeparate:
+		return policy
+	default:
+		return SkipPolicyExclude
+	}
+}
+
+// applySkipPolicy records the skipped questions on the Darius total score
+func applySkipPolicy(total *pb.TotalScore, skipped int32, policy SkipPolicy) *pb.TotalScore {
+	if total == nil {
+		total = &pb.TotalScore{}
+	}
+	if policy != SkipPolicyExclude {
+		total.Skipped = skipped
+	}
+	return total
+}
@@ -0,0 +1,273 @@
+package features
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "irelia/api"
+	repo "irelia/internal/repo"
+	"irelia/pkg/ent"
+)
+
+// UsageLedger records every upstream AI call in the background, calls are never slowed down by the ledger
+// and entries are dropped when the buffer is full
+type UsageLedger struct {
+	repo      repo.IUsage
+	logger    *zap.Logger
+	entries   chan *ent.Usage
+	batchSize int
+	interval  time.Duration
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+	// Metrics
+	recorded int64
+	dropped  int64
+	failed   int64
+}
+
+// NewUsageLedger configures a ledger from the usage config section
+func NewUsageLedger(usage repo.IUsage, logger *zap.Logger) *UsageLedger {
+	bufferSize := viper.GetInt("usage.buffer_size")
+	if bufferSize <= 0 {
+		bufferSize = 1000
+	}
+	batchSize := viper.GetInt("usage.batch_size")
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	interval := viper.GetInt("usage.flush_interval")
+	if interval <= 0 {
+		interval = 5
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &UsageLedger{
+		repo:      usage,
+		logger:    logger,
+		entries:   make(chan *ent.Usage, bufferSize),
+		batchSize: batchSize,
+		interval:  time.Duration(interval) * time.Second,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+func (l *UsageLedger) Start() {
+	go l.run()
+}
+
+// Stop flushes the buffered entries and returns once they are written
+func (l *UsageLedger) Stop() {
+	l.cancel()
+	<-l.done
+}
+
+// Record queues an upstream call, the payload sizes are those of the request and response messages
+func (l *UsageLedger) Record(userID uint64, interviewID, upstream, operation string, started time.Time, req, resp proto.Message, err error) {
+	if l == nil {
+		return
+	}
+
+	entry := &ent.Usage{
+		UserID:       userID,
+		InterviewID:  interviewID,
+		Upstream:     upstream,
+		Operation:    operation,
+		LatencyMs:    time.Since(started).Milliseconds(),
+		RequestBytes: proto.Size(req),
+		Success:      err == nil,
+		CreatedAt:    started,
+	}
+	if err == nil && resp != nil {
+		entry.ResponseBytes = proto.Size(resp)
+	}
+
+	select {
+	case l.entries <- entry:
+	default:
+		atomic.AddInt64(&l.dropped, 1)
+	}
+}
+
+func (l *UsageLedger) run() {
+	defer close(l.done)
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	batch := make([]*ent.Usage, 0, l.batchSize)
+	for {
+		select {
+		case entry := <-l.entries:
+			batch = append(batch, entry)
+			if len(batch) >= l.batchSize {
+				batch = l.flush(batch)
+			}
+		case <-ticker.C:
+			batch = l.flush(batch)
+		case <-l.ctx.Done():
+			l.drain(batch)
+			l.logger.Info("Usage ledger stopped")
+			return
+		}
+	}
+}
+
+// drain flushes the batch in hand and every entry still buffered
+func (l *UsageLedger) drain(batch []*ent.Usage) {
+	for {
+		select {
+		case entry := <-l.entries:
+			batch = append(batch, entry)
+			if len(batch) >= l.batchSize {
+				batch = l.flush(batch)
+			}
+		default:
+			l.flush(batch)
+			return
+		}
+	}
+}
+
+func (l *UsageLedger) flush(batch []*ent.Usage) []*ent.Usage {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := l.repo.Record(ctx, batch); err != nil {
+		atomic.AddInt64(&l.failed, int64(len(batch)))
+		l.logger.Error("Failed to record usage", zap.Int("entries", len(batch)), zap.Error(err))
+	} else {
+		atomic.AddInt64(&l.recorded, int64(len(batch)))
+	}
+	return batch[:0]
+}
+
+// GetMetrics returns usage ledger metrics
+func (l *UsageLedger) GetMetrics() map[string]interface{} {
+	return map[string]interface{}{
+		"recorded": atomic.LoadInt64(&l.recorded),
+		"dropped":  atomic.LoadInt64(&l.dropped),
+		"failed":   atomic.LoadInt64(&l.failed),
+		"queued":   len(l.entries),
+	}
+}
+
+// MeteredGenerator records the calls of a question generator backed by an upstream
+type MeteredGenerator struct {
+	QuestionGenerator
+	usage *UsageLedger
+}
+
+func NewMeteredGenerator(generator QuestionGenerator, usage *UsageLedger) *MeteredGenerator {
+	return &MeteredGenerator{QuestionGenerator: generator, usage: usage}
+}
+
+func (g *MeteredGenerator) Generate(ctx context.Context, userID uint64, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error) {
+	started := time.Now()
+	resp, err := g.QuestionGenerator.Generate(ctx, userID, req)
+	g.usage.Record(userID, req.InterviewId, g.Name(), "generate", started, req, resp, err)
+	return resp, err
+}
+
+func (g *MeteredGenerator) FollowUp(ctx context.Context, userID uint64, req *pb.FollowUpRequest) (*pb.FollowUpResponse, error) {
+	started := time.Now()
+	resp, err := g.QuestionGenerator.FollowUp(ctx, userID, req)
+	g.usage.Record(userID, req.InterviewId, g.Name(), "follow_up", started, req, resp, err)
+	return resp, err
+}
+
+// startOfDay returns midnight UTC of the current day, daily quotas reset then
+func startOfDay(now time.Time) time.Time {
+	return now.UTC().Truncate(24 * time.Hour)
+}
+
+// dailyQuotas returns the interviews and questions a user may start per day, zero is unlimited
+func dailyQuotas() (int32, int32) {
+	return viper.GetInt32("quota.daily_interviews"), viper.GetInt32("quota.daily_questions")
+}
+
+// reserveQuota takes an interview from the daily quotas of the user, it returns the day the reservation was made on
+func (s *Irelia) reserveQuota(ctx context.Context, userID uint64, totalQuestions int32) (time.Time, error) {
+	interviewLimit, questionLimit := dailyQuotas()
+	day := startOfDay(time.Now())
+
+	reserved, err := s.repo.Quota.Reserve(ctx, userID, day, totalQuestions, interviewLimit, questionLimit)
+	if err != nil {
+		s.logger.Error("Failed to reserve usage quota", zap.Uint64("userId", userID), zap.Error(err))
+		return day, status.Errorf(codes.Internal, "Failed to check usage quota: %v", err)
+	}
+	if reserved {
+		return day, nil
+	}
+
+	// The counts only explain the rejection, the reservation already decided it
+	interviews, questions, err := s.repo.Quota.Get(ctx, userID, day)
+	switch {
+	case err != nil:
+		return day, status.Errorf(codes.ResourceExhausted, "Daily usage quota reached")
+	case interviewLimit > 0 && interviews >= interviewLimit:
+		return day, status.Errorf(codes.ResourceExhausted, "Daily interview quota of %d reached", interviewLimit)
+	default:
+		return day, status.Errorf(codes.ResourceExhausted, "Daily question quota of %d reached, %d questions left today",
+			questionLimit, max(questionLimit-questions, 0))
+	}
+}
+
+// releaseQuota gives back the reservation of an interview that could not be created
+func (s *Irelia) releaseQuota(userID uint64, day time.Time, totalQuestions int32) {
+	if err := s.repo.Quota.Release(context.Background(), userID, day, totalQuestions); err != nil {
+		s.logger.Error("Failed to release usage quota", zap.Uint64("userId", userID), zap.Error(err))
+	}
+}
+
+// GetUsage returns the quotas of a user for the day and the upstream calls made on their behalf
+func (s *Irelia) GetUsage(ctx context.Context, req *pb.GetUsageRequest) (*pb.GetUsageResponse, error) {
+	principal, err := s.getPrincipal(ctx)
+	if err != nil {
+		s.logger.Error("Failed to extract user ID from context", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract user ID from context: %v", err)
+	}
+
+	userID := principal.UserID
+	if req.UserId != 0 && req.UserId != principal.UserID {
+		if principal.Role != pb.BulbasaurRole_ROLE_ADMIN {
+			return nil, status.Errorf(codes.PermissionDenied, "Only admins may read the usage of another user")
+		}
+		userID = req.UserId
+	}
+	days := req.Days
+	if days <= 0 {
+		days = 1
+	}
+
+	today := startOfDay(time.Now())
+	interviews, questions, err := s.repo.Quota.Get(ctx, userID, today)
+	if err != nil {
+		s.logger.Error("Failed to read usage quota", zap.Uint64("userId", userID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to read usage quota: %v", err)
+	}
+	operations, err := s.repo.Usage.Summarize(ctx, userID, today.AddDate(0, 0, -int(days-1)))
+	if err != nil {
+		s.logger.Error("Failed to summarize usage", zap.Uint64("userId", userID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to summarize usage: %v", err)
+	}
+
+	interviewLimit, questionLimit := dailyQuotas()
+	return &pb.GetUsageResponse{
+		UserId:     userID,
+		Interviews: &pb.UsageQuota{Used: interviews, Limit: interviewLimit},
+		Questions:  &pb.UsageQuota{Used: questions, Limit: questionLimit},
+		Operations: operations,
+	}, nil
+}
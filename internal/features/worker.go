@@ -2,11 +2,18 @@ package features
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
+
 	"go.uber.org/zap"
 
+	pb "irelia/api"
+	repo "irelia/internal/repo"
 	"irelia/pkg/ent"
 )
 
@@ -19,98 +26,196 @@ type QuestionPreparationJob struct {
 	EnqueuedAt     time.Time
 }
 
-type QuestionWorkerPool struct {
-	jobQueue          chan QuestionPreparationJob
-	workerCount       int
-	maxTasksPerWorker int
-	maxIdleTime       time.Duration
-	maxTaskWaitTime   time.Duration
-	ctx               context.Context
-	cancel            context.CancelFunc
-	wg                sync.WaitGroup
+// JobHandler runs a claimed job, a returned error schedules a retry
+type JobHandler func(ctx context.Context, job *ent.Job) error
+
+// WorkerPool runs durable jobs persisted through repo.IJob
+type WorkerPool struct {
+	name           string
+	jobs           repo.IJob
+	kinds          []pb.JobKind
+	handler        JobHandler
+	wake           chan struct{}
+	workerCount    int
+	maxIdleTime    time.Duration
+	pollInterval   time.Duration
+	lease          time.Duration
+	maxAttempts    int32
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+	ctx            context.Context
+	cancel         context.CancelFunc
+	wg             sync.WaitGroup
+	mu             sync.Mutex // guards started, nextWorkerID and spawning workers
+	started        bool
+	nextWorkerID   int
 	// Metrics
 	totalJobsEnqueued  int64
 	totalJobsProcessed int64
-	totalJobsDropped   int64
+	totalJobsRetried   int64
+	totalJobsDead      int64
 	activeWorkers      int64
 }
 
-func NewQuestionWorkerPool(size, maxTasksPerWorker, maxIdleTime, maxTaskWaitTime int) *QuestionWorkerPool {
+// RetryPolicy controls how many times a job is attempted and how long to wait between attempts
+type RetryPolicy struct {
+	MaxAttempts    int
+	RetryBaseDelay int
+	RetryMaxDelay  int
+}
+
+func NewWorkerPool(name string, jobs repo.IJob, kinds []pb.JobKind, handler JobHandler, size, maxIdleTime, pollInterval, lease int, retry RetryPolicy) *WorkerPool {
 	ctx, cancel := context.WithCancel(context.Background())
-	pool := &QuestionWorkerPool{
-		jobQueue:          make(chan QuestionPreparationJob, size*maxTasksPerWorker),
-		workerCount:       size,
-		maxTasksPerWorker: maxTasksPerWorker,
-		maxIdleTime:       time.Duration(maxIdleTime) * time.Second,
-		maxTaskWaitTime:   time.Duration(maxTaskWaitTime) * time.Second,
-		ctx:               ctx,
-		cancel:            cancel,
+	if pollInterval <= 0 {
+		pollInterval = 5
+	}
+	if lease <= 0 {
+		lease = 300
+	}
+	if retry.MaxAttempts <= 0 {
+		retry.MaxAttempts = 5
+	}
+	if retry.RetryBaseDelay <= 0 {
+		retry.RetryBaseDelay = 2
+	}
+	if retry.RetryMaxDelay <= 0 {
+		retry.RetryMaxDelay = 60
+	}
+	pool := &WorkerPool{
+		name:           name,
+		jobs:           jobs,
+		kinds:          kinds,
+		handler:        handler,
+		wake:           make(chan struct{}, size),
+		workerCount:    size,
+		maxIdleTime:    time.Duration(maxIdleTime) * time.Second,
+		pollInterval:   time.Duration(pollInterval) * time.Second,
+		lease:          time.Duration(lease) * time.Second,
+		maxAttempts:    int32(retry.MaxAttempts),
+		retryBaseDelay: time.Duration(retry.RetryBaseDelay) * time.Second,
+		retryMaxDelay:  time.Duration(retry.RetryMaxDelay) * time.Second,
+		ctx:            ctx,
+		cancel:         cancel,
 	}
 
 	return pool
 }
 
-func (wp *QuestionWorkerPool) Start(service *Irelia) {
-	service.logger.Info("Starting question worker pool",
-		zap.Int("workerCount", wp.workerCount),
-		zap.Int("queueCapacity", cap(wp.jobQueue)),
-		zap.Duration("maxIdleTime", wp.maxIdleTime))
+// Start launches the reclaimer and the workers, the reclaimer runs once per pool however often Start is called
+func (wp *WorkerPool) Start(logger *zap.Logger) {
+	wp.mu.Lock()
+	first := !wp.started
+	wp.started = true
+	wp.mu.Unlock()
+
+	if first {
+		logger.Info("Starting worker pool",
+			zap.String("pool", wp.name),
+			zap.Int("workerCount", wp.workerCount),
+			zap.Duration("pollInterval", wp.pollInterval),
+			zap.Duration("maxIdleTime", wp.maxIdleTime))
 
-	for i := 0; i < wp.workerCount; i++ {
+		wp.reclaim(logger)
 		wp.wg.Add(1)
-		go wp.worker(service, i)
+		go wp.reclaimer(logger)
 	}
+	wp.Ensure(logger)
 }
 
-func (wp *QuestionWorkerPool) Stop() {
+// Ensure tops the pool up to its worker count. Workers exit after being idle for maxIdleTime, so they are started
+// again whenever work shows up
+func (wp *WorkerPool) Ensure(logger *zap.Logger) {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	if wp.ctx.Err() != nil {
+		return
+	}
+
+	spawned := 0
+	for atomic.LoadInt64(&wp.activeWorkers) < int64(wp.workerCount) {
+		atomic.AddInt64(&wp.activeWorkers, 1)
+		wp.wg.Add(1)
+		go wp.worker(logger, wp.nextWorkerID)
+		wp.nextWorkerID++
+		spawned++
+	}
+	if spawned > 0 {
+		logger.Info("Started workers", zap.String("pool", wp.name), zap.Int("count", spawned))
+	}
+}
+
+// reclaimer keeps returning jobs whose lease expired to the queue while the pool runs, a replica that
+// stays up would otherwise never pick up the jobs of a worker that died or hung
+func (wp *WorkerPool) reclaimer(logger *zap.Logger) {
+	defer wp.wg.Done()
+	ticker := time.NewTicker(wp.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			wp.reclaim(logger)
+		case <-wp.ctx.Done():
+			return
+		}
+	}
+}
+
+// reclaim returns running jobs whose lease expired, e.g. after a crash or restart, to the pending state
+func (wp *WorkerPool) reclaim(logger *zap.Logger) {
+	reclaimed, err := wp.jobs.Reclaim(wp.ctx)
+	if err != nil {
+		if wp.ctx.Err() == nil {
+			logger.Error("Failed to reclaim unfinished jobs", zap.String("pool", wp.name), zap.Error(err))
+		}
+		return
+	}
+	if reclaimed == 0 {
+		return
+	}
+	logger.Info("Reclaimed unfinished jobs", zap.String("pool", wp.name), zap.Int("count", reclaimed))
+	// Every worker may have exited while idle, nothing else would claim the reclaimed jobs until the next enqueue
+	wp.Ensure(logger)
+	for i := 0; i < reclaimed && i < wp.workerCount; i++ {
+		select {
+		case wp.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (wp *WorkerPool) Stop() {
+	wp.mu.Lock()
 	wp.cancel()
-	close(wp.jobQueue)
+	wp.mu.Unlock()
 	wp.wg.Wait()
 }
 
-func (wp *QuestionWorkerPool) worker(service *Irelia, workerID int) {
+func (wp *WorkerPool) worker(logger *zap.Logger, workerID int) {
 	defer wp.wg.Done()
-	atomic.AddInt64(&wp.activeWorkers, 1)
+	// Counted by Ensure when the worker is spawned
 	defer atomic.AddInt64(&wp.activeWorkers, -1)
 
+	hostname, _ := os.Hostname()
+	owner := fmt.Sprintf("%s:%d:%s:%d", hostname, os.Getpid(), wp.name, workerID)
+
 	idleTimer := time.NewTimer(wp.maxIdleTime)
 	defer idleTimer.Stop()
+	poll := time.NewTicker(wp.pollInterval)
+	defer poll.Stop()
 
 	jobsProcessed := 0
 
 	for {
-		select {
-		case job, ok := <-wp.jobQueue:
-			if !ok {
-				service.logger.Info("Worker stopping - job queue closed",
-					zap.Int("workerID", workerID),
-					zap.Int("jobsProcessed", jobsProcessed))
-				return
-			}
-
-			// Calculate wait time in queue
-			waitTime := time.Since(job.EnqueuedAt)
-			service.logger.Debug("Worker processing job",
-				zap.Int("workerID", workerID),
-				zap.String("interviewID", job.InterviewID),
-				zap.Int32("questionID", job.NextQuestionID),
-				zap.Duration("waitTime", waitTime))
-
-			// Process the job
-			startTime := time.Now()
-			service.prepareQuestionSafe(job)
-			processingTime := time.Since(startTime)
+		job, err := wp.jobs.Claim(wp.ctx, wp.kinds, owner, wp.lease)
+		if err != nil && wp.ctx.Err() == nil {
+			logger.Error("Failed to claim job", zap.String("pool", wp.name), zap.Int("workerID", workerID), zap.Error(err))
+		}
 
-			atomic.AddInt64(&wp.totalJobsProcessed, 1)
+		if job != nil {
+			wp.process(logger, workerID, owner, job)
 			jobsProcessed++
 
-			service.logger.Debug("Worker completed job",
-				zap.Int("workerID", workerID),
-				zap.String("interviewID", job.InterviewID),
-				zap.Int32("questionID", job.NextQuestionID),
-				zap.Duration("processingTime", processingTime),
-				zap.Duration("totalTime", time.Since(job.EnqueuedAt)))
-
 			// Reset idle timer
 			if !idleTimer.Stop() {
 				select {
@@ -119,64 +224,154 @@ func (wp *QuestionWorkerPool) worker(service *Irelia, workerID int) {
 				}
 			}
 			idleTimer.Reset(wp.maxIdleTime)
+			continue
+		}
 
+		select {
+		case <-wp.wake:
+		case <-poll.C:
 		case <-idleTimer.C:
-			service.logger.Info("Worker idle timeout, exiting", zap.Int("workerID", workerID),
+			logger.Info("Worker idle timeout, exiting", zap.String("pool", wp.name), zap.Int("workerID", workerID),
 				zap.Int("jobsProcessed", jobsProcessed))
 			return
-
 		case <-wp.ctx.Done():
-			service.logger.Info("Worker stopping - context cancelled", zap.Int("workerID", workerID),
+			logger.Info("Worker stopping - context cancelled", zap.String("pool", wp.name), zap.Int("workerID", workerID),
 				zap.Int("jobsProcessed", jobsProcessed))
 			return
 		}
 	}
 }
 
-func (wp *QuestionWorkerPool) EnqueueJob(logger *zap.Logger, job QuestionPreparationJob) bool {
-	job.EnqueuedAt = time.Now()
-	logger.Info("Enqueuing question preparation job", zap.String("interviewID", job.InterviewID),
-		zap.Int32("nextQuestionID", job.NextQuestionID))
+func (wp *WorkerPool) process(logger *zap.Logger, workerID int, owner string, job *ent.Job) {
+	logger.Debug("Worker processing job",
+		zap.String("pool", wp.name),
+		zap.Int("workerID", workerID),
+		zap.Int("jobID", job.ID),
+		zap.String("interviewID", job.InterviewID),
+		zap.Int32("questionID", job.QuestionIndex),
+		zap.Int32("attempt", job.Attempts),
+		zap.Duration("waitTime", time.Since(job.RunAt)))
 
-	select {
-	case wp.jobQueue <- job:
-		atomic.AddInt64(&wp.totalJobsEnqueued, 1)
-		logger.Debug("Successfully enqueued question preparation job", zap.String("interviewID", job.InterviewID),
-			zap.Int32("nextQuestionID", job.NextQuestionID),
-			zap.Int("queueSize", len(wp.jobQueue)),
-			zap.Int("queueCapacity", cap(wp.jobQueue)))
-		return true
+	ctx, cancel := context.WithTimeout(wp.ctx, wp.lease)
+	defer cancel()
 
-	case <-time.After(wp.maxTaskWaitTime):
-		atomic.AddInt64(&wp.totalJobsDropped, 1)
-		logger.Error("Job enqueue timeout - queue may be full or workers unavailable", zap.String("interviewID", job.InterviewID),
-			zap.Int32("nextQuestionID", job.NextQuestionID),
-			zap.Duration("timeout", wp.maxTaskWaitTime),
-			zap.Int("queueSize", len(wp.jobQueue)),
-			zap.Int("queueCapacity", cap(wp.jobQueue)),
-			zap.Int64("activeWorkers", atomic.LoadInt64(&wp.activeWorkers)))
+	startTime := time.Now()
+	err := wp.handler(ctx, job)
+	atomic.AddInt64(&wp.totalJobsProcessed, 1)
+
+	// Bookkeeping must survive the handler's deadline
+	bgCtx := context.Background()
+	if err == nil {
+		if err := wp.jobs.Complete(bgCtx, job.ID, owner); err != nil {
+			wp.settleFailed(logger, job, "Failed to mark job as completed", err)
+		}
+		logger.Debug("Worker completed job",
+			zap.String("pool", wp.name),
+			zap.Int("workerID", workerID),
+			zap.Int("jobID", job.ID),
+			zap.Duration("processingTime", time.Since(startTime)))
+		return
+	}
+
+	// A job cut off by Stop did not fail, it runs again right away on whichever replica claims it
+	if wp.ctx.Err() != nil {
+		logger.Info("Job interrupted by shutdown, queueing it again", zap.String("pool", wp.name), zap.Int("jobID", job.ID))
+		if retryErr := wp.jobs.Retry(bgCtx, job.ID, owner, time.Now(), err.Error()); retryErr != nil {
+			wp.settleFailed(logger, job, "Failed to queue interrupted job", retryErr)
+		}
+		return
+	}
+
+	if job.Attempts >= job.MaxAttempts {
+		atomic.AddInt64(&wp.totalJobsDead, 1)
+		logger.Error("Job exhausted its attempts, moving to dead-letter",
+			zap.String("pool", wp.name),
+			zap.Int("jobID", job.ID),
+			zap.String("interviewID", job.InterviewID),
+			zap.Int32("questionID", job.QuestionIndex),
+			zap.Int32("attempts", job.Attempts),
+			zap.Error(err))
+		if buryErr := wp.jobs.Bury(bgCtx, job.ID, owner, err.Error()); buryErr != nil {
+			wp.settleFailed(logger, job, "Failed to bury job", buryErr)
+		}
+		return
+	}
+
+	delay := wp.backoff(job.Attempts)
+	atomic.AddInt64(&wp.totalJobsRetried, 1)
+	logger.Warn("Job failed, scheduling retry",
+		zap.String("pool", wp.name),
+		zap.Int("jobID", job.ID),
+		zap.String("interviewID", job.InterviewID),
+		zap.Int32("questionID", job.QuestionIndex),
+		zap.Int32("attempt", job.Attempts),
+		zap.Duration("retryIn", delay),
+		zap.Error(err))
+	if retryErr := wp.jobs.Retry(bgCtx, job.ID, owner, time.Now().Add(delay), err.Error()); retryErr != nil {
+		wp.settleFailed(logger, job, "Failed to schedule job retry", retryErr)
+	}
+}
+
+// settleFailed logs a job whose outcome could not be recorded, a lost lease means the job was reclaimed
+// and its new owner records the outcome
+func (wp *WorkerPool) settleFailed(logger *zap.Logger, job *ent.Job, msg string, err error) {
+	if errors.Is(err, repo.ErrLeaseLost) {
+		logger.Warn("Job lease lost before its outcome was recorded, leaving it to its new owner",
+			zap.String("pool", wp.name), zap.Int("jobID", job.ID), zap.String("interviewID", job.InterviewID))
+		return
+	}
+	logger.Error(msg, zap.Int("jobID", job.ID), zap.Error(err))
+}
+
+// backoff returns the exponential delay before the next attempt
+func (wp *WorkerPool) backoff(attempts int32) time.Duration {
+	delay := time.Duration(float64(wp.retryBaseDelay) * math.Pow(2, float64(attempts-1)))
+	if delay <= 0 || delay > wp.retryMaxDelay {
+		return wp.retryMaxDelay
+	}
+	return delay
+}
+
+// Enqueue persists a job and wakes an idle worker, it reports whether the job was scheduled
+func (wp *WorkerPool) Enqueue(ctx context.Context, logger *zap.Logger, job *ent.Job) bool {
+	logger.Info("Enqueuing job", zap.String("pool", wp.name),
+		zap.String("kind", job.Kind.String()),
+		zap.String("interviewID", job.InterviewID),
+		zap.Int32("questionID", job.QuestionIndex))
+
+	if job.MaxAttempts == 0 {
+		job.MaxAttempts = wp.maxAttempts
+	}
+	scheduled, err := wp.jobs.Enqueue(ctx, job)
+	if err != nil {
+		logger.Error("Failed to persist job", zap.String("pool", wp.name),
+			zap.String("interviewID", job.InterviewID),
+			zap.Int32("questionID", job.QuestionIndex),
+			zap.Error(err))
 		return false
+	}
+	if !scheduled {
+		logger.Debug("Job already scheduled", zap.String("pool", wp.name),
+			zap.String("interviewID", job.InterviewID),
+			zap.Int32("questionID", job.QuestionIndex))
+		return true
+	}
 
+	atomic.AddInt64(&wp.totalJobsEnqueued, 1)
+	select {
+	case wp.wake <- struct{}{}:
 	default:
-		atomic.AddInt64(&wp.totalJobsDropped, 1)
-		logger.Warn("Job queue is full, dropping job", zap.String("interviewID", job.InterviewID),
-			zap.Int32("nextQuestionID", job.NextQuestionID),
-			zap.Int("queueSize", len(wp.jobQueue)),
-			zap.Int("queueCapacity", cap(wp.jobQueue)),
-			zap.Int64("activeWorkers", atomic.LoadInt64(&wp.activeWorkers)))
-		return false
 	}
+	return true
 }
 
 // GetMetrics returns worker pool metrics
-func (wp *QuestionWorkerPool) GetMetrics() map[string]interface{} {
+func (wp *WorkerPool) GetMetrics() map[string]interface{} {
 	return map[string]interface{}{
 		"total_jobs_enqueued":  atomic.LoadInt64(&wp.totalJobsEnqueued),
 		"total_jobs_processed": atomic.LoadInt64(&wp.totalJobsProcessed),
-		"total_jobs_dropped":   atomic.LoadInt64(&wp.totalJobsDropped),
+		"total_jobs_retried":   atomic.LoadInt64(&wp.totalJobsRetried),
+		"total_jobs_dead":      atomic.LoadInt64(&wp.totalJobsDead),
 		"active_workers":       atomic.LoadInt64(&wp.activeWorkers),
-		"queue_size":           len(wp.jobQueue),
-		"queue_capacity":       cap(wp.jobQueue),
 	}
 }
-
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+    "time"
     "github.com/spf13/viper"
     "entgo.io/ent/dialect/sql"
     "entgo.io/ent/dialect/sql/sqljson"
@@ -11,6 +12,9 @@ import (
     "irelia/pkg/ent/predicate"
 	einterview "irelia/pkg/ent/interview"
 	efavorite "irelia/pkg/ent/interviewfavorite"
+    ejob "irelia/pkg/ent/job"
+    equestion "irelia/pkg/ent/question"
+    "irelia/internal/utils/tx"
 )
 
 type IInterview interface {
@@ -18,10 +22,15 @@ type IInterview interface {
     Update(ctx context.Context, ownerId uint64, interview *ent.Interview) error
     Delete(ctx context.Context, ownerId uint64, interviewID string) error
     Get(ctx context.Context, id string) (*ent.Interview, error)
+    GetScoped(ctx context.Context, id string, userId *uint64) (*ent.Interview, error)
     GetContext(ctx context.Context, interviewID string) (*pb.StartInterviewRequest, error)
-    List(ctx context.Context, req *pb.GetInterviewHistoryRequest, userId *uint64) ([]*ent.Interview, int32, int32, int32, error)
+    List(ctx context.Context, req *pb.GetInterviewHistoryRequest, userId uint64, scope *uint64) ([]*ent.Interview, *Page, error)
     Exists(ctx context.Context, interviewID string) (bool, error)
     Favorite(ctx context.Context, ownerId uint64, interviewID string) error
+    Touch(ctx context.Context, interviewID string) error
+    ExpireStale(ctx context.Context, idleSince time.Time) (int, error)
+    SaveFluency(ctx context.Context, interviewID string, result *pb.ScoreFluencyResponse) error
+    Purge(ctx context.Context, deletedBefore time.Time, limit int) (int, []string, error)
 }
 
 type EntInterview struct {
@@ -45,6 +54,9 @@ func (r *EntInterview) Create(ctx context.Context, ownerId uint64, interview *en
         SetSpeed(interview.Speed).
         SetSkills(interview.Skills).
         SetSkipCode(interview.SkipCode).
+        SetQuestionTimeLimit(interview.QuestionTimeLimit).
+        SetFluencyScoring(interview.FluencyScoring).
+        SetTextOnly(interview.TextOnly).
         SetTotalQuestions(interview.TotalQuestions).
         SetRemainingQuestions(interview.RemainingQuestions).
         SetTotalScore(interview.TotalScore).
@@ -77,51 +89,79 @@ func (r *EntInterview) Update(ctx context.Context, ownerId uint64, interview *en
         SetActionableFeedback(interview.ActionableFeedback).
         SetFinalComment(interview.FinalComment).
         SetStatus(interview.Status).
+        SetFailureReason(interview.FailureReason).
         Save(ctx)
     return err
 }
 
+// Delete soft-deletes an interview of its owner, it stays hidden until the purger removes it
 func (r *EntInterview) Delete(ctx context.Context, ownerId uint64, interviewID string) error {
-    _, err := r.client.Interview.
-        Delete().
+    interview, err := r.client.Interview.
+        Query().
         Where(
             einterview.ID(interviewID),
             einterview.UserID(ownerId),
+            einterview.DeletedAtIsNil(),
         ).
-        Exec(ctx)
+        Only(ctx)
     if err != nil {
         return err
     }
-    return nil
+    return interview.Update().
+        SetDeletedAt(time.Now()).
+        Exec(ctx)
 }
 
 // Get retrieves an interview by ID
 func (r *EntInterview) Get(ctx context.Context, id string) (*ent.Interview, error) {
     return r.client.Interview.
         Query().
-        Where(einterview.ID(id)).
+        Where(
+            einterview.ID(id),
+            einterview.DeletedAtIsNil(),
+        ).
         Only(ctx)
 }
 
+// GetScoped retrieves an interview by ID, restricted to its owner unless userId is nil
+func (r *EntInterview) GetScoped(ctx context.Context, id string, userId *uint64) (*ent.Interview, error) {
+    query := r.client.Interview.
+        Query().
+        Where(
+            einterview.ID(id),
+            einterview.DeletedAtIsNil(),
+        )
+    if userId != nil {
+        query = query.Where(einterview.UserID(*userId))
+    }
+    return query.Only(ctx)
+}
+
 // GetContext retrieves the context of an interview by its ID
 func (r *EntInterview) GetContext(ctx context.Context, interviewID string) (*pb.StartInterviewRequest, error) {
     entInterview, err := r.client.Interview.
         Query().
-        Where(einterview.ID(interviewID)).
+        Where(
+            einterview.ID(interviewID),
+            einterview.DeletedAtIsNil(),
+        ).
         Only(ctx)
     if err != nil {
         return nil, err
     }
 
     return &pb.StartInterviewRequest{
-        Position:       entInterview.Position,
-        Experience:     entInterview.Experience,
-        Language:       entInterview.Language,
-        Skills:         entInterview.Skills,
-        TotalQuestions: entInterview.TotalQuestions,
-        Models:         entInterview.VoiceID,
-        Speed:          entInterview.Speed,
-        SkipCode:       entInterview.SkipCode,
+        Position:          entInterview.Position,
+        Experience:        entInterview.Experience,
+        Language:          entInterview.Language,
+        Skills:            entInterview.Skills,
+        TotalQuestions:    entInterview.TotalQuestions,
+        Models:            entInterview.VoiceID,
+        Speed:             entInterview.Speed,
+        SkipCode:          entInterview.SkipCode,
+        QuestionTimeLimit: &entInterview.QuestionTimeLimit,
+        FluencyScoring:    &entInterview.FluencyScoring,
+        TextOnly:          entInterview.TextOnly,
     }, nil
 }
 
@@ -131,15 +171,29 @@ func SkillsContains(skill string) predicate.Interview {
     })
 }
 
-// List retrieves a list of completed interviews with search, paging, and ordering
-func (r *EntInterview) List(ctx context.Context, req *pb.GetInterviewHistoryRequest, userId *uint64) ([]*ent.Interview, int32, int32, int32, error) {
-    if req.Page == 0 {
+// List retrieves a page of completed interviews with search and ordering. A page token continues right after
+// the last interview of the previous page, so interviews scored in the meantime do not shift the pages
+func (r *EntInterview) List(ctx context.Context, req *pb.GetInterviewHistoryRequest, userId uint64, scope *uint64) ([]*ent.Interview, *Page, error) {
+    var position *cursor
+    if req.PageToken != "" {
+        var err error
+        if position, err = decodeCursor(req.PageToken, int32(req.Sort)); err != nil {
+            return nil, nil, err
+        }
+    } else if req.Page == 0 {
         req.Page = 1
     }
 
-    size := viper.GetInt("page_size")
+    defaultSize := viper.GetInt("page_size")
+    if defaultSize <= 0 {
+        defaultSize = 10
+    }
+    size := pageSize(req.PageSize, defaultSize)
     
-    query := r.client.Interview.Query().Where(einterview.StatusEQ(pb.InterviewStatus_INTERVIEW_STATUS_COMPLETED))
+    query := r.client.Interview.Query().Where(
+        einterview.StatusEQ(pb.InterviewStatus_INTERVIEW_STATUS_COMPLETED),
+        einterview.DeletedAtIsNil(),
+    )
 
     if req.Query != nil {
         query = query.Where(einterview.Or(
@@ -149,10 +203,12 @@ func (r *EntInterview) List(ctx context.Context, req *pb.GetInterviewHistoryRequ
         ))
     }
 
-    if userId != nil {
-        if req.Fvr != nil && *req.Fvr {
-			query = query.Where(einterview.HasFavoritesWith(efavorite.UserID(*userId)))
-		}
+    // A scoped caller only lists their own interviews, favorites are the caller's own on top of that
+    if scope != nil {
+        query = query.Where(einterview.UserID(*scope))
+    }
+    if req.Fvr != nil && *req.Fvr {
+        query = query.Where(einterview.HasFavoritesWith(efavorite.UserID(userId)))
     }
     if req.En != nil {
         if *req.En {
@@ -162,49 +218,89 @@ func (r *EntInterview) List(ctx context.Context, req *pb.GetInterviewHistoryRequ
         }
     }
 
-    switch req.Sort {
-    case pb.InterviewSortMethod_RECENTLY_RATED:
-        query = query.Order(ent.Desc(einterview.FieldUpdatedAt))
-    case pb.InterviewSortMethod_LEAST_RECENTLY_RATED:
-        query = query.Order(ent.Asc(einterview.FieldUpdatedAt))
-    case pb.InterviewSortMethod_MOST_TOTAL_QUESTIONS:
-        query = query.Order(ent.Desc(einterview.FieldTotalQuestions))
-    case pb.InterviewSortMethod_FEWEST_TOTAL_QUESTIONS:
-        query = query.Order(ent.Asc(einterview.FieldTotalQuestions))
-    case pb.InterviewSortMethod_MAX_SCORE:
-        query = query.Order(ent.Desc(einterview.FieldOverallScore))
-    case pb.InterviewSortMethod_MIN_SCORE:
-        query = query.Order(ent.Asc(einterview.FieldOverallScore))
-    default:
-        query = query.Order(ent.Desc(einterview.FieldUpdatedAt))
+    counted := includeTotal(req.IncludeTotal, req.PageToken)
+    totalCount := 0
+    if counted {
+        var err error
+        if totalCount, err = query.Clone().Count(ctx); err != nil {
+            return nil, nil, err
+        }
     }
 
-    totalCount, _ := query.Count(ctx)
-    if totalCount == 0 {
-        return nil, 0, 0, 0, nil
+    // The ID breaks ties so that every interview has a single place in the order
+    column, desc := interviewSortColumn(req.Sort)
+    order := ent.Asc
+    if desc {
+        order = ent.Desc
     }
-    totalPage := int32((totalCount-1)/size + 1)
+    query = query.Order(order(column), order(einterview.FieldID))
 
+    if position != nil {
+        var value any = position.Value
+        if column == einterview.FieldUpdatedAt {
+            value = position.Time
+        }
+        query = query.Where(predicate.Interview(after(column, value, position.ID, desc)))
+    } else {
+        query = query.Offset(int(req.Page-1) * size)
+    }
+
+    // One more interview than the page holds tells whether there is a next page
     interviews, err := query.
-        Offset(int(req.Page-1) * size).
-        Limit(size).
+        Limit(size + 1).
         Select(
             einterview.FieldID,
             einterview.FieldPosition,
             einterview.FieldExperience,
             einterview.FieldTotalScore,
+            einterview.FieldTotalQuestions,
+            einterview.FieldOverallScore,
             einterview.FieldCreatedAt,
             einterview.FieldUpdatedAt,
         ).
         All(ctx)
     if err != nil {
-        return nil, 0, 0, 0, err
+        return nil, nil, err
     }
 
-    return interviews, int32(totalCount), int32(size), totalPage, nil
+    page := newPage(size, totalCount, counted)
+    if len(interviews) > size {
+        interviews = interviews[:size]
+        last := interviews[size-1]
+        next := &cursor{Sort: int32(req.Sort), ID: last.ID}
+        switch column {
+        case einterview.FieldUpdatedAt:
+            next.Time = last.UpdatedAt
+        case einterview.FieldTotalQuestions:
+            next.Value = float64(last.TotalQuestions)
+        case einterview.FieldOverallScore:
+            next.Value = last.OverallScore
+        }
+        page.NextPageToken = next.encode()
+    }
+
+    return interviews, page, nil
+}
+
+// interviewSortColumn returns the column an interview listing is ordered by and whether it is descending
+func interviewSortColumn(sort pb.InterviewSortMethod) (string, bool) {
+    switch sort {
+    case pb.InterviewSortMethod_LEAST_RECENTLY_RATED:
+        return einterview.FieldUpdatedAt, false
+    case pb.InterviewSortMethod_MOST_TOTAL_QUESTIONS:
+        return einterview.FieldTotalQuestions, true
+    case pb.InterviewSortMethod_FEWEST_TOTAL_QUESTIONS:
+        return einterview.FieldTotalQuestions, false
+    case pb.InterviewSortMethod_MAX_SCORE:
+        return einterview.FieldOverallScore, true
+    case pb.InterviewSortMethod_MIN_SCORE:
+        return einterview.FieldOverallScore, false
+    default:
+        return einterview.FieldUpdatedAt, true
+    }
 }
 
-// Exists checks if an interview exists in the database
+// Exists checks if an interview exists in the database, soft-deleted ones included since their ID is still taken
 func (r *EntInterview) Exists(ctx context.Context, interviewID string) (bool, error) {
     count, err := r.client.Interview.
         Query().
@@ -246,4 +342,104 @@ func (r *EntInterview) Favorite(ctx context.Context, ownerId uint64, interviewID
         SetInterviewID(interviewID).
         Save(ctx)
     return err
-}
\ No newline at end of file
+}
+
+// Touch refreshes the activity timestamp of an interview
+func (r *EntInterview) Touch(ctx context.Context, interviewID string) error {
+    return r.client.Interview.
+        UpdateOneID(interviewID).
+        SetUpdatedAt(time.Now()).
+        Exec(ctx)
+}
+
+// expiredReason is the failure reason of an interview the reaper expired
+const expiredReason = "expired after inactivity"
+
+// ExpireStale marks in-progress interviews without activity since idleSince as failed
+func (r *EntInterview) ExpireStale(ctx context.Context, idleSince time.Time) (int, error) {
+    return r.client.Interview.
+        Update().
+        Where(
+            einterview.StatusEQ(pb.InterviewStatus_INTERVIEW_STATUS_IN_PROGRESS),
+            einterview.UpdatedAtLT(idleSince),
+            einterview.DeletedAtIsNil(),
+        ).
+        SetStatus(pb.InterviewStatus_INTERVIEW_STATUS_FAILED).
+        SetFailureReason(expiredReason).
+        Save(ctx)
+}
+
+// SaveFluency stores the Karma fluency result so a retried scoring run does not score the recordings again
+func (r *EntInterview) SaveFluency(ctx context.Context, interviewID string, result *pb.ScoreFluencyResponse) error {
+    return r.client.Interview.
+        UpdateOneID(interviewID).
+        SetFluencyResult(result).
+        Exec(ctx)
+}
+
+// countStarted returns how many interviews a user has started since a time and how many questions they planned in total,
+// deleted interviews still count so deleting one does not free quota
+func countStarted(ctx context.Context, client *ent.Client, ownerId uint64, since time.Time) (int32, int32, error) {
+    totals, err := client.Interview.
+        Query().
+        Where(
+            einterview.UserID(ownerId),
+            einterview.CreatedAtGTE(since),
+        ).
+        Select(einterview.FieldTotalQuestions).
+        Ints(ctx)
+    if err != nil {
+        return 0, 0, err
+    }
+
+    var questions int32
+    for _, total := range totals {
+        questions += int32(total)
+    }
+    return int32(len(totals)), questions, nil
+}
+
+// Purge hard-deletes interviews soft-deleted before a time together with their questions, favorites and jobs.
+// It returns how many interviews were removed and the audio keys their questions referenced
+func (r *EntInterview) Purge(ctx context.Context, deletedBefore time.Time, limit int) (int, []string, error) {
+    ids, err := r.client.Interview.
+        Query().
+        Where(einterview.DeletedAtLT(deletedBefore)).
+        Limit(limit).
+        IDs(ctx)
+    if err != nil || len(ids) == 0 {
+        return 0, nil, err
+    }
+
+    var audioKeys []string
+    err = tx.WithTransaction(ctx, r.client, func(ctx context.Context, tx tx.Tx) error {
+        client := tx.Client()
+        audioKeys, err = client.Question.
+            Query().
+            Where(
+                equestion.InterviewIDIn(ids...),
+                equestion.AudioKeyNEQ(""),
+            ).
+            Unique(true).
+            Select(equestion.FieldAudioKey).
+            Strings(ctx)
+        if err != nil {
+            return err
+        }
+        if _, err := client.Question.Delete().Where(equestion.InterviewIDIn(ids...)).Exec(ctx); err != nil {
+            return err
+        }
+        if _, err := client.InterviewFavorite.Delete().Where(efavorite.InterviewIDIn(ids...)).Exec(ctx); err != nil {
+            return err
+        }
+        if _, err := client.Job.Delete().Where(ejob.InterviewIDIn(ids...)).Exec(ctx); err != nil {
+            return err
+        }
+        _, err := client.Interview.Delete().Where(einterview.IDIn(ids...)).Exec(ctx)
+        return err
+    })
+    if err != nil {
+        return 0, nil, err
+    }
+    return len(ids), audioKeys, nil
+}
@@ -0,0 +1,199 @@
+package repo
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    pb "irelia/api"
+    "irelia/pkg/ent"
+    ejob "irelia/pkg/ent/job"
+)
+
+type IJob interface {
+    Enqueue(ctx context.Context, job *ent.Job) (bool, error)
+    Get(ctx context.Context, kind pb.JobKind, interviewID string, questionIndex int32, subIndex int32) (*ent.Job, error)
+    Claim(ctx context.Context, kinds []pb.JobKind, owner string, lease time.Duration) (*ent.Job, error)
+    Complete(ctx context.Context, id int, owner string) error
+    Retry(ctx context.Context, id int, owner string, runAt time.Time, reason string) error
+    Bury(ctx context.Context, id int, owner string, reason string) error
+    Reclaim(ctx context.Context) (int, error)
+}
+
+// ErrLeaseLost is returned when a job is settled by a worker that no longer holds its lease
+var ErrLeaseLost = errors.New("job lease lost")
+
+type EntJob struct {
+    client *ent.Client
+}
+
+func NewJobRepository(client *ent.Client) IJob {
+    return &EntJob{client: client}
+}
+
+// Enqueue persists a pending job, re-arming it if a previous run is dead. It reports whether the job was scheduled
+func (r *EntJob) Enqueue(ctx context.Context, job *ent.Job) (bool, error) {
+    create := r.client.Job.
+        Create().
+        SetKind(job.Kind).
+        SetInterviewID(job.InterviewID).
+        SetQuestionIndex(job.QuestionIndex).
+        SetSubIndex(job.SubIndex).
+        SetUserID(job.UserID).
+        SetStatus(pb.JobStatus_JOB_STATUS_PENDING).
+        SetRunAt(time.Now())
+    if job.MaxAttempts > 0 {
+        create = create.SetMaxAttempts(job.MaxAttempts)
+    }
+
+    _, err := create.Save(ctx)
+    if err == nil {
+        return true, nil
+    }
+    if !ent.IsConstraintError(err) {
+        return false, err
+    }
+
+    // The job already exists, only a dead one is scheduled again
+    affected, err := r.client.Job.
+        Update().
+        Where(
+            ejob.KindEQ(job.Kind),
+            ejob.InterviewID(job.InterviewID),
+            ejob.QuestionIndex(job.QuestionIndex),
+            ejob.SubIndex(job.SubIndex),
+            ejob.StatusEQ(pb.JobStatus_JOB_STATUS_DEAD),
+        ).
+        SetStatus(pb.JobStatus_JOB_STATUS_PENDING).
+        SetAttempts(0).
+        SetRunAt(time.Now()).
+        ClearLastError().
+        Save(ctx)
+    if err != nil {
+        return false, err
+    }
+    return affected > 0, nil
+}
+
+// Get retrieves the job of a kind for an interview question or one of its follow-ups
+func (r *EntJob) Get(ctx context.Context, kind pb.JobKind, interviewID string, questionIndex int32, subIndex int32) (*ent.Job, error) {
+    return r.client.Job.
+        Query().
+        Where(
+            ejob.KindEQ(kind),
+            ejob.InterviewID(interviewID),
+            ejob.QuestionIndex(questionIndex),
+            ejob.SubIndex(subIndex),
+        ).
+        Only(ctx)
+}
+
+// Claim leases the oldest due pending job of the given kinds to owner, it returns nil when nothing is due
+func (r *EntJob) Claim(ctx context.Context, kinds []pb.JobKind, owner string, lease time.Duration) (*ent.Job, error) {
+    const batch = 10
+    now := time.Now()
+
+    candidates, err := r.client.Job.
+        Query().
+        Where(
+            ejob.KindIn(kinds...),
+            ejob.StatusEQ(pb.JobStatus_JOB_STATUS_PENDING),
+            ejob.RunAtLTE(now),
+        ).
+        Order(ent.Asc(ejob.FieldRunAt)).
+        Limit(batch).
+        All(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    // Another worker may grab the same candidate first, the conditional update decides the winner
+    for _, candidate := range candidates {
+        affected, err := r.client.Job.
+            Update().
+            Where(
+                ejob.ID(candidate.ID),
+                ejob.StatusEQ(pb.JobStatus_JOB_STATUS_PENDING),
+            ).
+            SetStatus(pb.JobStatus_JOB_STATUS_RUNNING).
+            SetLockedBy(owner).
+            SetLockedUntil(now.Add(lease)).
+            AddAttempts(1).
+            Save(ctx)
+        if err != nil {
+            return nil, err
+        }
+        if affected == 1 {
+            return r.client.Job.Get(ctx, candidate.ID)
+        }
+    }
+    return nil, nil
+}
+
+// Complete marks a job as succeeded
+func (r *EntJob) Complete(ctx context.Context, id int, owner string) error {
+    return settled(r.leased(id, owner).
+        SetStatus(pb.JobStatus_JOB_STATUS_SUCCEEDED).
+        ClearLockedBy().
+        ClearLockedUntil().
+        ClearLastError().
+        Save(ctx))
+}
+
+// Retry releases a failed job so it runs again at runAt
+func (r *EntJob) Retry(ctx context.Context, id int, owner string, runAt time.Time, reason string) error {
+    return settled(r.leased(id, owner).
+        SetStatus(pb.JobStatus_JOB_STATUS_PENDING).
+        SetRunAt(runAt).
+        SetLastError(reason).
+        ClearLockedBy().
+        ClearLockedUntil().
+        Save(ctx))
+}
+
+// Bury moves a job that exhausted its attempts to the dead-letter state
+func (r *EntJob) Bury(ctx context.Context, id int, owner string, reason string) error {
+    return settled(r.leased(id, owner).
+        SetStatus(pb.JobStatus_JOB_STATUS_DEAD).
+        SetLastError(reason).
+        ClearLockedBy().
+        ClearLockedUntil().
+        Save(ctx))
+}
+
+// leased updates a job only while owner still holds its lease, a job reclaimed and claimed again by another
+// worker belongs to that worker
+func (r *EntJob) leased(id int, owner string) *ent.JobUpdate {
+    return r.client.Job.
+        Update().
+        Where(
+            ejob.ID(id),
+            ejob.LockedBy(owner),
+            ejob.StatusEQ(pb.JobStatus_JOB_STATUS_RUNNING),
+        )
+}
+
+func settled(affected int, err error) error {
+    if err != nil {
+        return err
+    }
+    if affected == 0 {
+        return ErrLeaseLost
+    }
+    return nil
+}
+
+// Reclaim returns running jobs whose lease expired, e.g. after a crash or restart, to the pending state
+func (r *EntJob) Reclaim(ctx context.Context) (int, error) {
+    return r.client.Job.
+        Update().
+        Where(
+            ejob.StatusEQ(pb.JobStatus_JOB_STATUS_RUNNING),
+            ejob.LockedUntilLT(time.Now()),
+        ).
+        SetStatus(pb.JobStatus_JOB_STATUS_PENDING).
+        SetRunAt(time.Now()).
+        ClearLockedBy().
+        ClearLockedUntil().
+        Save(ctx)
+}
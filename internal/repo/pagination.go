@@ -0,0 +1,95 @@
+package repo
+
+import (
+    "encoding/base64"
+    "encoding/json"
+    "errors"
+    "time"
+
+    "entgo.io/ent/dialect/sql"
+    "github.com/spf13/viper"
+)
+
+// ErrInvalidPageToken is returned for a page token that is malformed or was issued for another sort order
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Page describes a page of a listing, the totals are only set when they were counted
+type Page struct {
+    Size          int32
+    NextPageToken string
+    TotalCount    int32
+    TotalPages    int32
+}
+
+// cursor is the position after the last row of a page, it holds the sort column and ID of that row
+type cursor struct {
+    Sort  int32     `json:"s"`
+    Time  time.Time `json:"t,omitempty"`
+    Value float64   `json:"v,omitempty"`
+    ID    string    `json:"i,omitempty"`
+    Int   int       `json:"n,omitempty"`
+}
+
+func (c *cursor) encode() string {
+    data, _ := json.Marshal(c)
+    return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a page token issued for the given sort
+func decodeCursor(token string, sort int32) (*cursor, error) {
+    data, err := base64.RawURLEncoding.DecodeString(token)
+    if err != nil {
+        return nil, ErrInvalidPageToken
+    }
+    c := &cursor{}
+    if err := json.Unmarshal(data, c); err != nil || c.Sort != sort {
+        return nil, ErrInvalidPageToken
+    }
+    return c, nil
+}
+
+// pageSize returns the requested page size, or the default one, capped at max_page_size
+func pageSize(requested int32, fallback int) int {
+    size := int(requested)
+    if size <= 0 {
+        size = fallback
+    }
+    if limit := viper.GetInt("max_page_size"); limit > 0 && size > limit {
+        size = limit
+    }
+    return size
+}
+
+// includeTotal tells whether matches are counted, page numbers need the count to report the page total
+func includeTotal(requested *bool, token string) bool {
+    if requested != nil {
+        return *requested
+    }
+    return token == ""
+}
+
+// after selects the rows following a cursor in an order by column then ID, both in the same direction
+func after(column string, value, id any, desc bool) func(*sql.Selector) {
+    return func(s *sql.Selector) {
+        next := sql.GT
+        if desc {
+            next = sql.LT
+        }
+        s.Where(sql.Or(
+            next(s.C(column), value),
+            sql.And(sql.EQ(s.C(column), value), next(s.C("id"), id)),
+        ))
+    }
+}
+
+// newPage describes a page of the given size, the page total is derived from the match count when it was counted
+func newPage(size, total int, counted bool) *Page {
+    page := &Page{Size: int32(size)}
+    if counted {
+        page.TotalCount = int32(total)
+        if total > 0 {
+            page.TotalPages = int32((total-1)/size + 1)
+        }
+    }
+    return page
+}
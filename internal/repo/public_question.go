@@ -2,15 +2,36 @@ package repo
 
 import (
     "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "strings"
+    "time"
 
+    "entgo.io/ent/dialect"
+    "entgo.io/ent/dialect/sql"
+    "github.com/spf13/viper"
+    "golang.org/x/text/unicode/norm"
     "irelia/pkg/ent"
     pb "irelia/api"
+    eaudit "irelia/pkg/ent/moderationaudit"
     epq "irelia/pkg/ent/publicquestion"
+    "irelia/internal/utils/tx"
 )
 
 type IPublicQuestion interface {
-    List(ctx context.Context, req *pb.GetPublicQuestionRequest) ([]*ent.PublicQuestion, int32, int32, int32, error)
-    CreateBulk(ctx context.Context, questions []*ent.PublicQuestion) error
+    List(ctx context.Context, req *pb.GetPublicQuestionRequest) ([]*ent.PublicQuestion, *Page, error)
+    Upsert(ctx context.Context, questions []*ent.PublicQuestion) error
+    Dedupe(ctx context.Context, batchSize int) (int, error)
+    Get(ctx context.Context, id int) (*ent.PublicQuestion, error)
+    ListQueue(ctx context.Context, req *pb.ListModerationQueueRequest) ([]*ent.PublicQuestion, *Page, error)
+    Moderate(ctx context.Context, id int, moderation *Moderation, audit *ent.ModerationAudit) (*ent.PublicQuestion, error)
+    Merge(ctx context.Context, source, target *ent.PublicQuestion, audit *ent.ModerationAudit) error
+    Audit(ctx context.Context, questionID int) ([]*ent.ModerationAudit, error)
+    MissingAnswers(ctx context.Context, maxAttempts, limit int) ([]*ent.PublicQuestion, error)
+    ClaimAnswer(ctx context.Context, id int, owner string, lease time.Duration) (bool, error)
+    SaveAnswer(ctx context.Context, id int, owner, answer, generator, version string, generatedAt time.Time) (bool, error)
+    AnswerFailed(ctx context.Context, id int) error
+    Sample(ctx context.Context, position, language string, limit int) ([]*ent.PublicQuestion, error)
 }
 
 type EntPublicQuestion struct {
@@ -21,12 +42,20 @@ func NewPublicQuestionRepository(client *ent.Client) IPublicQuestion {
     return &EntPublicQuestion{client: client}
 }
 
-func (r *EntPublicQuestion) List(ctx context.Context, req *pb.GetPublicQuestionRequest) ([]*ent.PublicQuestion, int32, int32, int32, error) {
-    if req.Page == 0 {
+// List retrieves a page of the approved questions of the public bank, newest first. A page token continues right after the last
+// question of the previous page
+func (r *EntPublicQuestion) List(ctx context.Context, req *pb.GetPublicQuestionRequest) ([]*ent.PublicQuestion, *Page, error) {
+    var position *cursor
+    if req.PageToken != "" {
+        var err error
+        if position, err = decodeCursor(req.PageToken, 0); err != nil {
+            return nil, nil, err
+        }
+    } else if req.Page < 1 {
         req.Page = 1
     }
 
-	query := r.client.PublicQuestion.Query()
+    query := r.client.PublicQuestion.Query().Where(epq.StatusEQ(pb.ModerationStatus_MODERATION_STATUS_APPROVED))
 	if req.Pos != nil && *req.Pos != "" {
         query = query.Where(epq.PositionEQ(*req.Pos))
     }
@@ -36,22 +65,36 @@ func (r *EntPublicQuestion) List(ctx context.Context, req *pb.GetPublicQuestionR
     if req.Lang != nil && *req.Lang != "" {
         query = query.Where(epq.LanguageEQ(*req.Lang))
     }
-    totalCount, _ := query.Count(ctx)
 
-    const pageSize = 20
-    page := int(req.Page)
-    if page < 1 {
-        page = 1
+    counted := includeTotal(req.IncludeTotal, req.PageToken)
+    totalCount := 0
+    if counted {
+        var err error
+        if totalCount, err = query.Clone().Count(ctx); err != nil {
+            return nil, nil, err
+        }
     }
-    offset := (page - 1) * pageSize
-    totalPage := int32((totalCount-1)/pageSize + 1)
 
+    defaultSize := viper.GetInt("public_page_size")
+    if defaultSize <= 0 {
+        defaultSize = 20
+    }
+    size := pageSize(req.PageSize, defaultSize)
+    if position != nil {
+        query = query.Where(epq.IDLT(position.Int))
+    } else {
+        query = query.Offset(int(req.Page-1) * size)
+    }
+
+    // One more question than the page holds tells whether there is a next page
     questions, err := query.Order(ent.Desc(epq.FieldID)).
-        Limit(pageSize).
-        Offset(offset).
+        Limit(size + 1).
         Select(
             epq.FieldContent,
             epq.FieldAnswer,
+            epq.FieldAnswerGenerator,
+            epq.FieldAnswerVersion,
+            epq.FieldAnswerGeneratedAt,
             epq.FieldPosition,
             epq.FieldExperience,
             epq.FieldCreatedAt,
@@ -59,21 +102,469 @@ func (r *EntPublicQuestion) List(ctx context.Context, req *pb.GetPublicQuestionR
         ).
         All(ctx)
     if err != nil {
-        return nil, 0, 0, 0, err
+        return nil, nil, err
+    }
+
+    page := newPage(size, totalCount, counted)
+    if len(questions) > size {
+        questions = questions[:size]
+        page.NextPageToken = (&cursor{Int: questions[size-1].ID}).encode()
+    }
+    return questions, page, nil
+}
+
+// Upsert stores generated questions once per normalized content and language. A question seen again
+// counts one more occurrence and moves its last-seen time, its content and answer are kept. A question merged
+// into another one counts towards the question it was merged into
+func (r *EntPublicQuestion) Upsert(ctx context.Context, questions []*ent.PublicQuestion) error {
+    now := time.Now()
+    keys := make([]string, 0, len(questions))
+    hashes := make([]string, 0, len(questions))
+    seen := make(map[string]*ent.PublicQuestion, len(questions))
+    occurrences := make(map[string]int, len(questions))
+    for _, q := range questions {
+        hash := questionHash(q.Content)
+        // A batch repeating a question is counted on a single row, an upsert may not touch a row twice
+        key := hash + "\x00" + q.Language
+        if _, ok := seen[key]; !ok {
+            seen[key] = q
+            keys = append(keys, key)
+            hashes = append(hashes, hash)
+        }
+        occurrences[key]++
+    }
+
+    return tx.WithTransaction(ctx, r.client, func(ctx context.Context, tx tx.Tx) error {
+        client := tx.Client()
+        merged, err := client.PublicQuestion.
+            Query().
+            Where(
+                epq.StatusEQ(pb.ModerationStatus_MODERATION_STATUS_MERGED),
+                epq.ContentHashIn(hashes...),
+            ).
+            All(ctx)
+        if err != nil {
+            return err
+        }
+        for _, question := range merged {
+            key := *question.ContentHash + "\x00" + question.Language
+            if _, ok := seen[key]; !ok || question.MergedIntoID == nil {
+                continue
+            }
+            err := client.PublicQuestion.
+                UpdateOneID(*question.MergedIntoID).
+                AddOccurrences(occurrences[key]).
+                SetLastSeenAt(now).
+                Exec(ctx)
+            if err != nil {
+                return err
+            }
+            delete(seen, key)
+        }
+
+        builders := make([]*ent.PublicQuestionCreate, 0, len(seen))
+        for i, key := range keys {
+            q, ok := seen[key]
+            if !ok {
+                continue
+            }
+            builders = append(builders, client.PublicQuestion.
+                Create().
+                SetPosition(q.Position).
+                SetExperience(q.Experience).
+                SetLanguage(q.Language).
+                SetContent(strings.TrimSpace(q.Content)).
+                SetContentHash(hashes[i]).
+                SetOccurrences(occurrences[key]).
+                SetFirstSeenAt(now).
+                SetLastSeenAt(now))
+        }
+        if len(builders) == 0 {
+            return nil
+        }
+
+        return client.PublicQuestion.
+            CreateBulk(builders...).
+            OnConflictColumns(epq.FieldContentHash, epq.FieldLanguage).
+            Update(func(u *ent.PublicQuestionUpsert) {
+                u.Add(epq.FieldOccurrences, excluded(u.UpdateSet, epq.FieldOccurrences))
+                u.UpdateLastSeenAt()
+                u.UpdateUpdatedAt()
+            }).
+            Exec(ctx)
+    })
+}
+
+// Dedupe collapses the questions stored before deduplication into one row per normalized content and language.
+// The earliest row, or the one already upserted, is kept with the occurrences of its duplicates and their
+// first and last-seen times. It returns how many duplicate rows were removed
+func (r *EntPublicQuestion) Dedupe(ctx context.Context, batchSize int) (int, error) {
+    type group struct {
+        keep        *ent.PublicQuestion
+        hash        string
+        occurrences int
+        firstSeen   time.Time
+        lastSeen    time.Time
+        duplicates  []int
+    }
+
+    groups := make(map[string]*group)
+    var keys []string
+    for lastID := 0; ; {
+        batch, err := r.client.PublicQuestion.
+            Query().
+            Where(epq.IDGT(lastID)).
+            Order(ent.Asc(epq.FieldID)).
+            Limit(batchSize).
+            All(ctx)
+        if err != nil {
+            return 0, err
+        }
+        if len(batch) == 0 {
+            break
+        }
+        lastID = batch[len(batch)-1].ID
+
+        for _, q := range batch {
+            // Rows stored before deduplication have no seen times yet
+            firstSeen, lastSeen := q.FirstSeenAt, q.LastSeenAt
+            if firstSeen.IsZero() {
+                firstSeen = q.CreatedAt
+            }
+            if lastSeen.IsZero() {
+                lastSeen = q.UpdatedAt
+            }
+
+            hash := questionHash(q.Content)
+            key := hash + "\x00" + q.Language
+            g, ok := groups[key]
+            if !ok {
+                groups[key] = &group{keep: q, hash: hash, occurrences: q.Occurrences, firstSeen: firstSeen, lastSeen: lastSeen}
+                keys = append(keys, key)
+                continue
+            }
+
+            g.occurrences += q.Occurrences
+            if firstSeen.Before(g.firstSeen) {
+                g.firstSeen = firstSeen
+            }
+            if lastSeen.After(g.lastSeen) {
+                g.lastSeen = lastSeen
+            }
+            // A row already upserted holds the unique hash, so it is the one kept
+            if q.ContentHash != nil && g.keep.ContentHash == nil {
+                g.duplicates = append(g.duplicates, g.keep.ID)
+                g.keep = q
+            } else {
+                g.duplicates = append(g.duplicates, q.ID)
+            }
+        }
     }
-    return questions, int32(totalCount), int32(pageSize), totalPage, nil
+
+    removed := 0
+    for _, key := range keys {
+        g := groups[key]
+        if len(g.duplicates) == 0 && g.keep.ContentHash != nil && *g.keep.ContentHash == g.hash {
+            continue
+        }
+        err := tx.WithTransaction(ctx, r.client, func(ctx context.Context, tx tx.Tx) error {
+            client := tx.Client()
+            // The duplicates go first so that none of them holds the hash when the kept row takes it
+            if len(g.duplicates) > 0 {
+                if _, err := client.PublicQuestion.Delete().Where(epq.IDIn(g.duplicates...)).Exec(ctx); err != nil {
+                    return err
+                }
+            }
+            return client.PublicQuestion.
+                UpdateOneID(g.keep.ID).
+                SetContentHash(g.hash).
+                SetOccurrences(g.occurrences).
+                SetFirstSeenAt(g.firstSeen).
+                SetLastSeenAt(g.lastSeen).
+                Exec(ctx)
+        })
+        if err != nil {
+            return removed, err
+        }
+        removed += len(g.duplicates)
+    }
+    return removed, nil
+}
+
+// questionHash identifies a question regardless of case, spacing, Unicode composition and final punctuation
+func questionHash(content string) string {
+    normalized := strings.Join(strings.Fields(strings.ToLower(norm.NFC.String(content))), " ")
+    normalized = strings.TrimRight(normalized, " .?!。？！")
+    sum := sha256.Sum256([]byte(normalized))
+    return hex.EncodeToString(sum[:])
+}
+
+// excluded refers to the value a conflicting insert proposed for a column
+func excluded(u *sql.UpdateSet, column string) sql.Querier {
+    if u.Dialect() == dialect.MySQL {
+        return sql.ExprFunc(func(b *sql.Builder) {
+            b.WriteString("VALUES(").Ident(column).WriteByte(')')
+        })
+    }
+    return sql.Expr(sql.Dialect(u.Dialect()).Table("excluded").C(column))
 }
 
-func (r *EntPublicQuestion) CreateBulk(ctx context.Context, questions []*ent.PublicQuestion) error {
-    builders := make([]*ent.PublicQuestionCreate, len(questions))
-    for i, q := range questions {
-        builders[i] = r.client.PublicQuestion.
-            Create().
-            SetPosition(q.Position).
-            SetExperience(q.Experience).
-			SetLanguage(q.Language).
-            SetContent(q.Content)
+// Sample returns random approved questions from the bank asked for a position in a language
+func (r *EntPublicQuestion) Sample(ctx context.Context, position, language string, limit int) ([]*ent.PublicQuestion, error) {
+    return r.client.PublicQuestion.
+        Query().
+        Where(
+            epq.PositionEQ(position),
+            epq.LanguageEQ(language),
+            epq.StatusEQ(pb.ModerationStatus_MODERATION_STATUS_APPROVED),
+        ).
+        Order(sql.OrderByRand()).
+        Limit(limit).
+        All(ctx)
+}
+
+func (r *EntPublicQuestion) Get(ctx context.Context, id int) (*ent.PublicQuestion, error) {
+    return r.client.PublicQuestion.Get(ctx, id)
+}
+
+// ListQueue retrieves a page of the questions in a moderation status, oldest first
+func (r *EntPublicQuestion) ListQueue(ctx context.Context, req *pb.ListModerationQueueRequest) ([]*ent.PublicQuestion, *Page, error) {
+    var position *cursor
+    if req.PageToken != "" {
+        var err error
+        if position, err = decodeCursor(req.PageToken, int32(req.Status)); err != nil {
+            return nil, nil, err
+        }
+    }
+
+    query := r.client.PublicQuestion.Query().Where(epq.StatusEQ(req.Status))
+    if req.Pos != nil && *req.Pos != "" {
+        query = query.Where(epq.PositionEQ(*req.Pos))
+    }
+    if req.Lang != nil && *req.Lang != "" {
+        query = query.Where(epq.LanguageEQ(*req.Lang))
+    }
+
+    counted := includeTotal(req.IncludeTotal, req.PageToken)
+    totalCount := 0
+    if counted {
+        var err error
+        if totalCount, err = query.Clone().Count(ctx); err != nil {
+            return nil, nil, err
+        }
+    }
+
+    defaultSize := viper.GetInt("public_page_size")
+    if defaultSize <= 0 {
+        defaultSize = 20
+    }
+    size := pageSize(req.PageSize, defaultSize)
+    if position != nil {
+        query = query.Where(epq.IDGT(position.Int))
+    }
+
+    questions, err := query.Order(ent.Asc(epq.FieldID)).Limit(size + 1).All(ctx)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    page := newPage(size, totalCount, counted)
+    if len(questions) > size {
+        questions = questions[:size]
+        page.NextPageToken = (&cursor{Sort: int32(req.Status), Int: questions[size-1].ID}).encode()
     }
-    _, err := r.client.PublicQuestion.CreateBulk(builders...).Save(ctx)
-    return err
+    return questions, page, nil
+}
+
+// Moderation is what a moderation action changes on a question, nil fields keep their stored value
+// ModeratorAnswer is the generator recorded for a model answer a moderator wrote or edited
+const ModeratorAnswer = "moderator"
+
+type Moderation struct {
+    Status          *pb.ModerationStatus
+    ModeratedBy     uint64
+    ModeratedAt     time.Time
+    Content         *string
+    Answer          *string
+    AnswerGenerator string // recorded as the provenance of an edited answer
+    Position        *string
+    Experience      *string
+}
+
+// changesQuestion reports whether an edit changes what a model answer is generated from
+func (m *Moderation) changesQuestion(current *ent.PublicQuestion) bool {
+    return (m.Content != nil && *m.Content != current.Content) ||
+        (m.Position != nil && *m.Position != current.Position) ||
+        (m.Experience != nil && *m.Experience != current.Experience)
+}
+
+// Moderate applies a moderation action and saves its audit entry. Only the fields the action sets are written, so that
+// a model answer generated since the moderator loaded the question is kept; the audit compares against the stored row
+func (r *EntPublicQuestion) Moderate(ctx context.Context, id int, moderation *Moderation, audit *ent.ModerationAudit) (*ent.PublicQuestion, error) {
+    var question *ent.PublicQuestion
+    err := tx.WithTransaction(ctx, r.client, func(ctx context.Context, tx tx.Tx) error {
+        client := tx.Client()
+        current, err := client.PublicQuestion.Get(ctx, id)
+        if err != nil {
+            return err
+        }
+
+        update := client.PublicQuestion.
+            UpdateOneID(id).
+            SetModeratedBy(moderation.ModeratedBy).
+            SetModeratedAt(moderation.ModeratedAt).
+            SetNillableStatus(moderation.Status).
+            SetNillableContent(moderation.Content).
+            SetNillablePosition(moderation.Position).
+            SetNillableExperience(moderation.Experience)
+        if moderation.Answer != nil {
+            update = update.
+                SetAnswer(*moderation.Answer).
+                SetAnswerGenerator(moderation.AnswerGenerator).
+                SetAnswerVersion("").
+                SetAnswerGeneratedAt(moderation.ModeratedAt)
+        } else if current.AnswerGenerator != ModeratorAnswer && moderation.changesQuestion(current) {
+            // A generated answer no longer fits the edited question, the worker writes a new one. An answer being
+            // generated loses its lease so it is not saved for the old question
+            update = update.
+                ClearAnswer().
+                ClearAnswerGenerator().
+                ClearAnswerVersion().
+                ClearAnswerGeneratedAt().
+                SetAnswerAttempts(0).
+                ClearAnswerLockedBy().
+                ClearAnswerLockedUntil()
+        }
+        if question, err = update.Save(ctx); err != nil {
+            return err
+        }
+
+        audit.PreviousStatus = current.Status
+        audit.Status = question.Status
+        audit.Content = question.Content
+        if audit.Action == pb.ModerationAction_MODERATION_ACTION_EDIT {
+            audit.PreviousContent = current.Content
+            audit.PreviousAnswer = current.Answer
+            audit.Answer = question.Answer
+        }
+        return createAudit(ctx, client, audit)
+    })
+    return question, err
+}
+
+// Merge folds a question into another one, which takes over its occurrences and seen times
+func (r *EntPublicQuestion) Merge(ctx context.Context, source, target *ent.PublicQuestion, audit *ent.ModerationAudit) error {
+    return tx.WithTransaction(ctx, r.client, func(ctx context.Context, tx tx.Tx) error {
+        client := tx.Client()
+        update := client.PublicQuestion.
+            UpdateOneID(target.ID).
+            AddOccurrences(source.Occurrences)
+        if !source.FirstSeenAt.IsZero() && (target.FirstSeenAt.IsZero() || source.FirstSeenAt.Before(target.FirstSeenAt)) {
+            update = update.SetFirstSeenAt(source.FirstSeenAt)
+        }
+        if source.LastSeenAt.After(target.LastSeenAt) {
+            update = update.SetLastSeenAt(source.LastSeenAt)
+        }
+        if err := update.Exec(ctx); err != nil {
+            return err
+        }
+
+        err := client.PublicQuestion.
+            UpdateOneID(source.ID).
+            SetStatus(pb.ModerationStatus_MODERATION_STATUS_MERGED).
+            SetMergedIntoID(target.ID).
+            SetNillableModeratedBy(source.ModeratedBy).
+            SetNillableModeratedAt(source.ModeratedAt).
+            Exec(ctx)
+        if err != nil {
+            return err
+        }
+        return createAudit(ctx, client, audit)
+    })
+}
+
+// Audit returns the moderation actions taken on a question, oldest first
+func (r *EntPublicQuestion) Audit(ctx context.Context, questionID int) ([]*ent.ModerationAudit, error) {
+    return r.client.ModerationAudit.
+        Query().
+        Where(eaudit.QuestionID(questionID)).
+        Order(ent.Asc(eaudit.FieldCreatedAt), ent.Asc(eaudit.FieldID)).
+        All(ctx)
+}
+
+// MissingAnswers returns the pending and approved questions without a model answer that have failed fewer than
+// maxAttempts times and are not leased by another replica, the most frequently generated first
+func (r *EntPublicQuestion) MissingAnswers(ctx context.Context, maxAttempts, limit int) ([]*ent.PublicQuestion, error) {
+    return r.client.PublicQuestion.
+        Query().
+        Where(
+            epq.AnswerGeneratedAtIsNil(),
+            epq.AnswerAttemptsLT(int32(maxAttempts)),
+            epq.StatusIn(pb.ModerationStatus_MODERATION_STATUS_PENDING, pb.ModerationStatus_MODERATION_STATUS_APPROVED),
+            epq.Or(epq.AnswerLockedUntilIsNil(), epq.AnswerLockedUntilLT(time.Now())),
+        ).
+        Order(ent.Asc(epq.FieldAnswerAttempts), ent.Desc(epq.FieldOccurrences), ent.Asc(epq.FieldID)).
+        Limit(limit).
+        All(ctx)
+}
+
+// ClaimAnswer leases the generation of a question's model answer to owner. Another replica may grab the same
+// question first, the conditional update decides the winner
+func (r *EntPublicQuestion) ClaimAnswer(ctx context.Context, id int, owner string, lease time.Duration) (bool, error) {
+    now := time.Now()
+    affected, err := r.client.PublicQuestion.
+        Update().
+        Where(
+            epq.ID(id),
+            epq.AnswerGeneratedAtIsNil(),
+            epq.Or(epq.AnswerLockedUntilIsNil(), epq.AnswerLockedUntilLT(now)),
+        ).
+        SetAnswerLockedBy(owner).
+        SetAnswerLockedUntil(now.Add(lease)).
+        Save(ctx)
+    return affected > 0, err
+}
+
+// SaveAnswer stores a generated model answer with its provenance. It reports false when the question got an answer
+// in the meantime or owner lost its lease, e.g. because a moderator edited the question; an answer written by a
+// moderator is never replaced
+func (r *EntPublicQuestion) SaveAnswer(ctx context.Context, id int, owner, answer, generator, version string, generatedAt time.Time) (bool, error) {
+    updated, err := r.client.PublicQuestion.
+        Update().
+        Where(epq.ID(id), epq.AnswerGeneratedAtIsNil(), epq.AnswerLockedBy(owner)).
+        SetAnswer(answer).
+        SetAnswerGenerator(generator).
+        SetAnswerVersion(version).
+        SetAnswerGeneratedAt(generatedAt).
+        ClearAnswerLockedBy().
+        ClearAnswerLockedUntil().
+        Save(ctx)
+    return updated > 0, err
+}
+
+// AnswerFailed counts a failed model answer generation for a question and releases its lease
+func (r *EntPublicQuestion) AnswerFailed(ctx context.Context, id int) error {
+    return r.client.PublicQuestion.
+        UpdateOneID(id).
+        AddAnswerAttempts(1).
+        ClearAnswerLockedBy().
+        ClearAnswerLockedUntil().
+        Exec(ctx)
+}
+
+func createAudit(ctx context.Context, client *ent.Client, audit *ent.ModerationAudit) error {
+    return client.ModerationAudit.
+        Create().
+        SetQuestionID(audit.QuestionID).
+        SetModeratorID(audit.ModeratorID).
+        SetAction(audit.Action).
+        SetPreviousStatus(audit.PreviousStatus).
+        SetStatus(audit.Status).
+        SetNillableMergedIntoID(audit.MergedIntoID).
+        SetPreviousContent(audit.PreviousContent).
+        SetContent(audit.Content).
+        SetNote(audit.Note).
+        SetPreviousAnswer(audit.PreviousAnswer).
+        SetAnswer(audit.Answer).
+        Exec(ctx)
 }
\ No newline at end of file
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	pb "irelia/api"
 	"irelia/pkg/ent"
@@ -13,10 +14,19 @@ type IQuestion interface {
     CreateBulk(ctx context.Context, userId uint64, questions []*ent.Question) error
     Update(ctx context.Context, userId uint64, question *ent.Question) error
     Get(ctx context.Context, interviewID string, questionIndex int32) (*ent.Question, error)
+    GetTurn(ctx context.Context, interviewID string, questionIndex int32, subIndex int32) (*ent.Question, error)
+    GetThread(ctx context.Context, interviewID string, questionIndex int32) ([]*ent.Question, error)
     List(ctx context.Context, interviewID string) ([]*pb.AnswerResult, error)
     Exists(ctx context.Context, interviewID string, questionIndex int32) (bool, error)
     GetAnswers(ctx context.Context, interviewID string) ([]*pb.AnswerResult, error)
     GetQaPair(ctx context.Context, interviewID string, contextQALength int) ([]*pb.QaPair, error)
+    GetProgress(ctx context.Context, interviewID string) (int32, int32, error)
+    Answer(ctx context.Context, question *ent.Question, cutoff time.Time) (bool, error)
+    Skip(ctx context.Context, interviewID string, questionIndex int32, subIndex int32) (bool, error)
+    StartDeadline(ctx context.Context, interviewID string, questionIndex int32, subIndex int32, deadline time.Time) (*ent.Question, error)
+    ExpireDeadlines(ctx context.Context, now time.Time) ([]*ent.Question, error)
+    FillAudio(ctx context.Context, question *ent.Question) (bool, error)
+    UnreferencedAudio(ctx context.Context, audioKeys []string) ([]string, error)
 }
 
 type EntQuestion struct {
@@ -33,9 +43,12 @@ func (r *EntQuestion) Create(ctx context.Context, userId uint64, question *ent.Q
         Create().
         SetInterviewID(question.InterviewID).
         SetQuestionIndex(question.QuestionIndex).
+        SetSubIndex(question.SubIndex).
         SetContent(question.Content).
         SetAudio(question.Audio).
+        SetAudioKey(question.AudioKey).
         SetLipsync(question.Lipsync).
+        SetAudioPending(question.AudioPending).
         SetAnswer(question.Answer).
         SetRecordProof(question.RecordProof).
         SetComment(question.Comment).
@@ -52,9 +65,12 @@ func (r *EntQuestion) CreateBulk(ctx context.Context, userId uint64, questions [
             Create().
             SetInterviewID(question.InterviewID).
             SetQuestionIndex(question.QuestionIndex).
+            SetSubIndex(question.SubIndex).
             SetContent(question.Content).
             SetAudio(question.Audio).
+            SetAudioKey(question.AudioKey).
             SetLipsync(question.Lipsync).
+            SetAudioPending(question.AudioPending).
             SetAnswer(question.Answer).
             SetRecordProof(question.RecordProof).
             SetComment(question.Comment).
@@ -73,9 +89,12 @@ func (r *EntQuestion) Update(ctx context.Context, userId uint64, question *ent.Q
         Where(
             equestion.InterviewID(question.InterviewID),
             equestion.QuestionIndex(question.QuestionIndex),
+            equestion.SubIndex(question.SubIndex),
         ).
         SetContent(question.Content).
         SetAudio(question.Audio).
+        SetAudioKey(question.AudioKey).
+        SetAudioPending(question.AudioPending).
         SetLipsync(question.Lipsync).
         SetAnswer(question.Answer).
         SetRecordProof(question.RecordProof).
@@ -86,42 +105,53 @@ func (r *EntQuestion) Update(ctx context.Context, userId uint64, question *ent.Q
     return err
 }
 
-// Get retrieves a question by interview ID and question index
+// Get retrieves the main question by interview ID and question index
 func (r *EntQuestion) Get(ctx context.Context, interviewID string, questionIndex int32) (*ent.Question, error) {
+    return r.GetTurn(ctx, interviewID, questionIndex, 0)
+}
+
+// GetTurn retrieves the main question (sub index 0) or one of its follow-ups
+func (r *EntQuestion) GetTurn(ctx context.Context, interviewID string, questionIndex int32, subIndex int32) (*ent.Question, error) {
     return r.client.Question.
         Query().
         Where(
             equestion.InterviewID(interviewID),
             equestion.QuestionIndex(questionIndex),
+            equestion.SubIndex(subIndex),
         ).
         Only(ctx)
 }
 
-// List retrieves all answers for an interview
-func (r *EntQuestion) List(ctx context.Context, interviewID string) ([]*pb.AnswerResult, error) {
-    entQuestions, err := r.client.Question.
+// GetThread retrieves a main question followed by its follow-ups
+func (r *EntQuestion) GetThread(ctx context.Context, interviewID string, questionIndex int32) ([]*ent.Question, error) {
+    return r.client.Question.
         Query().
-        Where(equestion.InterviewID(interviewID)).
-        Order(ent.Asc(equestion.FieldQuestionIndex)).
+        Where(
+            equestion.InterviewID(interviewID),
+            equestion.QuestionIndex(questionIndex),
+        ).
+        Order(ent.Asc(equestion.FieldSubIndex)).
         All(ctx)
+}
+
+// List retrieves all answers for an interview, follow-ups are nested under their main question
+func (r *EntQuestion) List(ctx context.Context, interviewID string) ([]*pb.AnswerResult, error) {
+    answers, err := r.GetAnswers(ctx, interviewID)
     if err != nil {
         return nil, err
     }
 
-    answers := make([]*pb.AnswerResult, len(entQuestions))
-    for i, entQuestion := range entQuestions {
-        answers[i] = &pb.AnswerResult{
-            Index:       int32(entQuestion.QuestionIndex),
-            Content:     entQuestion.Content,
-            Answer:      entQuestion.Answer,
-            RecordProof: entQuestion.RecordProof,
-            Comment:     entQuestion.Comment,
-            Score:       entQuestion.Score,
-            Status:      entQuestion.Status,
+    threads := make([]*pb.AnswerResult, 0, len(answers))
+    for _, answer := range answers {
+        if answer.SubIndex > 0 && len(threads) > 0 && threads[len(threads)-1].Index == answer.Index {
+            parent := threads[len(threads)-1]
+            parent.FollowUps = append(parent.FollowUps, answer)
+            continue
         }
+        threads = append(threads, answer)
     }
 
-    return answers, nil
+    return threads, nil
 }
 
 // Exists checks if a question exists in the database
@@ -131,6 +161,7 @@ func (r *EntQuestion) Exists(ctx context.Context, interviewID string, questionIn
         Where(
             equestion.InterviewID(interviewID),
             equestion.QuestionIndex(questionIndex),
+            equestion.SubIndex(0),
         ).
         Count(ctx)
     if err != nil {
@@ -144,7 +175,7 @@ func (r *EntQuestion) GetAnswers(ctx context.Context, interviewID string) ([]*pb
     entQuestions, err := r.client.Question.
         Query().
         Where(equestion.InterviewID(interviewID)).
-        Order(ent.Asc(equestion.FieldQuestionIndex)).
+        Order(ent.Asc(equestion.FieldQuestionIndex), ent.Asc(equestion.FieldSubIndex)).
         All(ctx)
     if err != nil {
         return nil, err
@@ -160,6 +191,7 @@ func (r *EntQuestion) GetAnswers(ctx context.Context, interviewID string) ([]*pb
             Comment:     entQuestion.Comment,
             Score:       entQuestion.Score,
             Status:      entQuestion.Status,
+            SubIndex:    entQuestion.SubIndex,
         }
     }
 
@@ -183,7 +215,7 @@ func (r *EntQuestion) GetQaPair(ctx context.Context, interviewID string, context
     entQuestions, err := r.client.Question.
         Query().
         Where(equestion.InterviewID(interviewID)).
-        Order(ent.Asc(equestion.FieldQuestionIndex)).
+        Order(ent.Asc(equestion.FieldQuestionIndex), ent.Asc(equestion.FieldSubIndex)).
         Offset(totalCount - contextQALength).
         Limit(contextQALength).
         All(ctx)
@@ -196,8 +228,186 @@ func (r *EntQuestion) GetQaPair(ctx context.Context, interviewID string, context
         qaPairs[i] = &pb.QaPair{
             Question: entQuestion.Content,
             Answer:   entQuestion.Answer,
+            Skipped:  entQuestion.Status == pb.QuestionStatus_QUESTION_STATUS_SKIPPED,
         }
     }
 
     return qaPairs, nil
-}
\ No newline at end of file
+}
+
+// GetProgress returns the index of the first unanswered question and the number of questions already handled
+func (r *EntQuestion) GetProgress(ctx context.Context, interviewID string) (int32, int32, error) {
+    entQuestions, err := r.client.Question.
+        Query().
+        Where(
+            equestion.InterviewID(interviewID),
+            equestion.SubIndex(0),
+        ).
+        Order(ent.Asc(equestion.FieldQuestionIndex)).
+        Select(equestion.FieldQuestionIndex, equestion.FieldStatus).
+        All(ctx)
+    if err != nil {
+        return 0, 0, err
+    }
+
+    var nextIndex, answered int32 = 0, 0
+    for _, entQuestion := range entQuestions {
+        if entQuestion.Status == pb.QuestionStatus_QUESTION_STATUS_NEW {
+            if nextIndex == 0 {
+                nextIndex = entQuestion.QuestionIndex
+            }
+            continue
+        }
+        answered++
+    }
+    if nextIndex == 0 {
+        nextIndex = int32(len(entQuestions)) + 1
+    }
+
+    return nextIndex, answered, nil
+}
+
+// Answer stores the answer of a question still open at cutoff, it reports whether the answer was accepted
+func (r *EntQuestion) Answer(ctx context.Context, question *ent.Question, cutoff time.Time) (bool, error) {
+    updated, err := r.client.Question.
+        Update().
+        Where(
+            equestion.InterviewID(question.InterviewID),
+            equestion.QuestionIndex(question.QuestionIndex),
+            equestion.SubIndex(question.SubIndex),
+            equestion.StatusEQ(pb.QuestionStatus_QUESTION_STATUS_NEW),
+            equestion.Or(
+                equestion.DeadlineAtIsNil(),
+                equestion.DeadlineAtGTE(cutoff),
+            ),
+        ).
+        SetAnswer(question.Answer).
+        SetRecordProof(question.RecordProof).
+        SetStatus(pb.QuestionStatus_QUESTION_STATUS_ANSWERED).
+        Save(ctx)
+    if err != nil {
+        return false, err
+    }
+    return updated > 0, nil
+}
+
+// Skip marks an unanswered question as skipped, it reports whether the question was still open
+func (r *EntQuestion) Skip(ctx context.Context, interviewID string, questionIndex int32, subIndex int32) (bool, error) {
+    updated, err := r.client.Question.
+        Update().
+        Where(
+            equestion.InterviewID(interviewID),
+            equestion.QuestionIndex(questionIndex),
+            equestion.SubIndex(subIndex),
+            equestion.StatusEQ(pb.QuestionStatus_QUESTION_STATUS_NEW),
+        ).
+        SetStatus(pb.QuestionStatus_QUESTION_STATUS_SKIPPED).
+        Save(ctx)
+    if err != nil {
+        return false, err
+    }
+    return updated > 0, nil
+}
+
+// StartDeadline sets the answer deadline of an unanswered question unless one is already running
+func (r *EntQuestion) StartDeadline(ctx context.Context, interviewID string, questionIndex int32, subIndex int32, deadline time.Time) (*ent.Question, error) {
+    _, err := r.client.Question.
+        Update().
+        Where(
+            equestion.InterviewID(interviewID),
+            equestion.QuestionIndex(questionIndex),
+            equestion.SubIndex(subIndex),
+            equestion.StatusEQ(pb.QuestionStatus_QUESTION_STATUS_NEW),
+            equestion.DeadlineAtIsNil(),
+        ).
+        SetDeadlineAt(deadline).
+        Save(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return r.GetTurn(ctx, interviewID, questionIndex, subIndex)
+}
+
+// ExpireDeadlines skips every unanswered question whose deadline has passed and returns them
+func (r *EntQuestion) ExpireDeadlines(ctx context.Context, now time.Time) ([]*ent.Question, error) {
+    candidates, err := r.client.Question.
+        Query().
+        Where(
+            equestion.StatusEQ(pb.QuestionStatus_QUESTION_STATUS_NEW),
+            equestion.DeadlineAtLT(now),
+        ).
+        All(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    expired := make([]*ent.Question, 0, len(candidates))
+    for _, candidate := range candidates {
+        // Another replica or a late answer may have settled the question in the meantime
+        updated, err := r.client.Question.
+            Update().
+            Where(
+                equestion.ID(candidate.ID),
+                equestion.StatusEQ(pb.QuestionStatus_QUESTION_STATUS_NEW),
+            ).
+            SetStatus(pb.QuestionStatus_QUESTION_STATUS_SKIPPED).
+            Save(ctx)
+        if err != nil {
+            return expired, err
+        }
+        if updated > 0 {
+            candidate.Status = pb.QuestionStatus_QUESTION_STATUS_SKIPPED
+            expired = append(expired, candidate)
+        }
+    }
+    return expired, nil
+}
+
+// FillAudio backfills the audio and lip-sync of a question saved as text only, it reports whether the audio was still pending
+func (r *EntQuestion) FillAudio(ctx context.Context, question *ent.Question) (bool, error) {
+    updated, err := r.client.Question.
+        Update().
+        Where(
+            equestion.InterviewID(question.InterviewID),
+            equestion.QuestionIndex(question.QuestionIndex),
+            equestion.SubIndex(question.SubIndex),
+            equestion.AudioPending(true),
+        ).
+        SetAudio(question.Audio).
+        SetAudioKey(question.AudioKey).
+        SetLipsync(question.Lipsync).
+        SetAudioPending(false).
+        Save(ctx)
+    if err != nil {
+        return false, err
+    }
+    return updated > 0, nil
+}
+
+// UnreferencedAudio filters the audio keys no question refers to anymore, content-addressed audio may be shared
+func (r *EntQuestion) UnreferencedAudio(ctx context.Context, audioKeys []string) ([]string, error) {
+    if len(audioKeys) == 0 {
+        return nil, nil
+    }
+    referenced, err := r.client.Question.
+        Query().
+        Where(equestion.AudioKeyIn(audioKeys...)).
+        Unique(true).
+        Select(equestion.FieldAudioKey).
+        Strings(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    inUse := make(map[string]bool, len(referenced))
+    for _, key := range referenced {
+        inUse[key] = true
+    }
+    unreferenced := make([]string, 0, len(audioKeys))
+    for _, key := range audioKeys {
+        if !inUse[key] {
+            unreferenced = append(unreferenced, key)
+        }
+    }
+    return unreferenced, nil
+}
@@ -0,0 +1,113 @@
+package repo
+
+import (
+    "context"
+    "time"
+
+    "irelia/pkg/ent"
+    "irelia/pkg/ent/predicate"
+    equota "irelia/pkg/ent/quota"
+)
+
+type IQuota interface {
+    Reserve(ctx context.Context, ownerId uint64, day time.Time, questions, interviewLimit, questionLimit int32) (bool, error)
+    Release(ctx context.Context, ownerId uint64, day time.Time, questions int32) error
+    Get(ctx context.Context, ownerId uint64, day time.Time) (int32, int32, error)
+}
+
+type EntQuota struct {
+    client *ent.Client
+}
+
+func NewQuotaRepository(client *ent.Client) IQuota {
+    return &EntQuota{client: client}
+}
+
+// Reserve takes an interview and its questions from the quota of a user for a day, it reports false when a limit
+// would be exceeded, a zero limit is unlimited. The check and the increment are a single conditional update so
+// concurrent starts cannot overrun the quota
+func (r *EntQuota) Reserve(ctx context.Context, ownerId uint64, day time.Time, questions, interviewLimit, questionLimit int32) (bool, error) {
+    if err := r.open(ctx, ownerId, day); err != nil {
+        return false, err
+    }
+
+    predicates := []predicate.Quota{
+        equota.UserID(ownerId),
+        equota.Day(day),
+    }
+    if interviewLimit > 0 {
+        predicates = append(predicates, equota.InterviewsLT(interviewLimit))
+    }
+    if questionLimit > 0 {
+        predicates = append(predicates, equota.QuestionsLTE(questionLimit-questions))
+    }
+    affected, err := r.client.Quota.
+        Update().
+        Where(predicates...).
+        AddInterviews(1).
+        AddQuestions(questions).
+        Save(ctx)
+    return affected > 0, err
+}
+
+// Release gives back a reservation whose interview could not be created
+func (r *EntQuota) Release(ctx context.Context, ownerId uint64, day time.Time, questions int32) error {
+    return r.client.Quota.
+        Update().
+        Where(
+            equota.UserID(ownerId),
+            equota.Day(day),
+            equota.InterviewsGT(0),
+        ).
+        AddInterviews(-1).
+        AddQuestions(-questions).
+        Exec(ctx)
+}
+
+// Get returns the interviews and questions a user has taken from the quota of a day, the counters Reserve checks
+func (r *EntQuota) Get(ctx context.Context, ownerId uint64, day time.Time) (int32, int32, error) {
+    quota, err := r.client.Quota.
+        Query().
+        Where(
+            equota.UserID(ownerId),
+            equota.Day(day),
+        ).
+        Only(ctx)
+    if ent.IsNotFound(err) {
+        // The counter is created on the first start of the day, seeded with these
+        return countStarted(ctx, r.client, ownerId, day)
+    }
+    if err != nil {
+        return 0, 0, err
+    }
+    return quota.Interviews, quota.Questions, nil
+}
+
+// open creates the counter of a day, seeded with the interviews the user already started that day
+func (r *EntQuota) open(ctx context.Context, ownerId uint64, day time.Time) error {
+    exists, err := r.client.Quota.
+        Query().
+        Where(
+            equota.UserID(ownerId),
+            equota.Day(day),
+        ).
+        Exist(ctx)
+    if err != nil || exists {
+        return err
+    }
+
+    interviews, questions, err := countStarted(ctx, r.client, ownerId, day)
+    if err != nil {
+        return err
+    }
+    // A concurrent start may have created the counter in the meantime, its row is kept
+    return r.client.Quota.
+        Create().
+        SetUserID(ownerId).
+        SetDay(day).
+        SetInterviews(interviews).
+        SetQuestions(questions).
+        OnConflictColumns(equota.FieldUserID, equota.FieldDay).
+        Ignore().
+        Exec(ctx)
+}
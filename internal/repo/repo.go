@@ -6,6 +6,9 @@ type Repository struct {
 	Interview IInterview
 	Question  IQuestion
 	PublicQuestion IPublicQuestion
+	Job       IJob
+	Usage     IUsage
+	Quota     IQuota
 	Ent       *ent.Client
 }
 
@@ -15,5 +18,8 @@ func New(ent *ent.Client) *Repository {
 		Interview: NewInterviewRepository(ent),
 		Question:  NewQuestionRepository(ent),
 		PublicQuestion: NewPublicQuestionRepository(ent),
+		Job:       NewJobRepository(ent),
+		Usage:     NewUsageRepository(ent),
+		Quota:     NewQuotaRepository(ent),
 	}
 }
@@ -0,0 +1,113 @@
+package repo
+
+import (
+    "context"
+    "sort"
+    "time"
+
+    "entgo.io/ent/dialect/sql"
+
+    pb "irelia/api"
+    "irelia/pkg/ent"
+    eusage "irelia/pkg/ent/usage"
+)
+
+type IUsage interface {
+    Record(ctx context.Context, entries []*ent.Usage) error
+    Summarize(ctx context.Context, userID uint64, since time.Time) ([]*pb.OperationUsage, error)
+}
+
+type EntUsage struct {
+    client *ent.Client
+}
+
+func NewUsageRepository(client *ent.Client) IUsage {
+    return &EntUsage{client: client}
+}
+
+// Record appends upstream calls to the usage ledger
+func (r *EntUsage) Record(ctx context.Context, entries []*ent.Usage) error {
+    builders := make([]*ent.UsageCreate, 0, len(entries))
+    for _, entry := range entries {
+        builders = append(builders, r.client.Usage.
+            Create().
+            SetUserID(entry.UserID).
+            SetInterviewID(entry.InterviewID).
+            SetUpstream(entry.Upstream).
+            SetOperation(entry.Operation).
+            SetLatencyMs(entry.LatencyMs).
+            SetRequestBytes(entry.RequestBytes).
+            SetResponseBytes(entry.ResponseBytes).
+            SetSuccess(entry.Success).
+            SetCreatedAt(entry.CreatedAt))
+    }
+    _, err := r.client.Usage.CreateBulk(builders...).Save(ctx)
+    return err
+}
+
+// sumAs aggregates a column under its own name, ent.Sum would name every sum "sum"
+func sumAs(column string) ent.AggregateFunc {
+    return func(s *sql.Selector) string {
+        return sql.As(sql.Sum(s.C(column)), column)
+    }
+}
+
+// Summarize aggregates the upstream calls of a user per upstream and operation
+func (r *EntUsage) Summarize(ctx context.Context, userID uint64, since time.Time) ([]*pb.OperationUsage, error) {
+    var rows []struct {
+        Upstream      string `json:"upstream"`
+        Operation     string `json:"operation"`
+        Success       bool   `json:"success"`
+        Count         int64  `json:"count"`
+        LatencyMs     int64  `json:"latency_ms"`
+        RequestBytes  int64  `json:"request_bytes"`
+        ResponseBytes int64  `json:"response_bytes"`
+    }
+    err := r.client.Usage.
+        Query().
+        Where(
+            eusage.UserID(userID),
+            eusage.CreatedAtGTE(since),
+        ).
+        GroupBy(eusage.FieldUpstream, eusage.FieldOperation, eusage.FieldSuccess).
+        Aggregate(
+            ent.Count(),
+            sumAs(eusage.FieldLatencyMs),
+            sumAs(eusage.FieldRequestBytes),
+            sumAs(eusage.FieldResponseBytes),
+        ).
+        Scan(ctx, &rows)
+    if err != nil {
+        return nil, err
+    }
+
+    // Successful and failed calls of an operation are grouped apart
+    operations := make(map[string]*pb.OperationUsage)
+    for _, row := range rows {
+        key := row.Upstream + "/" + row.Operation
+        operation, ok := operations[key]
+        if !ok {
+            operation = &pb.OperationUsage{Upstream: row.Upstream, Operation: row.Operation}
+            operations[key] = operation
+        }
+        operation.Calls += row.Count
+        if !row.Success {
+            operation.Failures += row.Count
+        }
+        operation.TotalLatencyMs += row.LatencyMs
+        operation.RequestBytes += row.RequestBytes
+        operation.ResponseBytes += row.ResponseBytes
+    }
+
+    result := make([]*pb.OperationUsage, 0, len(operations))
+    for _, operation := range operations {
+        result = append(result, operation)
+    }
+    sort.Slice(result, func(i, j int) bool {
+        if result[i].Upstream != result[j].Upstream {
+            return result[i].Upstream < result[j].Upstream
+        }
+        return result[i].Operation < result[j].Operation
+    })
+    return result, nil
+}
@@ -1,119 +1,92 @@
 package service
 
 import (
-    "bytes"
     "context"
-    "io"
-    "net/http"
+    "errors"
+
     "github.com/spf13/viper"
     "go.uber.org/zap"
     "google.golang.org/grpc/codes"
     "google.golang.org/grpc/status"
     "google.golang.org/protobuf/encoding/protojson"
+    "google.golang.org/protobuf/proto"
 
     pb "irelia/api"
 )
 
 // DariusClient implements the DariusClient interface using HTTP
 type DariusClient struct {
-    client *http.Client
-    logger *zap.Logger
+    upstream *Upstream
+    logger   *zap.Logger
 }
 
 // NewDariusClient creates a new Darius HTTP client
 func NewDariusClient(logger *zap.Logger) *DariusClient {
     return &DariusClient{
-        client: &http.Client{},
-        logger: logger,
+        upstream: NewUpstream("darius", logger),
+        logger:   logger,
     }
 }
 
 // Generate sends a REST API request to the Darius service to generate questions
 func (d *DariusClient) Generate(ctx context.Context, userId string, req *pb.NextQuestionRequest) (*pb.NextQuestionResponse, error) {
-    dariusURL := viper.GetString("darius.genurl")
-
-    // Marshal the Protobuf request to JSON
-    payloadBytes, err := protojson.Marshal(req)
-    if err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to marshal request: %v", err)
-    }
-
-    // Create the HTTP request
-    httpReq, err := http.NewRequestWithContext(ctx, "POST", dariusURL, bytes.NewBuffer(payloadBytes))
-    if err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to create HTTP request: %v", err)
-    }
-    httpReq.Header.Set("Content-Type", "application/json")
-    httpReq.Header.Set("x-user-id", userId)
-
-    // Send the HTTP request
-    resp, err := d.client.Do(httpReq)
-    if err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to send HTTP request: %v", err)
+    var dariusResp pb.NextQuestionResponse
+    if err := d.post(ctx, "generate", viper.GetString("darius.genurl"), userId, req, &dariusResp); err != nil {
+        return nil, err
     }
-    defer resp.Body.Close()
+    return &dariusResp, nil
+}
 
-    // Read the response body
-    body, err := io.ReadAll(resp.Body)
-    if err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to read response body: %v", err)
+// Score sends a REST API request to the Darius service to score answers
+func (d *DariusClient) Score(ctx context.Context, userId string, req *pb.ScoreInterviewRequest) (*pb.ScoreInterviewResponse, error) {
+    var dariusResp pb.ScoreInterviewResponse
+    if err := d.post(ctx, "score", viper.GetString("darius.scrurl"), userId, req, &dariusResp); err != nil {
+        return nil, err
     }
+    return &dariusResp, nil
+}
 
-    // Check for non-200 status codes
-    if resp.StatusCode != http.StatusOK {
-        return nil, status.Errorf(codes.Internal, "Darius service returned non-200 status: %d, body: %s", resp.StatusCode, string(body))
+// FollowUp sends a REST API request to the Darius service to generate a follow-up on the last answer of a thread
+func (d *DariusClient) FollowUp(ctx context.Context, userId string, req *pb.FollowUpRequest) (*pb.FollowUpResponse, error) {
+    var dariusResp pb.FollowUpResponse
+    if err := d.post(ctx, "follow_up", viper.GetString("darius.followurl"), userId, req, &dariusResp); err != nil {
+        return nil, err
     }
+    return &dariusResp, nil
+}
 
-    // Unmarshal the JSON response into a Protobuf message
-    var dariusResp pb.NextQuestionResponse
-    if err := protojson.Unmarshal(body, &dariusResp); err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to unmarshal response JSON: %v", err)
+// Answer sends a REST API request to the Darius service to write the model answer of a public bank question
+func (d *DariusClient) Answer(ctx context.Context, userId string, req *pb.ModelAnswerRequest) (*pb.ModelAnswerResponse, error) {
+    var dariusResp pb.ModelAnswerResponse
+    if err := d.post(ctx, "answer", viper.GetString("darius.answerurl"), userId, req, &dariusResp); err != nil {
+        return nil, err
     }
-
     return &dariusResp, nil
 }
 
-// Score sends a REST API request to the Darius service to score answers
-func (d *DariusClient) Score(ctx context.Context, userId string, req *pb.ScoreInterviewRequest) (*pb.ScoreInterviewResponse, error) {
-    dariusURL := viper.GetString("darius.scrurl")
+// GetMetrics returns the metrics of the Darius upstream
+func (d *DariusClient) GetMetrics() map[string]interface{} {
+    return d.upstream.GetMetrics()
+}
 
+func (d *DariusClient) post(ctx context.Context, endpoint, dariusURL, userId string, req proto.Message, resp proto.Message) error {
     // Marshal the Protobuf request to JSON
     payloadBytes, err := protojson.Marshal(req)
     if err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to marshal request: %v", err)
+        return status.Errorf(codes.Internal, "Failed to marshal request: %v", err)
     }
 
-    // Create the HTTP request
-    httpReq, err := http.NewRequestWithContext(ctx, "POST", dariusURL, bytes.NewBuffer(payloadBytes))
-    if err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to create HTTP request: %v", err)
+    body, err := d.upstream.Post(ctx, endpoint, dariusURL, map[string]string{"x-user-id": userId}, payloadBytes)
+    if errors.Is(err, ErrCircuitOpen) {
+        return status.Errorf(codes.Unavailable, "Darius service is unavailable: %v", err)
     }
-    httpReq.Header.Set("Content-Type", "application/json")
-    httpReq.Header.Set("x-user-id", userId)
-
-    // Send the HTTP request
-    resp, err := d.client.Do(httpReq)
     if err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to send HTTP request: %v", err)
-    }
-    defer resp.Body.Close()
-
-    // Read the response body
-    body, err := io.ReadAll(resp.Body)
-    if err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to read response body: %v", err)
-    }
-
-    // Check for non-200 status codes
-    if resp.StatusCode != http.StatusOK {
-        return nil, status.Errorf(codes.Internal, "Darius service returned non-200 status: %d, body: %s", resp.StatusCode, string(body))
+        return status.Errorf(codes.Internal, "Failed to call Darius service: %v", err)
     }
 
     // Unmarshal the JSON response into a Protobuf message
-    var dariusResp pb.ScoreInterviewResponse
-    if err := protojson.Unmarshal(body, &dariusResp); err != nil {
-        return nil, status.Errorf(codes.Internal, "Failed to unmarshal response JSON: %v", err)
+    if err := protojson.Unmarshal(body, resp); err != nil {
+        return status.Errorf(codes.Internal, "Failed to unmarshal response JSON: %v", err)
     }
-
-    return &dariusResp, nil
-}
\ No newline at end of file
+    return nil
+}
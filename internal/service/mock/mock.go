@@ -0,0 +1,330 @@
+package mock
+
+import (
+    "bytes"
+    "encoding/base64"
+    "encoding/binary"
+    "fmt"
+    "hash/fnv"
+    "io"
+    "math/rand"
+    "net/http"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/spf13/viper"
+    "go.uber.org/zap"
+    "google.golang.org/protobuf/encoding/protojson"
+    "google.golang.org/protobuf/proto"
+
+    pb "irelia/api"
+)
+
+// Routes served by the mock upstreams, they mirror the paths of the real Darius and Karma endpoints
+const (
+    RouteGenerate = "/darius/v1/suggest_interview_question"
+    RouteScore    = "/darius/v1/score_interview"
+    RouteFollowUp = "/darius/v1/suggest_follow_up"
+    RouteAnswer   = "/darius/v1/suggest_model_answer"
+    RouteLipSync  = "/karma/lip-sync"
+    RouteFluency  = "/karma/audio-score"
+)
+
+// ConfigKeys maps the upstream URL config keys to the mock route answering them
+var ConfigKeys = map[string]string{
+    "darius.genurl":    RouteGenerate,
+    "darius.scrurl":    RouteScore,
+    "darius.followurl": RouteFollowUp,
+    "darius.answerurl": RouteAnswer,
+    "karma.genurl":     RouteLipSync,
+    "karma.scrurl":     RouteFluency,
+}
+
+var defaultQuestions = []string{
+    "Could you walk me through a recent project you worked on as a %s?",
+    "What is the hardest technical problem you have solved as a %s, and how did you approach it?",
+    "How do you make sure the quality of your work stays high as a %s?",
+    "Tell me about a time you disagreed with a teammate. How was it resolved?",
+    "Which tools do you rely on most as a %s, and why?",
+    "How do you prioritise when several urgent tasks land at once?",
+    "Describe a mistake you made at work and what you learned from it.",
+    "How do you keep your skills up to date as a %s?",
+}
+
+// Server answers Darius and Karma requests with deterministic responses derived from the request,
+// with optional injected latency and errors configured under the mock section
+type Server struct {
+    logger    *zap.Logger
+    questions []string
+    mu        sync.Mutex
+    random    *rand.Rand
+}
+
+func New(logger *zap.Logger) *Server {
+    questions := viper.GetStringSlice("mock.questions")
+    if len(questions) == 0 {
+        questions = defaultQuestions
+    }
+    return &Server{
+        logger:    logger,
+        questions: questions,
+        random:    rand.New(rand.NewSource(viper.GetInt64("mock.seed"))),
+    }
+}
+
+// Handler returns the HTTP handler serving every mock route
+func (s *Server) Handler() http.Handler {
+    mux := http.NewServeMux()
+    mux.HandleFunc(RouteGenerate, s.route("generate", &pb.NextQuestionRequest{}, s.generate))
+    mux.HandleFunc(RouteScore, s.route("score", &pb.ScoreInterviewRequest{}, s.score))
+    mux.HandleFunc(RouteFollowUp, s.route("follow_up", &pb.FollowUpRequest{}, s.followUp))
+    mux.HandleFunc(RouteAnswer, s.route("answer", &pb.ModelAnswerRequest{}, s.answer))
+    mux.HandleFunc(RouteLipSync, s.route("lip_sync", &pb.LipSyncRequest{}, s.lipSync))
+    mux.HandleFunc(RouteFluency, s.route("fluency", &pb.ScoreFluencyRequest{}, s.fluency))
+    return mux
+}
+
+// route decodes the request, applies the faults configured for the route and encodes the response
+func (s *Server) route(name string, req proto.Message, handle func(proto.Message) proto.Message) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodPost {
+            http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+            return
+        }
+        body, err := io.ReadAll(r.Body)
+        if err != nil {
+            http.Error(w, err.Error(), http.StatusBadRequest)
+            return
+        }
+        msg := proto.Clone(req)
+        proto.Reset(msg)
+        if err := protojson.Unmarshal(body, msg); err != nil {
+            http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+            return
+        }
+
+        latency, fail, status := s.faults(name)
+        if latency > 0 {
+            select {
+            case <-time.After(latency):
+            case <-r.Context().Done():
+                return
+            }
+        }
+        if fail {
+            s.logger.Info("Mock upstream injected error", zap.String("route", name), zap.Int("status", status))
+            http.Error(w, fmt.Sprintf("mock %s injected error", name), status)
+            return
+        }
+
+        payload, err := protojson.Marshal(handle(msg))
+        if err != nil {
+            http.Error(w, err.Error(), http.StatusInternalServerError)
+            return
+        }
+        w.Header().Set("Content-Type", "application/json")
+        _, _ = w.Write(payload)
+    }
+}
+
+// faults reads the latency and error settings of a route, falling back to the global mock settings
+func (s *Server) faults(name string) (time.Duration, bool, int) {
+    setting := func(key string) string {
+        routeKey := fmt.Sprintf("mock.routes.%s.%s", name, key)
+        if viper.IsSet(routeKey) {
+            return routeKey
+        }
+        return "mock." + key
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    latency := time.Duration(viper.GetInt(setting("latency_ms"))) * time.Millisecond
+    if jitter := viper.GetInt(setting("jitter_ms")); jitter > 0 {
+        latency += time.Duration(s.random.Intn(jitter)) * time.Millisecond
+    }
+    fail := s.random.Float64() < viper.GetFloat64(setting("error_rate"))
+    status := viper.GetInt(setting("error_status"))
+    if status == 0 {
+        status = http.StatusServiceUnavailable
+    }
+    return latency, fail, status
+}
+
+func (s *Server) generate(msg proto.Message) proto.Message {
+    req := msg.(*pb.NextQuestionRequest)
+    position := req.Context.GetPosition()
+    if position == "" {
+        position = "candidate"
+    }
+    pick := hash(req.InterviewId, fmt.Sprint(len(req.Submissions)), fmt.Sprint(req.RemainingQuestions))
+    template := s.questions[pick%uint32(len(s.questions))]
+    if strings.Contains(template, "%s") {
+        template = fmt.Sprintf(template, position)
+    }
+    return &pb.NextQuestionResponse{Questions: []string{template}}
+}
+
+func (s *Server) followUp(msg proto.Message) proto.Message {
+    req := msg.(*pb.FollowUpRequest)
+    last := ""
+    if len(req.Thread) > 0 {
+        last = req.Thread[len(req.Thread)-1].Question
+    }
+    return &pb.FollowUpResponse{Question: fmt.Sprintf("Could you give a concrete example for your answer to: %q?", last)}
+}
+
+func (s *Server) answer(msg proto.Message) proto.Message {
+    req := msg.(*pb.ModelAnswerRequest)
+    position := req.Position
+    if position == "" {
+        position = "candidate"
+    }
+    return &pb.ModelAnswerResponse{
+        Answer: fmt.Sprintf("A strong %s answer to %q states the situation, explains the approach taken and its trade-offs, "+
+            "and closes with a concrete, measurable result.", position, req.Question),
+        Version: "mock-1",
+    }
+}
+
+func (s *Server) score(msg proto.Message) proto.Message {
+    req := msg.(*pb.ScoreInterviewRequest)
+    resp := &pb.ScoreInterviewResponse{
+        TotalScore:         &pb.TotalScore{},
+        PositiveFeedback:   "Answers were on topic and clearly structured.",
+        ActionableFeedback: "Back your answers with concrete examples and measurable results.",
+        FinalComment:       "Scored by the mock Darius upstream.",
+    }
+    words := 0
+    for _, submission := range req.Submissions {
+        count := len(strings.Fields(submission.Answer))
+        words += count
+        grade := grade(count)
+        countGrade(resp.TotalScore, grade)
+        resp.Result = append(resp.Result, &pb.AnswerScore{
+            Index:   submission.Index,
+            Score:   grade,
+            Comment: fmt.Sprintf("The answer has %d words.", count),
+        })
+    }
+    average := 0
+    if len(req.Submissions) > 0 {
+        average = words / len(req.Submissions)
+    }
+    for _, skill := range req.Skills {
+        resp.Skills = append(resp.Skills, &pb.SkillScore{Skill: skill, Score: grade(average)})
+    }
+    return resp
+}
+
+func (s *Server) fluency(msg proto.Message) proto.Message {
+    req := msg.(*pb.ScoreFluencyRequest)
+    resp := &pb.ScoreFluencyResponse{
+        Skills:             map[string]string{},
+        ActionableFeedback: "Keep a steady pace and avoid long pauses.",
+    }
+    words := 0
+    for _, submission := range req.Submissions {
+        count := len(strings.Fields(submission.Answer))
+        words += count
+        resp.Result = append(resp.Result, &pb.AnswerScore{Index: submission.Index, Score: grade(count)})
+    }
+    if len(req.Submissions) > 0 {
+        resp.Skills["Fluency"] = grade(words / len(req.Submissions))
+        resp.Skills["Pronunciation"] = grade(words / len(req.Submissions))
+    }
+    return resp
+}
+
+func (s *Server) lipSync(msg proto.Message) proto.Message {
+    req := msg.(*pb.LipSyncRequest)
+    words := strings.Fields(req.Content)
+    speed := float32(req.Speed)
+    if speed <= 0 {
+        speed = 1
+    }
+
+    // Every word takes a third of a second, mouth shapes cycle through the Rhubarb set
+    const wordDuration = 0.33
+    shapes := []string{"B", "C", "D", "E", "F"}
+    cues := make([]*pb.MouthCue, 0, len(words)+1)
+    var start float32
+    for i := range words {
+        end := start + wordDuration/speed
+        cues = append(cues, &pb.MouthCue{Start: start, End: end, Value: shapes[i%len(shapes)]})
+        start = end
+    }
+    cues = append(cues, &pb.MouthCue{Start: start, End: start + 0.2, Value: "X"})
+    duration := start + 0.2
+
+    return &pb.LipSyncResponse{
+        Audio: silentWav(duration),
+        Lipsync: &pb.LipSyncData{
+            Metadata:  &pb.LipSyncMetadata{SoundFile: "mock.wav", Duration: duration},
+            MouthCues: cues,
+        },
+    }
+}
+
+func grade(words int) string {
+    switch {
+    case words >= 60:
+        return "A"
+    case words >= 40:
+        return "B"
+    case words >= 20:
+        return "C"
+    case words >= 5:
+        return "D"
+    default:
+        return "F"
+    }
+}
+
+func countGrade(total *pb.TotalScore, grade string) {
+    switch grade {
+    case "A":
+        total.A++
+    case "B":
+        total.B++
+    case "C":
+        total.C++
+    case "D":
+        total.D++
+    default:
+        total.F++
+    }
+}
+
+func hash(parts ...string) uint32 {
+    h := fnv.New32a()
+    for _, part := range parts {
+        _, _ = h.Write([]byte(part))
+        _, _ = h.Write([]byte{0})
+    }
+    return h.Sum32()
+}
+
+// silentWav returns a base64 encoded 8 kHz mono 16-bit WAV of silence
+func silentWav(seconds float32) string {
+    const sampleRate = 8000
+    dataSize := uint32(float32(sampleRate)*seconds) * 2
+
+    var buf bytes.Buffer
+    buf.WriteString("RIFF")
+    _ = binary.Write(&buf, binary.LittleEndian, 36+dataSize)
+    buf.WriteString("WAVEfmt ")
+    _ = binary.Write(&buf, binary.LittleEndian, uint32(16))
+    _ = binary.Write(&buf, binary.LittleEndian, uint16(1))
+    _ = binary.Write(&buf, binary.LittleEndian, uint16(1))
+    _ = binary.Write(&buf, binary.LittleEndian, uint32(sampleRate))
+    _ = binary.Write(&buf, binary.LittleEndian, uint32(sampleRate*2))
+    _ = binary.Write(&buf, binary.LittleEndian, uint16(2))
+    _ = binary.Write(&buf, binary.LittleEndian, uint16(16))
+    buf.WriteString("data")
+    _ = binary.Write(&buf, binary.LittleEndian, dataSize)
+    buf.Write(make([]byte, dataSize))
+    return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
@@ -0,0 +1,356 @@
+package service
+
+import (
+    "bytes"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "math"
+    "math/rand"
+    "net/http"
+    "sync"
+    "sync/atomic"
+    "time"
+
+    "github.com/spf13/viper"
+    "go.uber.org/zap"
+)
+
+// ErrCircuitOpen is returned without calling the upstream while its circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// UpstreamError is a non-200 answer from an upstream
+type UpstreamError struct {
+    Upstream   string
+    StatusCode int
+    Body       string
+}
+
+func (e *UpstreamError) Error() string {
+    return fmt.Sprintf("%s service returned non-200 status: %d, body: %s", e.Upstream, e.StatusCode, e.Body)
+}
+
+// Upstream is the shared outbound HTTP layer of an upstream service, it applies per-endpoint timeouts,
+// retries retryable failures with jittered backoff and trips a circuit breaker when the upstream keeps failing
+type Upstream struct {
+    name           string
+    client         *http.Client
+    logger         *zap.Logger
+    timeout        time.Duration
+    timeouts       map[string]time.Duration
+    maxAttempts    int
+    retryBaseDelay time.Duration
+    retryMaxDelay  time.Duration
+    breaker        *CircuitBreaker
+    // Metrics
+    totalRequests  int64
+    totalRetries   int64
+    totalFailures  int64
+    totalRejected  int64
+}
+
+// NewUpstream configures an upstream from the upstream.<name> config section
+func NewUpstream(name string, logger *zap.Logger) *Upstream {
+    prefix := "upstream." + name
+    timeout := viper.GetInt(prefix + ".timeout")
+    if timeout <= 0 {
+        timeout = 30
+    }
+    maxAttempts := viper.GetInt(prefix + ".max_attempts")
+    if maxAttempts <= 0 {
+        maxAttempts = 3
+    }
+    retryBaseDelay := viper.GetInt(prefix + ".retry_base_delay_ms")
+    if retryBaseDelay <= 0 {
+        retryBaseDelay = 500
+    }
+    retryMaxDelay := viper.GetInt(prefix + ".retry_max_delay_ms")
+    if retryMaxDelay <= 0 {
+        retryMaxDelay = 5000
+    }
+
+    timeouts := make(map[string]time.Duration)
+    for endpoint, seconds := range viper.GetStringMap(prefix + ".timeouts") {
+        if value, ok := seconds.(int); ok && value > 0 {
+            timeouts[endpoint] = time.Duration(value) * time.Second
+        }
+    }
+
+    return &Upstream{
+        name:           name,
+        client:         &http.Client{},
+        logger:         logger,
+        timeout:        time.Duration(timeout) * time.Second,
+        timeouts:       timeouts,
+        maxAttempts:    maxAttempts,
+        retryBaseDelay: time.Duration(retryBaseDelay) * time.Millisecond,
+        retryMaxDelay:  time.Duration(retryMaxDelay) * time.Millisecond,
+        breaker: NewCircuitBreaker(name, logger,
+            viper.GetInt(prefix+".breaker.failure_threshold"),
+            viper.GetInt(prefix+".breaker.open_timeout")),
+    }
+}
+
+// Post sends a JSON payload to an endpoint of the upstream and returns the body of the 200 response
+func (u *Upstream) Post(ctx context.Context, endpoint, url string, headers map[string]string, payload []byte) ([]byte, error) {
+    atomic.AddInt64(&u.totalRequests, 1)
+
+    var lastErr error
+    for attempt := 1; attempt <= u.maxAttempts; attempt++ {
+        if !u.breaker.Allow() {
+            atomic.AddInt64(&u.totalRejected, 1)
+            if lastErr != nil {
+                return nil, fmt.Errorf("%s %s: %w (last error: %v)", u.name, endpoint, ErrCircuitOpen, lastErr)
+            }
+            return nil, fmt.Errorf("%s %s: %w", u.name, endpoint, ErrCircuitOpen)
+        }
+
+        body, err := u.do(ctx, endpoint, url, headers, payload)
+        retryable := isRetryable(ctx, err)
+        switch {
+        case ctx.Err() != nil:
+            // The caller gave up, which says nothing about the upstream
+            u.breaker.Release()
+        case err == nil:
+            u.breaker.Record(true)
+        case retryable:
+            u.breaker.Record(false)
+        default:
+            // A client error neither proves the upstream healthy nor failing
+            u.breaker.Release()
+        }
+        if err == nil {
+            return body, nil
+        }
+        lastErr = err
+        if !retryable || attempt == u.maxAttempts {
+            break
+        }
+
+        delay := u.backoff(attempt)
+        atomic.AddInt64(&u.totalRetries, 1)
+        u.logger.Warn("Upstream request failed, retrying",
+            zap.String("upstream", u.name),
+            zap.String("endpoint", endpoint),
+            zap.Int("attempt", attempt),
+            zap.Duration("retryIn", delay),
+            zap.Error(err))
+        select {
+        case <-time.After(delay):
+        case <-ctx.Done():
+            return nil, ctx.Err()
+        }
+    }
+
+    atomic.AddInt64(&u.totalFailures, 1)
+    return nil, lastErr
+}
+
+func (u *Upstream) do(ctx context.Context, endpoint, url string, headers map[string]string, payload []byte) ([]byte, error) {
+    timeout, ok := u.timeouts[endpoint]
+    if !ok {
+        timeout = u.timeout
+    }
+    ctx, cancel := context.WithTimeout(ctx, timeout)
+    defer cancel()
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    for key, value := range headers {
+        req.Header.Set(key, value)
+    }
+
+    resp, err := u.client.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    body, err := io.ReadAll(resp.Body)
+    if err != nil {
+        return nil, err
+    }
+    if resp.StatusCode != http.StatusOK {
+        return nil, &UpstreamError{Upstream: u.name, StatusCode: resp.StatusCode, Body: string(body)}
+    }
+    return body, nil
+}
+
+// backoff returns the exponential delay before the next attempt with full jitter
+func (u *Upstream) backoff(attempt int) time.Duration {
+    delay := time.Duration(float64(u.retryBaseDelay) * math.Pow(2, float64(attempt-1)))
+    if delay <= 0 || delay > u.retryMaxDelay {
+        delay = u.retryMaxDelay
+    }
+    return time.Duration(rand.Int63n(int64(delay)) + 1)
+}
+
+// isRetryable reports whether a failed attempt may succeed when sent again, client errors never do
+func isRetryable(ctx context.Context, err error) bool {
+    if err == nil || ctx.Err() != nil {
+        return false
+    }
+    var upstreamErr *UpstreamError
+    if errors.As(err, &upstreamErr) {
+        switch upstreamErr.StatusCode {
+        case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+            http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+            return true
+        }
+        return false
+    }
+    // Transport errors and per-attempt timeouts
+    return true
+}
+
+// GetMetrics returns upstream metrics
+func (u *Upstream) GetMetrics() map[string]interface{} {
+    return map[string]interface{}{
+        "total_requests": atomic.LoadInt64(&u.totalRequests),
+        "total_retries":  atomic.LoadInt64(&u.totalRetries),
+        "total_failures": atomic.LoadInt64(&u.totalFailures),
+        "total_rejected": atomic.LoadInt64(&u.totalRejected),
+        "breaker_state":  u.breaker.State().String(),
+        "breaker_trips":  u.breaker.Trips(),
+    }
+}
+
+// BreakerState is the state of a circuit breaker
+type BreakerState int32
+
+const (
+    BreakerClosed BreakerState = iota
+    BreakerOpen
+    BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+    switch s {
+    case BreakerOpen:
+        return "open"
+    case BreakerHalfOpen:
+        return "half_open"
+    default:
+        return "closed"
+    }
+}
+
+// CircuitBreaker opens after consecutive failures, rejects calls while open,
+// then lets a single probe through once the open timeout has passed
+type CircuitBreaker struct {
+    name             string
+    logger           *zap.Logger
+    failureThreshold int
+    openTimeout      time.Duration
+    mu               sync.Mutex
+    state            BreakerState
+    failures         int
+    openedAt         time.Time
+    probing          bool
+    trips            int64
+}
+
+// NewCircuitBreaker creates a breaker, a failure threshold below one disables it
+func NewCircuitBreaker(name string, logger *zap.Logger, failureThreshold, openTimeout int) *CircuitBreaker {
+    if openTimeout <= 0 {
+        openTimeout = 30
+    }
+    return &CircuitBreaker{
+        name:             name,
+        logger:           logger,
+        failureThreshold: failureThreshold,
+        openTimeout:      time.Duration(openTimeout) * time.Second,
+    }
+}
+
+// Allow reports whether a call may go through
+func (b *CircuitBreaker) Allow() bool {
+    if b.failureThreshold <= 0 {
+        return true
+    }
+    b.mu.Lock()
+    defer b.mu.Unlock()
+
+    switch b.state {
+    case BreakerOpen:
+        if time.Since(b.openedAt) < b.openTimeout {
+            return false
+        }
+        b.transition(BreakerHalfOpen)
+        b.probing = true
+        return true
+    case BreakerHalfOpen:
+        if b.probing {
+            return false
+        }
+        b.probing = true
+        return true
+    default:
+        return true
+    }
+}
+
+// Record reports the outcome of an allowed call
+func (b *CircuitBreaker) Record(success bool) {
+    if b.failureThreshold <= 0 {
+        return
+    }
+    b.mu.Lock()
+    defer b.mu.Unlock()
+
+    b.probing = false
+    if success {
+        b.failures = 0
+        if b.state != BreakerClosed {
+            b.transition(BreakerClosed)
+        }
+        return
+    }
+
+    b.failures++
+    if b.state == BreakerHalfOpen || b.failures >= b.failureThreshold {
+        b.openedAt = time.Now()
+        b.trips++
+        b.transition(BreakerOpen)
+    }
+}
+
+// Release ends an allowed call without an outcome, a half-open breaker lets the next probe through
+func (b *CircuitBreaker) Release() {
+    if b.failureThreshold <= 0 {
+        return
+    }
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    b.probing = false
+}
+
+// State returns the current state of the breaker
+func (b *CircuitBreaker) State() BreakerState {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    return b.state
+}
+
+// Trips returns how many times the breaker has opened
+func (b *CircuitBreaker) Trips() int64 {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    return b.trips
+}
+
+func (b *CircuitBreaker) transition(state BreakerState) {
+    if b.state == state {
+        return
+    }
+    b.logger.Warn("Circuit breaker changed state",
+        zap.String("upstream", b.name),
+        zap.String("from", b.state.String()),
+        zap.String("to", state.String()),
+        zap.Int("consecutiveFailures", b.failures))
+    b.state = state
+}
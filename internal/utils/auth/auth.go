@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "irelia/api"
+	ext "irelia/internal/utils/extractor"
+)
+
+// Principal is the authenticated caller of an RPC
+type Principal struct {
+	UserID uint64
+	Role   pb.BulbasaurRole
+}
+
+// IsCandidate reports whether the caller acts as a candidate
+func (p *Principal) IsCandidate() bool {
+	return p.Role == pb.BulbasaurRole_ROLE_CANDIDATE
+}
+
+// Scope returns the owner an interview lookup must be restricted to, nil means unrestricted
+func (p *Principal) Scope() *uint64 {
+	if p.IsCandidate() {
+		userID := p.UserID
+		return &userID
+	}
+	return nil
+}
+
+// Policy maps a full gRPC method name to the roles allowed to call it.
+// A method mapped to no roles is public, a method missing from the policy is denied.
+type Policy map[string][]pb.BulbasaurRole
+
+type principalKey struct{}
+
+// NewContext returns a context carrying the principal
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the principal attached by the interceptors
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
+
+// Authorizer resolves and checks the caller of every RPC of a service
+type Authorizer struct {
+	extractor ext.Extractor
+	policy    Policy
+	service   string
+}
+
+// New creates an authorizer enforcing the policy on all methods of the given service,
+// methods of other services (e.g. reflection) are passed through
+func New(extractor ext.Extractor, service string, policy Policy) *Authorizer {
+	return &Authorizer{
+		extractor: extractor,
+		policy:    policy,
+		service:   "/" + service + "/",
+	}
+}
+
+// UnaryServerInterceptor authorizes unary RPCs
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authorizes streaming RPCs
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize attaches the caller to the context if one of its roles may call the method
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	if !strings.HasPrefix(fullMethod, a.service) {
+		return ctx, nil
+	}
+
+	allowed, ok := a.policy[fullMethod]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "Method %s is not exposed", fullMethod)
+	}
+
+	principal, err := a.principal(ctx, allowed)
+	if len(allowed) == 0 {
+		// Public methods still see the caller when the headers are present
+		if err == nil {
+			ctx = NewContext(ctx, principal)
+		}
+		return ctx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewContext(ctx, principal), nil
+}
+
+// Authenticate resolves the caller of a request served outside of gRPC, e.g. by the gateway
+func (a *Authorizer) Authenticate(ctx context.Context, allowed []pb.BulbasaurRole) (*Principal, error) {
+	return a.principal(ctx, allowed)
+}
+
+// principal builds the caller from the gateway headers, picking the first role allowed by the policy
+func (a *Authorizer) principal(ctx context.Context, allowed []pb.BulbasaurRole) (*Principal, error) {
+	userID, err := a.extractor.GetUserID(ctx)
+	if err != nil || userID <= 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "Missing or invalid user id")
+	}
+
+	roles := make([]pb.BulbasaurRole, 0)
+	for _, roleID := range a.extractor.GetRoleIDs(ctx) {
+		value, err := strconv.ParseInt(roleID, 10, 32)
+		if err != nil {
+			continue
+		}
+		roles = append(roles, pb.BulbasaurRole(value))
+	}
+	if len(roles) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "Missing or invalid role id")
+	}
+
+	principal := &Principal{UserID: uint64(userID), Role: roles[0]}
+	if len(allowed) == 0 {
+		return principal, nil
+	}
+	for _, role := range roles {
+		for _, target := range allowed {
+			if role == target {
+				principal.Role = role
+				return principal, nil
+			}
+		}
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+}
+
+// authorizedStream overrides the stream context with the authorized one
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
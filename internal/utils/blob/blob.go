@@ -0,0 +1,76 @@
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ErrNotFound is returned when no blob is stored under a key
+var ErrNotFound = errors.New("blob not found")
+
+// Store keeps binary objects such as question audio outside the database
+type Store interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, string, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete removes a blob, deleting a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL granting read access to a blob until the ttl elapses
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// Key returns the content-addressed key of a blob, identical content is stored once
+func Key(prefix string, data []byte) string {
+	sum := sha256.Sum256(data)
+	return prefix + "/" + hex.EncodeToString(sum[:])
+}
+
+// Config selects and configures a store
+type Config struct {
+	Backend string
+	Local   LocalConfig
+	S3      S3Config
+}
+
+func ReadConfig() Config {
+	return Config{
+		Backend: viper.GetString("blob.backend"),
+		Local: LocalConfig{
+			Dir:       viper.GetString("blob.local.dir"),
+			PublicURL: viper.GetString("blob.local.public_url"),
+			Secret:    viper.GetString("blob.local.secret"),
+		},
+		S3: S3Config{
+			Endpoint:  viper.GetString("blob.s3.endpoint"),
+			Region:    viper.GetString("blob.s3.region"),
+			Bucket:    viper.GetString("blob.s3.bucket"),
+			AccessKey: viper.GetString("blob.s3.access_key"),
+			SecretKey: viper.GetString("blob.s3.secret_key"),
+		},
+	}
+}
+
+// New creates the store selected by backend, "local" or "s3", any other backend disables blob storage
+func New(cfg Config) (Store, error) {
+	switch cfg.Backend {
+	case "local":
+		store, err := NewLocal(cfg.Local)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	case "s3":
+		store, err := NewS3(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,180 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalConfig configures a filesystem store, blobs are downloaded through the gateway at PublicURL
+type LocalConfig struct {
+	Dir       string
+	PublicURL string
+	Secret    string
+}
+
+// Local stores blobs on the filesystem and signs download URLs with an HMAC
+type Local struct {
+	dir       string
+	publicURL string
+	secret    []byte
+}
+
+func NewLocal(cfg LocalConfig) (*Local, error) {
+	if cfg.Secret == "" {
+		return nil, fmt.Errorf("local blob store requires a signing secret")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &Local{
+		dir:       cfg.Dir,
+		publicURL: strings.TrimRight(cfg.PublicURL, "/"),
+		secret:    []byte(cfg.Secret),
+	}, nil
+}
+
+// path maps a key to a file inside the store directory, keys escaping it are rejected
+func (l *Local) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" || strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+	return filepath.Join(l.dir, filepath.FromSlash(clean)), nil
+}
+
+func (l *Local) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Written aside then renamed so readers never see a partial blob
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path+".type", []byte(contentType), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (l *Local) Get(ctx context.Context, key string) ([]byte, string, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, "", ErrNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	contentType, err := os.ReadFile(path + ".type")
+	if err != nil || len(contentType) == 0 {
+		return data, http.DetectContentType(data), nil
+	}
+	return data, string(contentType), nil
+}
+
+func (l *Local) Exists(ctx context.Context, key string) (bool, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (l *Local) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	for _, file := range []string{path, path + ".type"} {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Local) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", l.sign(key, expires))
+	return fmt.Sprintf("%s/blobs/%s?%s", l.publicURL, key, query.Encode()), nil
+}
+
+func (l *Local) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, l.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and expiry of a download URL issued by SignedURL
+func (l *Local) Verify(key, expires, signature string) bool {
+	deadline, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > deadline {
+		return false
+	}
+	return hmac.Equal([]byte(l.sign(key, expires)), []byte(signature))
+}
+
+// ServeHTTP serves a blob behind a signed URL, it matches the grpc-gateway runtime.HandlerFunc signature
+func (l *Local) ServeHTTP(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	key := pathParams["key"]
+	query := r.URL.Query()
+	if !l.Verify(key, query.Get("expires"), query.Get("signature")) {
+		http.Error(w, "invalid or expired signature", http.StatusForbidden)
+		return
+	}
+
+	data, contentType, err := l.Get(r.Context(), key)
+	if errors.Is(err, ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "failed to read blob", http.StatusInternalServerError)
+		return
+	}
+
+	// Content-addressed blobs never change, but a copy must not outlive the signature it was served under
+	deadline, _ := strconv.ParseInt(query.Get("expires"), 10, 64)
+	maxAge := deadline - time.Now().Unix()
+	if maxAge < 0 {
+		maxAge = 0
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d, immutable", maxAge))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,300 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Config configures an S3-compatible store, MinIO included, addressed path-style
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// S3 stores blobs in an S3-compatible bucket, requests and download URLs are signed with AWS Signature Version 4
+type S3 struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+const (
+	sigAlgorithm     = "AWS4-HMAC-SHA256"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	amzDateFormat    = "20060102T150405Z"
+	amzDayFormat     = "20060102"
+	maxPresignExpiry = 7 * 24 * time.Hour
+)
+
+func NewS3(cfg S3Config) (*S3, error) {
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint: %q", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("S3 blob store requires a bucket")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	return &S3{
+		endpoint:  endpoint,
+		region:    cfg.Region,
+		bucket:    cfg.Bucket,
+		accessKey: cfg.AccessKey,
+		secretKey: cfg.SecretKey,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (s *S3) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	u.Path = fmt.Sprintf("%s/%s/%s", s.endpoint.Path, s.bucket, key)
+	return &u
+}
+
+func (s *S3) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, hashHex(data), time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("S3 put returned status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *S3) Get(ctx context.Context, key string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	s.sign(req, hashHex(nil), time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, "", ErrNotFound
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("S3 get returned status %d: %s", resp.StatusCode, string(body))
+	}
+	return body, resp.Header.Get("Content-Type"), nil
+}
+
+func (s *S3) Exists(ctx context.Context, key string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key).String(), nil)
+	if err != nil {
+		return false, err
+	}
+	s.sign(req, hashHex(nil), time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("S3 head returned status %d", resp.StatusCode)
+	}
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+	s.sign(req, hashHex(nil), time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// S3 answers 204 whether or not the object existed
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("S3 delete returned status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// SignedURL returns a presigned GET URL, the ttl is capped at the seven days S3 allows
+func (s *S3) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if ttl > maxPresignExpiry {
+		ttl = maxPresignExpiry
+	}
+	return s.presign(s.objectURL(key), ttl, time.Now().UTC()), nil
+}
+
+func (s *S3) presign(u *url.URL, ttl time.Duration, now time.Time) string {
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", sigAlgorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+s.scope(now))
+	query.Set("X-Amz-Date", now.Format(amzDateFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(ttl.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	u.RawQuery = canonicalQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		escapePath(u.Path),
+		u.RawQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+	query.Set("X-Amz-Signature", s.signature(now, canonicalRequest))
+	u.RawQuery = canonicalQuery(query)
+	return u.String()
+}
+
+// sign adds the SigV4 Authorization header to a request
+func (s *S3) sign(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	names := []string{"host"}
+	for name := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "content-type" || strings.HasPrefix(lower, "x-amz-") {
+			names = append(names, lower)
+		}
+	}
+	sort.Strings(names)
+
+	var headers strings.Builder
+	for _, name := range names {
+		value := req.URL.Host
+		if name != "host" {
+			value = strings.TrimSpace(req.Header.Get(name))
+		}
+		headers.WriteString(name + ":" + value + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		escapePath(req.URL.Path),
+		canonicalQuery(req.URL.Query()),
+		headers.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigAlgorithm, s.accessKey, s.scope(now), signedHeaders, s.signature(now, canonicalRequest)))
+}
+
+func (s *S3) scope(now time.Time) string {
+	return fmt.Sprintf("%s/%s/s3/aws4_request", now.Format(amzDayFormat), s.region)
+}
+
+func (s *S3) signature(now time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		sigAlgorithm,
+		now.Format(amzDateFormat),
+		s.scope(now),
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format(amzDayFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalQuery encodes query parameters sorted by key with SigV4 escaping
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, escape(key)+"="+escape(value))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// escape percent-encodes everything but the unreserved characters, as SigV4 requires
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
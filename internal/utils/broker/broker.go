@@ -0,0 +1,65 @@
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// Broker fans out raw event payloads to every subscriber of a topic. Subscribe returns once the subscription is
+// active, events published afterwards reach the returned channel
+type Broker interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+	Subscribe(ctx context.Context, topic string) (<-chan []byte, func(), error)
+}
+
+const subscriberBuffer = 32
+
+type local struct {
+	mu     sync.RWMutex
+	topics map[string]map[chan []byte]struct{}
+}
+
+// NewLocal creates an in-process broker
+func NewLocal() Broker {
+	return &local{
+		topics: make(map[string]map[chan []byte]struct{}),
+	}
+}
+
+func (b *local) Publish(ctx context.Context, topic string, payload []byte) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.topics[topic] {
+		// Slow subscribers drop events instead of blocking the publisher
+		select {
+		case ch <- payload:
+		default:
+		}
+	}
+	return nil
+}
+
+func (b *local) Subscribe(ctx context.Context, topic string) (<-chan []byte, func(), error) {
+	ch := make(chan []byte, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.topics[topic] == nil {
+		b.topics[topic] = make(map[chan []byte]struct{})
+	}
+	b.topics[topic][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.topics[topic], ch)
+			if len(b.topics[topic]) == 0 {
+				delete(b.topics, topic)
+			}
+			b.mu.Unlock()
+		})
+	}
+	return ch, unsubscribe, nil
+}
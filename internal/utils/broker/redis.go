@@ -0,0 +1,70 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	re "github.com/redis/go-redis/v9"
+
+	api "irelia/pkg/redis/api"
+)
+
+type redisBroker struct {
+	client    *re.Client
+	namespace string
+}
+
+// NewRedis creates a broker backed by Redis pub/sub so events reach subscribers on every replica
+func NewRedis(cfg *api.Redis) Broker {
+	return &redisBroker{
+		client: re.NewClient(&re.Options{
+			Addr: cfg.Address,
+		}),
+		namespace: cfg.Namespace,
+	}
+}
+
+// New creates the broker selected by backend, "redis" or "local"
+func New(backend string, cfg *api.Redis) Broker {
+	if backend == "redis" {
+		return NewRedis(cfg)
+	}
+	return NewLocal()
+}
+
+func (b *redisBroker) channel(topic string) string {
+	return fmt.Sprintf("%s:events:%s", b.namespace, topic)
+}
+
+func (b *redisBroker) Publish(ctx context.Context, topic string, payload []byte) error {
+	return b.client.Publish(ctx, b.channel(topic), payload).Err()
+}
+
+func (b *redisBroker) Subscribe(ctx context.Context, topic string) (<-chan []byte, func(), error) {
+	ch := make(chan []byte, subscriberBuffer)
+	pubsub := b.client.Subscribe(ctx, b.channel(topic))
+	// go-redis subscribes lazily, wait for Redis to confirm so no event published from now on is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, nil, fmt.Errorf("failed to subscribe to %s: %w", topic, err)
+	}
+
+	go func() {
+		for msg := range pubsub.Channel() {
+			// Slow subscribers drop events instead of stalling the Redis connection
+			select {
+			case ch <- []byte(msg.Payload):
+			default:
+			}
+		}
+	}()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			_ = pubsub.Close()
+		})
+	}
+	return ch, unsubscribe, nil
+}
@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 	re "github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
@@ -18,6 +19,12 @@ type Redis interface {
 	Set(ctx context.Context, key string, value proto.Message, expireTime time.Duration) (bool, error)
 	Get(ctx context.Context, key string) ([]byte, error)
 	Delete(ctx context.Context, key string) (bool, error)
+	SetBytes(ctx context.Context, key string, value []byte, expireTime time.Duration) (bool, error)
+	Expire(ctx context.Context, key string, expireTime time.Duration) (bool, error)
+	ZAdd(ctx context.Context, key string, score float64, member string) error
+	ZCard(ctx context.Context, key string) (int64, error)
+	ZPopMin(ctx context.Context, key string, count int64) ([]string, error)
+	ZRemRangeByScore(ctx context.Context, key string, max float64) (int64, error)
 }
 
 type redis struct {
@@ -86,4 +93,46 @@ func (r *redis) Delete(ctx context.Context, key string) (bool, error) {
 		return false, err
 	}
 	return result > 0, nil
-}
\ No newline at end of file
+}
+
+// SetBytes stores a raw value, unlike Set it does not JSON-encode a proto
+func (r *redis) SetBytes(ctx context.Context, key string, value []byte, expireTime time.Duration) (bool, error) {
+	namespacedKey := r.withNamespace(key)
+	if err := r.redis.Set(ctx, namespacedKey, value, expireTime).Err(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Expire resets the time to live of a key, it reports false when the key does not exist
+func (r *redis) Expire(ctx context.Context, key string, expireTime time.Duration) (bool, error) {
+	return r.redis.Expire(ctx, r.withNamespace(key), expireTime).Result()
+}
+
+func (r *redis) ZAdd(ctx context.Context, key string, score float64, member string) error {
+	return r.redis.ZAdd(ctx, r.withNamespace(key), re.Z{Score: score, Member: member}).Err()
+}
+
+func (r *redis) ZCard(ctx context.Context, key string) (int64, error) {
+	return r.redis.ZCard(ctx, r.withNamespace(key)).Result()
+}
+
+// ZPopMin removes and returns the members with the lowest scores
+func (r *redis) ZPopMin(ctx context.Context, key string, count int64) ([]string, error) {
+	popped, err := r.redis.ZPopMin(ctx, r.withNamespace(key), count).Result()
+	if err != nil {
+		return nil, err
+	}
+	members := make([]string, 0, len(popped))
+	for _, z := range popped {
+		if member, ok := z.Member.(string); ok {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+// ZRemRangeByScore removes the members scored at most max
+func (r *redis) ZRemRangeByScore(ctx context.Context, key string, max float64) (int64, error) {
+	return r.redis.ZRemRangeByScore(ctx, r.withNamespace(key), "-inf", strconv.FormatFloat(max, 'f', -1, 64)).Result()
+}
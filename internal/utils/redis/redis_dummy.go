@@ -24,4 +24,28 @@ func (d *dummy) Get(ctx context.Context, key string) ([]byte, error) {
 
 func (d *dummy) Delete(ctx context.Context, key string) (bool, error) {
 	return false, nil
-}
\ No newline at end of file
+}
+
+func (d *dummy) SetBytes(ctx context.Context, key string, value []byte, expireTime time.Duration) (bool, error) {
+	return false, nil
+}
+
+func (d *dummy) Expire(ctx context.Context, key string, expireTime time.Duration) (bool, error) {
+	return false, nil
+}
+
+func (d *dummy) ZAdd(ctx context.Context, key string, score float64, member string) error {
+	return nil
+}
+
+func (d *dummy) ZCard(ctx context.Context, key string) (int64, error) {
+	return 0, nil
+}
+
+func (d *dummy) ZPopMin(ctx context.Context, key string, count int64) ([]string, error) {
+	return nil, nil
+}
+
+func (d *dummy) ZRemRangeByScore(ctx context.Context, key string, max float64) (int64, error) {
+	return 0, nil
+}
@@ -1,29 +1,118 @@
 package sse
 
 import (
-    "sync"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "irelia/api"
+	"irelia/internal/utils/auth"
+	"irelia/internal/utils/broker"
 )
 
-var sseChannels sync.Map // key: uint64, value: chan map[string]interface{}
+// Annotator extracts the gateway headers of a request as gRPC metadata
+type Annotator func(ctx context.Context, req *http.Request) metadata.MD
+
+// Handler streams the interview events of the calling user as Server-Sent Events.
+// Every open tab holds its own subscription, so all of them receive every event.
+type Handler struct {
+	broker     broker.Broker
+	authorizer *auth.Authorizer
+	annotate   Annotator
+	topic      func(userID uint64) string
+	roles      []pb.BulbasaurRole
+	heartbeat  time.Duration
+	logger     *zap.Logger
+}
+
+func New(b broker.Broker, authorizer *auth.Authorizer, annotate Annotator, topic func(userID uint64) string, roles []pb.BulbasaurRole, heartbeat int, logger *zap.Logger) *Handler {
+	if heartbeat <= 0 {
+		heartbeat = 30
+	}
+	return &Handler{
+		broker:     b,
+		authorizer: authorizer,
+		annotate:   annotate,
+		topic:      topic,
+		roles:      roles,
+		heartbeat:  time.Duration(heartbeat) * time.Second,
+		logger:     logger,
+	}
+}
+
+// ServeHTTP matches the grpc-gateway runtime.HandlerFunc signature so it can be mounted with HandlePath
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx := metadata.NewIncomingContext(r.Context(), h.annotate(r.Context(), r))
+	principal, err := h.authorizer.Authenticate(ctx, h.roles)
+	if err != nil {
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	// Optionally narrow the stream to a single interview
+	interviewID := r.URL.Query().Get("interview_id")
+
+	events, unsubscribe, err := h.broker.Subscribe(r.Context(), h.topic(principal.UserID))
+	if err != nil {
+		h.logger.Error("Failed to subscribe to events", zap.Uint64("userID", principal.UserID), zap.Error(err))
+		http.Error(w, "event stream unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", (5 * time.Second).Milliseconds())
+	flusher.Flush()
+
+	h.logger.Info("SSE client connected", zap.Uint64("userID", principal.UserID), zap.String("interviewID", interviewID))
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
 
-func RegisterChannel(userID uint64, ch chan map[string]interface{}) {
-    sseChannels.Store(userID, ch)
+	for {
+		select {
+		case <-r.Context().Done():
+			h.logger.Info("SSE client disconnected", zap.Uint64("userID", principal.UserID))
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+		case payload := <-events:
+			var event pb.InterviewEvent
+			if err := protojson.Unmarshal(payload, &event); err != nil {
+				h.logger.Warn("Dropping malformed interview event", zap.Error(err))
+				continue
+			}
+			if interviewID != "" && event.InterviewId != interviewID {
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", EventName(event.Type), payload)
+			flusher.Flush()
+		}
+	}
 }
 
-func UnregisterChannel(userID uint64) {
-    sseChannels.Delete(userID)
+// EventName returns the SSE event name of an interview event type, e.g. question_ready
+func EventName(eventType pb.InterviewEventType) string {
+	return strings.ToLower(strings.TrimPrefix(eventType.String(), "INTERVIEW_EVENT_"))
 }
 
-func SendToUser(userID uint64, notification map[string]interface{}) bool {
-    if chVal, ok := sseChannels.Load(userID); ok {
-        if ch, ok := chVal.(chan map[string]interface{}); ok {
-            select {
-            case ch <- notification:
-                return true
-            default:
-                return false
-            }
-        }
-    }
-    return false
-}
\ No newline at end of file
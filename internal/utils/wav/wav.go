@@ -0,0 +1,105 @@
+package wav
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrFormatMismatch is returned when concatenating clips that do not share the same sample format
+var ErrFormatMismatch = errors.New("wav clips have different formats")
+
+// Clip is a parsed RIFF/WAVE file
+type Clip struct {
+	// Format is the raw fmt chunk, clips can only be joined when it is identical
+	Format []byte
+	Data   []byte
+}
+
+// Parse reads the fmt and data chunks of a WAV file, other chunks are dropped
+func Parse(file []byte) (*Clip, error) {
+	if len(file) < 12 || string(file[0:4]) != "RIFF" || string(file[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("not a wav file")
+	}
+
+	clip := &Clip{}
+	for offset := 12; offset+8 <= len(file); {
+		id := string(file[offset : offset+4])
+		size := int(binary.LittleEndian.Uint32(file[offset+4 : offset+8]))
+		start := offset + 8
+		end := start + size
+		if end > len(file) {
+			// Streaming encoders leave the size of the last chunk unset
+			if id != "data" {
+				return nil, fmt.Errorf("truncated %q chunk", id)
+			}
+			end = len(file)
+		}
+
+		switch id {
+		case "fmt ":
+			clip.Format = file[start:end]
+		case "data":
+			clip.Data = file[start:end]
+		}
+		// Chunks are padded to an even size
+		offset = end + size%2
+	}
+
+	if len(clip.Format) < 16 {
+		return nil, fmt.Errorf("missing fmt chunk")
+	}
+	if clip.Data == nil {
+		return nil, fmt.Errorf("missing data chunk")
+	}
+	return clip, nil
+}
+
+// Duration returns the length of the clip in seconds
+func (c *Clip) Duration() float32 {
+	byteRate := binary.LittleEndian.Uint32(c.Format[8:12])
+	if byteRate == 0 {
+		return 0
+	}
+	return float32(len(c.Data)) / float32(byteRate)
+}
+
+// Encode writes the clip back as a WAV file
+func (c *Clip) Encode() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(4+8+len(c.Format)+len(c.Format)%2+8+len(c.Data)))
+	buf.WriteString("WAVE")
+	buf.WriteString("fmt ")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(c.Format)))
+	buf.Write(c.Format)
+	if len(c.Format)%2 == 1 {
+		buf.WriteByte(0)
+	}
+	buf.WriteString("data")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(c.Data)))
+	buf.Write(c.Data)
+	return buf.Bytes()
+}
+
+// Concat joins clips sharing the same format into one
+func Concat(clips []*Clip) (*Clip, error) {
+	if len(clips) == 0 {
+		return nil, fmt.Errorf("no clips to concatenate")
+	}
+
+	size := 0
+	for _, clip := range clips {
+		if !bytes.Equal(clip.Format, clips[0].Format) {
+			return nil, ErrFormatMismatch
+		}
+		size += len(clip.Data)
+	}
+
+	data := make([]byte, 0, size)
+	for _, clip := range clips {
+		data = append(data, clip.Data...)
+	}
+	return &Clip{Format: clips[0].Format, Data: data}, nil
+}
@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"irelia/pkg/ent/interview"
 	"irelia/pkg/ent/interviewfavorite"
+	"irelia/pkg/ent/job"
+	"irelia/pkg/ent/moderationaudit"
 	"irelia/pkg/ent/publicquestion"
 	"irelia/pkg/ent/question"
+	"irelia/pkg/ent/quota"
+	"irelia/pkg/ent/usage"
 	"reflect"
 	"sync"
 
@@ -78,8 +82,12 @@ func checkColumn(table, column string) error {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			interview.Table:         interview.ValidColumn,
 			interviewfavorite.Table: interviewfavorite.ValidColumn,
+			job.Table:               job.ValidColumn,
+			moderationaudit.Table:   moderationaudit.ValidColumn,
 			publicquestion.Table:    publicquestion.ValidColumn,
 			question.Table:          question.ValidColumn,
+			quota.Table:             quota.ValidColumn,
+			usage.Table:             usage.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
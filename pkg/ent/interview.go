@@ -23,6 +23,8 @@ type Interview struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID uint64 `json:"user_id,omitempty"`
 	// Position holds the value of the "position" field.
@@ -41,6 +43,12 @@ type Interview struct {
 	SkillsScore []string `json:"skills_score,omitempty"`
 	// SkipCode holds the value of the "skip_code" field.
 	SkipCode bool `json:"skip_code,omitempty"`
+	// QuestionTimeLimit holds the value of the "question_time_limit" field.
+	QuestionTimeLimit int32 `json:"question_time_limit,omitempty"`
+	// FluencyScoring holds the value of the "fluency_scoring" field.
+	FluencyScoring bool `json:"fluency_scoring,omitempty"`
+	// TextOnly holds the value of the "text_only" field.
+	TextOnly bool `json:"text_only,omitempty"`
 	// TotalQuestions holds the value of the "total_questions" field.
 	TotalQuestions int32 `json:"total_questions,omitempty"`
 	// RemainingQuestions holds the value of the "remaining_questions" field.
@@ -49,6 +57,8 @@ type Interview struct {
 	TotalScore *irelia.TotalScore `json:"total_score,omitempty"`
 	// OverallScore holds the value of the "overall_score" field.
 	OverallScore float64 `json:"overall_score,omitempty"`
+	// FluencyResult holds the value of the "fluency_result" field.
+	FluencyResult *irelia.ScoreFluencyResponse `json:"fluency_result,omitempty"`
 	// PositiveFeedback holds the value of the "positive_feedback" field.
 	PositiveFeedback string `json:"positive_feedback,omitempty"`
 	// ActionableFeedback holds the value of the "actionable_feedback" field.
@@ -57,6 +67,8 @@ type Interview struct {
 	FinalComment string `json:"final_comment,omitempty"`
 	// Status holds the value of the "status" field.
 	Status irelia.InterviewStatus `json:"status,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason string `json:"failure_reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InterviewQuery when eager-loading is set.
 	Edges        InterviewEdges `json:"edges"`
@@ -97,17 +109,17 @@ func (*Interview) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case interview.FieldSkills, interview.FieldSkillsScore, interview.FieldTotalScore:
+		case interview.FieldSkills, interview.FieldSkillsScore, interview.FieldTotalScore, interview.FieldFluencyResult:
 			values[i] = new([]byte)
-		case interview.FieldSkipCode:
+		case interview.FieldSkipCode, interview.FieldFluencyScoring, interview.FieldTextOnly:
 			values[i] = new(sql.NullBool)
 		case interview.FieldOverallScore:
 			values[i] = new(sql.NullFloat64)
-		case interview.FieldUserID, interview.FieldSpeed, interview.FieldTotalQuestions, interview.FieldRemainingQuestions, interview.FieldStatus:
+		case interview.FieldUserID, interview.FieldSpeed, interview.FieldQuestionTimeLimit, interview.FieldTotalQuestions, interview.FieldRemainingQuestions, interview.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case interview.FieldID, interview.FieldPosition, interview.FieldExperience, interview.FieldLanguage, interview.FieldVoiceID, interview.FieldPositiveFeedback, interview.FieldActionableFeedback, interview.FieldFinalComment:
+		case interview.FieldID, interview.FieldPosition, interview.FieldExperience, interview.FieldLanguage, interview.FieldVoiceID, interview.FieldPositiveFeedback, interview.FieldActionableFeedback, interview.FieldFinalComment, interview.FieldFailureReason:
 			values[i] = new(sql.NullString)
-		case interview.FieldCreatedAt, interview.FieldUpdatedAt:
+		case interview.FieldCreatedAt, interview.FieldUpdatedAt, interview.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -142,6 +154,13 @@ func (i *Interview) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.UpdatedAt = value.Time
 			}
+		case interview.FieldDeletedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[j])
+			} else if value.Valid {
+				i.DeletedAt = new(time.Time)
+				*i.DeletedAt = value.Time
+			}
 		case interview.FieldUserID:
 			if value, ok := values[j].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[j])
@@ -200,6 +219,24 @@ func (i *Interview) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.SkipCode = value.Bool
 			}
+		case interview.FieldQuestionTimeLimit:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field question_time_limit", values[j])
+			} else if value.Valid {
+				i.QuestionTimeLimit = int32(value.Int64)
+			}
+		case interview.FieldFluencyScoring:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fluency_scoring", values[j])
+			} else if value.Valid {
+				i.FluencyScoring = value.Bool
+			}
+		case interview.FieldTextOnly:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field text_only", values[j])
+			} else if value.Valid {
+				i.TextOnly = value.Bool
+			}
 		case interview.FieldTotalQuestions:
 			if value, ok := values[j].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field total_questions", values[j])
@@ -226,6 +263,14 @@ func (i *Interview) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.OverallScore = value.Float64
 			}
+		case interview.FieldFluencyResult:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fluency_result", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.FluencyResult); err != nil {
+					return fmt.Errorf("unmarshal field fluency_result: %w", err)
+				}
+			}
 		case interview.FieldPositiveFeedback:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field positive_feedback", values[j])
@@ -250,6 +295,12 @@ func (i *Interview) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Status = irelia.InterviewStatus(value.Int64)
 			}
+		case interview.FieldFailureReason:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[j])
+			} else if value.Valid {
+				i.FailureReason = value.String
+			}
 		default:
 			i.selectValues.Set(columns[j], values[j])
 		}
@@ -302,6 +353,11 @@ func (i *Interview) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(i.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := i.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", i.UserID))
 	builder.WriteString(", ")
@@ -329,6 +385,15 @@ func (i *Interview) String() string {
 	builder.WriteString("skip_code=")
 	builder.WriteString(fmt.Sprintf("%v", i.SkipCode))
 	builder.WriteString(", ")
+	builder.WriteString("question_time_limit=")
+	builder.WriteString(fmt.Sprintf("%v", i.QuestionTimeLimit))
+	builder.WriteString(", ")
+	builder.WriteString("fluency_scoring=")
+	builder.WriteString(fmt.Sprintf("%v", i.FluencyScoring))
+	builder.WriteString(", ")
+	builder.WriteString("text_only=")
+	builder.WriteString(fmt.Sprintf("%v", i.TextOnly))
+	builder.WriteString(", ")
 	builder.WriteString("total_questions=")
 	builder.WriteString(fmt.Sprintf("%v", i.TotalQuestions))
 	builder.WriteString(", ")
@@ -341,6 +406,9 @@ func (i *Interview) String() string {
 	builder.WriteString("overall_score=")
 	builder.WriteString(fmt.Sprintf("%v", i.OverallScore))
 	builder.WriteString(", ")
+	builder.WriteString("fluency_result=")
+	builder.WriteString(fmt.Sprintf("%v", i.FluencyResult))
+	builder.WriteString(", ")
 	builder.WriteString("positive_feedback=")
 	builder.WriteString(i.PositiveFeedback)
 	builder.WriteString(", ")
@@ -352,6 +420,9 @@ func (i *Interview) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", i.Status))
+	builder.WriteString(", ")
+	builder.WriteString("failure_reason=")
+	builder.WriteString(i.FailureReason)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -18,6 +18,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldPosition holds the string denoting the position field in the database.
@@ -36,6 +38,12 @@ const (
 	FieldSkillsScore = "skills_score"
 	// FieldSkipCode holds the string denoting the skip_code field in the database.
 	FieldSkipCode = "skip_code"
+	// FieldQuestionTimeLimit holds the string denoting the question_time_limit field in the database.
+	FieldQuestionTimeLimit = "question_time_limit"
+	// FieldFluencyScoring holds the string denoting the fluency_scoring field in the database.
+	FieldFluencyScoring = "fluency_scoring"
+	// FieldTextOnly holds the string denoting the text_only field in the database.
+	FieldTextOnly = "text_only"
 	// FieldTotalQuestions holds the string denoting the total_questions field in the database.
 	FieldTotalQuestions = "total_questions"
 	// FieldRemainingQuestions holds the string denoting the remaining_questions field in the database.
@@ -44,6 +52,8 @@ const (
 	FieldTotalScore = "total_score"
 	// FieldOverallScore holds the string denoting the overall_score field in the database.
 	FieldOverallScore = "overall_score"
+	// FieldFluencyResult holds the string denoting the fluency_result field in the database.
+	FieldFluencyResult = "fluency_result"
 	// FieldPositiveFeedback holds the string denoting the positive_feedback field in the database.
 	FieldPositiveFeedback = "positive_feedback"
 	// FieldActionableFeedback holds the string denoting the actionable_feedback field in the database.
@@ -52,6 +62,8 @@ const (
 	FieldFinalComment = "final_comment"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldFailureReason holds the string denoting the failure_reason field in the database.
+	FieldFailureReason = "failure_reason"
 	// EdgeQuestions holds the string denoting the questions edge name in mutations.
 	EdgeQuestions = "questions"
 	// EdgeFavorites holds the string denoting the favorites edge name in mutations.
@@ -79,6 +91,7 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldUserID,
 	FieldPosition,
 	FieldExperience,
@@ -88,14 +101,19 @@ var Columns = []string{
 	FieldSkills,
 	FieldSkillsScore,
 	FieldSkipCode,
+	FieldQuestionTimeLimit,
+	FieldFluencyScoring,
+	FieldTextOnly,
 	FieldTotalQuestions,
 	FieldRemainingQuestions,
 	FieldTotalScore,
 	FieldOverallScore,
+	FieldFluencyResult,
 	FieldPositiveFeedback,
 	FieldActionableFeedback,
 	FieldFinalComment,
 	FieldStatus,
+	FieldFailureReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -123,6 +141,12 @@ var (
 	DefaultSpeed int32
 	// DefaultSkipCode holds the default value on creation for the "skip_code" field.
 	DefaultSkipCode bool
+	// DefaultQuestionTimeLimit holds the default value on creation for the "question_time_limit" field.
+	DefaultQuestionTimeLimit int32
+	// DefaultFluencyScoring holds the default value on creation for the "fluency_scoring" field.
+	DefaultFluencyScoring bool
+	// DefaultTextOnly holds the default value on creation for the "text_only" field.
+	DefaultTextOnly bool
 	// DefaultTotalQuestions holds the default value on creation for the "total_questions" field.
 	DefaultTotalQuestions int32
 	// DefaultRemainingQuestions holds the default value on creation for the "remaining_questions" field.
@@ -149,6 +173,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -184,6 +213,21 @@ func BySkipCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSkipCode, opts...).ToFunc()
 }
 
+// ByQuestionTimeLimit orders the results by the question_time_limit field.
+func ByQuestionTimeLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionTimeLimit, opts...).ToFunc()
+}
+
+// ByFluencyScoring orders the results by the fluency_scoring field.
+func ByFluencyScoring(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFluencyScoring, opts...).ToFunc()
+}
+
+// ByTextOnly orders the results by the text_only field.
+func ByTextOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTextOnly, opts...).ToFunc()
+}
+
 // ByTotalQuestions orders the results by the total_questions field.
 func ByTotalQuestions(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalQuestions, opts...).ToFunc()
@@ -219,6 +263,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByFailureReason orders the results by the failure_reason field.
+func ByFailureReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureReason, opts...).ToFunc()
+}
+
 // ByQuestionsCount orders the results by questions count.
 func ByQuestionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
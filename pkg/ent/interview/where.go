@@ -76,6 +76,11 @@ func UpdatedAt(v time.Time) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v uint64) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldUserID, v))
@@ -111,6 +116,21 @@ func SkipCode(v bool) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldSkipCode, v))
 }
 
+// QuestionTimeLimit applies equality check predicate on the "question_time_limit" field. It's identical to QuestionTimeLimitEQ.
+func QuestionTimeLimit(v int32) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldQuestionTimeLimit, v))
+}
+
+// FluencyScoring applies equality check predicate on the "fluency_scoring" field. It's identical to FluencyScoringEQ.
+func FluencyScoring(v bool) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldFluencyScoring, v))
+}
+
+// TextOnly applies equality check predicate on the "text_only" field. It's identical to TextOnlyEQ.
+func TextOnly(v bool) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldTextOnly, v))
+}
+
 // TotalQuestions applies equality check predicate on the "total_questions" field. It's identical to TotalQuestionsEQ.
 func TotalQuestions(v int32) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldTotalQuestions, v))
@@ -147,6 +167,11 @@ func Status(v irelia.InterviewStatus) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldStatus, vc))
 }
 
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldFailureReason, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldCreatedAt, v))
@@ -227,6 +252,56 @@ func UpdatedAtLTE(v time.Time) predicate.Interview {
 	return predicate.Interview(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Interview {
+	return predicate.Interview(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Interview {
+	return predicate.Interview(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Interview {
+	return predicate.Interview(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uint64) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldUserID, v))
@@ -617,6 +692,66 @@ func SkipCodeNEQ(v bool) predicate.Interview {
 	return predicate.Interview(sql.FieldNEQ(FieldSkipCode, v))
 }
 
+// QuestionTimeLimitEQ applies the EQ predicate on the "question_time_limit" field.
+func QuestionTimeLimitEQ(v int32) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldQuestionTimeLimit, v))
+}
+
+// QuestionTimeLimitNEQ applies the NEQ predicate on the "question_time_limit" field.
+func QuestionTimeLimitNEQ(v int32) predicate.Interview {
+	return predicate.Interview(sql.FieldNEQ(FieldQuestionTimeLimit, v))
+}
+
+// QuestionTimeLimitIn applies the In predicate on the "question_time_limit" field.
+func QuestionTimeLimitIn(vs ...int32) predicate.Interview {
+	return predicate.Interview(sql.FieldIn(FieldQuestionTimeLimit, vs...))
+}
+
+// QuestionTimeLimitNotIn applies the NotIn predicate on the "question_time_limit" field.
+func QuestionTimeLimitNotIn(vs ...int32) predicate.Interview {
+	return predicate.Interview(sql.FieldNotIn(FieldQuestionTimeLimit, vs...))
+}
+
+// QuestionTimeLimitGT applies the GT predicate on the "question_time_limit" field.
+func QuestionTimeLimitGT(v int32) predicate.Interview {
+	return predicate.Interview(sql.FieldGT(FieldQuestionTimeLimit, v))
+}
+
+// QuestionTimeLimitGTE applies the GTE predicate on the "question_time_limit" field.
+func QuestionTimeLimitGTE(v int32) predicate.Interview {
+	return predicate.Interview(sql.FieldGTE(FieldQuestionTimeLimit, v))
+}
+
+// QuestionTimeLimitLT applies the LT predicate on the "question_time_limit" field.
+func QuestionTimeLimitLT(v int32) predicate.Interview {
+	return predicate.Interview(sql.FieldLT(FieldQuestionTimeLimit, v))
+}
+
+// QuestionTimeLimitLTE applies the LTE predicate on the "question_time_limit" field.
+func QuestionTimeLimitLTE(v int32) predicate.Interview {
+	return predicate.Interview(sql.FieldLTE(FieldQuestionTimeLimit, v))
+}
+
+// FluencyScoringEQ applies the EQ predicate on the "fluency_scoring" field.
+func FluencyScoringEQ(v bool) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldFluencyScoring, v))
+}
+
+// FluencyScoringNEQ applies the NEQ predicate on the "fluency_scoring" field.
+func FluencyScoringNEQ(v bool) predicate.Interview {
+	return predicate.Interview(sql.FieldNEQ(FieldFluencyScoring, v))
+}
+
+// TextOnlyEQ applies the EQ predicate on the "text_only" field.
+func TextOnlyEQ(v bool) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldTextOnly, v))
+}
+
+// TextOnlyNEQ applies the NEQ predicate on the "text_only" field.
+func TextOnlyNEQ(v bool) predicate.Interview {
+	return predicate.Interview(sql.FieldNEQ(FieldTextOnly, v))
+}
+
 // TotalQuestionsEQ applies the EQ predicate on the "total_questions" field.
 func TotalQuestionsEQ(v int32) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldTotalQuestions, v))
@@ -747,6 +882,16 @@ func OverallScoreLTE(v float64) predicate.Interview {
 	return predicate.Interview(sql.FieldLTE(FieldOverallScore, v))
 }
 
+// FluencyResultIsNil applies the IsNil predicate on the "fluency_result" field.
+func FluencyResultIsNil() predicate.Interview {
+	return predicate.Interview(sql.FieldIsNull(FieldFluencyResult))
+}
+
+// FluencyResultNotNil applies the NotNil predicate on the "fluency_result" field.
+func FluencyResultNotNil() predicate.Interview {
+	return predicate.Interview(sql.FieldNotNull(FieldFluencyResult))
+}
+
 // PositiveFeedbackEQ applies the EQ predicate on the "positive_feedback" field.
 func PositiveFeedbackEQ(v string) predicate.Interview {
 	return predicate.Interview(sql.FieldEQ(FieldPositiveFeedback, v))
@@ -1026,6 +1171,81 @@ func StatusLTE(v irelia.InterviewStatus) predicate.Interview {
 	return predicate.Interview(sql.FieldLTE(FieldStatus, vc))
 }
 
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldNEQ(FieldFailureReason, v))
+}
+
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.Interview {
+	return predicate.Interview(sql.FieldIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.Interview {
+	return predicate.Interview(sql.FieldNotIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldGT(FieldFailureReason, v))
+}
+
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonIsNil applies the IsNil predicate on the "failure_reason" field.
+func FailureReasonIsNil() predicate.Interview {
+	return predicate.Interview(sql.FieldIsNull(FieldFailureReason))
+}
+
+// FailureReasonNotNil applies the NotNil predicate on the "failure_reason" field.
+func FailureReasonNotNil() predicate.Interview {
+	return predicate.Interview(sql.FieldNotNull(FieldFailureReason))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.Interview {
+	return predicate.Interview(sql.FieldContainsFold(FieldFailureReason, v))
+}
+
 // HasQuestions applies the HasEdge predicate on the "questions" edge.
 func HasQuestions() predicate.Interview {
 	return predicate.Interview(func(s *sql.Selector) {
@@ -12,6 +12,8 @@ import (
 	"irelia/pkg/ent/question"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -21,6 +23,7 @@ type InterviewCreate struct {
 	config
 	mutation *InterviewMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -51,6 +54,20 @@ func (ic *InterviewCreate) SetNillableUpdatedAt(t *time.Time) *InterviewCreate {
 	return ic
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *InterviewCreate) SetDeletedAt(t time.Time) *InterviewCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *InterviewCreate) SetNillableDeletedAt(t *time.Time) *InterviewCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
 // SetUserID sets the "user_id" field.
 func (ic *InterviewCreate) SetUserID(u uint64) *InterviewCreate {
 	ic.mutation.SetUserID(u)
@@ -137,6 +154,48 @@ func (ic *InterviewCreate) SetNillableSkipCode(b *bool) *InterviewCreate {
 	return ic
 }
 
+// SetQuestionTimeLimit sets the "question_time_limit" field.
+func (ic *InterviewCreate) SetQuestionTimeLimit(i int32) *InterviewCreate {
+	ic.mutation.SetQuestionTimeLimit(i)
+	return ic
+}
+
+// SetNillableQuestionTimeLimit sets the "question_time_limit" field if the given value is not nil.
+func (ic *InterviewCreate) SetNillableQuestionTimeLimit(i *int32) *InterviewCreate {
+	if i != nil {
+		ic.SetQuestionTimeLimit(*i)
+	}
+	return ic
+}
+
+// SetFluencyScoring sets the "fluency_scoring" field.
+func (ic *InterviewCreate) SetFluencyScoring(b bool) *InterviewCreate {
+	ic.mutation.SetFluencyScoring(b)
+	return ic
+}
+
+// SetNillableFluencyScoring sets the "fluency_scoring" field if the given value is not nil.
+func (ic *InterviewCreate) SetNillableFluencyScoring(b *bool) *InterviewCreate {
+	if b != nil {
+		ic.SetFluencyScoring(*b)
+	}
+	return ic
+}
+
+// SetTextOnly sets the "text_only" field.
+func (ic *InterviewCreate) SetTextOnly(b bool) *InterviewCreate {
+	ic.mutation.SetTextOnly(b)
+	return ic
+}
+
+// SetNillableTextOnly sets the "text_only" field if the given value is not nil.
+func (ic *InterviewCreate) SetNillableTextOnly(b *bool) *InterviewCreate {
+	if b != nil {
+		ic.SetTextOnly(*b)
+	}
+	return ic
+}
+
 // SetTotalQuestions sets the "total_questions" field.
 func (ic *InterviewCreate) SetTotalQuestions(i int32) *InterviewCreate {
 	ic.mutation.SetTotalQuestions(i)
@@ -185,6 +244,12 @@ func (ic *InterviewCreate) SetNillableOverallScore(f *float64) *InterviewCreate
 	return ic
 }
 
+// SetFluencyResult sets the "fluency_result" field.
+func (ic *InterviewCreate) SetFluencyResult(ifr *irelia.ScoreFluencyResponse) *InterviewCreate {
+	ic.mutation.SetFluencyResult(ifr)
+	return ic
+}
+
 // SetPositiveFeedback sets the "positive_feedback" field.
 func (ic *InterviewCreate) SetPositiveFeedback(s string) *InterviewCreate {
 	ic.mutation.SetPositiveFeedback(s)
@@ -233,6 +298,20 @@ func (ic *InterviewCreate) SetStatus(is irelia.InterviewStatus) *InterviewCreate
 	return ic
 }
 
+// SetFailureReason sets the "failure_reason" field.
+func (ic *InterviewCreate) SetFailureReason(s string) *InterviewCreate {
+	ic.mutation.SetFailureReason(s)
+	return ic
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (ic *InterviewCreate) SetNillableFailureReason(s *string) *InterviewCreate {
+	if s != nil {
+		ic.SetFailureReason(*s)
+	}
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *InterviewCreate) SetID(s string) *InterviewCreate {
 	ic.mutation.SetID(s)
@@ -320,6 +399,18 @@ func (ic *InterviewCreate) defaults() {
 		v := interview.DefaultSkipCode
 		ic.mutation.SetSkipCode(v)
 	}
+	if _, ok := ic.mutation.QuestionTimeLimit(); !ok {
+		v := interview.DefaultQuestionTimeLimit
+		ic.mutation.SetQuestionTimeLimit(v)
+	}
+	if _, ok := ic.mutation.FluencyScoring(); !ok {
+		v := interview.DefaultFluencyScoring
+		ic.mutation.SetFluencyScoring(v)
+	}
+	if _, ok := ic.mutation.TextOnly(); !ok {
+		v := interview.DefaultTextOnly
+		ic.mutation.SetTextOnly(v)
+	}
 	if _, ok := ic.mutation.TotalQuestions(); !ok {
 		v := interview.DefaultTotalQuestions
 		ic.mutation.SetTotalQuestions(v)
@@ -367,6 +458,15 @@ func (ic *InterviewCreate) check() error {
 	if _, ok := ic.mutation.SkipCode(); !ok {
 		return &ValidationError{Name: "skip_code", err: errors.New(`ent: missing required field "Interview.skip_code"`)}
 	}
+	if _, ok := ic.mutation.QuestionTimeLimit(); !ok {
+		return &ValidationError{Name: "question_time_limit", err: errors.New(`ent: missing required field "Interview.question_time_limit"`)}
+	}
+	if _, ok := ic.mutation.FluencyScoring(); !ok {
+		return &ValidationError{Name: "fluency_scoring", err: errors.New(`ent: missing required field "Interview.fluency_scoring"`)}
+	}
+	if _, ok := ic.mutation.TextOnly(); !ok {
+		return &ValidationError{Name: "text_only", err: errors.New(`ent: missing required field "Interview.text_only"`)}
+	}
 	if _, ok := ic.mutation.TotalQuestions(); !ok {
 		return &ValidationError{Name: "total_questions", err: errors.New(`ent: missing required field "Interview.total_questions"`)}
 	}
@@ -410,6 +510,7 @@ func (ic *InterviewCreate) createSpec() (*Interview, *sqlgraph.CreateSpec) {
 		_node = &Interview{config: ic.config}
 		_spec = sqlgraph.NewCreateSpec(interview.Table, sqlgraph.NewFieldSpec(interview.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = ic.conflict
 	if id, ok := ic.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -422,6 +523,10 @@ func (ic *InterviewCreate) createSpec() (*Interview, *sqlgraph.CreateSpec) {
 		_spec.SetField(interview.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(interview.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := ic.mutation.UserID(); ok {
 		_spec.SetField(interview.FieldUserID, field.TypeUint64, value)
 		_node.UserID = value
@@ -458,6 +563,18 @@ func (ic *InterviewCreate) createSpec() (*Interview, *sqlgraph.CreateSpec) {
 		_spec.SetField(interview.FieldSkipCode, field.TypeBool, value)
 		_node.SkipCode = value
 	}
+	if value, ok := ic.mutation.QuestionTimeLimit(); ok {
+		_spec.SetField(interview.FieldQuestionTimeLimit, field.TypeInt32, value)
+		_node.QuestionTimeLimit = value
+	}
+	if value, ok := ic.mutation.FluencyScoring(); ok {
+		_spec.SetField(interview.FieldFluencyScoring, field.TypeBool, value)
+		_node.FluencyScoring = value
+	}
+	if value, ok := ic.mutation.TextOnly(); ok {
+		_spec.SetField(interview.FieldTextOnly, field.TypeBool, value)
+		_node.TextOnly = value
+	}
 	if value, ok := ic.mutation.TotalQuestions(); ok {
 		_spec.SetField(interview.FieldTotalQuestions, field.TypeInt32, value)
 		_node.TotalQuestions = value
@@ -474,6 +591,10 @@ func (ic *InterviewCreate) createSpec() (*Interview, *sqlgraph.CreateSpec) {
 		_spec.SetField(interview.FieldOverallScore, field.TypeFloat64, value)
 		_node.OverallScore = value
 	}
+	if value, ok := ic.mutation.FluencyResult(); ok {
+		_spec.SetField(interview.FieldFluencyResult, field.TypeJSON, value)
+		_node.FluencyResult = value
+	}
 	if value, ok := ic.mutation.PositiveFeedback(); ok {
 		_spec.SetField(interview.FieldPositiveFeedback, field.TypeString, value)
 		_node.PositiveFeedback = value
@@ -490,6 +611,10 @@ func (ic *InterviewCreate) createSpec() (*Interview, *sqlgraph.CreateSpec) {
 		_spec.SetField(interview.FieldStatus, field.TypeInt32, value)
 		_node.Status = value
 	}
+	if value, ok := ic.mutation.FailureReason(); ok {
+		_spec.SetField(interview.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
+	}
 	if nodes := ic.mutation.QuestionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -525,86 +650,1609 @@ func (ic *InterviewCreate) createSpec() (*Interview, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
-// InterviewCreateBulk is the builder for creating many Interview entities in bulk.
-type InterviewCreateBulk struct {
-	config
-	err      error
-	builders []*InterviewCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Interview.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InterviewUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *InterviewCreate) OnConflict(opts ...sql.ConflictOption) *InterviewUpsertOne {
+	ic.conflict = opts
+	return &InterviewUpsertOne{
+		create: ic,
+	}
 }
 
-// Save creates the Interview entities in the database.
-func (icb *InterviewCreateBulk) Save(ctx context.Context) ([]*Interview, error) {
-	if icb.err != nil {
-		return nil, icb.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Interview.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *InterviewCreate) OnConflictColumns(columns ...string) *InterviewUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InterviewUpsertOne{
+		create: ic,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
-	nodes := make([]*Interview, len(icb.builders))
-	mutators := make([]Mutator, len(icb.builders))
-	for i := range icb.builders {
-		func(i int, root context.Context) {
-			builder := icb.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*InterviewMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// InterviewUpsertOne is the builder for "upsert"-ing
+	//  one Interview node.
+	InterviewUpsertOne struct {
+		create *InterviewCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// InterviewUpsert is the "OnConflict" setter.
+	InterviewUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterviewUpsert) SetUpdatedAt(v time.Time) *InterviewUpsert {
+	u.Set(interview.FieldUpdatedAt, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (icb *InterviewCreateBulk) SaveX(ctx context.Context) []*Interview {
-	v, err := icb.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateUpdatedAt() *InterviewUpsert {
+	u.SetExcluded(interview.FieldUpdatedAt)
+	return u
 }
 
-// Exec executes the query.
-func (icb *InterviewCreateBulk) Exec(ctx context.Context) error {
-	_, err := icb.Save(ctx)
-	return err
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InterviewUpsert) SetDeletedAt(v time.Time) *InterviewUpsert {
+	u.Set(interview.FieldDeletedAt, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (icb *InterviewCreateBulk) ExecX(ctx context.Context) {
-	if err := icb.Exec(ctx); err != nil {
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateDeletedAt() *InterviewUpsert {
+	u.SetExcluded(interview.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InterviewUpsert) ClearDeletedAt() *InterviewUpsert {
+	u.SetNull(interview.FieldDeletedAt)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *InterviewUpsert) SetPosition(v string) *InterviewUpsert {
+	u.Set(interview.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdatePosition() *InterviewUpsert {
+	u.SetExcluded(interview.FieldPosition)
+	return u
+}
+
+// SetExperience sets the "experience" field.
+func (u *InterviewUpsert) SetExperience(v string) *InterviewUpsert {
+	u.Set(interview.FieldExperience, v)
+	return u
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateExperience() *InterviewUpsert {
+	u.SetExcluded(interview.FieldExperience)
+	return u
+}
+
+// ClearExperience clears the value of the "experience" field.
+func (u *InterviewUpsert) ClearExperience() *InterviewUpsert {
+	u.SetNull(interview.FieldExperience)
+	return u
+}
+
+// SetLanguage sets the "language" field.
+func (u *InterviewUpsert) SetLanguage(v string) *InterviewUpsert {
+	u.Set(interview.FieldLanguage, v)
+	return u
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateLanguage() *InterviewUpsert {
+	u.SetExcluded(interview.FieldLanguage)
+	return u
+}
+
+// SetVoiceID sets the "voice_id" field.
+func (u *InterviewUpsert) SetVoiceID(v string) *InterviewUpsert {
+	u.Set(interview.FieldVoiceID, v)
+	return u
+}
+
+// UpdateVoiceID sets the "voice_id" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateVoiceID() *InterviewUpsert {
+	u.SetExcluded(interview.FieldVoiceID)
+	return u
+}
+
+// ClearVoiceID clears the value of the "voice_id" field.
+func (u *InterviewUpsert) ClearVoiceID() *InterviewUpsert {
+	u.SetNull(interview.FieldVoiceID)
+	return u
+}
+
+// SetSpeed sets the "speed" field.
+func (u *InterviewUpsert) SetSpeed(v int32) *InterviewUpsert {
+	u.Set(interview.FieldSpeed, v)
+	return u
+}
+
+// UpdateSpeed sets the "speed" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateSpeed() *InterviewUpsert {
+	u.SetExcluded(interview.FieldSpeed)
+	return u
+}
+
+// AddSpeed adds v to the "speed" field.
+func (u *InterviewUpsert) AddSpeed(v int32) *InterviewUpsert {
+	u.Add(interview.FieldSpeed, v)
+	return u
+}
+
+// SetSkills sets the "skills" field.
+func (u *InterviewUpsert) SetSkills(v []string) *InterviewUpsert {
+	u.Set(interview.FieldSkills, v)
+	return u
+}
+
+// UpdateSkills sets the "skills" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateSkills() *InterviewUpsert {
+	u.SetExcluded(interview.FieldSkills)
+	return u
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (u *InterviewUpsert) ClearSkills() *InterviewUpsert {
+	u.SetNull(interview.FieldSkills)
+	return u
+}
+
+// SetSkillsScore sets the "skills_score" field.
+func (u *InterviewUpsert) SetSkillsScore(v []string) *InterviewUpsert {
+	u.Set(interview.FieldSkillsScore, v)
+	return u
+}
+
+// UpdateSkillsScore sets the "skills_score" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateSkillsScore() *InterviewUpsert {
+	u.SetExcluded(interview.FieldSkillsScore)
+	return u
+}
+
+// ClearSkillsScore clears the value of the "skills_score" field.
+func (u *InterviewUpsert) ClearSkillsScore() *InterviewUpsert {
+	u.SetNull(interview.FieldSkillsScore)
+	return u
+}
+
+// SetSkipCode sets the "skip_code" field.
+func (u *InterviewUpsert) SetSkipCode(v bool) *InterviewUpsert {
+	u.Set(interview.FieldSkipCode, v)
+	return u
+}
+
+// UpdateSkipCode sets the "skip_code" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateSkipCode() *InterviewUpsert {
+	u.SetExcluded(interview.FieldSkipCode)
+	return u
+}
+
+// SetQuestionTimeLimit sets the "question_time_limit" field.
+func (u *InterviewUpsert) SetQuestionTimeLimit(v int32) *InterviewUpsert {
+	u.Set(interview.FieldQuestionTimeLimit, v)
+	return u
+}
+
+// UpdateQuestionTimeLimit sets the "question_time_limit" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateQuestionTimeLimit() *InterviewUpsert {
+	u.SetExcluded(interview.FieldQuestionTimeLimit)
+	return u
+}
+
+// AddQuestionTimeLimit adds v to the "question_time_limit" field.
+func (u *InterviewUpsert) AddQuestionTimeLimit(v int32) *InterviewUpsert {
+	u.Add(interview.FieldQuestionTimeLimit, v)
+	return u
+}
+
+// SetFluencyScoring sets the "fluency_scoring" field.
+func (u *InterviewUpsert) SetFluencyScoring(v bool) *InterviewUpsert {
+	u.Set(interview.FieldFluencyScoring, v)
+	return u
+}
+
+// UpdateFluencyScoring sets the "fluency_scoring" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateFluencyScoring() *InterviewUpsert {
+	u.SetExcluded(interview.FieldFluencyScoring)
+	return u
+}
+
+// SetTextOnly sets the "text_only" field.
+func (u *InterviewUpsert) SetTextOnly(v bool) *InterviewUpsert {
+	u.Set(interview.FieldTextOnly, v)
+	return u
+}
+
+// UpdateTextOnly sets the "text_only" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateTextOnly() *InterviewUpsert {
+	u.SetExcluded(interview.FieldTextOnly)
+	return u
+}
+
+// SetTotalQuestions sets the "total_questions" field.
+func (u *InterviewUpsert) SetTotalQuestions(v int32) *InterviewUpsert {
+	u.Set(interview.FieldTotalQuestions, v)
+	return u
+}
+
+// UpdateTotalQuestions sets the "total_questions" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateTotalQuestions() *InterviewUpsert {
+	u.SetExcluded(interview.FieldTotalQuestions)
+	return u
+}
+
+// AddTotalQuestions adds v to the "total_questions" field.
+func (u *InterviewUpsert) AddTotalQuestions(v int32) *InterviewUpsert {
+	u.Add(interview.FieldTotalQuestions, v)
+	return u
+}
+
+// SetRemainingQuestions sets the "remaining_questions" field.
+func (u *InterviewUpsert) SetRemainingQuestions(v int32) *InterviewUpsert {
+	u.Set(interview.FieldRemainingQuestions, v)
+	return u
+}
+
+// UpdateRemainingQuestions sets the "remaining_questions" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateRemainingQuestions() *InterviewUpsert {
+	u.SetExcluded(interview.FieldRemainingQuestions)
+	return u
+}
+
+// AddRemainingQuestions adds v to the "remaining_questions" field.
+func (u *InterviewUpsert) AddRemainingQuestions(v int32) *InterviewUpsert {
+	u.Add(interview.FieldRemainingQuestions, v)
+	return u
+}
+
+// SetTotalScore sets the "total_score" field.
+func (u *InterviewUpsert) SetTotalScore(v *irelia.TotalScore) *InterviewUpsert {
+	u.Set(interview.FieldTotalScore, v)
+	return u
+}
+
+// UpdateTotalScore sets the "total_score" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateTotalScore() *InterviewUpsert {
+	u.SetExcluded(interview.FieldTotalScore)
+	return u
+}
+
+// ClearTotalScore clears the value of the "total_score" field.
+func (u *InterviewUpsert) ClearTotalScore() *InterviewUpsert {
+	u.SetNull(interview.FieldTotalScore)
+	return u
+}
+
+// SetOverallScore sets the "overall_score" field.
+func (u *InterviewUpsert) SetOverallScore(v float64) *InterviewUpsert {
+	u.Set(interview.FieldOverallScore, v)
+	return u
+}
+
+// UpdateOverallScore sets the "overall_score" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateOverallScore() *InterviewUpsert {
+	u.SetExcluded(interview.FieldOverallScore)
+	return u
+}
+
+// AddOverallScore adds v to the "overall_score" field.
+func (u *InterviewUpsert) AddOverallScore(v float64) *InterviewUpsert {
+	u.Add(interview.FieldOverallScore, v)
+	return u
+}
+
+// SetFluencyResult sets the "fluency_result" field.
+func (u *InterviewUpsert) SetFluencyResult(v *irelia.ScoreFluencyResponse) *InterviewUpsert {
+	u.Set(interview.FieldFluencyResult, v)
+	return u
+}
+
+// UpdateFluencyResult sets the "fluency_result" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateFluencyResult() *InterviewUpsert {
+	u.SetExcluded(interview.FieldFluencyResult)
+	return u
+}
+
+// ClearFluencyResult clears the value of the "fluency_result" field.
+func (u *InterviewUpsert) ClearFluencyResult() *InterviewUpsert {
+	u.SetNull(interview.FieldFluencyResult)
+	return u
+}
+
+// SetPositiveFeedback sets the "positive_feedback" field.
+func (u *InterviewUpsert) SetPositiveFeedback(v string) *InterviewUpsert {
+	u.Set(interview.FieldPositiveFeedback, v)
+	return u
+}
+
+// UpdatePositiveFeedback sets the "positive_feedback" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdatePositiveFeedback() *InterviewUpsert {
+	u.SetExcluded(interview.FieldPositiveFeedback)
+	return u
+}
+
+// ClearPositiveFeedback clears the value of the "positive_feedback" field.
+func (u *InterviewUpsert) ClearPositiveFeedback() *InterviewUpsert {
+	u.SetNull(interview.FieldPositiveFeedback)
+	return u
+}
+
+// SetActionableFeedback sets the "actionable_feedback" field.
+func (u *InterviewUpsert) SetActionableFeedback(v string) *InterviewUpsert {
+	u.Set(interview.FieldActionableFeedback, v)
+	return u
+}
+
+// UpdateActionableFeedback sets the "actionable_feedback" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateActionableFeedback() *InterviewUpsert {
+	u.SetExcluded(interview.FieldActionableFeedback)
+	return u
+}
+
+// ClearActionableFeedback clears the value of the "actionable_feedback" field.
+func (u *InterviewUpsert) ClearActionableFeedback() *InterviewUpsert {
+	u.SetNull(interview.FieldActionableFeedback)
+	return u
+}
+
+// SetFinalComment sets the "final_comment" field.
+func (u *InterviewUpsert) SetFinalComment(v string) *InterviewUpsert {
+	u.Set(interview.FieldFinalComment, v)
+	return u
+}
+
+// UpdateFinalComment sets the "final_comment" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateFinalComment() *InterviewUpsert {
+	u.SetExcluded(interview.FieldFinalComment)
+	return u
+}
+
+// ClearFinalComment clears the value of the "final_comment" field.
+func (u *InterviewUpsert) ClearFinalComment() *InterviewUpsert {
+	u.SetNull(interview.FieldFinalComment)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *InterviewUpsert) SetStatus(v irelia.InterviewStatus) *InterviewUpsert {
+	u.Set(interview.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateStatus() *InterviewUpsert {
+	u.SetExcluded(interview.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *InterviewUpsert) AddStatus(v irelia.InterviewStatus) *InterviewUpsert {
+	u.Add(interview.FieldStatus, v)
+	return u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *InterviewUpsert) SetFailureReason(v string) *InterviewUpsert {
+	u.Set(interview.FieldFailureReason, v)
+	return u
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *InterviewUpsert) UpdateFailureReason() *InterviewUpsert {
+	u.SetExcluded(interview.FieldFailureReason)
+	return u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *InterviewUpsert) ClearFailureReason() *InterviewUpsert {
+	u.SetNull(interview.FieldFailureReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Interview.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(interview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InterviewUpsertOne) UpdateNewValues() *InterviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(interview.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(interview.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(interview.FieldUserID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Interview.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InterviewUpsertOne) Ignore() *InterviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InterviewUpsertOne) DoNothing() *InterviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InterviewCreate.OnConflict
+// documentation for more info.
+func (u *InterviewUpsertOne) Update(set func(*InterviewUpsert)) *InterviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InterviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterviewUpsertOne) SetUpdatedAt(v time.Time) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateUpdatedAt() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InterviewUpsertOne) SetDeletedAt(v time.Time) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateDeletedAt() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InterviewUpsertOne) ClearDeletedAt() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *InterviewUpsertOne) SetPosition(v string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdatePosition() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetExperience sets the "experience" field.
+func (u *InterviewUpsertOne) SetExperience(v string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetExperience(v)
+	})
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateExperience() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateExperience()
+	})
+}
+
+// ClearExperience clears the value of the "experience" field.
+func (u *InterviewUpsertOne) ClearExperience() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearExperience()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *InterviewUpsertOne) SetLanguage(v string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateLanguage() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
+// SetVoiceID sets the "voice_id" field.
+func (u *InterviewUpsertOne) SetVoiceID(v string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetVoiceID(v)
+	})
+}
+
+// UpdateVoiceID sets the "voice_id" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateVoiceID() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateVoiceID()
+	})
+}
+
+// ClearVoiceID clears the value of the "voice_id" field.
+func (u *InterviewUpsertOne) ClearVoiceID() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearVoiceID()
+	})
+}
+
+// SetSpeed sets the "speed" field.
+func (u *InterviewUpsertOne) SetSpeed(v int32) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetSpeed(v)
+	})
+}
+
+// AddSpeed adds v to the "speed" field.
+func (u *InterviewUpsertOne) AddSpeed(v int32) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddSpeed(v)
+	})
+}
+
+// UpdateSpeed sets the "speed" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateSpeed() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateSpeed()
+	})
+}
+
+// SetSkills sets the "skills" field.
+func (u *InterviewUpsertOne) SetSkills(v []string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetSkills(v)
+	})
+}
+
+// UpdateSkills sets the "skills" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateSkills() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateSkills()
+	})
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (u *InterviewUpsertOne) ClearSkills() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearSkills()
+	})
+}
+
+// SetSkillsScore sets the "skills_score" field.
+func (u *InterviewUpsertOne) SetSkillsScore(v []string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetSkillsScore(v)
+	})
+}
+
+// UpdateSkillsScore sets the "skills_score" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateSkillsScore() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateSkillsScore()
+	})
+}
+
+// ClearSkillsScore clears the value of the "skills_score" field.
+func (u *InterviewUpsertOne) ClearSkillsScore() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearSkillsScore()
+	})
+}
+
+// SetSkipCode sets the "skip_code" field.
+func (u *InterviewUpsertOne) SetSkipCode(v bool) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetSkipCode(v)
+	})
+}
+
+// UpdateSkipCode sets the "skip_code" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateSkipCode() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateSkipCode()
+	})
+}
+
+// SetQuestionTimeLimit sets the "question_time_limit" field.
+func (u *InterviewUpsertOne) SetQuestionTimeLimit(v int32) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetQuestionTimeLimit(v)
+	})
+}
+
+// AddQuestionTimeLimit adds v to the "question_time_limit" field.
+func (u *InterviewUpsertOne) AddQuestionTimeLimit(v int32) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddQuestionTimeLimit(v)
+	})
+}
+
+// UpdateQuestionTimeLimit sets the "question_time_limit" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateQuestionTimeLimit() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateQuestionTimeLimit()
+	})
+}
+
+// SetFluencyScoring sets the "fluency_scoring" field.
+func (u *InterviewUpsertOne) SetFluencyScoring(v bool) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetFluencyScoring(v)
+	})
+}
+
+// UpdateFluencyScoring sets the "fluency_scoring" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateFluencyScoring() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateFluencyScoring()
+	})
+}
+
+// SetTextOnly sets the "text_only" field.
+func (u *InterviewUpsertOne) SetTextOnly(v bool) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetTextOnly(v)
+	})
+}
+
+// UpdateTextOnly sets the "text_only" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateTextOnly() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateTextOnly()
+	})
+}
+
+// SetTotalQuestions sets the "total_questions" field.
+func (u *InterviewUpsertOne) SetTotalQuestions(v int32) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetTotalQuestions(v)
+	})
+}
+
+// AddTotalQuestions adds v to the "total_questions" field.
+func (u *InterviewUpsertOne) AddTotalQuestions(v int32) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddTotalQuestions(v)
+	})
+}
+
+// UpdateTotalQuestions sets the "total_questions" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateTotalQuestions() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateTotalQuestions()
+	})
+}
+
+// SetRemainingQuestions sets the "remaining_questions" field.
+func (u *InterviewUpsertOne) SetRemainingQuestions(v int32) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetRemainingQuestions(v)
+	})
+}
+
+// AddRemainingQuestions adds v to the "remaining_questions" field.
+func (u *InterviewUpsertOne) AddRemainingQuestions(v int32) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddRemainingQuestions(v)
+	})
+}
+
+// UpdateRemainingQuestions sets the "remaining_questions" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateRemainingQuestions() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateRemainingQuestions()
+	})
+}
+
+// SetTotalScore sets the "total_score" field.
+func (u *InterviewUpsertOne) SetTotalScore(v *irelia.TotalScore) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetTotalScore(v)
+	})
+}
+
+// UpdateTotalScore sets the "total_score" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateTotalScore() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateTotalScore()
+	})
+}
+
+// ClearTotalScore clears the value of the "total_score" field.
+func (u *InterviewUpsertOne) ClearTotalScore() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearTotalScore()
+	})
+}
+
+// SetOverallScore sets the "overall_score" field.
+func (u *InterviewUpsertOne) SetOverallScore(v float64) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetOverallScore(v)
+	})
+}
+
+// AddOverallScore adds v to the "overall_score" field.
+func (u *InterviewUpsertOne) AddOverallScore(v float64) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddOverallScore(v)
+	})
+}
+
+// UpdateOverallScore sets the "overall_score" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateOverallScore() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateOverallScore()
+	})
+}
+
+// SetFluencyResult sets the "fluency_result" field.
+func (u *InterviewUpsertOne) SetFluencyResult(v *irelia.ScoreFluencyResponse) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetFluencyResult(v)
+	})
+}
+
+// UpdateFluencyResult sets the "fluency_result" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateFluencyResult() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateFluencyResult()
+	})
+}
+
+// ClearFluencyResult clears the value of the "fluency_result" field.
+func (u *InterviewUpsertOne) ClearFluencyResult() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearFluencyResult()
+	})
+}
+
+// SetPositiveFeedback sets the "positive_feedback" field.
+func (u *InterviewUpsertOne) SetPositiveFeedback(v string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetPositiveFeedback(v)
+	})
+}
+
+// UpdatePositiveFeedback sets the "positive_feedback" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdatePositiveFeedback() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdatePositiveFeedback()
+	})
+}
+
+// ClearPositiveFeedback clears the value of the "positive_feedback" field.
+func (u *InterviewUpsertOne) ClearPositiveFeedback() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearPositiveFeedback()
+	})
+}
+
+// SetActionableFeedback sets the "actionable_feedback" field.
+func (u *InterviewUpsertOne) SetActionableFeedback(v string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetActionableFeedback(v)
+	})
+}
+
+// UpdateActionableFeedback sets the "actionable_feedback" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateActionableFeedback() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateActionableFeedback()
+	})
+}
+
+// ClearActionableFeedback clears the value of the "actionable_feedback" field.
+func (u *InterviewUpsertOne) ClearActionableFeedback() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearActionableFeedback()
+	})
+}
+
+// SetFinalComment sets the "final_comment" field.
+func (u *InterviewUpsertOne) SetFinalComment(v string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetFinalComment(v)
+	})
+}
+
+// UpdateFinalComment sets the "final_comment" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateFinalComment() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateFinalComment()
+	})
+}
+
+// ClearFinalComment clears the value of the "final_comment" field.
+func (u *InterviewUpsertOne) ClearFinalComment() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearFinalComment()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InterviewUpsertOne) SetStatus(v irelia.InterviewStatus) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *InterviewUpsertOne) AddStatus(v irelia.InterviewStatus) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateStatus() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *InterviewUpsertOne) SetFailureReason(v string) *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *InterviewUpsertOne) UpdateFailureReason() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *InterviewUpsertOne) ClearFailureReason() *InterviewUpsertOne {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// Exec executes the query.
+func (u *InterviewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InterviewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InterviewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InterviewUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InterviewUpsertOne.ID is not supported by MySQL driver. Use InterviewUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InterviewUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InterviewCreateBulk is the builder for creating many Interview entities in bulk.
+type InterviewCreateBulk struct {
+	config
+	err      error
+	builders []*InterviewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Interview entities in the database.
+func (icb *InterviewCreateBulk) Save(ctx context.Context) ([]*Interview, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Interview, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InterviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InterviewCreateBulk) SaveX(ctx context.Context) []*Interview {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InterviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InterviewCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Interview.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InterviewUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *InterviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *InterviewUpsertBulk {
+	icb.conflict = opts
+	return &InterviewUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Interview.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *InterviewCreateBulk) OnConflictColumns(columns ...string) *InterviewUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InterviewUpsertBulk{
+		create: icb,
+	}
+}
+
+// InterviewUpsertBulk is the builder for "upsert"-ing
+// a bulk of Interview nodes.
+type InterviewUpsertBulk struct {
+	create *InterviewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Interview.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(interview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InterviewUpsertBulk) UpdateNewValues() *InterviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(interview.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(interview.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(interview.FieldUserID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Interview.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InterviewUpsertBulk) Ignore() *InterviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InterviewUpsertBulk) DoNothing() *InterviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InterviewCreateBulk.OnConflict
+// documentation for more info.
+func (u *InterviewUpsertBulk) Update(set func(*InterviewUpsert)) *InterviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InterviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterviewUpsertBulk) SetUpdatedAt(v time.Time) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateUpdatedAt() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InterviewUpsertBulk) SetDeletedAt(v time.Time) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateDeletedAt() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InterviewUpsertBulk) ClearDeletedAt() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *InterviewUpsertBulk) SetPosition(v string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdatePosition() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetExperience sets the "experience" field.
+func (u *InterviewUpsertBulk) SetExperience(v string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetExperience(v)
+	})
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateExperience() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateExperience()
+	})
+}
+
+// ClearExperience clears the value of the "experience" field.
+func (u *InterviewUpsertBulk) ClearExperience() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearExperience()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *InterviewUpsertBulk) SetLanguage(v string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateLanguage() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
+// SetVoiceID sets the "voice_id" field.
+func (u *InterviewUpsertBulk) SetVoiceID(v string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetVoiceID(v)
+	})
+}
+
+// UpdateVoiceID sets the "voice_id" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateVoiceID() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateVoiceID()
+	})
+}
+
+// ClearVoiceID clears the value of the "voice_id" field.
+func (u *InterviewUpsertBulk) ClearVoiceID() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearVoiceID()
+	})
+}
+
+// SetSpeed sets the "speed" field.
+func (u *InterviewUpsertBulk) SetSpeed(v int32) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetSpeed(v)
+	})
+}
+
+// AddSpeed adds v to the "speed" field.
+func (u *InterviewUpsertBulk) AddSpeed(v int32) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddSpeed(v)
+	})
+}
+
+// UpdateSpeed sets the "speed" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateSpeed() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateSpeed()
+	})
+}
+
+// SetSkills sets the "skills" field.
+func (u *InterviewUpsertBulk) SetSkills(v []string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetSkills(v)
+	})
+}
+
+// UpdateSkills sets the "skills" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateSkills() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateSkills()
+	})
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (u *InterviewUpsertBulk) ClearSkills() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearSkills()
+	})
+}
+
+// SetSkillsScore sets the "skills_score" field.
+func (u *InterviewUpsertBulk) SetSkillsScore(v []string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetSkillsScore(v)
+	})
+}
+
+// UpdateSkillsScore sets the "skills_score" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateSkillsScore() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateSkillsScore()
+	})
+}
+
+// ClearSkillsScore clears the value of the "skills_score" field.
+func (u *InterviewUpsertBulk) ClearSkillsScore() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearSkillsScore()
+	})
+}
+
+// SetSkipCode sets the "skip_code" field.
+func (u *InterviewUpsertBulk) SetSkipCode(v bool) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetSkipCode(v)
+	})
+}
+
+// UpdateSkipCode sets the "skip_code" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateSkipCode() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateSkipCode()
+	})
+}
+
+// SetQuestionTimeLimit sets the "question_time_limit" field.
+func (u *InterviewUpsertBulk) SetQuestionTimeLimit(v int32) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetQuestionTimeLimit(v)
+	})
+}
+
+// AddQuestionTimeLimit adds v to the "question_time_limit" field.
+func (u *InterviewUpsertBulk) AddQuestionTimeLimit(v int32) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddQuestionTimeLimit(v)
+	})
+}
+
+// UpdateQuestionTimeLimit sets the "question_time_limit" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateQuestionTimeLimit() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateQuestionTimeLimit()
+	})
+}
+
+// SetFluencyScoring sets the "fluency_scoring" field.
+func (u *InterviewUpsertBulk) SetFluencyScoring(v bool) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetFluencyScoring(v)
+	})
+}
+
+// UpdateFluencyScoring sets the "fluency_scoring" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateFluencyScoring() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateFluencyScoring()
+	})
+}
+
+// SetTextOnly sets the "text_only" field.
+func (u *InterviewUpsertBulk) SetTextOnly(v bool) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetTextOnly(v)
+	})
+}
+
+// UpdateTextOnly sets the "text_only" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateTextOnly() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateTextOnly()
+	})
+}
+
+// SetTotalQuestions sets the "total_questions" field.
+func (u *InterviewUpsertBulk) SetTotalQuestions(v int32) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetTotalQuestions(v)
+	})
+}
+
+// AddTotalQuestions adds v to the "total_questions" field.
+func (u *InterviewUpsertBulk) AddTotalQuestions(v int32) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddTotalQuestions(v)
+	})
+}
+
+// UpdateTotalQuestions sets the "total_questions" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateTotalQuestions() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateTotalQuestions()
+	})
+}
+
+// SetRemainingQuestions sets the "remaining_questions" field.
+func (u *InterviewUpsertBulk) SetRemainingQuestions(v int32) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetRemainingQuestions(v)
+	})
+}
+
+// AddRemainingQuestions adds v to the "remaining_questions" field.
+func (u *InterviewUpsertBulk) AddRemainingQuestions(v int32) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddRemainingQuestions(v)
+	})
+}
+
+// UpdateRemainingQuestions sets the "remaining_questions" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateRemainingQuestions() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateRemainingQuestions()
+	})
+}
+
+// SetTotalScore sets the "total_score" field.
+func (u *InterviewUpsertBulk) SetTotalScore(v *irelia.TotalScore) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetTotalScore(v)
+	})
+}
+
+// UpdateTotalScore sets the "total_score" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateTotalScore() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateTotalScore()
+	})
+}
+
+// ClearTotalScore clears the value of the "total_score" field.
+func (u *InterviewUpsertBulk) ClearTotalScore() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearTotalScore()
+	})
+}
+
+// SetOverallScore sets the "overall_score" field.
+func (u *InterviewUpsertBulk) SetOverallScore(v float64) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetOverallScore(v)
+	})
+}
+
+// AddOverallScore adds v to the "overall_score" field.
+func (u *InterviewUpsertBulk) AddOverallScore(v float64) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddOverallScore(v)
+	})
+}
+
+// UpdateOverallScore sets the "overall_score" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateOverallScore() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateOverallScore()
+	})
+}
+
+// SetFluencyResult sets the "fluency_result" field.
+func (u *InterviewUpsertBulk) SetFluencyResult(v *irelia.ScoreFluencyResponse) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetFluencyResult(v)
+	})
+}
+
+// UpdateFluencyResult sets the "fluency_result" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateFluencyResult() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateFluencyResult()
+	})
+}
+
+// ClearFluencyResult clears the value of the "fluency_result" field.
+func (u *InterviewUpsertBulk) ClearFluencyResult() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearFluencyResult()
+	})
+}
+
+// SetPositiveFeedback sets the "positive_feedback" field.
+func (u *InterviewUpsertBulk) SetPositiveFeedback(v string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetPositiveFeedback(v)
+	})
+}
+
+// UpdatePositiveFeedback sets the "positive_feedback" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdatePositiveFeedback() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdatePositiveFeedback()
+	})
+}
+
+// ClearPositiveFeedback clears the value of the "positive_feedback" field.
+func (u *InterviewUpsertBulk) ClearPositiveFeedback() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearPositiveFeedback()
+	})
+}
+
+// SetActionableFeedback sets the "actionable_feedback" field.
+func (u *InterviewUpsertBulk) SetActionableFeedback(v string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetActionableFeedback(v)
+	})
+}
+
+// UpdateActionableFeedback sets the "actionable_feedback" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateActionableFeedback() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateActionableFeedback()
+	})
+}
+
+// ClearActionableFeedback clears the value of the "actionable_feedback" field.
+func (u *InterviewUpsertBulk) ClearActionableFeedback() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearActionableFeedback()
+	})
+}
+
+// SetFinalComment sets the "final_comment" field.
+func (u *InterviewUpsertBulk) SetFinalComment(v string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetFinalComment(v)
+	})
+}
+
+// UpdateFinalComment sets the "final_comment" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateFinalComment() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateFinalComment()
+	})
+}
+
+// ClearFinalComment clears the value of the "final_comment" field.
+func (u *InterviewUpsertBulk) ClearFinalComment() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearFinalComment()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InterviewUpsertBulk) SetStatus(v irelia.InterviewStatus) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *InterviewUpsertBulk) AddStatus(v irelia.InterviewStatus) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateStatus() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *InterviewUpsertBulk) SetFailureReason(v string) *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *InterviewUpsertBulk) UpdateFailureReason() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *InterviewUpsertBulk) ClearFailureReason() *InterviewUpsertBulk {
+	return u.Update(func(s *InterviewUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// Exec executes the query.
+func (u *InterviewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InterviewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InterviewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InterviewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -38,6 +38,26 @@ func (iu *InterviewUpdate) SetUpdatedAt(t time.Time) *InterviewUpdate {
 	return iu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *InterviewUpdate) SetDeletedAt(t time.Time) *InterviewUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *InterviewUpdate) SetNillableDeletedAt(t *time.Time) *InterviewUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *InterviewUpdate) ClearDeletedAt() *InterviewUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
 // SetPosition sets the "position" field.
 func (iu *InterviewUpdate) SetPosition(s string) *InterviewUpdate {
 	iu.mutation.SetPosition(s)
@@ -177,6 +197,55 @@ func (iu *InterviewUpdate) SetNillableSkipCode(b *bool) *InterviewUpdate {
 	return iu
 }
 
+// SetQuestionTimeLimit sets the "question_time_limit" field.
+func (iu *InterviewUpdate) SetQuestionTimeLimit(i int32) *InterviewUpdate {
+	iu.mutation.ResetQuestionTimeLimit()
+	iu.mutation.SetQuestionTimeLimit(i)
+	return iu
+}
+
+// SetNillableQuestionTimeLimit sets the "question_time_limit" field if the given value is not nil.
+func (iu *InterviewUpdate) SetNillableQuestionTimeLimit(i *int32) *InterviewUpdate {
+	if i != nil {
+		iu.SetQuestionTimeLimit(*i)
+	}
+	return iu
+}
+
+// AddQuestionTimeLimit adds i to the "question_time_limit" field.
+func (iu *InterviewUpdate) AddQuestionTimeLimit(i int32) *InterviewUpdate {
+	iu.mutation.AddQuestionTimeLimit(i)
+	return iu
+}
+
+// SetFluencyScoring sets the "fluency_scoring" field.
+func (iu *InterviewUpdate) SetFluencyScoring(b bool) *InterviewUpdate {
+	iu.mutation.SetFluencyScoring(b)
+	return iu
+}
+
+// SetNillableFluencyScoring sets the "fluency_scoring" field if the given value is not nil.
+func (iu *InterviewUpdate) SetNillableFluencyScoring(b *bool) *InterviewUpdate {
+	if b != nil {
+		iu.SetFluencyScoring(*b)
+	}
+	return iu
+}
+
+// SetTextOnly sets the "text_only" field.
+func (iu *InterviewUpdate) SetTextOnly(b bool) *InterviewUpdate {
+	iu.mutation.SetTextOnly(b)
+	return iu
+}
+
+// SetNillableTextOnly sets the "text_only" field if the given value is not nil.
+func (iu *InterviewUpdate) SetNillableTextOnly(b *bool) *InterviewUpdate {
+	if b != nil {
+		iu.SetTextOnly(*b)
+	}
+	return iu
+}
+
 // SetTotalQuestions sets the "total_questions" field.
 func (iu *InterviewUpdate) SetTotalQuestions(i int32) *InterviewUpdate {
 	iu.mutation.ResetTotalQuestions()
@@ -252,6 +321,18 @@ func (iu *InterviewUpdate) AddOverallScore(f float64) *InterviewUpdate {
 	return iu
 }
 
+// SetFluencyResult sets the "fluency_result" field.
+func (iu *InterviewUpdate) SetFluencyResult(ifr *irelia.ScoreFluencyResponse) *InterviewUpdate {
+	iu.mutation.SetFluencyResult(ifr)
+	return iu
+}
+
+// ClearFluencyResult clears the value of the "fluency_result" field.
+func (iu *InterviewUpdate) ClearFluencyResult() *InterviewUpdate {
+	iu.mutation.ClearFluencyResult()
+	return iu
+}
+
 // SetPositiveFeedback sets the "positive_feedback" field.
 func (iu *InterviewUpdate) SetPositiveFeedback(s string) *InterviewUpdate {
 	iu.mutation.SetPositiveFeedback(s)
@@ -333,6 +414,26 @@ func (iu *InterviewUpdate) AddStatus(is irelia.InterviewStatus) *InterviewUpdate
 	return iu
 }
 
+// SetFailureReason sets the "failure_reason" field.
+func (iu *InterviewUpdate) SetFailureReason(s string) *InterviewUpdate {
+	iu.mutation.SetFailureReason(s)
+	return iu
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (iu *InterviewUpdate) SetNillableFailureReason(s *string) *InterviewUpdate {
+	if s != nil {
+		iu.SetFailureReason(*s)
+	}
+	return iu
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (iu *InterviewUpdate) ClearFailureReason() *InterviewUpdate {
+	iu.mutation.ClearFailureReason()
+	return iu
+}
+
 // AddQuestionIDs adds the "questions" edge to the Question entity by IDs.
 func (iu *InterviewUpdate) AddQuestionIDs(ids ...int) *InterviewUpdate {
 	iu.mutation.AddQuestionIDs(ids...)
@@ -476,6 +577,12 @@ func (iu *InterviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.UpdatedAt(); ok {
 		_spec.SetField(interview.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.SetField(interview.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.ClearField(interview.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iu.mutation.Position(); ok {
 		_spec.SetField(interview.FieldPosition, field.TypeString, value)
 	}
@@ -525,6 +632,18 @@ func (iu *InterviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.SkipCode(); ok {
 		_spec.SetField(interview.FieldSkipCode, field.TypeBool, value)
 	}
+	if value, ok := iu.mutation.QuestionTimeLimit(); ok {
+		_spec.SetField(interview.FieldQuestionTimeLimit, field.TypeInt32, value)
+	}
+	if value, ok := iu.mutation.AddedQuestionTimeLimit(); ok {
+		_spec.AddField(interview.FieldQuestionTimeLimit, field.TypeInt32, value)
+	}
+	if value, ok := iu.mutation.FluencyScoring(); ok {
+		_spec.SetField(interview.FieldFluencyScoring, field.TypeBool, value)
+	}
+	if value, ok := iu.mutation.TextOnly(); ok {
+		_spec.SetField(interview.FieldTextOnly, field.TypeBool, value)
+	}
 	if value, ok := iu.mutation.TotalQuestions(); ok {
 		_spec.SetField(interview.FieldTotalQuestions, field.TypeInt32, value)
 	}
@@ -549,6 +668,12 @@ func (iu *InterviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.AddedOverallScore(); ok {
 		_spec.AddField(interview.FieldOverallScore, field.TypeFloat64, value)
 	}
+	if value, ok := iu.mutation.FluencyResult(); ok {
+		_spec.SetField(interview.FieldFluencyResult, field.TypeJSON, value)
+	}
+	if iu.mutation.FluencyResultCleared() {
+		_spec.ClearField(interview.FieldFluencyResult, field.TypeJSON)
+	}
 	if value, ok := iu.mutation.PositiveFeedback(); ok {
 		_spec.SetField(interview.FieldPositiveFeedback, field.TypeString, value)
 	}
@@ -573,6 +698,12 @@ func (iu *InterviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.AddedStatus(); ok {
 		_spec.AddField(interview.FieldStatus, field.TypeInt32, value)
 	}
+	if value, ok := iu.mutation.FailureReason(); ok {
+		_spec.SetField(interview.FieldFailureReason, field.TypeString, value)
+	}
+	if iu.mutation.FailureReasonCleared() {
+		_spec.ClearField(interview.FieldFailureReason, field.TypeString)
+	}
 	if iu.mutation.QuestionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -689,6 +820,26 @@ func (iuo *InterviewUpdateOne) SetUpdatedAt(t time.Time) *InterviewUpdateOne {
 	return iuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *InterviewUpdateOne) SetDeletedAt(t time.Time) *InterviewUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *InterviewUpdateOne) SetNillableDeletedAt(t *time.Time) *InterviewUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *InterviewUpdateOne) ClearDeletedAt() *InterviewUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
+}
+
 // SetPosition sets the "position" field.
 func (iuo *InterviewUpdateOne) SetPosition(s string) *InterviewUpdateOne {
 	iuo.mutation.SetPosition(s)
@@ -828,6 +979,55 @@ func (iuo *InterviewUpdateOne) SetNillableSkipCode(b *bool) *InterviewUpdateOne
 	return iuo
 }
 
+// SetQuestionTimeLimit sets the "question_time_limit" field.
+func (iuo *InterviewUpdateOne) SetQuestionTimeLimit(i int32) *InterviewUpdateOne {
+	iuo.mutation.ResetQuestionTimeLimit()
+	iuo.mutation.SetQuestionTimeLimit(i)
+	return iuo
+}
+
+// SetNillableQuestionTimeLimit sets the "question_time_limit" field if the given value is not nil.
+func (iuo *InterviewUpdateOne) SetNillableQuestionTimeLimit(i *int32) *InterviewUpdateOne {
+	if i != nil {
+		iuo.SetQuestionTimeLimit(*i)
+	}
+	return iuo
+}
+
+// AddQuestionTimeLimit adds i to the "question_time_limit" field.
+func (iuo *InterviewUpdateOne) AddQuestionTimeLimit(i int32) *InterviewUpdateOne {
+	iuo.mutation.AddQuestionTimeLimit(i)
+	return iuo
+}
+
+// SetFluencyScoring sets the "fluency_scoring" field.
+func (iuo *InterviewUpdateOne) SetFluencyScoring(b bool) *InterviewUpdateOne {
+	iuo.mutation.SetFluencyScoring(b)
+	return iuo
+}
+
+// SetNillableFluencyScoring sets the "fluency_scoring" field if the given value is not nil.
+func (iuo *InterviewUpdateOne) SetNillableFluencyScoring(b *bool) *InterviewUpdateOne {
+	if b != nil {
+		iuo.SetFluencyScoring(*b)
+	}
+	return iuo
+}
+
+// SetTextOnly sets the "text_only" field.
+func (iuo *InterviewUpdateOne) SetTextOnly(b bool) *InterviewUpdateOne {
+	iuo.mutation.SetTextOnly(b)
+	return iuo
+}
+
+// SetNillableTextOnly sets the "text_only" field if the given value is not nil.
+func (iuo *InterviewUpdateOne) SetNillableTextOnly(b *bool) *InterviewUpdateOne {
+	if b != nil {
+		iuo.SetTextOnly(*b)
+	}
+	return iuo
+}
+
 // SetTotalQuestions sets the "total_questions" field.
 func (iuo *InterviewUpdateOne) SetTotalQuestions(i int32) *InterviewUpdateOne {
 	iuo.mutation.ResetTotalQuestions()
@@ -903,6 +1103,18 @@ func (iuo *InterviewUpdateOne) AddOverallScore(f float64) *InterviewUpdateOne {
 	return iuo
 }
 
+// SetFluencyResult sets the "fluency_result" field.
+func (iuo *InterviewUpdateOne) SetFluencyResult(ifr *irelia.ScoreFluencyResponse) *InterviewUpdateOne {
+	iuo.mutation.SetFluencyResult(ifr)
+	return iuo
+}
+
+// ClearFluencyResult clears the value of the "fluency_result" field.
+func (iuo *InterviewUpdateOne) ClearFluencyResult() *InterviewUpdateOne {
+	iuo.mutation.ClearFluencyResult()
+	return iuo
+}
+
 // SetPositiveFeedback sets the "positive_feedback" field.
 func (iuo *InterviewUpdateOne) SetPositiveFeedback(s string) *InterviewUpdateOne {
 	iuo.mutation.SetPositiveFeedback(s)
@@ -984,6 +1196,26 @@ func (iuo *InterviewUpdateOne) AddStatus(is irelia.InterviewStatus) *InterviewUp
 	return iuo
 }
 
+// SetFailureReason sets the "failure_reason" field.
+func (iuo *InterviewUpdateOne) SetFailureReason(s string) *InterviewUpdateOne {
+	iuo.mutation.SetFailureReason(s)
+	return iuo
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (iuo *InterviewUpdateOne) SetNillableFailureReason(s *string) *InterviewUpdateOne {
+	if s != nil {
+		iuo.SetFailureReason(*s)
+	}
+	return iuo
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (iuo *InterviewUpdateOne) ClearFailureReason() *InterviewUpdateOne {
+	iuo.mutation.ClearFailureReason()
+	return iuo
+}
+
 // AddQuestionIDs adds the "questions" edge to the Question entity by IDs.
 func (iuo *InterviewUpdateOne) AddQuestionIDs(ids ...int) *InterviewUpdateOne {
 	iuo.mutation.AddQuestionIDs(ids...)
@@ -1157,6 +1389,12 @@ func (iuo *InterviewUpdateOne) sqlSave(ctx context.Context) (_node *Interview, e
 	if value, ok := iuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(interview.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.SetField(interview.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(interview.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iuo.mutation.Position(); ok {
 		_spec.SetField(interview.FieldPosition, field.TypeString, value)
 	}
@@ -1206,6 +1444,18 @@ func (iuo *InterviewUpdateOne) sqlSave(ctx context.Context) (_node *Interview, e
 	if value, ok := iuo.mutation.SkipCode(); ok {
 		_spec.SetField(interview.FieldSkipCode, field.TypeBool, value)
 	}
+	if value, ok := iuo.mutation.QuestionTimeLimit(); ok {
+		_spec.SetField(interview.FieldQuestionTimeLimit, field.TypeInt32, value)
+	}
+	if value, ok := iuo.mutation.AddedQuestionTimeLimit(); ok {
+		_spec.AddField(interview.FieldQuestionTimeLimit, field.TypeInt32, value)
+	}
+	if value, ok := iuo.mutation.FluencyScoring(); ok {
+		_spec.SetField(interview.FieldFluencyScoring, field.TypeBool, value)
+	}
+	if value, ok := iuo.mutation.TextOnly(); ok {
+		_spec.SetField(interview.FieldTextOnly, field.TypeBool, value)
+	}
 	if value, ok := iuo.mutation.TotalQuestions(); ok {
 		_spec.SetField(interview.FieldTotalQuestions, field.TypeInt32, value)
 	}
@@ -1230,6 +1480,12 @@ func (iuo *InterviewUpdateOne) sqlSave(ctx context.Context) (_node *Interview, e
 	if value, ok := iuo.mutation.AddedOverallScore(); ok {
 		_spec.AddField(interview.FieldOverallScore, field.TypeFloat64, value)
 	}
+	if value, ok := iuo.mutation.FluencyResult(); ok {
+		_spec.SetField(interview.FieldFluencyResult, field.TypeJSON, value)
+	}
+	if iuo.mutation.FluencyResultCleared() {
+		_spec.ClearField(interview.FieldFluencyResult, field.TypeJSON)
+	}
 	if value, ok := iuo.mutation.PositiveFeedback(); ok {
 		_spec.SetField(interview.FieldPositiveFeedback, field.TypeString, value)
 	}
@@ -1254,6 +1510,12 @@ func (iuo *InterviewUpdateOne) sqlSave(ctx context.Context) (_node *Interview, e
 	if value, ok := iuo.mutation.AddedStatus(); ok {
 		_spec.AddField(interview.FieldStatus, field.TypeInt32, value)
 	}
+	if value, ok := iuo.mutation.FailureReason(); ok {
+		_spec.SetField(interview.FieldFailureReason, field.TypeString, value)
+	}
+	if iuo.mutation.FailureReasonCleared() {
+		_spec.ClearField(interview.FieldFailureReason, field.TypeString)
+	}
 	if iuo.mutation.QuestionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
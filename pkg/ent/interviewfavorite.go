@@ -22,6 +22,8 @@ type InterviewFavorite struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID uint64 `json:"user_id,omitempty"`
 	// InterviewID holds the value of the "interview_id" field.
@@ -61,7 +63,7 @@ func (*InterviewFavorite) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case interviewfavorite.FieldInterviewID:
 			values[i] = new(sql.NullString)
-		case interviewfavorite.FieldCreatedAt, interviewfavorite.FieldUpdatedAt:
+		case interviewfavorite.FieldCreatedAt, interviewfavorite.FieldUpdatedAt, interviewfavorite.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -96,6 +98,13 @@ func (_if *InterviewFavorite) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_if.UpdatedAt = value.Time
 			}
+		case interviewfavorite.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_if.DeletedAt = new(time.Time)
+				*_if.DeletedAt = value.Time
+			}
 		case interviewfavorite.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -155,6 +164,11 @@ func (_if *InterviewFavorite) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(_if.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _if.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _if.UserID))
 	builder.WriteString(", ")
@@ -65,6 +65,11 @@ func UpdatedAt(v time.Time) predicate.InterviewFavorite {
 	return predicate.InterviewFavorite(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v uint64) predicate.InterviewFavorite {
 	return predicate.InterviewFavorite(sql.FieldEQ(FieldUserID, v))
@@ -155,6 +160,56 @@ func UpdatedAtLTE(v time.Time) predicate.InterviewFavorite {
 	return predicate.InterviewFavorite(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.InterviewFavorite {
+	return predicate.InterviewFavorite(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uint64) predicate.InterviewFavorite {
 	return predicate.InterviewFavorite(sql.FieldEQ(FieldUserID, v))
@@ -10,6 +10,7 @@ import (
 	"irelia/pkg/ent/interviewfavorite"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,7 @@ type InterviewFavoriteCreate struct {
 	config
 	mutation *InterviewFavoriteMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -49,6 +51,20 @@ func (ifc *InterviewFavoriteCreate) SetNillableUpdatedAt(t *time.Time) *Intervie
 	return ifc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ifc *InterviewFavoriteCreate) SetDeletedAt(t time.Time) *InterviewFavoriteCreate {
+	ifc.mutation.SetDeletedAt(t)
+	return ifc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ifc *InterviewFavoriteCreate) SetNillableDeletedAt(t *time.Time) *InterviewFavoriteCreate {
+	if t != nil {
+		ifc.SetDeletedAt(*t)
+	}
+	return ifc
+}
+
 // SetUserID sets the "user_id" field.
 func (ifc *InterviewFavoriteCreate) SetUserID(u uint64) *InterviewFavoriteCreate {
 	ifc.mutation.SetUserID(u)
@@ -154,6 +170,7 @@ func (ifc *InterviewFavoriteCreate) createSpec() (*InterviewFavorite, *sqlgraph.
 		_node = &InterviewFavorite{config: ifc.config}
 		_spec = sqlgraph.NewCreateSpec(interviewfavorite.Table, sqlgraph.NewFieldSpec(interviewfavorite.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = ifc.conflict
 	if value, ok := ifc.mutation.CreatedAt(); ok {
 		_spec.SetField(interviewfavorite.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -162,6 +179,10 @@ func (ifc *InterviewFavoriteCreate) createSpec() (*InterviewFavorite, *sqlgraph.
 		_spec.SetField(interviewfavorite.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := ifc.mutation.DeletedAt(); ok {
+		_spec.SetField(interviewfavorite.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := ifc.mutation.UserID(); ok {
 		_spec.SetField(interviewfavorite.FieldUserID, field.TypeUint64, value)
 		_node.UserID = value
@@ -186,11 +207,269 @@ func (ifc *InterviewFavoriteCreate) createSpec() (*InterviewFavorite, *sqlgraph.
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InterviewFavorite.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InterviewFavoriteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ifc *InterviewFavoriteCreate) OnConflict(opts ...sql.ConflictOption) *InterviewFavoriteUpsertOne {
+	ifc.conflict = opts
+	return &InterviewFavoriteUpsertOne{
+		create: ifc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InterviewFavorite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ifc *InterviewFavoriteCreate) OnConflictColumns(columns ...string) *InterviewFavoriteUpsertOne {
+	ifc.conflict = append(ifc.conflict, sql.ConflictColumns(columns...))
+	return &InterviewFavoriteUpsertOne{
+		create: ifc,
+	}
+}
+
+type (
+	// InterviewFavoriteUpsertOne is the builder for "upsert"-ing
+	//  one InterviewFavorite node.
+	InterviewFavoriteUpsertOne struct {
+		create *InterviewFavoriteCreate
+	}
+
+	// InterviewFavoriteUpsert is the "OnConflict" setter.
+	InterviewFavoriteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterviewFavoriteUpsert) SetUpdatedAt(v time.Time) *InterviewFavoriteUpsert {
+	u.Set(interviewfavorite.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsert) UpdateUpdatedAt() *InterviewFavoriteUpsert {
+	u.SetExcluded(interviewfavorite.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InterviewFavoriteUpsert) SetDeletedAt(v time.Time) *InterviewFavoriteUpsert {
+	u.Set(interviewfavorite.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsert) UpdateDeletedAt() *InterviewFavoriteUpsert {
+	u.SetExcluded(interviewfavorite.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InterviewFavoriteUpsert) ClearDeletedAt() *InterviewFavoriteUpsert {
+	u.SetNull(interviewfavorite.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InterviewFavoriteUpsert) SetUserID(v uint64) *InterviewFavoriteUpsert {
+	u.Set(interviewfavorite.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsert) UpdateUserID() *InterviewFavoriteUpsert {
+	u.SetExcluded(interviewfavorite.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *InterviewFavoriteUpsert) AddUserID(v uint64) *InterviewFavoriteUpsert {
+	u.Add(interviewfavorite.FieldUserID, v)
+	return u
+}
+
+// SetInterviewID sets the "interview_id" field.
+func (u *InterviewFavoriteUpsert) SetInterviewID(v string) *InterviewFavoriteUpsert {
+	u.Set(interviewfavorite.FieldInterviewID, v)
+	return u
+}
+
+// UpdateInterviewID sets the "interview_id" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsert) UpdateInterviewID() *InterviewFavoriteUpsert {
+	u.SetExcluded(interviewfavorite.FieldInterviewID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.InterviewFavorite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InterviewFavoriteUpsertOne) UpdateNewValues() *InterviewFavoriteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(interviewfavorite.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InterviewFavorite.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InterviewFavoriteUpsertOne) Ignore() *InterviewFavoriteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InterviewFavoriteUpsertOne) DoNothing() *InterviewFavoriteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InterviewFavoriteCreate.OnConflict
+// documentation for more info.
+func (u *InterviewFavoriteUpsertOne) Update(set func(*InterviewFavoriteUpsert)) *InterviewFavoriteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InterviewFavoriteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterviewFavoriteUpsertOne) SetUpdatedAt(v time.Time) *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsertOne) UpdateUpdatedAt() *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InterviewFavoriteUpsertOne) SetDeletedAt(v time.Time) *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsertOne) UpdateDeletedAt() *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InterviewFavoriteUpsertOne) ClearDeletedAt() *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InterviewFavoriteUpsertOne) SetUserID(v uint64) *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *InterviewFavoriteUpsertOne) AddUserID(v uint64) *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsertOne) UpdateUserID() *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetInterviewID sets the "interview_id" field.
+func (u *InterviewFavoriteUpsertOne) SetInterviewID(v string) *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.SetInterviewID(v)
+	})
+}
+
+// UpdateInterviewID sets the "interview_id" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsertOne) UpdateInterviewID() *InterviewFavoriteUpsertOne {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.UpdateInterviewID()
+	})
+}
+
+// Exec executes the query.
+func (u *InterviewFavoriteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InterviewFavoriteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InterviewFavoriteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InterviewFavoriteUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InterviewFavoriteUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // InterviewFavoriteCreateBulk is the builder for creating many InterviewFavorite entities in bulk.
 type InterviewFavoriteCreateBulk struct {
 	config
 	err      error
 	builders []*InterviewFavoriteCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the InterviewFavorite entities in the database.
@@ -220,6 +499,7 @@ func (ifcb *InterviewFavoriteCreateBulk) Save(ctx context.Context) ([]*Interview
 					_, err = mutators[i+1].Mutate(root, ifcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ifcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ifcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -273,3 +553,184 @@ func (ifcb *InterviewFavoriteCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InterviewFavorite.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InterviewFavoriteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ifcb *InterviewFavoriteCreateBulk) OnConflict(opts ...sql.ConflictOption) *InterviewFavoriteUpsertBulk {
+	ifcb.conflict = opts
+	return &InterviewFavoriteUpsertBulk{
+		create: ifcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InterviewFavorite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ifcb *InterviewFavoriteCreateBulk) OnConflictColumns(columns ...string) *InterviewFavoriteUpsertBulk {
+	ifcb.conflict = append(ifcb.conflict, sql.ConflictColumns(columns...))
+	return &InterviewFavoriteUpsertBulk{
+		create: ifcb,
+	}
+}
+
+// InterviewFavoriteUpsertBulk is the builder for "upsert"-ing
+// a bulk of InterviewFavorite nodes.
+type InterviewFavoriteUpsertBulk struct {
+	create *InterviewFavoriteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InterviewFavorite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InterviewFavoriteUpsertBulk) UpdateNewValues() *InterviewFavoriteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(interviewfavorite.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InterviewFavorite.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InterviewFavoriteUpsertBulk) Ignore() *InterviewFavoriteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InterviewFavoriteUpsertBulk) DoNothing() *InterviewFavoriteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InterviewFavoriteCreateBulk.OnConflict
+// documentation for more info.
+func (u *InterviewFavoriteUpsertBulk) Update(set func(*InterviewFavoriteUpsert)) *InterviewFavoriteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InterviewFavoriteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterviewFavoriteUpsertBulk) SetUpdatedAt(v time.Time) *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsertBulk) UpdateUpdatedAt() *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InterviewFavoriteUpsertBulk) SetDeletedAt(v time.Time) *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsertBulk) UpdateDeletedAt() *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InterviewFavoriteUpsertBulk) ClearDeletedAt() *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InterviewFavoriteUpsertBulk) SetUserID(v uint64) *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *InterviewFavoriteUpsertBulk) AddUserID(v uint64) *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsertBulk) UpdateUserID() *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetInterviewID sets the "interview_id" field.
+func (u *InterviewFavoriteUpsertBulk) SetInterviewID(v string) *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.SetInterviewID(v)
+	})
+}
+
+// UpdateInterviewID sets the "interview_id" field to the value that was provided on create.
+func (u *InterviewFavoriteUpsertBulk) UpdateInterviewID() *InterviewFavoriteUpsertBulk {
+	return u.Update(func(s *InterviewFavoriteUpsert) {
+		s.UpdateInterviewID()
+	})
+}
+
+// Exec executes the query.
+func (u *InterviewFavoriteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InterviewFavoriteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InterviewFavoriteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InterviewFavoriteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
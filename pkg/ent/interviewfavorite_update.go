@@ -35,6 +35,26 @@ func (ifu *InterviewFavoriteUpdate) SetUpdatedAt(t time.Time) *InterviewFavorite
 	return ifu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ifu *InterviewFavoriteUpdate) SetDeletedAt(t time.Time) *InterviewFavoriteUpdate {
+	ifu.mutation.SetDeletedAt(t)
+	return ifu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ifu *InterviewFavoriteUpdate) SetNillableDeletedAt(t *time.Time) *InterviewFavoriteUpdate {
+	if t != nil {
+		ifu.SetDeletedAt(*t)
+	}
+	return ifu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ifu *InterviewFavoriteUpdate) ClearDeletedAt() *InterviewFavoriteUpdate {
+	ifu.mutation.ClearDeletedAt()
+	return ifu
+}
+
 // SetUserID sets the "user_id" field.
 func (ifu *InterviewFavoriteUpdate) SetUserID(u uint64) *InterviewFavoriteUpdate {
 	ifu.mutation.ResetUserID()
@@ -145,6 +165,12 @@ func (ifu *InterviewFavoriteUpdate) sqlSave(ctx context.Context) (n int, err err
 	if value, ok := ifu.mutation.UpdatedAt(); ok {
 		_spec.SetField(interviewfavorite.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ifu.mutation.DeletedAt(); ok {
+		_spec.SetField(interviewfavorite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ifu.mutation.DeletedAtCleared() {
+		_spec.ClearField(interviewfavorite.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := ifu.mutation.UserID(); ok {
 		_spec.SetField(interviewfavorite.FieldUserID, field.TypeUint64, value)
 	}
@@ -206,6 +232,26 @@ func (ifuo *InterviewFavoriteUpdateOne) SetUpdatedAt(t time.Time) *InterviewFavo
 	return ifuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ifuo *InterviewFavoriteUpdateOne) SetDeletedAt(t time.Time) *InterviewFavoriteUpdateOne {
+	ifuo.mutation.SetDeletedAt(t)
+	return ifuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ifuo *InterviewFavoriteUpdateOne) SetNillableDeletedAt(t *time.Time) *InterviewFavoriteUpdateOne {
+	if t != nil {
+		ifuo.SetDeletedAt(*t)
+	}
+	return ifuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ifuo *InterviewFavoriteUpdateOne) ClearDeletedAt() *InterviewFavoriteUpdateOne {
+	ifuo.mutation.ClearDeletedAt()
+	return ifuo
+}
+
 // SetUserID sets the "user_id" field.
 func (ifuo *InterviewFavoriteUpdateOne) SetUserID(u uint64) *InterviewFavoriteUpdateOne {
 	ifuo.mutation.ResetUserID()
@@ -346,6 +392,12 @@ func (ifuo *InterviewFavoriteUpdateOne) sqlSave(ctx context.Context) (_node *Int
 	if value, ok := ifuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(interviewfavorite.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ifuo.mutation.DeletedAt(); ok {
+		_spec.SetField(interviewfavorite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ifuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(interviewfavorite.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := ifuo.mutation.UserID(); ok {
 		_spec.SetField(interviewfavorite.FieldUserID, field.TypeUint64, value)
 	}
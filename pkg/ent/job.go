@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	irelia "irelia/api"
+	"irelia/pkg/ent/job"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Job is the model entity for the Job schema.
+type Job struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind irelia.JobKind `json:"kind,omitempty"`
+	// InterviewID holds the value of the "interview_id" field.
+	InterviewID string `json:"interview_id,omitempty"`
+	// QuestionIndex holds the value of the "question_index" field.
+	QuestionIndex int32 `json:"question_index,omitempty"`
+	// SubIndex holds the value of the "sub_index" field.
+	SubIndex int32 `json:"sub_index,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status irelia.JobStatus `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int32 `json:"attempts,omitempty"`
+	// MaxAttempts holds the value of the "max_attempts" field.
+	MaxAttempts int32 `json:"max_attempts,omitempty"`
+	// RunAt holds the value of the "run_at" field.
+	RunAt time.Time `json:"run_at,omitempty"`
+	// LockedBy holds the value of the "locked_by" field.
+	LockedBy string `json:"locked_by,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError    string `json:"last_error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Job) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case job.FieldID, job.FieldKind, job.FieldQuestionIndex, job.FieldSubIndex, job.FieldUserID, job.FieldStatus, job.FieldAttempts, job.FieldMaxAttempts:
+			values[i] = new(sql.NullInt64)
+		case job.FieldInterviewID, job.FieldLockedBy, job.FieldLastError:
+			values[i] = new(sql.NullString)
+		case job.FieldCreatedAt, job.FieldUpdatedAt, job.FieldDeletedAt, job.FieldRunAt, job.FieldLockedUntil:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Job fields.
+func (j *Job) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case job.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			j.ID = int(value.Int64)
+		case job.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				j.CreatedAt = value.Time
+			}
+		case job.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				j.UpdatedAt = value.Time
+			}
+		case job.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				j.DeletedAt = new(time.Time)
+				*j.DeletedAt = value.Time
+			}
+		case job.FieldKind:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				j.Kind = irelia.JobKind(value.Int64)
+			}
+		case job.FieldInterviewID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field interview_id", values[i])
+			} else if value.Valid {
+				j.InterviewID = value.String
+			}
+		case job.FieldQuestionIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field question_index", values[i])
+			} else if value.Valid {
+				j.QuestionIndex = int32(value.Int64)
+			}
+		case job.FieldSubIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sub_index", values[i])
+			} else if value.Valid {
+				j.SubIndex = int32(value.Int64)
+			}
+		case job.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				j.UserID = uint64(value.Int64)
+			}
+		case job.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				j.Status = irelia.JobStatus(value.Int64)
+			}
+		case job.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				j.Attempts = int32(value.Int64)
+			}
+		case job.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				j.MaxAttempts = int32(value.Int64)
+			}
+		case job.FieldRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field run_at", values[i])
+			} else if value.Valid {
+				j.RunAt = value.Time
+			}
+		case job.FieldLockedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_by", values[i])
+			} else if value.Valid {
+				j.LockedBy = value.String
+			}
+		case job.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				j.LockedUntil = new(time.Time)
+				*j.LockedUntil = value.Time
+			}
+		case job.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				j.LastError = value.String
+			}
+		default:
+			j.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Job.
+// This includes values selected through modifiers, order, etc.
+func (j *Job) Value(name string) (ent.Value, error) {
+	return j.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Job.
+// Note that you need to call Job.Unwrap() before calling this method if this Job
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (j *Job) Update() *JobUpdateOne {
+	return NewJobClient(j.config).UpdateOne(j)
+}
+
+// Unwrap unwraps the Job entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (j *Job) Unwrap() *Job {
+	_tx, ok := j.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Job is not a transactional entity")
+	}
+	j.config.driver = _tx.drv
+	return j
+}
+
+// String implements the fmt.Stringer.
+func (j *Job) String() string {
+	var builder strings.Builder
+	builder.WriteString("Job(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", j.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(j.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(j.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := j.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", j.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("interview_id=")
+	builder.WriteString(j.InterviewID)
+	builder.WriteString(", ")
+	builder.WriteString("question_index=")
+	builder.WriteString(fmt.Sprintf("%v", j.QuestionIndex))
+	builder.WriteString(", ")
+	builder.WriteString("sub_index=")
+	builder.WriteString(fmt.Sprintf("%v", j.SubIndex))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", j.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", j.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", j.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("max_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", j.MaxAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("run_at=")
+	builder.WriteString(j.RunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("locked_by=")
+	builder.WriteString(j.LockedBy)
+	builder.WriteString(", ")
+	if v := j.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(j.LastError)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Jobs is a parsable slice of Job.
+type Jobs []*Job
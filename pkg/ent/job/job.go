@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package job
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the job type in the database.
+	Label = "job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldInterviewID holds the string denoting the interview_id field in the database.
+	FieldInterviewID = "interview_id"
+	// FieldQuestionIndex holds the string denoting the question_index field in the database.
+	FieldQuestionIndex = "question_index"
+	// FieldSubIndex holds the string denoting the sub_index field in the database.
+	FieldSubIndex = "sub_index"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldMaxAttempts holds the string denoting the max_attempts field in the database.
+	FieldMaxAttempts = "max_attempts"
+	// FieldRunAt holds the string denoting the run_at field in the database.
+	FieldRunAt = "run_at"
+	// FieldLockedBy holds the string denoting the locked_by field in the database.
+	FieldLockedBy = "locked_by"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// Table holds the table name of the job in the database.
+	Table = "jobs"
+)
+
+// Columns holds all SQL columns for job fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldKind,
+	FieldInterviewID,
+	FieldQuestionIndex,
+	FieldSubIndex,
+	FieldUserID,
+	FieldStatus,
+	FieldAttempts,
+	FieldMaxAttempts,
+	FieldRunAt,
+	FieldLockedBy,
+	FieldLockedUntil,
+	FieldLastError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// InterviewIDValidator is a validator for the "interview_id" field. It is called by the builders before save.
+	InterviewIDValidator func(string) error
+	// DefaultQuestionIndex holds the default value on creation for the "question_index" field.
+	DefaultQuestionIndex int32
+	// DefaultSubIndex holds the default value on creation for the "sub_index" field.
+	DefaultSubIndex int32
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int32
+	// DefaultMaxAttempts holds the default value on creation for the "max_attempts" field.
+	DefaultMaxAttempts int32
+	// DefaultRunAt holds the default value on creation for the "run_at" field.
+	DefaultRunAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Job queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByInterviewID orders the results by the interview_id field.
+func ByInterviewID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterviewID, opts...).ToFunc()
+}
+
+// ByQuestionIndex orders the results by the question_index field.
+func ByQuestionIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionIndex, opts...).ToFunc()
+}
+
+// BySubIndex orders the results by the sub_index field.
+func BySubIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubIndex, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByMaxAttempts orders the results by the max_attempts field.
+func ByMaxAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAttempts, opts...).ToFunc()
+}
+
+// ByRunAt orders the results by the run_at field.
+func ByRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunAt, opts...).ToFunc()
+}
+
+// ByLockedBy orders the results by the locked_by field.
+func ByLockedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedBy, opts...).ToFunc()
+}
+
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
@@ -0,0 +1,891 @@
+// Code generated by ent, DO NOT EDIT.
+
+package job
+
+import (
+	irelia "irelia/api"
+	"irelia/pkg/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v irelia.JobKind) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldEQ(FieldKind, vc))
+}
+
+// InterviewID applies equality check predicate on the "interview_id" field. It's identical to InterviewIDEQ.
+func InterviewID(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldInterviewID, v))
+}
+
+// QuestionIndex applies equality check predicate on the "question_index" field. It's identical to QuestionIndexEQ.
+func QuestionIndex(v int32) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldQuestionIndex, v))
+}
+
+// SubIndex applies equality check predicate on the "sub_index" field. It's identical to SubIndexEQ.
+func SubIndex(v int32) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldSubIndex, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUserID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v irelia.JobStatus) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldEQ(FieldStatus, vc))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int32) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAttempts, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int32) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// RunAt applies equality check predicate on the "run_at" field. It's identical to RunAtEQ.
+func RunAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRunAt, v))
+}
+
+// LockedBy applies equality check predicate on the "locked_by" field. It's identical to LockedByEQ.
+func LockedBy(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v irelia.JobKind) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldEQ(FieldKind, vc))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v irelia.JobKind) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldNEQ(FieldKind, vc))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...irelia.JobKind) predicate.Job {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.Job(sql.FieldIn(FieldKind, v...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...irelia.JobKind) predicate.Job {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.Job(sql.FieldNotIn(FieldKind, v...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v irelia.JobKind) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldGT(FieldKind, vc))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v irelia.JobKind) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldGTE(FieldKind, vc))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v irelia.JobKind) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldLT(FieldKind, vc))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v irelia.JobKind) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldLTE(FieldKind, vc))
+}
+
+// InterviewIDEQ applies the EQ predicate on the "interview_id" field.
+func InterviewIDEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldInterviewID, v))
+}
+
+// InterviewIDNEQ applies the NEQ predicate on the "interview_id" field.
+func InterviewIDNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldInterviewID, v))
+}
+
+// InterviewIDIn applies the In predicate on the "interview_id" field.
+func InterviewIDIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldInterviewID, vs...))
+}
+
+// InterviewIDNotIn applies the NotIn predicate on the "interview_id" field.
+func InterviewIDNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldInterviewID, vs...))
+}
+
+// InterviewIDGT applies the GT predicate on the "interview_id" field.
+func InterviewIDGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldInterviewID, v))
+}
+
+// InterviewIDGTE applies the GTE predicate on the "interview_id" field.
+func InterviewIDGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldInterviewID, v))
+}
+
+// InterviewIDLT applies the LT predicate on the "interview_id" field.
+func InterviewIDLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldInterviewID, v))
+}
+
+// InterviewIDLTE applies the LTE predicate on the "interview_id" field.
+func InterviewIDLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldInterviewID, v))
+}
+
+// InterviewIDContains applies the Contains predicate on the "interview_id" field.
+func InterviewIDContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldInterviewID, v))
+}
+
+// InterviewIDHasPrefix applies the HasPrefix predicate on the "interview_id" field.
+func InterviewIDHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldInterviewID, v))
+}
+
+// InterviewIDHasSuffix applies the HasSuffix predicate on the "interview_id" field.
+func InterviewIDHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldInterviewID, v))
+}
+
+// InterviewIDEqualFold applies the EqualFold predicate on the "interview_id" field.
+func InterviewIDEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldInterviewID, v))
+}
+
+// InterviewIDContainsFold applies the ContainsFold predicate on the "interview_id" field.
+func InterviewIDContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldInterviewID, v))
+}
+
+// QuestionIndexEQ applies the EQ predicate on the "question_index" field.
+func QuestionIndexEQ(v int32) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldQuestionIndex, v))
+}
+
+// QuestionIndexNEQ applies the NEQ predicate on the "question_index" field.
+func QuestionIndexNEQ(v int32) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldQuestionIndex, v))
+}
+
+// QuestionIndexIn applies the In predicate on the "question_index" field.
+func QuestionIndexIn(vs ...int32) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldQuestionIndex, vs...))
+}
+
+// QuestionIndexNotIn applies the NotIn predicate on the "question_index" field.
+func QuestionIndexNotIn(vs ...int32) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldQuestionIndex, vs...))
+}
+
+// QuestionIndexGT applies the GT predicate on the "question_index" field.
+func QuestionIndexGT(v int32) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldQuestionIndex, v))
+}
+
+// QuestionIndexGTE applies the GTE predicate on the "question_index" field.
+func QuestionIndexGTE(v int32) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldQuestionIndex, v))
+}
+
+// QuestionIndexLT applies the LT predicate on the "question_index" field.
+func QuestionIndexLT(v int32) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldQuestionIndex, v))
+}
+
+// QuestionIndexLTE applies the LTE predicate on the "question_index" field.
+func QuestionIndexLTE(v int32) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldQuestionIndex, v))
+}
+
+// SubIndexEQ applies the EQ predicate on the "sub_index" field.
+func SubIndexEQ(v int32) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldSubIndex, v))
+}
+
+// SubIndexNEQ applies the NEQ predicate on the "sub_index" field.
+func SubIndexNEQ(v int32) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldSubIndex, v))
+}
+
+// SubIndexIn applies the In predicate on the "sub_index" field.
+func SubIndexIn(vs ...int32) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldSubIndex, vs...))
+}
+
+// SubIndexNotIn applies the NotIn predicate on the "sub_index" field.
+func SubIndexNotIn(vs ...int32) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldSubIndex, vs...))
+}
+
+// SubIndexGT applies the GT predicate on the "sub_index" field.
+func SubIndexGT(v int32) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldSubIndex, v))
+}
+
+// SubIndexGTE applies the GTE predicate on the "sub_index" field.
+func SubIndexGTE(v int32) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldSubIndex, v))
+}
+
+// SubIndexLT applies the LT predicate on the "sub_index" field.
+func SubIndexLT(v int32) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldSubIndex, v))
+}
+
+// SubIndexLTE applies the LTE predicate on the "sub_index" field.
+func SubIndexLTE(v int32) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldSubIndex, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint64) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint64) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint64) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint64) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUserID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v irelia.JobStatus) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v irelia.JobStatus) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...irelia.JobStatus) predicate.Job {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.Job(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...irelia.JobStatus) predicate.Job {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.Job(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v irelia.JobStatus) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v irelia.JobStatus) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v irelia.JobStatus) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v irelia.JobStatus) predicate.Job {
+	vc := int32(v)
+	return predicate.Job(sql.FieldLTE(FieldStatus, vc))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int32) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int32) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int32) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int32) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int32) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int32) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int32) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int32) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldAttempts, v))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int32) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int32) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int32) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int32) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int32) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int32) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int32) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int32) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// RunAtEQ applies the EQ predicate on the "run_at" field.
+func RunAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRunAt, v))
+}
+
+// RunAtNEQ applies the NEQ predicate on the "run_at" field.
+func RunAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldRunAt, v))
+}
+
+// RunAtIn applies the In predicate on the "run_at" field.
+func RunAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldRunAt, vs...))
+}
+
+// RunAtNotIn applies the NotIn predicate on the "run_at" field.
+func RunAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldRunAt, vs...))
+}
+
+// RunAtGT applies the GT predicate on the "run_at" field.
+func RunAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldRunAt, v))
+}
+
+// RunAtGTE applies the GTE predicate on the "run_at" field.
+func RunAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldRunAt, v))
+}
+
+// RunAtLT applies the LT predicate on the "run_at" field.
+func RunAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldRunAt, v))
+}
+
+// RunAtLTE applies the LTE predicate on the "run_at" field.
+func RunAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldRunAt, v))
+}
+
+// LockedByEQ applies the EQ predicate on the "locked_by" field.
+func LockedByEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedByNEQ applies the NEQ predicate on the "locked_by" field.
+func LockedByNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLockedBy, v))
+}
+
+// LockedByIn applies the In predicate on the "locked_by" field.
+func LockedByIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLockedBy, vs...))
+}
+
+// LockedByNotIn applies the NotIn predicate on the "locked_by" field.
+func LockedByNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLockedBy, vs...))
+}
+
+// LockedByGT applies the GT predicate on the "locked_by" field.
+func LockedByGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLockedBy, v))
+}
+
+// LockedByGTE applies the GTE predicate on the "locked_by" field.
+func LockedByGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLockedBy, v))
+}
+
+// LockedByLT applies the LT predicate on the "locked_by" field.
+func LockedByLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLockedBy, v))
+}
+
+// LockedByLTE applies the LTE predicate on the "locked_by" field.
+func LockedByLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLockedBy, v))
+}
+
+// LockedByContains applies the Contains predicate on the "locked_by" field.
+func LockedByContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldLockedBy, v))
+}
+
+// LockedByHasPrefix applies the HasPrefix predicate on the "locked_by" field.
+func LockedByHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldLockedBy, v))
+}
+
+// LockedByHasSuffix applies the HasSuffix predicate on the "locked_by" field.
+func LockedByHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldLockedBy, v))
+}
+
+// LockedByIsNil applies the IsNil predicate on the "locked_by" field.
+func LockedByIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLockedBy))
+}
+
+// LockedByNotNil applies the NotNil predicate on the "locked_by" field.
+func LockedByNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLockedBy))
+}
+
+// LockedByEqualFold applies the EqualFold predicate on the "locked_by" field.
+func LockedByEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldLockedBy, v))
+}
+
+// LockedByContainsFold applies the ContainsFold predicate on the "locked_by" field.
+func LockedByContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldLockedBy, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Job) predicate.Job {
+	return predicate.Job(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Job) predicate.Job {
+	return predicate.Job(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Job) predicate.Job {
+	return predicate.Job(sql.NotPredicates(p))
+}
@@ -0,0 +1,1255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	irelia "irelia/api"
+	"irelia/pkg/ent/job"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobCreate is the builder for creating a Job entity.
+type JobCreate struct {
+	config
+	mutation *JobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jc *JobCreate) SetCreatedAt(t time.Time) *JobCreate {
+	jc.mutation.SetCreatedAt(t)
+	return jc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableCreatedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetCreatedAt(*t)
+	}
+	return jc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jc *JobCreate) SetUpdatedAt(t time.Time) *JobCreate {
+	jc.mutation.SetUpdatedAt(t)
+	return jc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableUpdatedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetUpdatedAt(*t)
+	}
+	return jc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (jc *JobCreate) SetDeletedAt(t time.Time) *JobCreate {
+	jc.mutation.SetDeletedAt(t)
+	return jc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableDeletedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetDeletedAt(*t)
+	}
+	return jc
+}
+
+// SetKind sets the "kind" field.
+func (jc *JobCreate) SetKind(ik irelia.JobKind) *JobCreate {
+	jc.mutation.SetKind(ik)
+	return jc
+}
+
+// SetInterviewID sets the "interview_id" field.
+func (jc *JobCreate) SetInterviewID(s string) *JobCreate {
+	jc.mutation.SetInterviewID(s)
+	return jc
+}
+
+// SetQuestionIndex sets the "question_index" field.
+func (jc *JobCreate) SetQuestionIndex(i int32) *JobCreate {
+	jc.mutation.SetQuestionIndex(i)
+	return jc
+}
+
+// SetNillableQuestionIndex sets the "question_index" field if the given value is not nil.
+func (jc *JobCreate) SetNillableQuestionIndex(i *int32) *JobCreate {
+	if i != nil {
+		jc.SetQuestionIndex(*i)
+	}
+	return jc
+}
+
+// SetSubIndex sets the "sub_index" field.
+func (jc *JobCreate) SetSubIndex(i int32) *JobCreate {
+	jc.mutation.SetSubIndex(i)
+	return jc
+}
+
+// SetNillableSubIndex sets the "sub_index" field if the given value is not nil.
+func (jc *JobCreate) SetNillableSubIndex(i *int32) *JobCreate {
+	if i != nil {
+		jc.SetSubIndex(*i)
+	}
+	return jc
+}
+
+// SetUserID sets the "user_id" field.
+func (jc *JobCreate) SetUserID(u uint64) *JobCreate {
+	jc.mutation.SetUserID(u)
+	return jc
+}
+
+// SetStatus sets the "status" field.
+func (jc *JobCreate) SetStatus(is irelia.JobStatus) *JobCreate {
+	jc.mutation.SetStatus(is)
+	return jc
+}
+
+// SetAttempts sets the "attempts" field.
+func (jc *JobCreate) SetAttempts(i int32) *JobCreate {
+	jc.mutation.SetAttempts(i)
+	return jc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jc *JobCreate) SetNillableAttempts(i *int32) *JobCreate {
+	if i != nil {
+		jc.SetAttempts(*i)
+	}
+	return jc
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (jc *JobCreate) SetMaxAttempts(i int32) *JobCreate {
+	jc.mutation.SetMaxAttempts(i)
+	return jc
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (jc *JobCreate) SetNillableMaxAttempts(i *int32) *JobCreate {
+	if i != nil {
+		jc.SetMaxAttempts(*i)
+	}
+	return jc
+}
+
+// SetRunAt sets the "run_at" field.
+func (jc *JobCreate) SetRunAt(t time.Time) *JobCreate {
+	jc.mutation.SetRunAt(t)
+	return jc
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableRunAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetRunAt(*t)
+	}
+	return jc
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (jc *JobCreate) SetLockedBy(s string) *JobCreate {
+	jc.mutation.SetLockedBy(s)
+	return jc
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLockedBy(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLockedBy(*s)
+	}
+	return jc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (jc *JobCreate) SetLockedUntil(t time.Time) *JobCreate {
+	jc.mutation.SetLockedUntil(t)
+	return jc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLockedUntil(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetLockedUntil(*t)
+	}
+	return jc
+}
+
+// SetLastError sets the "last_error" field.
+func (jc *JobCreate) SetLastError(s string) *JobCreate {
+	jc.mutation.SetLastError(s)
+	return jc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLastError(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLastError(*s)
+	}
+	return jc
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (jc *JobCreate) Mutation() *JobMutation {
+	return jc.mutation
+}
+
+// Save creates the Job in the database.
+func (jc *JobCreate) Save(ctx context.Context) (*Job, error) {
+	jc.defaults()
+	return withHooks(ctx, jc.sqlSave, jc.mutation, jc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jc *JobCreate) SaveX(ctx context.Context) *Job {
+	v, err := jc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jc *JobCreate) Exec(ctx context.Context) error {
+	_, err := jc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jc *JobCreate) ExecX(ctx context.Context) {
+	if err := jc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jc *JobCreate) defaults() {
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		v := job.DefaultCreatedAt()
+		jc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := jc.mutation.UpdatedAt(); !ok {
+		v := job.DefaultUpdatedAt()
+		jc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := jc.mutation.QuestionIndex(); !ok {
+		v := job.DefaultQuestionIndex
+		jc.mutation.SetQuestionIndex(v)
+	}
+	if _, ok := jc.mutation.SubIndex(); !ok {
+		v := job.DefaultSubIndex
+		jc.mutation.SetSubIndex(v)
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		v := job.DefaultAttempts
+		jc.mutation.SetAttempts(v)
+	}
+	if _, ok := jc.mutation.MaxAttempts(); !ok {
+		v := job.DefaultMaxAttempts
+		jc.mutation.SetMaxAttempts(v)
+	}
+	if _, ok := jc.mutation.RunAt(); !ok {
+		v := job.DefaultRunAt()
+		jc.mutation.SetRunAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jc *JobCreate) check() error {
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
+	}
+	if _, ok := jc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Job.updated_at"`)}
+	}
+	if _, ok := jc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Job.kind"`)}
+	}
+	if _, ok := jc.mutation.InterviewID(); !ok {
+		return &ValidationError{Name: "interview_id", err: errors.New(`ent: missing required field "Job.interview_id"`)}
+	}
+	if v, ok := jc.mutation.InterviewID(); ok {
+		if err := job.InterviewIDValidator(v); err != nil {
+			return &ValidationError{Name: "interview_id", err: fmt.Errorf(`ent: validator failed for field "Job.interview_id": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.QuestionIndex(); !ok {
+		return &ValidationError{Name: "question_index", err: errors.New(`ent: missing required field "Job.question_index"`)}
+	}
+	if _, ok := jc.mutation.SubIndex(); !ok {
+		return &ValidationError{Name: "sub_index", err: errors.New(`ent: missing required field "Job.sub_index"`)}
+	}
+	if _, ok := jc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Job.user_id"`)}
+	}
+	if _, ok := jc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Job.status"`)}
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Job.attempts"`)}
+	}
+	if _, ok := jc.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`ent: missing required field "Job.max_attempts"`)}
+	}
+	if _, ok := jc.mutation.RunAt(); !ok {
+		return &ValidationError{Name: "run_at", err: errors.New(`ent: missing required field "Job.run_at"`)}
+	}
+	return nil
+}
+
+func (jc *JobCreate) sqlSave(ctx context.Context) (*Job, error) {
+	if err := jc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	jc.mutation.id = &_node.ID
+	jc.mutation.done = true
+	return _node, nil
+}
+
+func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Job{config: jc.config}
+		_spec = sqlgraph.NewCreateSpec(job.Table, sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = jc.conflict
+	if value, ok := jc.mutation.CreatedAt(); ok {
+		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := jc.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := jc.mutation.DeletedAt(); ok {
+		_spec.SetField(job.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := jc.mutation.Kind(); ok {
+		_spec.SetField(job.FieldKind, field.TypeInt32, value)
+		_node.Kind = value
+	}
+	if value, ok := jc.mutation.InterviewID(); ok {
+		_spec.SetField(job.FieldInterviewID, field.TypeString, value)
+		_node.InterviewID = value
+	}
+	if value, ok := jc.mutation.QuestionIndex(); ok {
+		_spec.SetField(job.FieldQuestionIndex, field.TypeInt32, value)
+		_node.QuestionIndex = value
+	}
+	if value, ok := jc.mutation.SubIndex(); ok {
+		_spec.SetField(job.FieldSubIndex, field.TypeInt32, value)
+		_node.SubIndex = value
+	}
+	if value, ok := jc.mutation.UserID(); ok {
+		_spec.SetField(job.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := jc.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeInt32, value)
+		_node.Status = value
+	}
+	if value, ok := jc.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt32, value)
+		_node.Attempts = value
+	}
+	if value, ok := jc.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt32, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := jc.mutation.RunAt(); ok {
+		_spec.SetField(job.FieldRunAt, field.TypeTime, value)
+		_node.RunAt = value
+	}
+	if value, ok := jc.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+		_node.LockedBy = value
+	}
+	if value, ok := jc.mutation.LockedUntil(); ok {
+		_spec.SetField(job.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := jc.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (jc *JobCreate) OnConflict(opts ...sql.ConflictOption) *JobUpsertOne {
+	jc.conflict = opts
+	return &JobUpsertOne{
+		create: jc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jc *JobCreate) OnConflictColumns(columns ...string) *JobUpsertOne {
+	jc.conflict = append(jc.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertOne{
+		create: jc,
+	}
+}
+
+type (
+	// JobUpsertOne is the builder for "upsert"-ing
+	//  one Job node.
+	JobUpsertOne struct {
+		create *JobCreate
+	}
+
+	// JobUpsert is the "OnConflict" setter.
+	JobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsert) SetUpdatedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateUpdatedAt() *JobUpsert {
+	u.SetExcluded(job.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *JobUpsert) SetDeletedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateDeletedAt() *JobUpsert {
+	u.SetExcluded(job.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *JobUpsert) ClearDeletedAt() *JobUpsert {
+	u.SetNull(job.FieldDeletedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsert) SetStatus(v irelia.JobStatus) *JobUpsert {
+	u.Set(job.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsert) UpdateStatus() *JobUpsert {
+	u.SetExcluded(job.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *JobUpsert) AddStatus(v irelia.JobStatus) *JobUpsert {
+	u.Add(job.FieldStatus, v)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsert) SetAttempts(v int32) *JobUpsert {
+	u.Set(job.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsert) UpdateAttempts() *JobUpsert {
+	u.SetExcluded(job.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsert) AddAttempts(v int32) *JobUpsert {
+	u.Add(job.FieldAttempts, v)
+	return u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsert) SetMaxAttempts(v int32) *JobUpsert {
+	u.Set(job.FieldMaxAttempts, v)
+	return u
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsert) UpdateMaxAttempts() *JobUpsert {
+	u.SetExcluded(job.FieldMaxAttempts)
+	return u
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsert) AddMaxAttempts(v int32) *JobUpsert {
+	u.Add(job.FieldMaxAttempts, v)
+	return u
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsert) SetRunAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldRunAt, v)
+	return u
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateRunAt() *JobUpsert {
+	u.SetExcluded(job.FieldRunAt)
+	return u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *JobUpsert) SetLockedBy(v string) *JobUpsert {
+	u.Set(job.FieldLockedBy, v)
+	return u
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *JobUpsert) UpdateLockedBy() *JobUpsert {
+	u.SetExcluded(job.FieldLockedBy)
+	return u
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *JobUpsert) ClearLockedBy() *JobUpsert {
+	u.SetNull(job.FieldLockedBy)
+	return u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *JobUpsert) SetLockedUntil(v time.Time) *JobUpsert {
+	u.Set(job.FieldLockedUntil, v)
+	return u
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *JobUpsert) UpdateLockedUntil() *JobUpsert {
+	u.SetExcluded(job.FieldLockedUntil)
+	return u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *JobUpsert) ClearLockedUntil() *JobUpsert {
+	u.SetNull(job.FieldLockedUntil)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsert) SetLastError(v string) *JobUpsert {
+	u.Set(job.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsert) UpdateLastError() *JobUpsert {
+	u.SetExcluded(job.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsert) ClearLastError() *JobUpsert {
+	u.SetNull(job.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertOne) UpdateNewValues() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(job.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(job.FieldKind)
+		}
+		if _, exists := u.create.mutation.InterviewID(); exists {
+			s.SetIgnore(job.FieldInterviewID)
+		}
+		if _, exists := u.create.mutation.QuestionIndex(); exists {
+			s.SetIgnore(job.FieldQuestionIndex)
+		}
+		if _, exists := u.create.mutation.SubIndex(); exists {
+			s.SetIgnore(job.FieldSubIndex)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(job.FieldUserID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobUpsertOne) Ignore() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertOne) DoNothing() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreate.OnConflict
+// documentation for more info.
+func (u *JobUpsertOne) Update(set func(*JobUpsert)) *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsertOne) SetUpdatedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateUpdatedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *JobUpsertOne) SetDeletedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateDeletedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *JobUpsertOne) ClearDeletedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsertOne) SetStatus(v irelia.JobStatus) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *JobUpsertOne) AddStatus(v irelia.JobStatus) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateStatus() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertOne) SetAttempts(v int32) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertOne) AddAttempts(v int32) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateAttempts() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsertOne) SetMaxAttempts(v int32) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetMaxAttempts(v)
+	})
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsertOne) AddMaxAttempts(v int32) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddMaxAttempts(v)
+	})
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateMaxAttempts() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateMaxAttempts()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsertOne) SetRunAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateRunAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *JobUpsertOne) SetLockedBy(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLockedBy(v)
+	})
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateLockedBy() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLockedBy()
+	})
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *JobUpsertOne) ClearLockedBy() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLockedBy()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *JobUpsertOne) SetLockedUntil(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateLockedUntil() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *JobUpsertOne) ClearLockedUntil() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsertOne) SetLastError(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateLastError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsertOne) ClearLastError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobCreateBulk is the builder for creating many Job entities in bulk.
+type JobCreateBulk struct {
+	config
+	err      error
+	builders []*JobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Job entities in the database.
+func (jcb *JobCreateBulk) Save(ctx context.Context) ([]*Job, error) {
+	if jcb.err != nil {
+		return nil, jcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jcb.builders))
+	nodes := make([]*Job, len(jcb.builders))
+	mutators := make([]Mutator, len(jcb.builders))
+	for i := range jcb.builders {
+		func(i int, root context.Context) {
+			builder := jcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jcb *JobCreateBulk) SaveX(ctx context.Context) []*Job {
+	v, err := jcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jcb *JobCreateBulk) Exec(ctx context.Context) error {
+	_, err := jcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jcb *JobCreateBulk) ExecX(ctx context.Context) {
+	if err := jcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (jcb *JobCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobUpsertBulk {
+	jcb.conflict = opts
+	return &JobUpsertBulk{
+		create: jcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jcb *JobCreateBulk) OnConflictColumns(columns ...string) *JobUpsertBulk {
+	jcb.conflict = append(jcb.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertBulk{
+		create: jcb,
+	}
+}
+
+// JobUpsertBulk is the builder for "upsert"-ing
+// a bulk of Job nodes.
+type JobUpsertBulk struct {
+	create *JobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertBulk) UpdateNewValues() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(job.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(job.FieldKind)
+			}
+			if _, exists := b.mutation.InterviewID(); exists {
+				s.SetIgnore(job.FieldInterviewID)
+			}
+			if _, exists := b.mutation.QuestionIndex(); exists {
+				s.SetIgnore(job.FieldQuestionIndex)
+			}
+			if _, exists := b.mutation.SubIndex(); exists {
+				s.SetIgnore(job.FieldSubIndex)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(job.FieldUserID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobUpsertBulk) Ignore() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertBulk) DoNothing() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobUpsertBulk) Update(set func(*JobUpsert)) *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsertBulk) SetUpdatedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateUpdatedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *JobUpsertBulk) SetDeletedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateDeletedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *JobUpsertBulk) ClearDeletedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsertBulk) SetStatus(v irelia.JobStatus) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *JobUpsertBulk) AddStatus(v irelia.JobStatus) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateStatus() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertBulk) SetAttempts(v int32) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertBulk) AddAttempts(v int32) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateAttempts() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsertBulk) SetMaxAttempts(v int32) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetMaxAttempts(v)
+	})
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsertBulk) AddMaxAttempts(v int32) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddMaxAttempts(v)
+	})
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateMaxAttempts() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateMaxAttempts()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsertBulk) SetRunAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateRunAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *JobUpsertBulk) SetLockedBy(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLockedBy(v)
+	})
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateLockedBy() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLockedBy()
+	})
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *JobUpsertBulk) ClearLockedBy() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLockedBy()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *JobUpsertBulk) SetLockedUntil(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateLockedUntil() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *JobUpsertBulk) ClearLockedUntil() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsertBulk) SetLastError(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateLastError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsertBulk) ClearLastError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -13,6 +13,7 @@ var (
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_id", Type: field.TypeUint64},
 		{Name: "position", Type: field.TypeString},
 		{Name: "experience", Type: field.TypeString, Nullable: true},
@@ -22,14 +23,19 @@ var (
 		{Name: "skills", Type: field.TypeJSON, Nullable: true},
 		{Name: "skills_score", Type: field.TypeJSON, Nullable: true},
 		{Name: "skip_code", Type: field.TypeBool, Default: false},
+		{Name: "question_time_limit", Type: field.TypeInt32, Default: 0},
+		{Name: "fluency_scoring", Type: field.TypeBool, Default: false},
+		{Name: "text_only", Type: field.TypeBool, Default: false},
 		{Name: "total_questions", Type: field.TypeInt32, Default: 10},
 		{Name: "remaining_questions", Type: field.TypeInt32, Default: 10},
 		{Name: "total_score", Type: field.TypeJSON, Nullable: true},
 		{Name: "overall_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "fluency_result", Type: field.TypeJSON, Nullable: true},
 		{Name: "positive_feedback", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "actionable_feedback", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "final_comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "status", Type: field.TypeInt32},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
 	}
 	// InterviewsTable holds the schema information for the "interviews" table.
 	InterviewsTable = &schema.Table{
@@ -42,6 +48,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_id", Type: field.TypeUint64},
 		{Name: "interview_id", Type: field.TypeString},
 	}
@@ -53,43 +60,148 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "interview_favorites_interviews_favorites",
-				Columns:    []*schema.Column{InterviewFavoritesColumns[4]},
+				Columns:    []*schema.Column{InterviewFavoritesColumns[5]},
 				RefColumns: []*schema.Column{InterviewsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "kind", Type: field.TypeInt32},
+		{Name: "interview_id", Type: field.TypeString},
+		{Name: "question_index", Type: field.TypeInt32, Default: 0},
+		{Name: "sub_index", Type: field.TypeInt32, Default: 0},
+		{Name: "user_id", Type: field.TypeUint64},
+		{Name: "status", Type: field.TypeInt32},
+		{Name: "attempts", Type: field.TypeInt32, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt32, Default: 5},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "locked_by", Type: field.TypeString, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_kind_interview_id_question_index_sub_index",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[4], JobsColumns[5], JobsColumns[6], JobsColumns[7]},
+			},
+			{
+				Name:    "job_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[9], JobsColumns[12]},
+			},
+		},
+	}
+	// ModerationAuditsColumns holds the columns for the "moderation_audits" table.
+	ModerationAuditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "question_id", Type: field.TypeInt},
+		{Name: "moderator_id", Type: field.TypeUint64},
+		{Name: "action", Type: field.TypeInt32},
+		{Name: "previous_status", Type: field.TypeInt32},
+		{Name: "status", Type: field.TypeInt32},
+		{Name: "merged_into_id", Type: field.TypeInt, Nullable: true},
+		{Name: "previous_content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "previous_answer", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "answer", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// ModerationAuditsTable holds the schema information for the "moderation_audits" table.
+	ModerationAuditsTable = &schema.Table{
+		Name:       "moderation_audits",
+		Columns:    ModerationAuditsColumns,
+		PrimaryKey: []*schema.Column{ModerationAuditsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationaudit_question_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationAuditsColumns[4], ModerationAuditsColumns[1]},
+			},
+			{
+				Name:    "moderationaudit_moderator_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationAuditsColumns[5], ModerationAuditsColumns[1]},
+			},
+		},
+	}
 	// PublicQuestionsColumns holds the columns for the "public_questions" table.
 	PublicQuestionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "position", Type: field.TypeString},
 		{Name: "experience", Type: field.TypeString},
 		{Name: "language", Type: field.TypeString},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "answer", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "occurrences", Type: field.TypeInt, Default: 1},
+		{Name: "first_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeInt32, Default: "2"},
+		{Name: "merged_into_id", Type: field.TypeInt, Nullable: true},
+		{Name: "moderated_by", Type: field.TypeUint64, Nullable: true},
+		{Name: "moderated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "answer_generator", Type: field.TypeString, Nullable: true},
+		{Name: "answer_version", Type: field.TypeString, Nullable: true},
+		{Name: "answer_generated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "answer_attempts", Type: field.TypeInt32, Default: 0},
+		{Name: "answer_locked_by", Type: field.TypeString, Nullable: true},
+		{Name: "answer_locked_until", Type: field.TypeTime, Nullable: true},
 	}
 	// PublicQuestionsTable holds the schema information for the "public_questions" table.
 	PublicQuestionsTable = &schema.Table{
 		Name:       "public_questions",
 		Columns:    PublicQuestionsColumns,
 		PrimaryKey: []*schema.Column{PublicQuestionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "publicquestion_content_hash_language",
+				Unique:  true,
+				Columns: []*schema.Column{PublicQuestionsColumns[9], PublicQuestionsColumns[6]},
+			},
+			{
+				Name:    "publicquestion_status",
+				Unique:  false,
+				Columns: []*schema.Column{PublicQuestionsColumns[13]},
+			},
+		},
 	}
 	// QuestionsColumns holds the columns for the "questions" table.
 	QuestionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "question_index", Type: field.TypeInt32},
+		{Name: "sub_index", Type: field.TypeInt32, Default: 0},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "audio", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "audio_key", Type: field.TypeString, Nullable: true},
 		{Name: "lipsync", Type: field.TypeJSON, Nullable: true},
+		{Name: "audio_pending", Type: field.TypeBool, Default: false},
 		{Name: "answer", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "record_proof", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "score", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeInt32},
+		{Name: "deadline_at", Type: field.TypeTime, Nullable: true},
 		{Name: "interview_id", Type: field.TypeString},
 	}
 	// QuestionsTable holds the schema information for the "questions" table.
@@ -100,16 +212,78 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "questions_interviews_questions",
-				Columns:    []*schema.Column{QuestionsColumns[12]},
+				Columns:    []*schema.Column{QuestionsColumns[17]},
 				RefColumns: []*schema.Column{InterviewsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "question_interview_id_question_index",
+				Name:    "question_interview_id_question_index_sub_index",
+				Unique:  true,
+				Columns: []*schema.Column{QuestionsColumns[17], QuestionsColumns[4], QuestionsColumns[5]},
+			},
+			{
+				Name:    "question_status_deadline_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionsColumns[15], QuestionsColumns[16]},
+			},
+		},
+	}
+	// QuotaColumns holds the columns for the "quota" table.
+	QuotaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "interviews", Type: field.TypeInt32, Default: 0},
+		{Name: "questions", Type: field.TypeInt32, Default: 0},
+	}
+	// QuotaTable holds the schema information for the "quota" table.
+	QuotaTable = &schema.Table{
+		Name:       "quota",
+		Columns:    QuotaColumns,
+		PrimaryKey: []*schema.Column{QuotaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quota_user_id_day",
 				Unique:  true,
-				Columns: []*schema.Column{QuestionsColumns[12], QuestionsColumns[3]},
+				Columns: []*schema.Column{QuotaColumns[4], QuotaColumns[5]},
+			},
+		},
+	}
+	// UsagesColumns holds the columns for the "usages" table.
+	UsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64},
+		{Name: "interview_id", Type: field.TypeString, Nullable: true},
+		{Name: "upstream", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "latency_ms", Type: field.TypeInt64},
+		{Name: "request_bytes", Type: field.TypeInt, Default: 0},
+		{Name: "response_bytes", Type: field.TypeInt, Default: 0},
+		{Name: "success", Type: field.TypeBool},
+	}
+	// UsagesTable holds the schema information for the "usages" table.
+	UsagesTable = &schema.Table{
+		Name:       "usages",
+		Columns:    UsagesColumns,
+		PrimaryKey: []*schema.Column{UsagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usage_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsagesColumns[4], UsagesColumns[1]},
+			},
+			{
+				Name:    "usage_interview_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsagesColumns[5]},
 			},
 		},
 	}
@@ -117,8 +291,12 @@ var (
 	Tables = []*schema.Table{
 		InterviewsTable,
 		InterviewFavoritesTable,
+		JobsTable,
+		ModerationAuditsTable,
 		PublicQuestionsTable,
 		QuestionsTable,
+		QuotaTable,
+		UsagesTable,
 	}
 )
 
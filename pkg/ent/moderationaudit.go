@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	irelia "irelia/api"
+	"irelia/pkg/ent/moderationaudit"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ModerationAudit is the model entity for the ModerationAudit schema.
+type ModerationAudit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// QuestionID holds the value of the "question_id" field.
+	QuestionID int `json:"question_id,omitempty"`
+	// ModeratorID holds the value of the "moderator_id" field.
+	ModeratorID uint64 `json:"moderator_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action irelia.ModerationAction `json:"action,omitempty"`
+	// PreviousStatus holds the value of the "previous_status" field.
+	PreviousStatus irelia.ModerationStatus `json:"previous_status,omitempty"`
+	// Status holds the value of the "status" field.
+	Status irelia.ModerationStatus `json:"status,omitempty"`
+	// MergedIntoID holds the value of the "merged_into_id" field.
+	MergedIntoID *int `json:"merged_into_id,omitempty"`
+	// PreviousContent holds the value of the "previous_content" field.
+	PreviousContent string `json:"previous_content,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// PreviousAnswer holds the value of the "previous_answer" field.
+	PreviousAnswer string `json:"previous_answer,omitempty"`
+	// Answer holds the value of the "answer" field.
+	Answer       string `json:"answer,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationAudit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationaudit.FieldID, moderationaudit.FieldQuestionID, moderationaudit.FieldModeratorID, moderationaudit.FieldAction, moderationaudit.FieldPreviousStatus, moderationaudit.FieldStatus, moderationaudit.FieldMergedIntoID:
+			values[i] = new(sql.NullInt64)
+		case moderationaudit.FieldPreviousContent, moderationaudit.FieldContent, moderationaudit.FieldNote, moderationaudit.FieldPreviousAnswer, moderationaudit.FieldAnswer:
+			values[i] = new(sql.NullString)
+		case moderationaudit.FieldCreatedAt, moderationaudit.FieldUpdatedAt, moderationaudit.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationAudit fields.
+func (ma *ModerationAudit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationaudit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ma.ID = int(value.Int64)
+		case moderationaudit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ma.CreatedAt = value.Time
+			}
+		case moderationaudit.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ma.UpdatedAt = value.Time
+			}
+		case moderationaudit.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ma.DeletedAt = new(time.Time)
+				*ma.DeletedAt = value.Time
+			}
+		case moderationaudit.FieldQuestionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field question_id", values[i])
+			} else if value.Valid {
+				ma.QuestionID = int(value.Int64)
+			}
+		case moderationaudit.FieldModeratorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field moderator_id", values[i])
+			} else if value.Valid {
+				ma.ModeratorID = uint64(value.Int64)
+			}
+		case moderationaudit.FieldAction:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ma.Action = irelia.ModerationAction(value.Int64)
+			}
+		case moderationaudit.FieldPreviousStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_status", values[i])
+			} else if value.Valid {
+				ma.PreviousStatus = irelia.ModerationStatus(value.Int64)
+			}
+		case moderationaudit.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ma.Status = irelia.ModerationStatus(value.Int64)
+			}
+		case moderationaudit.FieldMergedIntoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_into_id", values[i])
+			} else if value.Valid {
+				ma.MergedIntoID = new(int)
+				*ma.MergedIntoID = int(value.Int64)
+			}
+		case moderationaudit.FieldPreviousContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_content", values[i])
+			} else if value.Valid {
+				ma.PreviousContent = value.String
+			}
+		case moderationaudit.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				ma.Content = value.String
+			}
+		case moderationaudit.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				ma.Note = value.String
+			}
+		case moderationaudit.FieldPreviousAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_answer", values[i])
+			} else if value.Valid {
+				ma.PreviousAnswer = value.String
+			}
+		case moderationaudit.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				ma.Answer = value.String
+			}
+		default:
+			ma.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationAudit.
+// This includes values selected through modifiers, order, etc.
+func (ma *ModerationAudit) Value(name string) (ent.Value, error) {
+	return ma.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ModerationAudit.
+// Note that you need to call ModerationAudit.Unwrap() before calling this method if this ModerationAudit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ma *ModerationAudit) Update() *ModerationAuditUpdateOne {
+	return NewModerationAuditClient(ma.config).UpdateOne(ma)
+}
+
+// Unwrap unwraps the ModerationAudit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ma *ModerationAudit) Unwrap() *ModerationAudit {
+	_tx, ok := ma.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ModerationAudit is not a transactional entity")
+	}
+	ma.config.driver = _tx.drv
+	return ma
+}
+
+// String implements the fmt.Stringer.
+func (ma *ModerationAudit) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationAudit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ma.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ma.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ma.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ma.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("question_id=")
+	builder.WriteString(fmt.Sprintf("%v", ma.QuestionID))
+	builder.WriteString(", ")
+	builder.WriteString("moderator_id=")
+	builder.WriteString(fmt.Sprintf("%v", ma.ModeratorID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", ma.Action))
+	builder.WriteString(", ")
+	builder.WriteString("previous_status=")
+	builder.WriteString(fmt.Sprintf("%v", ma.PreviousStatus))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ma.Status))
+	builder.WriteString(", ")
+	if v := ma.MergedIntoID; v != nil {
+		builder.WriteString("merged_into_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("previous_content=")
+	builder.WriteString(ma.PreviousContent)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(ma.Content)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(ma.Note)
+	builder.WriteString(", ")
+	builder.WriteString("previous_answer=")
+	builder.WriteString(ma.PreviousAnswer)
+	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(ma.Answer)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModerationAudits is a parsable slice of ModerationAudit.
+type ModerationAudits []*ModerationAudit
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationaudit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the moderationaudit type in the database.
+	Label = "moderation_audit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldQuestionID holds the string denoting the question_id field in the database.
+	FieldQuestionID = "question_id"
+	// FieldModeratorID holds the string denoting the moderator_id field in the database.
+	FieldModeratorID = "moderator_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldPreviousStatus holds the string denoting the previous_status field in the database.
+	FieldPreviousStatus = "previous_status"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMergedIntoID holds the string denoting the merged_into_id field in the database.
+	FieldMergedIntoID = "merged_into_id"
+	// FieldPreviousContent holds the string denoting the previous_content field in the database.
+	FieldPreviousContent = "previous_content"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldPreviousAnswer holds the string denoting the previous_answer field in the database.
+	FieldPreviousAnswer = "previous_answer"
+	// FieldAnswer holds the string denoting the answer field in the database.
+	FieldAnswer = "answer"
+	// Table holds the table name of the moderationaudit in the database.
+	Table = "moderation_audits"
+)
+
+// Columns holds all SQL columns for moderationaudit fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldQuestionID,
+	FieldModeratorID,
+	FieldAction,
+	FieldPreviousStatus,
+	FieldStatus,
+	FieldMergedIntoID,
+	FieldPreviousContent,
+	FieldContent,
+	FieldNote,
+	FieldPreviousAnswer,
+	FieldAnswer,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ModerationAudit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByQuestionID orders the results by the question_id field.
+func ByQuestionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionID, opts...).ToFunc()
+}
+
+// ByModeratorID orders the results by the moderator_id field.
+func ByModeratorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModeratorID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByPreviousStatus orders the results by the previous_status field.
+func ByPreviousStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousStatus, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMergedIntoID orders the results by the merged_into_id field.
+func ByMergedIntoID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedIntoID, opts...).ToFunc()
+}
+
+// ByPreviousContent orders the results by the previous_content field.
+func ByPreviousContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousContent, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByPreviousAnswer orders the results by the previous_answer field.
+func ByPreviousAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousAnswer, opts...).ToFunc()
+}
+
+// ByAnswer orders the results by the answer field.
+func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
+}
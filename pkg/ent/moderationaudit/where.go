@@ -0,0 +1,941 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationaudit
+
+import (
+	irelia "irelia/api"
+	"irelia/pkg/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// QuestionID applies equality check predicate on the "question_id" field. It's identical to QuestionIDEQ.
+func QuestionID(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// ModeratorID applies equality check predicate on the "moderator_id" field. It's identical to ModeratorIDEQ.
+func ModeratorID(v uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v irelia.ModerationAction) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldEQ(FieldAction, vc))
+}
+
+// PreviousStatus applies equality check predicate on the "previous_status" field. It's identical to PreviousStatusEQ.
+func PreviousStatus(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldEQ(FieldPreviousStatus, vc))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldEQ(FieldStatus, vc))
+}
+
+// MergedIntoID applies equality check predicate on the "merged_into_id" field. It's identical to MergedIntoIDEQ.
+func MergedIntoID(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldMergedIntoID, v))
+}
+
+// PreviousContent applies equality check predicate on the "previous_content" field. It's identical to PreviousContentEQ.
+func PreviousContent(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldPreviousContent, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldContent, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldNote, v))
+}
+
+// PreviousAnswer applies equality check predicate on the "previous_answer" field. It's identical to PreviousAnswerEQ.
+func PreviousAnswer(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldPreviousAnswer, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldAnswer, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// QuestionIDEQ applies the EQ predicate on the "question_id" field.
+func QuestionIDEQ(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// QuestionIDNEQ applies the NEQ predicate on the "question_id" field.
+func QuestionIDNEQ(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldQuestionID, v))
+}
+
+// QuestionIDIn applies the In predicate on the "question_id" field.
+func QuestionIDIn(vs ...int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDNotIn applies the NotIn predicate on the "question_id" field.
+func QuestionIDNotIn(vs ...int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDGT applies the GT predicate on the "question_id" field.
+func QuestionIDGT(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldQuestionID, v))
+}
+
+// QuestionIDGTE applies the GTE predicate on the "question_id" field.
+func QuestionIDGTE(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldQuestionID, v))
+}
+
+// QuestionIDLT applies the LT predicate on the "question_id" field.
+func QuestionIDLT(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldQuestionID, v))
+}
+
+// QuestionIDLTE applies the LTE predicate on the "question_id" field.
+func QuestionIDLTE(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldQuestionID, v))
+}
+
+// ModeratorIDEQ applies the EQ predicate on the "moderator_id" field.
+func ModeratorIDEQ(v uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDNEQ applies the NEQ predicate on the "moderator_id" field.
+func ModeratorIDNEQ(v uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDIn applies the In predicate on the "moderator_id" field.
+func ModeratorIDIn(vs ...uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDNotIn applies the NotIn predicate on the "moderator_id" field.
+func ModeratorIDNotIn(vs ...uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDGT applies the GT predicate on the "moderator_id" field.
+func ModeratorIDGT(v uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldModeratorID, v))
+}
+
+// ModeratorIDGTE applies the GTE predicate on the "moderator_id" field.
+func ModeratorIDGTE(v uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldModeratorID, v))
+}
+
+// ModeratorIDLT applies the LT predicate on the "moderator_id" field.
+func ModeratorIDLT(v uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldModeratorID, v))
+}
+
+// ModeratorIDLTE applies the LTE predicate on the "moderator_id" field.
+func ModeratorIDLTE(v uint64) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldModeratorID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v irelia.ModerationAction) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldEQ(FieldAction, vc))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v irelia.ModerationAction) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldAction, vc))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...irelia.ModerationAction) predicate.ModerationAudit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.ModerationAudit(sql.FieldIn(FieldAction, v...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...irelia.ModerationAction) predicate.ModerationAudit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldAction, v...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v irelia.ModerationAction) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldGT(FieldAction, vc))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v irelia.ModerationAction) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldGTE(FieldAction, vc))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v irelia.ModerationAction) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldLT(FieldAction, vc))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v irelia.ModerationAction) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldLTE(FieldAction, vc))
+}
+
+// PreviousStatusEQ applies the EQ predicate on the "previous_status" field.
+func PreviousStatusEQ(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldEQ(FieldPreviousStatus, vc))
+}
+
+// PreviousStatusNEQ applies the NEQ predicate on the "previous_status" field.
+func PreviousStatusNEQ(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldPreviousStatus, vc))
+}
+
+// PreviousStatusIn applies the In predicate on the "previous_status" field.
+func PreviousStatusIn(vs ...irelia.ModerationStatus) predicate.ModerationAudit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.ModerationAudit(sql.FieldIn(FieldPreviousStatus, v...))
+}
+
+// PreviousStatusNotIn applies the NotIn predicate on the "previous_status" field.
+func PreviousStatusNotIn(vs ...irelia.ModerationStatus) predicate.ModerationAudit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldPreviousStatus, v...))
+}
+
+// PreviousStatusGT applies the GT predicate on the "previous_status" field.
+func PreviousStatusGT(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldGT(FieldPreviousStatus, vc))
+}
+
+// PreviousStatusGTE applies the GTE predicate on the "previous_status" field.
+func PreviousStatusGTE(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldGTE(FieldPreviousStatus, vc))
+}
+
+// PreviousStatusLT applies the LT predicate on the "previous_status" field.
+func PreviousStatusLT(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldLT(FieldPreviousStatus, vc))
+}
+
+// PreviousStatusLTE applies the LTE predicate on the "previous_status" field.
+func PreviousStatusLTE(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldLTE(FieldPreviousStatus, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...irelia.ModerationStatus) predicate.ModerationAudit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.ModerationAudit(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...irelia.ModerationStatus) predicate.ModerationAudit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v irelia.ModerationStatus) predicate.ModerationAudit {
+	vc := int32(v)
+	return predicate.ModerationAudit(sql.FieldLTE(FieldStatus, vc))
+}
+
+// MergedIntoIDEQ applies the EQ predicate on the "merged_into_id" field.
+func MergedIntoIDEQ(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDNEQ applies the NEQ predicate on the "merged_into_id" field.
+func MergedIntoIDNEQ(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDIn applies the In predicate on the "merged_into_id" field.
+func MergedIntoIDIn(vs ...int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldMergedIntoID, vs...))
+}
+
+// MergedIntoIDNotIn applies the NotIn predicate on the "merged_into_id" field.
+func MergedIntoIDNotIn(vs ...int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldMergedIntoID, vs...))
+}
+
+// MergedIntoIDGT applies the GT predicate on the "merged_into_id" field.
+func MergedIntoIDGT(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDGTE applies the GTE predicate on the "merged_into_id" field.
+func MergedIntoIDGTE(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDLT applies the LT predicate on the "merged_into_id" field.
+func MergedIntoIDLT(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDLTE applies the LTE predicate on the "merged_into_id" field.
+func MergedIntoIDLTE(v int) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDIsNil applies the IsNil predicate on the "merged_into_id" field.
+func MergedIntoIDIsNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIsNull(FieldMergedIntoID))
+}
+
+// MergedIntoIDNotNil applies the NotNil predicate on the "merged_into_id" field.
+func MergedIntoIDNotNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotNull(FieldMergedIntoID))
+}
+
+// PreviousContentEQ applies the EQ predicate on the "previous_content" field.
+func PreviousContentEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldPreviousContent, v))
+}
+
+// PreviousContentNEQ applies the NEQ predicate on the "previous_content" field.
+func PreviousContentNEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldPreviousContent, v))
+}
+
+// PreviousContentIn applies the In predicate on the "previous_content" field.
+func PreviousContentIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldPreviousContent, vs...))
+}
+
+// PreviousContentNotIn applies the NotIn predicate on the "previous_content" field.
+func PreviousContentNotIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldPreviousContent, vs...))
+}
+
+// PreviousContentGT applies the GT predicate on the "previous_content" field.
+func PreviousContentGT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldPreviousContent, v))
+}
+
+// PreviousContentGTE applies the GTE predicate on the "previous_content" field.
+func PreviousContentGTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldPreviousContent, v))
+}
+
+// PreviousContentLT applies the LT predicate on the "previous_content" field.
+func PreviousContentLT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldPreviousContent, v))
+}
+
+// PreviousContentLTE applies the LTE predicate on the "previous_content" field.
+func PreviousContentLTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldPreviousContent, v))
+}
+
+// PreviousContentContains applies the Contains predicate on the "previous_content" field.
+func PreviousContentContains(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContains(FieldPreviousContent, v))
+}
+
+// PreviousContentHasPrefix applies the HasPrefix predicate on the "previous_content" field.
+func PreviousContentHasPrefix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasPrefix(FieldPreviousContent, v))
+}
+
+// PreviousContentHasSuffix applies the HasSuffix predicate on the "previous_content" field.
+func PreviousContentHasSuffix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasSuffix(FieldPreviousContent, v))
+}
+
+// PreviousContentIsNil applies the IsNil predicate on the "previous_content" field.
+func PreviousContentIsNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIsNull(FieldPreviousContent))
+}
+
+// PreviousContentNotNil applies the NotNil predicate on the "previous_content" field.
+func PreviousContentNotNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotNull(FieldPreviousContent))
+}
+
+// PreviousContentEqualFold applies the EqualFold predicate on the "previous_content" field.
+func PreviousContentEqualFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEqualFold(FieldPreviousContent, v))
+}
+
+// PreviousContentContainsFold applies the ContainsFold predicate on the "previous_content" field.
+func PreviousContentContainsFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContainsFold(FieldPreviousContent, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContainsFold(FieldContent, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContainsFold(FieldNote, v))
+}
+
+// PreviousAnswerEQ applies the EQ predicate on the "previous_answer" field.
+func PreviousAnswerEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerNEQ applies the NEQ predicate on the "previous_answer" field.
+func PreviousAnswerNEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerIn applies the In predicate on the "previous_answer" field.
+func PreviousAnswerIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldPreviousAnswer, vs...))
+}
+
+// PreviousAnswerNotIn applies the NotIn predicate on the "previous_answer" field.
+func PreviousAnswerNotIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldPreviousAnswer, vs...))
+}
+
+// PreviousAnswerGT applies the GT predicate on the "previous_answer" field.
+func PreviousAnswerGT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerGTE applies the GTE predicate on the "previous_answer" field.
+func PreviousAnswerGTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerLT applies the LT predicate on the "previous_answer" field.
+func PreviousAnswerLT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerLTE applies the LTE predicate on the "previous_answer" field.
+func PreviousAnswerLTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerContains applies the Contains predicate on the "previous_answer" field.
+func PreviousAnswerContains(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContains(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerHasPrefix applies the HasPrefix predicate on the "previous_answer" field.
+func PreviousAnswerHasPrefix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasPrefix(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerHasSuffix applies the HasSuffix predicate on the "previous_answer" field.
+func PreviousAnswerHasSuffix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasSuffix(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerIsNil applies the IsNil predicate on the "previous_answer" field.
+func PreviousAnswerIsNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIsNull(FieldPreviousAnswer))
+}
+
+// PreviousAnswerNotNil applies the NotNil predicate on the "previous_answer" field.
+func PreviousAnswerNotNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotNull(FieldPreviousAnswer))
+}
+
+// PreviousAnswerEqualFold applies the EqualFold predicate on the "previous_answer" field.
+func PreviousAnswerEqualFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEqualFold(FieldPreviousAnswer, v))
+}
+
+// PreviousAnswerContainsFold applies the ContainsFold predicate on the "previous_answer" field.
+func PreviousAnswerContainsFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContainsFold(FieldPreviousAnswer, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerIsNil applies the IsNil predicate on the "answer" field.
+func AnswerIsNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldIsNull(FieldAnswer))
+}
+
+// AnswerNotNil applies the NotNil predicate on the "answer" field.
+func AnswerNotNil() predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldNotNull(FieldAnswer))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModerationAudit) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModerationAudit) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModerationAudit) predicate.ModerationAudit {
+	return predicate.ModerationAudit(sql.NotPredicates(p))
+}
@@ -0,0 +1,828 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	irelia "irelia/api"
+	"irelia/pkg/ent/moderationaudit"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationAuditCreate is the builder for creating a ModerationAudit entity.
+type ModerationAuditCreate struct {
+	config
+	mutation *ModerationAuditMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mac *ModerationAuditCreate) SetCreatedAt(t time.Time) *ModerationAuditCreate {
+	mac.mutation.SetCreatedAt(t)
+	return mac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillableCreatedAt(t *time.Time) *ModerationAuditCreate {
+	if t != nil {
+		mac.SetCreatedAt(*t)
+	}
+	return mac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mac *ModerationAuditCreate) SetUpdatedAt(t time.Time) *ModerationAuditCreate {
+	mac.mutation.SetUpdatedAt(t)
+	return mac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillableUpdatedAt(t *time.Time) *ModerationAuditCreate {
+	if t != nil {
+		mac.SetUpdatedAt(*t)
+	}
+	return mac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mac *ModerationAuditCreate) SetDeletedAt(t time.Time) *ModerationAuditCreate {
+	mac.mutation.SetDeletedAt(t)
+	return mac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillableDeletedAt(t *time.Time) *ModerationAuditCreate {
+	if t != nil {
+		mac.SetDeletedAt(*t)
+	}
+	return mac
+}
+
+// SetQuestionID sets the "question_id" field.
+func (mac *ModerationAuditCreate) SetQuestionID(i int) *ModerationAuditCreate {
+	mac.mutation.SetQuestionID(i)
+	return mac
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (mac *ModerationAuditCreate) SetModeratorID(u uint64) *ModerationAuditCreate {
+	mac.mutation.SetModeratorID(u)
+	return mac
+}
+
+// SetAction sets the "action" field.
+func (mac *ModerationAuditCreate) SetAction(ia irelia.ModerationAction) *ModerationAuditCreate {
+	mac.mutation.SetAction(ia)
+	return mac
+}
+
+// SetPreviousStatus sets the "previous_status" field.
+func (mac *ModerationAuditCreate) SetPreviousStatus(is irelia.ModerationStatus) *ModerationAuditCreate {
+	mac.mutation.SetPreviousStatus(is)
+	return mac
+}
+
+// SetStatus sets the "status" field.
+func (mac *ModerationAuditCreate) SetStatus(is irelia.ModerationStatus) *ModerationAuditCreate {
+	mac.mutation.SetStatus(is)
+	return mac
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (mac *ModerationAuditCreate) SetMergedIntoID(i int) *ModerationAuditCreate {
+	mac.mutation.SetMergedIntoID(i)
+	return mac
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillableMergedIntoID(i *int) *ModerationAuditCreate {
+	if i != nil {
+		mac.SetMergedIntoID(*i)
+	}
+	return mac
+}
+
+// SetPreviousContent sets the "previous_content" field.
+func (mac *ModerationAuditCreate) SetPreviousContent(s string) *ModerationAuditCreate {
+	mac.mutation.SetPreviousContent(s)
+	return mac
+}
+
+// SetNillablePreviousContent sets the "previous_content" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillablePreviousContent(s *string) *ModerationAuditCreate {
+	if s != nil {
+		mac.SetPreviousContent(*s)
+	}
+	return mac
+}
+
+// SetContent sets the "content" field.
+func (mac *ModerationAuditCreate) SetContent(s string) *ModerationAuditCreate {
+	mac.mutation.SetContent(s)
+	return mac
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillableContent(s *string) *ModerationAuditCreate {
+	if s != nil {
+		mac.SetContent(*s)
+	}
+	return mac
+}
+
+// SetNote sets the "note" field.
+func (mac *ModerationAuditCreate) SetNote(s string) *ModerationAuditCreate {
+	mac.mutation.SetNote(s)
+	return mac
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillableNote(s *string) *ModerationAuditCreate {
+	if s != nil {
+		mac.SetNote(*s)
+	}
+	return mac
+}
+
+// SetPreviousAnswer sets the "previous_answer" field.
+func (mac *ModerationAuditCreate) SetPreviousAnswer(s string) *ModerationAuditCreate {
+	mac.mutation.SetPreviousAnswer(s)
+	return mac
+}
+
+// SetNillablePreviousAnswer sets the "previous_answer" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillablePreviousAnswer(s *string) *ModerationAuditCreate {
+	if s != nil {
+		mac.SetPreviousAnswer(*s)
+	}
+	return mac
+}
+
+// SetAnswer sets the "answer" field.
+func (mac *ModerationAuditCreate) SetAnswer(s string) *ModerationAuditCreate {
+	mac.mutation.SetAnswer(s)
+	return mac
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (mac *ModerationAuditCreate) SetNillableAnswer(s *string) *ModerationAuditCreate {
+	if s != nil {
+		mac.SetAnswer(*s)
+	}
+	return mac
+}
+
+// Mutation returns the ModerationAuditMutation object of the builder.
+func (mac *ModerationAuditCreate) Mutation() *ModerationAuditMutation {
+	return mac.mutation
+}
+
+// Save creates the ModerationAudit in the database.
+func (mac *ModerationAuditCreate) Save(ctx context.Context) (*ModerationAudit, error) {
+	mac.defaults()
+	return withHooks(ctx, mac.sqlSave, mac.mutation, mac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mac *ModerationAuditCreate) SaveX(ctx context.Context) *ModerationAudit {
+	v, err := mac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mac *ModerationAuditCreate) Exec(ctx context.Context) error {
+	_, err := mac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mac *ModerationAuditCreate) ExecX(ctx context.Context) {
+	if err := mac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mac *ModerationAuditCreate) defaults() {
+	if _, ok := mac.mutation.CreatedAt(); !ok {
+		v := moderationaudit.DefaultCreatedAt()
+		mac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mac.mutation.UpdatedAt(); !ok {
+		v := moderationaudit.DefaultUpdatedAt()
+		mac.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mac *ModerationAuditCreate) check() error {
+	if _, ok := mac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModerationAudit.created_at"`)}
+	}
+	if _, ok := mac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ModerationAudit.updated_at"`)}
+	}
+	if _, ok := mac.mutation.QuestionID(); !ok {
+		return &ValidationError{Name: "question_id", err: errors.New(`ent: missing required field "ModerationAudit.question_id"`)}
+	}
+	if _, ok := mac.mutation.ModeratorID(); !ok {
+		return &ValidationError{Name: "moderator_id", err: errors.New(`ent: missing required field "ModerationAudit.moderator_id"`)}
+	}
+	if _, ok := mac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ModerationAudit.action"`)}
+	}
+	if _, ok := mac.mutation.PreviousStatus(); !ok {
+		return &ValidationError{Name: "previous_status", err: errors.New(`ent: missing required field "ModerationAudit.previous_status"`)}
+	}
+	if _, ok := mac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ModerationAudit.status"`)}
+	}
+	return nil
+}
+
+func (mac *ModerationAuditCreate) sqlSave(ctx context.Context) (*ModerationAudit, error) {
+	if err := mac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mac.mutation.id = &_node.ID
+	mac.mutation.done = true
+	return _node, nil
+}
+
+func (mac *ModerationAuditCreate) createSpec() (*ModerationAudit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationAudit{config: mac.config}
+		_spec = sqlgraph.NewCreateSpec(moderationaudit.Table, sqlgraph.NewFieldSpec(moderationaudit.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = mac.conflict
+	if value, ok := mac.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationaudit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mac.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationaudit.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mac.mutation.DeletedAt(); ok {
+		_spec.SetField(moderationaudit.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := mac.mutation.QuestionID(); ok {
+		_spec.SetField(moderationaudit.FieldQuestionID, field.TypeInt, value)
+		_node.QuestionID = value
+	}
+	if value, ok := mac.mutation.ModeratorID(); ok {
+		_spec.SetField(moderationaudit.FieldModeratorID, field.TypeUint64, value)
+		_node.ModeratorID = value
+	}
+	if value, ok := mac.mutation.Action(); ok {
+		_spec.SetField(moderationaudit.FieldAction, field.TypeInt32, value)
+		_node.Action = value
+	}
+	if value, ok := mac.mutation.PreviousStatus(); ok {
+		_spec.SetField(moderationaudit.FieldPreviousStatus, field.TypeInt32, value)
+		_node.PreviousStatus = value
+	}
+	if value, ok := mac.mutation.Status(); ok {
+		_spec.SetField(moderationaudit.FieldStatus, field.TypeInt32, value)
+		_node.Status = value
+	}
+	if value, ok := mac.mutation.MergedIntoID(); ok {
+		_spec.SetField(moderationaudit.FieldMergedIntoID, field.TypeInt, value)
+		_node.MergedIntoID = &value
+	}
+	if value, ok := mac.mutation.PreviousContent(); ok {
+		_spec.SetField(moderationaudit.FieldPreviousContent, field.TypeString, value)
+		_node.PreviousContent = value
+	}
+	if value, ok := mac.mutation.Content(); ok {
+		_spec.SetField(moderationaudit.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := mac.mutation.Note(); ok {
+		_spec.SetField(moderationaudit.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := mac.mutation.PreviousAnswer(); ok {
+		_spec.SetField(moderationaudit.FieldPreviousAnswer, field.TypeString, value)
+		_node.PreviousAnswer = value
+	}
+	if value, ok := mac.mutation.Answer(); ok {
+		_spec.SetField(moderationaudit.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationAudit.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationAuditUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mac *ModerationAuditCreate) OnConflict(opts ...sql.ConflictOption) *ModerationAuditUpsertOne {
+	mac.conflict = opts
+	return &ModerationAuditUpsertOne{
+		create: mac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationAudit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mac *ModerationAuditCreate) OnConflictColumns(columns ...string) *ModerationAuditUpsertOne {
+	mac.conflict = append(mac.conflict, sql.ConflictColumns(columns...))
+	return &ModerationAuditUpsertOne{
+		create: mac,
+	}
+}
+
+type (
+	// ModerationAuditUpsertOne is the builder for "upsert"-ing
+	//  one ModerationAudit node.
+	ModerationAuditUpsertOne struct {
+		create *ModerationAuditCreate
+	}
+
+	// ModerationAuditUpsert is the "OnConflict" setter.
+	ModerationAuditUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationAuditUpsert) SetUpdatedAt(v time.Time) *ModerationAuditUpsert {
+	u.Set(moderationaudit.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationAuditUpsert) UpdateUpdatedAt() *ModerationAuditUpsert {
+	u.SetExcluded(moderationaudit.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ModerationAuditUpsert) SetDeletedAt(v time.Time) *ModerationAuditUpsert {
+	u.Set(moderationaudit.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ModerationAuditUpsert) UpdateDeletedAt() *ModerationAuditUpsert {
+	u.SetExcluded(moderationaudit.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ModerationAuditUpsert) ClearDeletedAt() *ModerationAuditUpsert {
+	u.SetNull(moderationaudit.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAudit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationAuditUpsertOne) UpdateNewValues() *ModerationAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationaudit.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.QuestionID(); exists {
+			s.SetIgnore(moderationaudit.FieldQuestionID)
+		}
+		if _, exists := u.create.mutation.ModeratorID(); exists {
+			s.SetIgnore(moderationaudit.FieldModeratorID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(moderationaudit.FieldAction)
+		}
+		if _, exists := u.create.mutation.PreviousStatus(); exists {
+			s.SetIgnore(moderationaudit.FieldPreviousStatus)
+		}
+		if _, exists := u.create.mutation.Status(); exists {
+			s.SetIgnore(moderationaudit.FieldStatus)
+		}
+		if _, exists := u.create.mutation.MergedIntoID(); exists {
+			s.SetIgnore(moderationaudit.FieldMergedIntoID)
+		}
+		if _, exists := u.create.mutation.PreviousContent(); exists {
+			s.SetIgnore(moderationaudit.FieldPreviousContent)
+		}
+		if _, exists := u.create.mutation.Content(); exists {
+			s.SetIgnore(moderationaudit.FieldContent)
+		}
+		if _, exists := u.create.mutation.Note(); exists {
+			s.SetIgnore(moderationaudit.FieldNote)
+		}
+		if _, exists := u.create.mutation.PreviousAnswer(); exists {
+			s.SetIgnore(moderationaudit.FieldPreviousAnswer)
+		}
+		if _, exists := u.create.mutation.Answer(); exists {
+			s.SetIgnore(moderationaudit.FieldAnswer)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAudit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationAuditUpsertOne) Ignore() *ModerationAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationAuditUpsertOne) DoNothing() *ModerationAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationAuditCreate.OnConflict
+// documentation for more info.
+func (u *ModerationAuditUpsertOne) Update(set func(*ModerationAuditUpsert)) *ModerationAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationAuditUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationAuditUpsertOne) SetUpdatedAt(v time.Time) *ModerationAuditUpsertOne {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationAuditUpsertOne) UpdateUpdatedAt() *ModerationAuditUpsertOne {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ModerationAuditUpsertOne) SetDeletedAt(v time.Time) *ModerationAuditUpsertOne {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ModerationAuditUpsertOne) UpdateDeletedAt() *ModerationAuditUpsertOne {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ModerationAuditUpsertOne) ClearDeletedAt() *ModerationAuditUpsertOne {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationAuditUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationAuditCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationAuditUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationAuditUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationAuditUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationAuditCreateBulk is the builder for creating many ModerationAudit entities in bulk.
+type ModerationAuditCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationAuditCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationAudit entities in the database.
+func (macb *ModerationAuditCreateBulk) Save(ctx context.Context) ([]*ModerationAudit, error) {
+	if macb.err != nil {
+		return nil, macb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(macb.builders))
+	nodes := make([]*ModerationAudit, len(macb.builders))
+	mutators := make([]Mutator, len(macb.builders))
+	for i := range macb.builders {
+		func(i int, root context.Context) {
+			builder := macb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationAuditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, macb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = macb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, macb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, macb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (macb *ModerationAuditCreateBulk) SaveX(ctx context.Context) []*ModerationAudit {
+	v, err := macb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (macb *ModerationAuditCreateBulk) Exec(ctx context.Context) error {
+	_, err := macb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (macb *ModerationAuditCreateBulk) ExecX(ctx context.Context) {
+	if err := macb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationAudit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationAuditUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (macb *ModerationAuditCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationAuditUpsertBulk {
+	macb.conflict = opts
+	return &ModerationAuditUpsertBulk{
+		create: macb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationAudit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (macb *ModerationAuditCreateBulk) OnConflictColumns(columns ...string) *ModerationAuditUpsertBulk {
+	macb.conflict = append(macb.conflict, sql.ConflictColumns(columns...))
+	return &ModerationAuditUpsertBulk{
+		create: macb,
+	}
+}
+
+// ModerationAuditUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationAudit nodes.
+type ModerationAuditUpsertBulk struct {
+	create *ModerationAuditCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationAudit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationAuditUpsertBulk) UpdateNewValues() *ModerationAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationaudit.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.QuestionID(); exists {
+				s.SetIgnore(moderationaudit.FieldQuestionID)
+			}
+			if _, exists := b.mutation.ModeratorID(); exists {
+				s.SetIgnore(moderationaudit.FieldModeratorID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(moderationaudit.FieldAction)
+			}
+			if _, exists := b.mutation.PreviousStatus(); exists {
+				s.SetIgnore(moderationaudit.FieldPreviousStatus)
+			}
+			if _, exists := b.mutation.Status(); exists {
+				s.SetIgnore(moderationaudit.FieldStatus)
+			}
+			if _, exists := b.mutation.MergedIntoID(); exists {
+				s.SetIgnore(moderationaudit.FieldMergedIntoID)
+			}
+			if _, exists := b.mutation.PreviousContent(); exists {
+				s.SetIgnore(moderationaudit.FieldPreviousContent)
+			}
+			if _, exists := b.mutation.Content(); exists {
+				s.SetIgnore(moderationaudit.FieldContent)
+			}
+			if _, exists := b.mutation.Note(); exists {
+				s.SetIgnore(moderationaudit.FieldNote)
+			}
+			if _, exists := b.mutation.PreviousAnswer(); exists {
+				s.SetIgnore(moderationaudit.FieldPreviousAnswer)
+			}
+			if _, exists := b.mutation.Answer(); exists {
+				s.SetIgnore(moderationaudit.FieldAnswer)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAudit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationAuditUpsertBulk) Ignore() *ModerationAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationAuditUpsertBulk) DoNothing() *ModerationAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationAuditCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationAuditUpsertBulk) Update(set func(*ModerationAuditUpsert)) *ModerationAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationAuditUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationAuditUpsertBulk) SetUpdatedAt(v time.Time) *ModerationAuditUpsertBulk {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationAuditUpsertBulk) UpdateUpdatedAt() *ModerationAuditUpsertBulk {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ModerationAuditUpsertBulk) SetDeletedAt(v time.Time) *ModerationAuditUpsertBulk {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ModerationAuditUpsertBulk) UpdateDeletedAt() *ModerationAuditUpsertBulk {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ModerationAuditUpsertBulk) ClearDeletedAt() *ModerationAuditUpsertBulk {
+	return u.Update(func(s *ModerationAuditUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationAuditUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModerationAuditCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationAuditCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationAuditUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
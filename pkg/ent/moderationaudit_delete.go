@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"irelia/pkg/ent/moderationaudit"
+	"irelia/pkg/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationAuditDelete is the builder for deleting a ModerationAudit entity.
+type ModerationAuditDelete struct {
+	config
+	hooks    []Hook
+	mutation *ModerationAuditMutation
+}
+
+// Where appends a list predicates to the ModerationAuditDelete builder.
+func (mad *ModerationAuditDelete) Where(ps ...predicate.ModerationAudit) *ModerationAuditDelete {
+	mad.mutation.Where(ps...)
+	return mad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mad *ModerationAuditDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mad.sqlExec, mad.mutation, mad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mad *ModerationAuditDelete) ExecX(ctx context.Context) int {
+	n, err := mad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mad *ModerationAuditDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(moderationaudit.Table, sqlgraph.NewFieldSpec(moderationaudit.FieldID, field.TypeInt))
+	if ps := mad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mad.mutation.done = true
+	return affected, err
+}
+
+// ModerationAuditDeleteOne is the builder for deleting a single ModerationAudit entity.
+type ModerationAuditDeleteOne struct {
+	mad *ModerationAuditDelete
+}
+
+// Where appends a list predicates to the ModerationAuditDelete builder.
+func (mado *ModerationAuditDeleteOne) Where(ps ...predicate.ModerationAudit) *ModerationAuditDeleteOne {
+	mado.mad.mutation.Where(ps...)
+	return mado
+}
+
+// Exec executes the deletion query.
+func (mado *ModerationAuditDeleteOne) Exec(ctx context.Context) error {
+	n, err := mado.mad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{moderationaudit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mado *ModerationAuditDeleteOne) ExecX(ctx context.Context) {
+	if err := mado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"irelia/pkg/ent/moderationaudit"
+	"irelia/pkg/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationAuditUpdate is the builder for updating ModerationAudit entities.
+type ModerationAuditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationAuditMutation
+}
+
+// Where appends a list predicates to the ModerationAuditUpdate builder.
+func (mau *ModerationAuditUpdate) Where(ps ...predicate.ModerationAudit) *ModerationAuditUpdate {
+	mau.mutation.Where(ps...)
+	return mau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mau *ModerationAuditUpdate) SetUpdatedAt(t time.Time) *ModerationAuditUpdate {
+	mau.mutation.SetUpdatedAt(t)
+	return mau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mau *ModerationAuditUpdate) SetDeletedAt(t time.Time) *ModerationAuditUpdate {
+	mau.mutation.SetDeletedAt(t)
+	return mau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mau *ModerationAuditUpdate) SetNillableDeletedAt(t *time.Time) *ModerationAuditUpdate {
+	if t != nil {
+		mau.SetDeletedAt(*t)
+	}
+	return mau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mau *ModerationAuditUpdate) ClearDeletedAt() *ModerationAuditUpdate {
+	mau.mutation.ClearDeletedAt()
+	return mau
+}
+
+// Mutation returns the ModerationAuditMutation object of the builder.
+func (mau *ModerationAuditUpdate) Mutation() *ModerationAuditMutation {
+	return mau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mau *ModerationAuditUpdate) Save(ctx context.Context) (int, error) {
+	mau.defaults()
+	return withHooks(ctx, mau.sqlSave, mau.mutation, mau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mau *ModerationAuditUpdate) SaveX(ctx context.Context) int {
+	affected, err := mau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mau *ModerationAuditUpdate) Exec(ctx context.Context) error {
+	_, err := mau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mau *ModerationAuditUpdate) ExecX(ctx context.Context) {
+	if err := mau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mau *ModerationAuditUpdate) defaults() {
+	if _, ok := mau.mutation.UpdatedAt(); !ok {
+		v := moderationaudit.UpdateDefaultUpdatedAt()
+		mau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (mau *ModerationAuditUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(moderationaudit.Table, moderationaudit.Columns, sqlgraph.NewFieldSpec(moderationaudit.FieldID, field.TypeInt))
+	if ps := mau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mau.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationaudit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mau.mutation.DeletedAt(); ok {
+		_spec.SetField(moderationaudit.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mau.mutation.DeletedAtCleared() {
+		_spec.ClearField(moderationaudit.FieldDeletedAt, field.TypeTime)
+	}
+	if mau.mutation.MergedIntoIDCleared() {
+		_spec.ClearField(moderationaudit.FieldMergedIntoID, field.TypeInt)
+	}
+	if mau.mutation.PreviousContentCleared() {
+		_spec.ClearField(moderationaudit.FieldPreviousContent, field.TypeString)
+	}
+	if mau.mutation.ContentCleared() {
+		_spec.ClearField(moderationaudit.FieldContent, field.TypeString)
+	}
+	if mau.mutation.NoteCleared() {
+		_spec.ClearField(moderationaudit.FieldNote, field.TypeString)
+	}
+	if mau.mutation.PreviousAnswerCleared() {
+		_spec.ClearField(moderationaudit.FieldPreviousAnswer, field.TypeString)
+	}
+	if mau.mutation.AnswerCleared() {
+		_spec.ClearField(moderationaudit.FieldAnswer, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mau.mutation.done = true
+	return n, nil
+}
+
+// ModerationAuditUpdateOne is the builder for updating a single ModerationAudit entity.
+type ModerationAuditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationAuditMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mauo *ModerationAuditUpdateOne) SetUpdatedAt(t time.Time) *ModerationAuditUpdateOne {
+	mauo.mutation.SetUpdatedAt(t)
+	return mauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mauo *ModerationAuditUpdateOne) SetDeletedAt(t time.Time) *ModerationAuditUpdateOne {
+	mauo.mutation.SetDeletedAt(t)
+	return mauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mauo *ModerationAuditUpdateOne) SetNillableDeletedAt(t *time.Time) *ModerationAuditUpdateOne {
+	if t != nil {
+		mauo.SetDeletedAt(*t)
+	}
+	return mauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mauo *ModerationAuditUpdateOne) ClearDeletedAt() *ModerationAuditUpdateOne {
+	mauo.mutation.ClearDeletedAt()
+	return mauo
+}
+
+// Mutation returns the ModerationAuditMutation object of the builder.
+func (mauo *ModerationAuditUpdateOne) Mutation() *ModerationAuditMutation {
+	return mauo.mutation
+}
+
+// Where appends a list predicates to the ModerationAuditUpdate builder.
+func (mauo *ModerationAuditUpdateOne) Where(ps ...predicate.ModerationAudit) *ModerationAuditUpdateOne {
+	mauo.mutation.Where(ps...)
+	return mauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mauo *ModerationAuditUpdateOne) Select(field string, fields ...string) *ModerationAuditUpdateOne {
+	mauo.fields = append([]string{field}, fields...)
+	return mauo
+}
+
+// Save executes the query and returns the updated ModerationAudit entity.
+func (mauo *ModerationAuditUpdateOne) Save(ctx context.Context) (*ModerationAudit, error) {
+	mauo.defaults()
+	return withHooks(ctx, mauo.sqlSave, mauo.mutation, mauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mauo *ModerationAuditUpdateOne) SaveX(ctx context.Context) *ModerationAudit {
+	node, err := mauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mauo *ModerationAuditUpdateOne) Exec(ctx context.Context) error {
+	_, err := mauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mauo *ModerationAuditUpdateOne) ExecX(ctx context.Context) {
+	if err := mauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mauo *ModerationAuditUpdateOne) defaults() {
+	if _, ok := mauo.mutation.UpdatedAt(); !ok {
+		v := moderationaudit.UpdateDefaultUpdatedAt()
+		mauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (mauo *ModerationAuditUpdateOne) sqlSave(ctx context.Context) (_node *ModerationAudit, err error) {
+	_spec := sqlgraph.NewUpdateSpec(moderationaudit.Table, moderationaudit.Columns, sqlgraph.NewFieldSpec(moderationaudit.FieldID, field.TypeInt))
+	id, ok := mauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModerationAudit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationaudit.FieldID)
+		for _, f := range fields {
+			if !moderationaudit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != moderationaudit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationaudit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mauo.mutation.DeletedAt(); ok {
+		_spec.SetField(moderationaudit.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(moderationaudit.FieldDeletedAt, field.TypeTime)
+	}
+	if mauo.mutation.MergedIntoIDCleared() {
+		_spec.ClearField(moderationaudit.FieldMergedIntoID, field.TypeInt)
+	}
+	if mauo.mutation.PreviousContentCleared() {
+		_spec.ClearField(moderationaudit.FieldPreviousContent, field.TypeString)
+	}
+	if mauo.mutation.ContentCleared() {
+		_spec.ClearField(moderationaudit.FieldContent, field.TypeString)
+	}
+	if mauo.mutation.NoteCleared() {
+		_spec.ClearField(moderationaudit.FieldNote, field.TypeString)
+	}
+	if mauo.mutation.PreviousAnswerCleared() {
+		_spec.ClearField(moderationaudit.FieldPreviousAnswer, field.TypeString)
+	}
+	if mauo.mutation.AnswerCleared() {
+		_spec.ClearField(moderationaudit.FieldAnswer, field.TypeString)
+	}
+	_node = &ModerationAudit{config: mauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mauo.mutation.done = true
+	return _node, nil
+}
@@ -9,9 +9,13 @@ import (
 	irelia "irelia/api"
 	"irelia/pkg/ent/interview"
 	"irelia/pkg/ent/interviewfavorite"
+	"irelia/pkg/ent/job"
+	"irelia/pkg/ent/moderationaudit"
 	"irelia/pkg/ent/predicate"
 	"irelia/pkg/ent/publicquestion"
 	"irelia/pkg/ent/question"
+	"irelia/pkg/ent/quota"
+	"irelia/pkg/ent/usage"
 	"sync"
 	"time"
 
@@ -30,8 +34,12 @@ const (
 	// Node types.
 	TypeInterview         = "Interview"
 	TypeInterviewFavorite = "InterviewFavorite"
+	TypeJob               = "Job"
+	TypeModerationAudit   = "ModerationAudit"
 	TypePublicQuestion    = "PublicQuestion"
 	TypeQuestion          = "Question"
+	TypeQuota             = "Quota"
+	TypeUsage             = "Usage"
 )
 
 // InterviewMutation represents an operation that mutates the Interview nodes in the graph.
@@ -42,6 +50,7 @@ type InterviewMutation struct {
 	id                     *string
 	created_at             *time.Time
 	updated_at             *time.Time
+	deleted_at             *time.Time
 	user_id                *uint64
 	adduser_id             *int64
 	position               *string
@@ -55,6 +64,10 @@ type InterviewMutation struct {
 	skills_score           *[]string
 	appendskills_score     []string
 	skip_code              *bool
+	question_time_limit    *int32
+	addquestion_time_limit *int32
+	fluency_scoring        *bool
+	text_only              *bool
 	total_questions        *int32
 	addtotal_questions     *int32
 	remaining_questions    *int32
@@ -62,11 +75,13 @@ type InterviewMutation struct {
 	total_score            **irelia.TotalScore
 	overall_score          *float64
 	addoverall_score       *float64
+	fluency_result         **irelia.ScoreFluencyResponse
 	positive_feedback      *string
 	actionable_feedback    *string
 	final_comment          *string
 	status                 *irelia.InterviewStatus
 	addstatus              *irelia.InterviewStatus
+	failure_reason         *string
 	clearedFields          map[string]struct{}
 	questions              map[int]struct{}
 	removedquestions       map[int]struct{}
@@ -255,6 +270,55 @@ func (m *InterviewMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *InterviewMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *InterviewMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Interview entity.
+// If the Interview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InterviewMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *InterviewMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[interview.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *InterviewMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[interview.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *InterviewMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, interview.FieldDeletedAt)
+}
+
 // SetUserID sets the "user_id" field.
 func (m *InterviewMutation) SetUserID(u uint64) {
 	m.user_id = &u
@@ -703,6 +767,134 @@ func (m *InterviewMutation) ResetSkipCode() {
 	m.skip_code = nil
 }
 
+// SetQuestionTimeLimit sets the "question_time_limit" field.
+func (m *InterviewMutation) SetQuestionTimeLimit(i int32) {
+	m.question_time_limit = &i
+	m.addquestion_time_limit = nil
+}
+
+// QuestionTimeLimit returns the value of the "question_time_limit" field in the mutation.
+func (m *InterviewMutation) QuestionTimeLimit() (r int32, exists bool) {
+	v := m.question_time_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionTimeLimit returns the old "question_time_limit" field's value of the Interview entity.
+// If the Interview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InterviewMutation) OldQuestionTimeLimit(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionTimeLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionTimeLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionTimeLimit: %w", err)
+	}
+	return oldValue.QuestionTimeLimit, nil
+}
+
+// AddQuestionTimeLimit adds i to the "question_time_limit" field.
+func (m *InterviewMutation) AddQuestionTimeLimit(i int32) {
+	if m.addquestion_time_limit != nil {
+		*m.addquestion_time_limit += i
+	} else {
+		m.addquestion_time_limit = &i
+	}
+}
+
+// AddedQuestionTimeLimit returns the value that was added to the "question_time_limit" field in this mutation.
+func (m *InterviewMutation) AddedQuestionTimeLimit() (r int32, exists bool) {
+	v := m.addquestion_time_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuestionTimeLimit resets all changes to the "question_time_limit" field.
+func (m *InterviewMutation) ResetQuestionTimeLimit() {
+	m.question_time_limit = nil
+	m.addquestion_time_limit = nil
+}
+
+// SetFluencyScoring sets the "fluency_scoring" field.
+func (m *InterviewMutation) SetFluencyScoring(b bool) {
+	m.fluency_scoring = &b
+}
+
+// FluencyScoring returns the value of the "fluency_scoring" field in the mutation.
+func (m *InterviewMutation) FluencyScoring() (r bool, exists bool) {
+	v := m.fluency_scoring
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFluencyScoring returns the old "fluency_scoring" field's value of the Interview entity.
+// If the Interview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InterviewMutation) OldFluencyScoring(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFluencyScoring is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFluencyScoring requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFluencyScoring: %w", err)
+	}
+	return oldValue.FluencyScoring, nil
+}
+
+// ResetFluencyScoring resets all changes to the "fluency_scoring" field.
+func (m *InterviewMutation) ResetFluencyScoring() {
+	m.fluency_scoring = nil
+}
+
+// SetTextOnly sets the "text_only" field.
+func (m *InterviewMutation) SetTextOnly(b bool) {
+	m.text_only = &b
+}
+
+// TextOnly returns the value of the "text_only" field in the mutation.
+func (m *InterviewMutation) TextOnly() (r bool, exists bool) {
+	v := m.text_only
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextOnly returns the old "text_only" field's value of the Interview entity.
+// If the Interview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InterviewMutation) OldTextOnly(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextOnly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextOnly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextOnly: %w", err)
+	}
+	return oldValue.TextOnly, nil
+}
+
+// ResetTextOnly resets all changes to the "text_only" field.
+func (m *InterviewMutation) ResetTextOnly() {
+	m.text_only = nil
+}
+
 // SetTotalQuestions sets the "total_questions" field.
 func (m *InterviewMutation) SetTotalQuestions(i int32) {
 	m.total_questions = &i
@@ -920,6 +1112,55 @@ func (m *InterviewMutation) ResetOverallScore() {
 	m.addoverall_score = nil
 }
 
+// SetFluencyResult sets the "fluency_result" field.
+func (m *InterviewMutation) SetFluencyResult(ifr *irelia.ScoreFluencyResponse) {
+	m.fluency_result = &ifr
+}
+
+// FluencyResult returns the value of the "fluency_result" field in the mutation.
+func (m *InterviewMutation) FluencyResult() (r *irelia.ScoreFluencyResponse, exists bool) {
+	v := m.fluency_result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFluencyResult returns the old "fluency_result" field's value of the Interview entity.
+// If the Interview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InterviewMutation) OldFluencyResult(ctx context.Context) (v *irelia.ScoreFluencyResponse, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFluencyResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFluencyResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFluencyResult: %w", err)
+	}
+	return oldValue.FluencyResult, nil
+}
+
+// ClearFluencyResult clears the value of the "fluency_result" field.
+func (m *InterviewMutation) ClearFluencyResult() {
+	m.fluency_result = nil
+	m.clearedFields[interview.FieldFluencyResult] = struct{}{}
+}
+
+// FluencyResultCleared returns if the "fluency_result" field was cleared in this mutation.
+func (m *InterviewMutation) FluencyResultCleared() bool {
+	_, ok := m.clearedFields[interview.FieldFluencyResult]
+	return ok
+}
+
+// ResetFluencyResult resets all changes to the "fluency_result" field.
+func (m *InterviewMutation) ResetFluencyResult() {
+	m.fluency_result = nil
+	delete(m.clearedFields, interview.FieldFluencyResult)
+}
+
 // SetPositiveFeedback sets the "positive_feedback" field.
 func (m *InterviewMutation) SetPositiveFeedback(s string) {
 	m.positive_feedback = &s
@@ -1123,6 +1364,55 @@ func (m *InterviewMutation) ResetStatus() {
 	m.addstatus = nil
 }
 
+// SetFailureReason sets the "failure_reason" field.
+func (m *InterviewMutation) SetFailureReason(s string) {
+	m.failure_reason = &s
+}
+
+// FailureReason returns the value of the "failure_reason" field in the mutation.
+func (m *InterviewMutation) FailureReason() (r string, exists bool) {
+	v := m.failure_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureReason returns the old "failure_reason" field's value of the Interview entity.
+// If the Interview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InterviewMutation) OldFailureReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (m *InterviewMutation) ClearFailureReason() {
+	m.failure_reason = nil
+	m.clearedFields[interview.FieldFailureReason] = struct{}{}
+}
+
+// FailureReasonCleared returns if the "failure_reason" field was cleared in this mutation.
+func (m *InterviewMutation) FailureReasonCleared() bool {
+	_, ok := m.clearedFields[interview.FieldFailureReason]
+	return ok
+}
+
+// ResetFailureReason resets all changes to the "failure_reason" field.
+func (m *InterviewMutation) ResetFailureReason() {
+	m.failure_reason = nil
+	delete(m.clearedFields, interview.FieldFailureReason)
+}
+
 // AddQuestionIDs adds the "questions" edge to the Question entity by ids.
 func (m *InterviewMutation) AddQuestionIDs(ids ...int) {
 	if m.questions == nil {
@@ -1265,13 +1555,16 @@ func (m *InterviewMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *InterviewMutation) Fields() []string {
-	fields := make([]string, 0, 19)
+	fields := make([]string, 0, 25)
 	if m.created_at != nil {
 		fields = append(fields, interview.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
 		fields = append(fields, interview.FieldUpdatedAt)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, interview.FieldDeletedAt)
+	}
 	if m.user_id != nil {
 		fields = append(fields, interview.FieldUserID)
 	}
@@ -1299,6 +1592,15 @@ func (m *InterviewMutation) Fields() []string {
 	if m.skip_code != nil {
 		fields = append(fields, interview.FieldSkipCode)
 	}
+	if m.question_time_limit != nil {
+		fields = append(fields, interview.FieldQuestionTimeLimit)
+	}
+	if m.fluency_scoring != nil {
+		fields = append(fields, interview.FieldFluencyScoring)
+	}
+	if m.text_only != nil {
+		fields = append(fields, interview.FieldTextOnly)
+	}
 	if m.total_questions != nil {
 		fields = append(fields, interview.FieldTotalQuestions)
 	}
@@ -1311,6 +1613,9 @@ func (m *InterviewMutation) Fields() []string {
 	if m.overall_score != nil {
 		fields = append(fields, interview.FieldOverallScore)
 	}
+	if m.fluency_result != nil {
+		fields = append(fields, interview.FieldFluencyResult)
+	}
 	if m.positive_feedback != nil {
 		fields = append(fields, interview.FieldPositiveFeedback)
 	}
@@ -1323,6 +1628,9 @@ func (m *InterviewMutation) Fields() []string {
 	if m.status != nil {
 		fields = append(fields, interview.FieldStatus)
 	}
+	if m.failure_reason != nil {
+		fields = append(fields, interview.FieldFailureReason)
+	}
 	return fields
 }
 
@@ -1335,6 +1643,8 @@ func (m *InterviewMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case interview.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case interview.FieldDeletedAt:
+		return m.DeletedAt()
 	case interview.FieldUserID:
 		return m.UserID()
 	case interview.FieldPosition:
@@ -1353,6 +1663,12 @@ func (m *InterviewMutation) Field(name string) (ent.Value, bool) {
 		return m.SkillsScore()
 	case interview.FieldSkipCode:
 		return m.SkipCode()
+	case interview.FieldQuestionTimeLimit:
+		return m.QuestionTimeLimit()
+	case interview.FieldFluencyScoring:
+		return m.FluencyScoring()
+	case interview.FieldTextOnly:
+		return m.TextOnly()
 	case interview.FieldTotalQuestions:
 		return m.TotalQuestions()
 	case interview.FieldRemainingQuestions:
@@ -1361,6 +1677,8 @@ func (m *InterviewMutation) Field(name string) (ent.Value, bool) {
 		return m.TotalScore()
 	case interview.FieldOverallScore:
 		return m.OverallScore()
+	case interview.FieldFluencyResult:
+		return m.FluencyResult()
 	case interview.FieldPositiveFeedback:
 		return m.PositiveFeedback()
 	case interview.FieldActionableFeedback:
@@ -1369,6 +1687,8 @@ func (m *InterviewMutation) Field(name string) (ent.Value, bool) {
 		return m.FinalComment()
 	case interview.FieldStatus:
 		return m.Status()
+	case interview.FieldFailureReason:
+		return m.FailureReason()
 	}
 	return nil, false
 }
@@ -1382,6 +1702,8 @@ func (m *InterviewMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldCreatedAt(ctx)
 	case interview.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case interview.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	case interview.FieldUserID:
 		return m.OldUserID(ctx)
 	case interview.FieldPosition:
@@ -1400,6 +1722,12 @@ func (m *InterviewMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldSkillsScore(ctx)
 	case interview.FieldSkipCode:
 		return m.OldSkipCode(ctx)
+	case interview.FieldQuestionTimeLimit:
+		return m.OldQuestionTimeLimit(ctx)
+	case interview.FieldFluencyScoring:
+		return m.OldFluencyScoring(ctx)
+	case interview.FieldTextOnly:
+		return m.OldTextOnly(ctx)
 	case interview.FieldTotalQuestions:
 		return m.OldTotalQuestions(ctx)
 	case interview.FieldRemainingQuestions:
@@ -1408,6 +1736,8 @@ func (m *InterviewMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldTotalScore(ctx)
 	case interview.FieldOverallScore:
 		return m.OldOverallScore(ctx)
+	case interview.FieldFluencyResult:
+		return m.OldFluencyResult(ctx)
 	case interview.FieldPositiveFeedback:
 		return m.OldPositiveFeedback(ctx)
 	case interview.FieldActionableFeedback:
@@ -1416,6 +1746,8 @@ func (m *InterviewMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldFinalComment(ctx)
 	case interview.FieldStatus:
 		return m.OldStatus(ctx)
+	case interview.FieldFailureReason:
+		return m.OldFailureReason(ctx)
 	}
 	return nil, fmt.Errorf("unknown Interview field %s", name)
 }
@@ -1439,6 +1771,13 @@ func (m *InterviewMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case interview.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	case interview.FieldUserID:
 		v, ok := value.(uint64)
 		if !ok {
@@ -1502,6 +1841,27 @@ func (m *InterviewMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSkipCode(v)
 		return nil
+	case interview.FieldQuestionTimeLimit:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionTimeLimit(v)
+		return nil
+	case interview.FieldFluencyScoring:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFluencyScoring(v)
+		return nil
+	case interview.FieldTextOnly:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextOnly(v)
+		return nil
 	case interview.FieldTotalQuestions:
 		v, ok := value.(int32)
 		if !ok {
@@ -1530,6 +1890,13 @@ func (m *InterviewMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetOverallScore(v)
 		return nil
+	case interview.FieldFluencyResult:
+		v, ok := value.(*irelia.ScoreFluencyResponse)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFluencyResult(v)
+		return nil
 	case interview.FieldPositiveFeedback:
 		v, ok := value.(string)
 		if !ok {
@@ -1558,6 +1925,13 @@ func (m *InterviewMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetStatus(v)
 		return nil
+	case interview.FieldFailureReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureReason(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Interview field %s", name)
 }
@@ -1572,6 +1946,9 @@ func (m *InterviewMutation) AddedFields() []string {
 	if m.addspeed != nil {
 		fields = append(fields, interview.FieldSpeed)
 	}
+	if m.addquestion_time_limit != nil {
+		fields = append(fields, interview.FieldQuestionTimeLimit)
+	}
 	if m.addtotal_questions != nil {
 		fields = append(fields, interview.FieldTotalQuestions)
 	}
@@ -1596,6 +1973,8 @@ func (m *InterviewMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedUserID()
 	case interview.FieldSpeed:
 		return m.AddedSpeed()
+	case interview.FieldQuestionTimeLimit:
+		return m.AddedQuestionTimeLimit()
 	case interview.FieldTotalQuestions:
 		return m.AddedTotalQuestions()
 	case interview.FieldRemainingQuestions:
@@ -1627,6 +2006,13 @@ func (m *InterviewMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddSpeed(v)
 		return nil
+	case interview.FieldQuestionTimeLimit:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestionTimeLimit(v)
+		return nil
 	case interview.FieldTotalQuestions:
 		v, ok := value.(int32)
 		if !ok {
@@ -1663,6 +2049,9 @@ func (m *InterviewMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *InterviewMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(interview.FieldDeletedAt) {
+		fields = append(fields, interview.FieldDeletedAt)
+	}
 	if m.FieldCleared(interview.FieldExperience) {
 		fields = append(fields, interview.FieldExperience)
 	}
@@ -1678,6 +2067,9 @@ func (m *InterviewMutation) ClearedFields() []string {
 	if m.FieldCleared(interview.FieldTotalScore) {
 		fields = append(fields, interview.FieldTotalScore)
 	}
+	if m.FieldCleared(interview.FieldFluencyResult) {
+		fields = append(fields, interview.FieldFluencyResult)
+	}
 	if m.FieldCleared(interview.FieldPositiveFeedback) {
 		fields = append(fields, interview.FieldPositiveFeedback)
 	}
@@ -1687,6 +2079,9 @@ func (m *InterviewMutation) ClearedFields() []string {
 	if m.FieldCleared(interview.FieldFinalComment) {
 		fields = append(fields, interview.FieldFinalComment)
 	}
+	if m.FieldCleared(interview.FieldFailureReason) {
+		fields = append(fields, interview.FieldFailureReason)
+	}
 	return fields
 }
 
@@ -1701,6 +2096,9 @@ func (m *InterviewMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *InterviewMutation) ClearField(name string) error {
 	switch name {
+	case interview.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
 	case interview.FieldExperience:
 		m.ClearExperience()
 		return nil
@@ -1716,6 +2114,9 @@ func (m *InterviewMutation) ClearField(name string) error {
 	case interview.FieldTotalScore:
 		m.ClearTotalScore()
 		return nil
+	case interview.FieldFluencyResult:
+		m.ClearFluencyResult()
+		return nil
 	case interview.FieldPositiveFeedback:
 		m.ClearPositiveFeedback()
 		return nil
@@ -1725,6 +2126,9 @@ func (m *InterviewMutation) ClearField(name string) error {
 	case interview.FieldFinalComment:
 		m.ClearFinalComment()
 		return nil
+	case interview.FieldFailureReason:
+		m.ClearFailureReason()
+		return nil
 	}
 	return fmt.Errorf("unknown Interview nullable field %s", name)
 }
@@ -1739,6 +2143,9 @@ func (m *InterviewMutation) ResetField(name string) error {
 	case interview.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case interview.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	case interview.FieldUserID:
 		m.ResetUserID()
 		return nil
@@ -1766,6 +2173,15 @@ func (m *InterviewMutation) ResetField(name string) error {
 	case interview.FieldSkipCode:
 		m.ResetSkipCode()
 		return nil
+	case interview.FieldQuestionTimeLimit:
+		m.ResetQuestionTimeLimit()
+		return nil
+	case interview.FieldFluencyScoring:
+		m.ResetFluencyScoring()
+		return nil
+	case interview.FieldTextOnly:
+		m.ResetTextOnly()
+		return nil
 	case interview.FieldTotalQuestions:
 		m.ResetTotalQuestions()
 		return nil
@@ -1778,6 +2194,9 @@ func (m *InterviewMutation) ResetField(name string) error {
 	case interview.FieldOverallScore:
 		m.ResetOverallScore()
 		return nil
+	case interview.FieldFluencyResult:
+		m.ResetFluencyResult()
+		return nil
 	case interview.FieldPositiveFeedback:
 		m.ResetPositiveFeedback()
 		return nil
@@ -1790,6 +2209,9 @@ func (m *InterviewMutation) ResetField(name string) error {
 	case interview.FieldStatus:
 		m.ResetStatus()
 		return nil
+	case interview.FieldFailureReason:
+		m.ResetFailureReason()
+		return nil
 	}
 	return fmt.Errorf("unknown Interview field %s", name)
 }
@@ -1912,6 +2334,7 @@ type InterviewFavoriteMutation struct {
 	id               *int
 	created_at       *time.Time
 	updated_at       *time.Time
+	deleted_at       *time.Time
 	user_id          *uint64
 	adduser_id       *int64
 	clearedFields    map[string]struct{}
@@ -2092,6 +2515,55 @@ func (m *InterviewFavoriteMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *InterviewFavoriteMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *InterviewFavoriteMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the InterviewFavorite entity.
+// If the InterviewFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InterviewFavoriteMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *InterviewFavoriteMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[interviewfavorite.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *InterviewFavoriteMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[interviewfavorite.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *InterviewFavoriteMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, interviewfavorite.FieldDeletedAt)
+}
+
 // SetUserID sets the "user_id" field.
 func (m *InterviewFavoriteMutation) SetUserID(u uint64) {
 	m.user_id = &u
@@ -2245,13 +2717,16 @@ func (m *InterviewFavoriteMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *InterviewFavoriteMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
 		fields = append(fields, interviewfavorite.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
 		fields = append(fields, interviewfavorite.FieldUpdatedAt)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, interviewfavorite.FieldDeletedAt)
+	}
 	if m.user_id != nil {
 		fields = append(fields, interviewfavorite.FieldUserID)
 	}
@@ -2270,6 +2745,8 @@ func (m *InterviewFavoriteMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case interviewfavorite.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case interviewfavorite.FieldDeletedAt:
+		return m.DeletedAt()
 	case interviewfavorite.FieldUserID:
 		return m.UserID()
 	case interviewfavorite.FieldInterviewID:
@@ -2287,6 +2764,8 @@ func (m *InterviewFavoriteMutation) OldField(ctx context.Context, name string) (
 		return m.OldCreatedAt(ctx)
 	case interviewfavorite.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case interviewfavorite.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	case interviewfavorite.FieldUserID:
 		return m.OldUserID(ctx)
 	case interviewfavorite.FieldInterviewID:
@@ -2314,6 +2793,13 @@ func (m *InterviewFavoriteMutation) SetField(name string, value ent.Value) error
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case interviewfavorite.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	case interviewfavorite.FieldUserID:
 		v, ok := value.(uint64)
 		if !ok {
@@ -2372,7 +2858,11 @@ func (m *InterviewFavoriteMutation) AddField(name string, value ent.Value) error
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *InterviewFavoriteMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(interviewfavorite.FieldDeletedAt) {
+		fields = append(fields, interviewfavorite.FieldDeletedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -2385,6 +2875,11 @@ func (m *InterviewFavoriteMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *InterviewFavoriteMutation) ClearField(name string) error {
+	switch name {
+	case interviewfavorite.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown InterviewFavorite nullable field %s", name)
 }
 
@@ -2398,6 +2893,9 @@ func (m *InterviewFavoriteMutation) ResetField(name string) error {
 	case interviewfavorite.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case interviewfavorite.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	case interviewfavorite.FieldUserID:
 		m.ResetUserID()
 		return nil
@@ -2482,36 +2980,51 @@ func (m *InterviewFavoriteMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown InterviewFavorite edge %s", name)
 }
 
-// PublicQuestionMutation represents an operation that mutates the PublicQuestion nodes in the graph.
-type PublicQuestionMutation struct {
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	position      *string
-	experience    *string
-	language      *string
-	content       *string
-	answer        *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*PublicQuestion, error)
-	predicates    []predicate.PublicQuestion
-}
-
-var _ ent.Mutation = (*PublicQuestionMutation)(nil)
+	op                Op
+	typ               string
+	id                *int
+	created_at        *time.Time
+	updated_at        *time.Time
+	deleted_at        *time.Time
+	kind              *irelia.JobKind
+	addkind           *irelia.JobKind
+	interview_id      *string
+	question_index    *int32
+	addquestion_index *int32
+	sub_index         *int32
+	addsub_index      *int32
+	user_id           *uint64
+	adduser_id        *int64
+	status            *irelia.JobStatus
+	addstatus         *irelia.JobStatus
+	attempts          *int32
+	addattempts       *int32
+	max_attempts      *int32
+	addmax_attempts   *int32
+	run_at            *time.Time
+	locked_by         *string
+	locked_until      *time.Time
+	last_error        *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Job, error)
+	predicates        []predicate.Job
+}
 
-// publicquestionOption allows management of the mutation configuration using functional options.
-type publicquestionOption func(*PublicQuestionMutation)
+var _ ent.Mutation = (*JobMutation)(nil)
 
-// newPublicQuestionMutation creates new mutation for the PublicQuestion entity.
-func newPublicQuestionMutation(c config, op Op, opts ...publicquestionOption) *PublicQuestionMutation {
-	m := &PublicQuestionMutation{
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePublicQuestion,
+		typ:           TypeJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2520,20 +3033,20 @@ func newPublicQuestionMutation(c config, op Op, opts ...publicquestionOption) *P
 	return m
 }
 
-// withPublicQuestionID sets the ID field of the mutation.
-func withPublicQuestionID(id int) publicquestionOption {
-	return func(m *PublicQuestionMutation) {
+// withJobID sets the ID field of the mutation.
+func withJobID(id int) jobOption {
+	return func(m *JobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PublicQuestion
+			value *Job
 		)
-		m.oldValue = func(ctx context.Context) (*PublicQuestion, error) {
+		m.oldValue = func(ctx context.Context) (*Job, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PublicQuestion.Get(ctx, id)
+					value, err = m.Client().Job.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2542,10 +3055,10 @@ func withPublicQuestionID(id int) publicquestionOption {
 	}
 }
 
-// withPublicQuestion sets the old PublicQuestion of the mutation.
-func withPublicQuestion(node *PublicQuestion) publicquestionOption {
-	return func(m *PublicQuestionMutation) {
-		m.oldValue = func(context.Context) (*PublicQuestion, error) {
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2554,7 +3067,7 @@ func withPublicQuestion(node *PublicQuestion) publicquestionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PublicQuestionMutation) Client() *Client {
+func (m JobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2562,7 +3075,7 @@ func (m PublicQuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PublicQuestionMutation) Tx() (*Tx, error) {
+func (m JobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2573,7 +3086,7 @@ func (m PublicQuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PublicQuestionMutation) ID() (id int, exists bool) {
+func (m *JobMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2584,7 +3097,7 @@ func (m *PublicQuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PublicQuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *JobMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2593,19 +3106,19 @@ func (m *PublicQuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PublicQuestion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PublicQuestionMutation) SetCreatedAt(t time.Time) {
+func (m *JobMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PublicQuestionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2613,10 +3126,10 @@ func (m *PublicQuestionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PublicQuestion entity.
-// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2631,17 +3144,17 @@ func (m *PublicQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PublicQuestionMutation) ResetCreatedAt() {
+func (m *JobMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PublicQuestionMutation) SetUpdatedAt(t time.Time) {
+func (m *JobMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PublicQuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *JobMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2649,10 +3162,10 @@ func (m *PublicQuestionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PublicQuestion entity.
-// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicQuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2667,258 +3180,6467 @@ func (m *PublicQuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PublicQuestionMutation) ResetUpdatedAt() {
+func (m *JobMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetPosition sets the "position" field.
-func (m *PublicQuestionMutation) SetPosition(s string) {
-	m.position = &s
+// SetDeletedAt sets the "deleted_at" field.
+func (m *JobMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Position returns the value of the "position" field in the mutation.
-func (m *PublicQuestionMutation) Position() (r string, exists bool) {
-	v := m.position
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *JobMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPosition returns the old "position" field's value of the PublicQuestion entity.
-// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicQuestionMutation) OldPosition(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosition requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Position, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetPosition resets all changes to the "position" field.
-func (m *PublicQuestionMutation) ResetPosition() {
-	m.position = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *JobMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[job.FieldDeletedAt] = struct{}{}
 }
 
-// SetExperience sets the "experience" field.
-func (m *PublicQuestionMutation) SetExperience(s string) {
-	m.experience = &s
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *JobMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldDeletedAt]
+	return ok
 }
 
-// Experience returns the value of the "experience" field in the mutation.
-func (m *PublicQuestionMutation) Experience() (r string, exists bool) {
-	v := m.experience
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *JobMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, job.FieldDeletedAt)
+}
+
+// SetKind sets the "kind" field.
+func (m *JobMutation) SetKind(ik irelia.JobKind) {
+	m.kind = &ik
+	m.addkind = nil
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *JobMutation) Kind() (r irelia.JobKind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExperience returns the old "experience" field's value of the PublicQuestion entity.
-// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicQuestionMutation) OldExperience(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldKind(ctx context.Context) (v irelia.JobKind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExperience is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExperience requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExperience: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Experience, nil
+	return oldValue.Kind, nil
 }
 
-// ResetExperience resets all changes to the "experience" field.
-func (m *PublicQuestionMutation) ResetExperience() {
-	m.experience = nil
+// AddKind adds ik to the "kind" field.
+func (m *JobMutation) AddKind(ik irelia.JobKind) {
+	if m.addkind != nil {
+		*m.addkind += ik
+	} else {
+		m.addkind = &ik
+	}
 }
 
-// SetLanguage sets the "language" field.
-func (m *PublicQuestionMutation) SetLanguage(s string) {
-	m.language = &s
+// AddedKind returns the value that was added to the "kind" field in this mutation.
+func (m *JobMutation) AddedKind() (r irelia.JobKind, exists bool) {
+	v := m.addkind
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Language returns the value of the "language" field in the mutation.
-func (m *PublicQuestionMutation) Language() (r string, exists bool) {
-	v := m.language
+// ResetKind resets all changes to the "kind" field.
+func (m *JobMutation) ResetKind() {
+	m.kind = nil
+	m.addkind = nil
+}
+
+// SetInterviewID sets the "interview_id" field.
+func (m *JobMutation) SetInterviewID(s string) {
+	m.interview_id = &s
+}
+
+// InterviewID returns the value of the "interview_id" field in the mutation.
+func (m *JobMutation) InterviewID() (r string, exists bool) {
+	v := m.interview_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLanguage returns the old "language" field's value of the PublicQuestion entity.
-// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldInterviewID returns the old "interview_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicQuestionMutation) OldLanguage(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldInterviewID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldInterviewID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLanguage requires an ID field in the mutation")
+		return v, errors.New("OldInterviewID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldInterviewID: %w", err)
 	}
-	return oldValue.Language, nil
+	return oldValue.InterviewID, nil
 }
 
-// ResetLanguage resets all changes to the "language" field.
-func (m *PublicQuestionMutation) ResetLanguage() {
-	m.language = nil
+// ResetInterviewID resets all changes to the "interview_id" field.
+func (m *JobMutation) ResetInterviewID() {
+	m.interview_id = nil
 }
 
-// SetContent sets the "content" field.
-func (m *PublicQuestionMutation) SetContent(s string) {
-	m.content = &s
+// SetQuestionIndex sets the "question_index" field.
+func (m *JobMutation) SetQuestionIndex(i int32) {
+	m.question_index = &i
+	m.addquestion_index = nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *PublicQuestionMutation) Content() (r string, exists bool) {
-	v := m.content
+// QuestionIndex returns the value of the "question_index" field in the mutation.
+func (m *JobMutation) QuestionIndex() (r int32, exists bool) {
+	v := m.question_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the PublicQuestion entity.
-// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldQuestionIndex returns the old "question_index" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicQuestionMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldQuestionIndex(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuestionIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldQuestionIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuestionIndex: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.QuestionIndex, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *PublicQuestionMutation) ResetContent() {
-	m.content = nil
+// AddQuestionIndex adds i to the "question_index" field.
+func (m *JobMutation) AddQuestionIndex(i int32) {
+	if m.addquestion_index != nil {
+		*m.addquestion_index += i
+	} else {
+		m.addquestion_index = &i
+	}
 }
 
-// SetAnswer sets the "answer" field.
-func (m *PublicQuestionMutation) SetAnswer(s string) {
-	m.answer = &s
+// AddedQuestionIndex returns the value that was added to the "question_index" field in this mutation.
+func (m *JobMutation) AddedQuestionIndex() (r int32, exists bool) {
+	v := m.addquestion_index
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Answer returns the value of the "answer" field in the mutation.
-func (m *PublicQuestionMutation) Answer() (r string, exists bool) {
-	v := m.answer
+// ResetQuestionIndex resets all changes to the "question_index" field.
+func (m *JobMutation) ResetQuestionIndex() {
+	m.question_index = nil
+	m.addquestion_index = nil
+}
+
+// SetSubIndex sets the "sub_index" field.
+func (m *JobMutation) SetSubIndex(i int32) {
+	m.sub_index = &i
+	m.addsub_index = nil
+}
+
+// SubIndex returns the value of the "sub_index" field in the mutation.
+func (m *JobMutation) SubIndex() (r int32, exists bool) {
+	v := m.sub_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnswer returns the old "answer" field's value of the PublicQuestion entity.
-// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldSubIndex returns the old "sub_index" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicQuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldSubIndex(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswer requires an ID field in the mutation")
+		return v, errors.New("OldSubIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubIndex: %w", err)
 	}
-	return oldValue.Answer, nil
+	return oldValue.SubIndex, nil
 }
 
-// ClearAnswer clears the value of the "answer" field.
-func (m *PublicQuestionMutation) ClearAnswer() {
-	m.answer = nil
-	m.clearedFields[publicquestion.FieldAnswer] = struct{}{}
+// AddSubIndex adds i to the "sub_index" field.
+func (m *JobMutation) AddSubIndex(i int32) {
+	if m.addsub_index != nil {
+		*m.addsub_index += i
+	} else {
+		m.addsub_index = &i
+	}
 }
 
-// AnswerCleared returns if the "answer" field was cleared in this mutation.
-func (m *PublicQuestionMutation) AnswerCleared() bool {
-	_, ok := m.clearedFields[publicquestion.FieldAnswer]
-	return ok
+// AddedSubIndex returns the value that was added to the "sub_index" field in this mutation.
+func (m *JobMutation) AddedSubIndex() (r int32, exists bool) {
+	v := m.addsub_index
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAnswer resets all changes to the "answer" field.
-func (m *PublicQuestionMutation) ResetAnswer() {
-	m.answer = nil
-	delete(m.clearedFields, publicquestion.FieldAnswer)
+// ResetSubIndex resets all changes to the "sub_index" field.
+func (m *JobMutation) ResetSubIndex() {
+	m.sub_index = nil
+	m.addsub_index = nil
 }
 
-// Where appends a list predicates to the PublicQuestionMutation builder.
-func (m *PublicQuestionMutation) Where(ps ...predicate.PublicQuestion) {
-	m.predicates = append(m.predicates, ps...)
+// SetUserID sets the "user_id" field.
+func (m *JobMutation) SetUserID(u uint64) {
+	m.user_id = &u
+	m.adduser_id = nil
 }
 
-// WhereP appends storage-level predicates to the PublicQuestionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PublicQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PublicQuestion, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *JobMutation) UserID() (r uint64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *PublicQuestionMutation) Op() Op {
-	return m.op
+// OldUserID returns the old "user_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUserID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PublicQuestionMutation) SetOp(op Op) {
-	m.op = op
+// AddUserID adds u to the "user_id" field.
+func (m *JobMutation) AddUserID(u int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += u
+	} else {
+		m.adduser_id = &u
+	}
 }
 
-// Type returns the node type of this mutation (PublicQuestion).
-func (m *PublicQuestionMutation) Type() string {
-	return m.typ
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *JobMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PublicQuestionMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.created_at != nil {
-		fields = append(fields, publicquestion.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, publicquestion.FieldUpdatedAt)
-	}
-	if m.position != nil {
-		fields = append(fields, publicquestion.FieldPosition)
-	}
-	if m.experience != nil {
-		fields = append(fields, publicquestion.FieldExperience)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *JobMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(is irelia.JobStatus) {
+	m.status = &is
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r irelia.JobStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStatus(ctx context.Context) (v irelia.JobStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds is to the "status" field.
+func (m *JobMutation) AddStatus(is irelia.JobStatus) {
+	if m.addstatus != nil {
+		*m.addstatus += is
+	} else {
+		m.addstatus = &is
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *JobMutation) AddedStatus() (r irelia.JobStatus, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int32) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int32, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int32) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int32, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *JobMutation) SetMaxAttempts(i int32) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *JobMutation) MaxAttempts() (r int32, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldMaxAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *JobMutation) AddMaxAttempts(i int32) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *JobMutation) AddedMaxAttempts() (r int32, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *JobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+}
+
+// SetRunAt sets the "run_at" field.
+func (m *JobMutation) SetRunAt(t time.Time) {
+	m.run_at = &t
+}
+
+// RunAt returns the value of the "run_at" field in the mutation.
+func (m *JobMutation) RunAt() (r time.Time, exists bool) {
+	v := m.run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunAt returns the old "run_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunAt: %w", err)
+	}
+	return oldValue.RunAt, nil
+}
+
+// ResetRunAt resets all changes to the "run_at" field.
+func (m *JobMutation) ResetRunAt() {
+	m.run_at = nil
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (m *JobMutation) SetLockedBy(s string) {
+	m.locked_by = &s
+}
+
+// LockedBy returns the value of the "locked_by" field in the mutation.
+func (m *JobMutation) LockedBy() (r string, exists bool) {
+	v := m.locked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedBy returns the old "locked_by" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedBy: %w", err)
+	}
+	return oldValue.LockedBy, nil
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (m *JobMutation) ClearLockedBy() {
+	m.locked_by = nil
+	m.clearedFields[job.FieldLockedBy] = struct{}{}
+}
+
+// LockedByCleared returns if the "locked_by" field was cleared in this mutation.
+func (m *JobMutation) LockedByCleared() bool {
+	_, ok := m.clearedFields[job.FieldLockedBy]
+	return ok
+}
+
+// ResetLockedBy resets all changes to the "locked_by" field.
+func (m *JobMutation) ResetLockedBy() {
+	m.locked_by = nil
+	delete(m.clearedFields, job.FieldLockedBy)
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *JobMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *JobMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *JobMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[job.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *JobMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[job.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *JobMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, job.FieldLockedUntil)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *JobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *JobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *JobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[job.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *JobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *JobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, job.FieldLastError)
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, job.FieldDeletedAt)
+	}
+	if m.kind != nil {
+		fields = append(fields, job.FieldKind)
+	}
+	if m.interview_id != nil {
+		fields = append(fields, job.FieldInterviewID)
+	}
+	if m.question_index != nil {
+		fields = append(fields, job.FieldQuestionIndex)
+	}
+	if m.sub_index != nil {
+		fields = append(fields, job.FieldSubIndex)
+	}
+	if m.user_id != nil {
+		fields = append(fields, job.FieldUserID)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	if m.run_at != nil {
+		fields = append(fields, job.FieldRunAt)
+	}
+	if m.locked_by != nil {
+		fields = append(fields, job.FieldLockedBy)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, job.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, job.FieldLastError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case job.FieldDeletedAt:
+		return m.DeletedAt()
+	case job.FieldKind:
+		return m.Kind()
+	case job.FieldInterviewID:
+		return m.InterviewID()
+	case job.FieldQuestionIndex:
+		return m.QuestionIndex()
+	case job.FieldSubIndex:
+		return m.SubIndex()
+	case job.FieldUserID:
+		return m.UserID()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case job.FieldRunAt:
+		return m.RunAt()
+	case job.FieldLockedBy:
+		return m.LockedBy()
+	case job.FieldLockedUntil:
+		return m.LockedUntil()
+	case job.FieldLastError:
+		return m.LastError()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case job.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case job.FieldKind:
+		return m.OldKind(ctx)
+	case job.FieldInterviewID:
+		return m.OldInterviewID(ctx)
+	case job.FieldQuestionIndex:
+		return m.OldQuestionIndex(ctx)
+	case job.FieldSubIndex:
+		return m.OldSubIndex(ctx)
+	case job.FieldUserID:
+		return m.OldUserID(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case job.FieldRunAt:
+		return m.OldRunAt(ctx)
+	case job.FieldLockedBy:
+		return m.OldLockedBy(ctx)
+	case job.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case job.FieldLastError:
+		return m.OldLastError(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case job.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case job.FieldKind:
+		v, ok := value.(irelia.JobKind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case job.FieldInterviewID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterviewID(v)
+		return nil
+	case job.FieldQuestionIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionIndex(v)
+		return nil
+	case job.FieldSubIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubIndex(v)
+		return nil
+	case job.FieldUserID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(irelia.JobStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case job.FieldRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunAt(v)
+		return nil
+	case job.FieldLockedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedBy(v)
+		return nil
+	case job.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case job.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addkind != nil {
+		fields = append(fields, job.FieldKind)
+	}
+	if m.addquestion_index != nil {
+		fields = append(fields, job.FieldQuestionIndex)
+	}
+	if m.addsub_index != nil {
+		fields = append(fields, job.FieldSubIndex)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, job.FieldUserID)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldKind:
+		return m.AddedKind()
+	case job.FieldQuestionIndex:
+		return m.AddedQuestionIndex()
+	case job.FieldSubIndex:
+		return m.AddedSubIndex()
+	case job.FieldUserID:
+		return m.AddedUserID()
+	case job.FieldStatus:
+		return m.AddedStatus()
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	case job.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldKind:
+		v, ok := value.(irelia.JobKind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKind(v)
+		return nil
+	case job.FieldQuestionIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestionIndex(v)
+		return nil
+	case job.FieldSubIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubIndex(v)
+		return nil
+	case job.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(irelia.JobStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldDeletedAt) {
+		fields = append(fields, job.FieldDeletedAt)
+	}
+	if m.FieldCleared(job.FieldLockedBy) {
+		fields = append(fields, job.FieldLockedBy)
+	}
+	if m.FieldCleared(job.FieldLockedUntil) {
+		fields = append(fields, job.FieldLockedUntil)
+	}
+	if m.FieldCleared(job.FieldLastError) {
+		fields = append(fields, job.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case job.FieldLockedBy:
+		m.ClearLockedBy()
+		return nil
+	case job.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case job.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case job.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case job.FieldKind:
+		m.ResetKind()
+		return nil
+	case job.FieldInterviewID:
+		m.ResetInterviewID()
+		return nil
+	case job.FieldQuestionIndex:
+		m.ResetQuestionIndex()
+		return nil
+	case job.FieldSubIndex:
+		m.ResetSubIndex()
+		return nil
+	case job.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case job.FieldRunAt:
+		m.ResetRunAt()
+		return nil
+	case job.FieldLockedBy:
+		m.ResetLockedBy()
+		return nil
+	case job.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case job.FieldLastError:
+		m.ResetLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// ModerationAuditMutation represents an operation that mutates the ModerationAudit nodes in the graph.
+type ModerationAuditMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	deleted_at         *time.Time
+	question_id        *int
+	addquestion_id     *int
+	moderator_id       *uint64
+	addmoderator_id    *int64
+	action             *irelia.ModerationAction
+	addaction          *irelia.ModerationAction
+	previous_status    *irelia.ModerationStatus
+	addprevious_status *irelia.ModerationStatus
+	status             *irelia.ModerationStatus
+	addstatus          *irelia.ModerationStatus
+	merged_into_id     *int
+	addmerged_into_id  *int
+	previous_content   *string
+	content            *string
+	note               *string
+	previous_answer    *string
+	answer             *string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ModerationAudit, error)
+	predicates         []predicate.ModerationAudit
+}
+
+var _ ent.Mutation = (*ModerationAuditMutation)(nil)
+
+// moderationauditOption allows management of the mutation configuration using functional options.
+type moderationauditOption func(*ModerationAuditMutation)
+
+// newModerationAuditMutation creates new mutation for the ModerationAudit entity.
+func newModerationAuditMutation(c config, op Op, opts ...moderationauditOption) *ModerationAuditMutation {
+	m := &ModerationAuditMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModerationAudit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModerationAuditID sets the ID field of the mutation.
+func withModerationAuditID(id int) moderationauditOption {
+	return func(m *ModerationAuditMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModerationAudit
+		)
+		m.oldValue = func(ctx context.Context) (*ModerationAudit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModerationAudit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModerationAudit sets the old ModerationAudit of the mutation.
+func withModerationAudit(node *ModerationAudit) moderationauditOption {
+	return func(m *ModerationAuditMutation) {
+		m.oldValue = func(context.Context) (*ModerationAudit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModerationAuditMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModerationAuditMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModerationAuditMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModerationAuditMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModerationAudit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModerationAuditMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModerationAuditMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModerationAuditMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ModerationAuditMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ModerationAuditMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ModerationAuditMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ModerationAuditMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ModerationAuditMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ModerationAuditMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[moderationaudit.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ModerationAuditMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[moderationaudit.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ModerationAuditMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, moderationaudit.FieldDeletedAt)
+}
+
+// SetQuestionID sets the "question_id" field.
+func (m *ModerationAuditMutation) SetQuestionID(i int) {
+	m.question_id = &i
+	m.addquestion_id = nil
+}
+
+// QuestionID returns the value of the "question_id" field in the mutation.
+func (m *ModerationAuditMutation) QuestionID() (r int, exists bool) {
+	v := m.question_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionID returns the old "question_id" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldQuestionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
+	}
+	return oldValue.QuestionID, nil
+}
+
+// AddQuestionID adds i to the "question_id" field.
+func (m *ModerationAuditMutation) AddQuestionID(i int) {
+	if m.addquestion_id != nil {
+		*m.addquestion_id += i
+	} else {
+		m.addquestion_id = &i
+	}
+}
+
+// AddedQuestionID returns the value that was added to the "question_id" field in this mutation.
+func (m *ModerationAuditMutation) AddedQuestionID() (r int, exists bool) {
+	v := m.addquestion_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuestionID resets all changes to the "question_id" field.
+func (m *ModerationAuditMutation) ResetQuestionID() {
+	m.question_id = nil
+	m.addquestion_id = nil
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (m *ModerationAuditMutation) SetModeratorID(u uint64) {
+	m.moderator_id = &u
+	m.addmoderator_id = nil
+}
+
+// ModeratorID returns the value of the "moderator_id" field in the mutation.
+func (m *ModerationAuditMutation) ModeratorID() (r uint64, exists bool) {
+	v := m.moderator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModeratorID returns the old "moderator_id" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldModeratorID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModeratorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModeratorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModeratorID: %w", err)
+	}
+	return oldValue.ModeratorID, nil
+}
+
+// AddModeratorID adds u to the "moderator_id" field.
+func (m *ModerationAuditMutation) AddModeratorID(u int64) {
+	if m.addmoderator_id != nil {
+		*m.addmoderator_id += u
+	} else {
+		m.addmoderator_id = &u
+	}
+}
+
+// AddedModeratorID returns the value that was added to the "moderator_id" field in this mutation.
+func (m *ModerationAuditMutation) AddedModeratorID() (r int64, exists bool) {
+	v := m.addmoderator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetModeratorID resets all changes to the "moderator_id" field.
+func (m *ModerationAuditMutation) ResetModeratorID() {
+	m.moderator_id = nil
+	m.addmoderator_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ModerationAuditMutation) SetAction(ia irelia.ModerationAction) {
+	m.action = &ia
+	m.addaction = nil
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ModerationAuditMutation) Action() (r irelia.ModerationAction, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldAction(ctx context.Context) (v irelia.ModerationAction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// AddAction adds ia to the "action" field.
+func (m *ModerationAuditMutation) AddAction(ia irelia.ModerationAction) {
+	if m.addaction != nil {
+		*m.addaction += ia
+	} else {
+		m.addaction = &ia
+	}
+}
+
+// AddedAction returns the value that was added to the "action" field in this mutation.
+func (m *ModerationAuditMutation) AddedAction() (r irelia.ModerationAction, exists bool) {
+	v := m.addaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ModerationAuditMutation) ResetAction() {
+	m.action = nil
+	m.addaction = nil
+}
+
+// SetPreviousStatus sets the "previous_status" field.
+func (m *ModerationAuditMutation) SetPreviousStatus(is irelia.ModerationStatus) {
+	m.previous_status = &is
+	m.addprevious_status = nil
+}
+
+// PreviousStatus returns the value of the "previous_status" field in the mutation.
+func (m *ModerationAuditMutation) PreviousStatus() (r irelia.ModerationStatus, exists bool) {
+	v := m.previous_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousStatus returns the old "previous_status" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldPreviousStatus(ctx context.Context) (v irelia.ModerationStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousStatus: %w", err)
+	}
+	return oldValue.PreviousStatus, nil
+}
+
+// AddPreviousStatus adds is to the "previous_status" field.
+func (m *ModerationAuditMutation) AddPreviousStatus(is irelia.ModerationStatus) {
+	if m.addprevious_status != nil {
+		*m.addprevious_status += is
+	} else {
+		m.addprevious_status = &is
+	}
+}
+
+// AddedPreviousStatus returns the value that was added to the "previous_status" field in this mutation.
+func (m *ModerationAuditMutation) AddedPreviousStatus() (r irelia.ModerationStatus, exists bool) {
+	v := m.addprevious_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPreviousStatus resets all changes to the "previous_status" field.
+func (m *ModerationAuditMutation) ResetPreviousStatus() {
+	m.previous_status = nil
+	m.addprevious_status = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ModerationAuditMutation) SetStatus(is irelia.ModerationStatus) {
+	m.status = &is
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ModerationAuditMutation) Status() (r irelia.ModerationStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldStatus(ctx context.Context) (v irelia.ModerationStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds is to the "status" field.
+func (m *ModerationAuditMutation) AddStatus(is irelia.ModerationStatus) {
+	if m.addstatus != nil {
+		*m.addstatus += is
+	} else {
+		m.addstatus = &is
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *ModerationAuditMutation) AddedStatus() (r irelia.ModerationStatus, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ModerationAuditMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (m *ModerationAuditMutation) SetMergedIntoID(i int) {
+	m.merged_into_id = &i
+	m.addmerged_into_id = nil
+}
+
+// MergedIntoID returns the value of the "merged_into_id" field in the mutation.
+func (m *ModerationAuditMutation) MergedIntoID() (r int, exists bool) {
+	v := m.merged_into_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMergedIntoID returns the old "merged_into_id" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldMergedIntoID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMergedIntoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMergedIntoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMergedIntoID: %w", err)
+	}
+	return oldValue.MergedIntoID, nil
+}
+
+// AddMergedIntoID adds i to the "merged_into_id" field.
+func (m *ModerationAuditMutation) AddMergedIntoID(i int) {
+	if m.addmerged_into_id != nil {
+		*m.addmerged_into_id += i
+	} else {
+		m.addmerged_into_id = &i
+	}
+}
+
+// AddedMergedIntoID returns the value that was added to the "merged_into_id" field in this mutation.
+func (m *ModerationAuditMutation) AddedMergedIntoID() (r int, exists bool) {
+	v := m.addmerged_into_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (m *ModerationAuditMutation) ClearMergedIntoID() {
+	m.merged_into_id = nil
+	m.addmerged_into_id = nil
+	m.clearedFields[moderationaudit.FieldMergedIntoID] = struct{}{}
+}
+
+// MergedIntoIDCleared returns if the "merged_into_id" field was cleared in this mutation.
+func (m *ModerationAuditMutation) MergedIntoIDCleared() bool {
+	_, ok := m.clearedFields[moderationaudit.FieldMergedIntoID]
+	return ok
+}
+
+// ResetMergedIntoID resets all changes to the "merged_into_id" field.
+func (m *ModerationAuditMutation) ResetMergedIntoID() {
+	m.merged_into_id = nil
+	m.addmerged_into_id = nil
+	delete(m.clearedFields, moderationaudit.FieldMergedIntoID)
+}
+
+// SetPreviousContent sets the "previous_content" field.
+func (m *ModerationAuditMutation) SetPreviousContent(s string) {
+	m.previous_content = &s
+}
+
+// PreviousContent returns the value of the "previous_content" field in the mutation.
+func (m *ModerationAuditMutation) PreviousContent() (r string, exists bool) {
+	v := m.previous_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousContent returns the old "previous_content" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldPreviousContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousContent: %w", err)
+	}
+	return oldValue.PreviousContent, nil
+}
+
+// ClearPreviousContent clears the value of the "previous_content" field.
+func (m *ModerationAuditMutation) ClearPreviousContent() {
+	m.previous_content = nil
+	m.clearedFields[moderationaudit.FieldPreviousContent] = struct{}{}
+}
+
+// PreviousContentCleared returns if the "previous_content" field was cleared in this mutation.
+func (m *ModerationAuditMutation) PreviousContentCleared() bool {
+	_, ok := m.clearedFields[moderationaudit.FieldPreviousContent]
+	return ok
+}
+
+// ResetPreviousContent resets all changes to the "previous_content" field.
+func (m *ModerationAuditMutation) ResetPreviousContent() {
+	m.previous_content = nil
+	delete(m.clearedFields, moderationaudit.FieldPreviousContent)
+}
+
+// SetContent sets the "content" field.
+func (m *ModerationAuditMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ModerationAuditMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *ModerationAuditMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[moderationaudit.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *ModerationAuditMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[moderationaudit.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ModerationAuditMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, moderationaudit.FieldContent)
+}
+
+// SetNote sets the "note" field.
+func (m *ModerationAuditMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *ModerationAuditMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *ModerationAuditMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[moderationaudit.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *ModerationAuditMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[moderationaudit.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *ModerationAuditMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, moderationaudit.FieldNote)
+}
+
+// SetPreviousAnswer sets the "previous_answer" field.
+func (m *ModerationAuditMutation) SetPreviousAnswer(s string) {
+	m.previous_answer = &s
+}
+
+// PreviousAnswer returns the value of the "previous_answer" field in the mutation.
+func (m *ModerationAuditMutation) PreviousAnswer() (r string, exists bool) {
+	v := m.previous_answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousAnswer returns the old "previous_answer" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldPreviousAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousAnswer: %w", err)
+	}
+	return oldValue.PreviousAnswer, nil
+}
+
+// ClearPreviousAnswer clears the value of the "previous_answer" field.
+func (m *ModerationAuditMutation) ClearPreviousAnswer() {
+	m.previous_answer = nil
+	m.clearedFields[moderationaudit.FieldPreviousAnswer] = struct{}{}
+}
+
+// PreviousAnswerCleared returns if the "previous_answer" field was cleared in this mutation.
+func (m *ModerationAuditMutation) PreviousAnswerCleared() bool {
+	_, ok := m.clearedFields[moderationaudit.FieldPreviousAnswer]
+	return ok
+}
+
+// ResetPreviousAnswer resets all changes to the "previous_answer" field.
+func (m *ModerationAuditMutation) ResetPreviousAnswer() {
+	m.previous_answer = nil
+	delete(m.clearedFields, moderationaudit.FieldPreviousAnswer)
+}
+
+// SetAnswer sets the "answer" field.
+func (m *ModerationAuditMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *ModerationAuditMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the ModerationAudit entity.
+// If the ModerationAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationAuditMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (m *ModerationAuditMutation) ClearAnswer() {
+	m.answer = nil
+	m.clearedFields[moderationaudit.FieldAnswer] = struct{}{}
+}
+
+// AnswerCleared returns if the "answer" field was cleared in this mutation.
+func (m *ModerationAuditMutation) AnswerCleared() bool {
+	_, ok := m.clearedFields[moderationaudit.FieldAnswer]
+	return ok
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *ModerationAuditMutation) ResetAnswer() {
+	m.answer = nil
+	delete(m.clearedFields, moderationaudit.FieldAnswer)
+}
+
+// Where appends a list predicates to the ModerationAuditMutation builder.
+func (m *ModerationAuditMutation) Where(ps ...predicate.ModerationAudit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModerationAuditMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModerationAuditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModerationAudit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModerationAuditMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModerationAuditMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModerationAudit).
+func (m *ModerationAuditMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModerationAuditMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, moderationaudit.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, moderationaudit.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, moderationaudit.FieldDeletedAt)
+	}
+	if m.question_id != nil {
+		fields = append(fields, moderationaudit.FieldQuestionID)
+	}
+	if m.moderator_id != nil {
+		fields = append(fields, moderationaudit.FieldModeratorID)
+	}
+	if m.action != nil {
+		fields = append(fields, moderationaudit.FieldAction)
+	}
+	if m.previous_status != nil {
+		fields = append(fields, moderationaudit.FieldPreviousStatus)
+	}
+	if m.status != nil {
+		fields = append(fields, moderationaudit.FieldStatus)
+	}
+	if m.merged_into_id != nil {
+		fields = append(fields, moderationaudit.FieldMergedIntoID)
+	}
+	if m.previous_content != nil {
+		fields = append(fields, moderationaudit.FieldPreviousContent)
+	}
+	if m.content != nil {
+		fields = append(fields, moderationaudit.FieldContent)
+	}
+	if m.note != nil {
+		fields = append(fields, moderationaudit.FieldNote)
+	}
+	if m.previous_answer != nil {
+		fields = append(fields, moderationaudit.FieldPreviousAnswer)
+	}
+	if m.answer != nil {
+		fields = append(fields, moderationaudit.FieldAnswer)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModerationAuditMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moderationaudit.FieldCreatedAt:
+		return m.CreatedAt()
+	case moderationaudit.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case moderationaudit.FieldDeletedAt:
+		return m.DeletedAt()
+	case moderationaudit.FieldQuestionID:
+		return m.QuestionID()
+	case moderationaudit.FieldModeratorID:
+		return m.ModeratorID()
+	case moderationaudit.FieldAction:
+		return m.Action()
+	case moderationaudit.FieldPreviousStatus:
+		return m.PreviousStatus()
+	case moderationaudit.FieldStatus:
+		return m.Status()
+	case moderationaudit.FieldMergedIntoID:
+		return m.MergedIntoID()
+	case moderationaudit.FieldPreviousContent:
+		return m.PreviousContent()
+	case moderationaudit.FieldContent:
+		return m.Content()
+	case moderationaudit.FieldNote:
+		return m.Note()
+	case moderationaudit.FieldPreviousAnswer:
+		return m.PreviousAnswer()
+	case moderationaudit.FieldAnswer:
+		return m.Answer()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModerationAuditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case moderationaudit.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case moderationaudit.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case moderationaudit.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case moderationaudit.FieldQuestionID:
+		return m.OldQuestionID(ctx)
+	case moderationaudit.FieldModeratorID:
+		return m.OldModeratorID(ctx)
+	case moderationaudit.FieldAction:
+		return m.OldAction(ctx)
+	case moderationaudit.FieldPreviousStatus:
+		return m.OldPreviousStatus(ctx)
+	case moderationaudit.FieldStatus:
+		return m.OldStatus(ctx)
+	case moderationaudit.FieldMergedIntoID:
+		return m.OldMergedIntoID(ctx)
+	case moderationaudit.FieldPreviousContent:
+		return m.OldPreviousContent(ctx)
+	case moderationaudit.FieldContent:
+		return m.OldContent(ctx)
+	case moderationaudit.FieldNote:
+		return m.OldNote(ctx)
+	case moderationaudit.FieldPreviousAnswer:
+		return m.OldPreviousAnswer(ctx)
+	case moderationaudit.FieldAnswer:
+		return m.OldAnswer(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModerationAudit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationAuditMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case moderationaudit.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case moderationaudit.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case moderationaudit.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case moderationaudit.FieldQuestionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionID(v)
+		return nil
+	case moderationaudit.FieldModeratorID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModeratorID(v)
+		return nil
+	case moderationaudit.FieldAction:
+		v, ok := value.(irelia.ModerationAction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case moderationaudit.FieldPreviousStatus:
+		v, ok := value.(irelia.ModerationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousStatus(v)
+		return nil
+	case moderationaudit.FieldStatus:
+		v, ok := value.(irelia.ModerationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case moderationaudit.FieldMergedIntoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMergedIntoID(v)
+		return nil
+	case moderationaudit.FieldPreviousContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousContent(v)
+		return nil
+	case moderationaudit.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case moderationaudit.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case moderationaudit.FieldPreviousAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousAnswer(v)
+		return nil
+	case moderationaudit.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAudit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModerationAuditMutation) AddedFields() []string {
+	var fields []string
+	if m.addquestion_id != nil {
+		fields = append(fields, moderationaudit.FieldQuestionID)
+	}
+	if m.addmoderator_id != nil {
+		fields = append(fields, moderationaudit.FieldModeratorID)
+	}
+	if m.addaction != nil {
+		fields = append(fields, moderationaudit.FieldAction)
+	}
+	if m.addprevious_status != nil {
+		fields = append(fields, moderationaudit.FieldPreviousStatus)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, moderationaudit.FieldStatus)
+	}
+	if m.addmerged_into_id != nil {
+		fields = append(fields, moderationaudit.FieldMergedIntoID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModerationAuditMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case moderationaudit.FieldQuestionID:
+		return m.AddedQuestionID()
+	case moderationaudit.FieldModeratorID:
+		return m.AddedModeratorID()
+	case moderationaudit.FieldAction:
+		return m.AddedAction()
+	case moderationaudit.FieldPreviousStatus:
+		return m.AddedPreviousStatus()
+	case moderationaudit.FieldStatus:
+		return m.AddedStatus()
+	case moderationaudit.FieldMergedIntoID:
+		return m.AddedMergedIntoID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationAuditMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case moderationaudit.FieldQuestionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestionID(v)
+		return nil
+	case moderationaudit.FieldModeratorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModeratorID(v)
+		return nil
+	case moderationaudit.FieldAction:
+		v, ok := value.(irelia.ModerationAction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAction(v)
+		return nil
+	case moderationaudit.FieldPreviousStatus:
+		v, ok := value.(irelia.ModerationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPreviousStatus(v)
+		return nil
+	case moderationaudit.FieldStatus:
+		v, ok := value.(irelia.ModerationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case moderationaudit.FieldMergedIntoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMergedIntoID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAudit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModerationAuditMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moderationaudit.FieldDeletedAt) {
+		fields = append(fields, moderationaudit.FieldDeletedAt)
+	}
+	if m.FieldCleared(moderationaudit.FieldMergedIntoID) {
+		fields = append(fields, moderationaudit.FieldMergedIntoID)
+	}
+	if m.FieldCleared(moderationaudit.FieldPreviousContent) {
+		fields = append(fields, moderationaudit.FieldPreviousContent)
+	}
+	if m.FieldCleared(moderationaudit.FieldContent) {
+		fields = append(fields, moderationaudit.FieldContent)
+	}
+	if m.FieldCleared(moderationaudit.FieldNote) {
+		fields = append(fields, moderationaudit.FieldNote)
+	}
+	if m.FieldCleared(moderationaudit.FieldPreviousAnswer) {
+		fields = append(fields, moderationaudit.FieldPreviousAnswer)
+	}
+	if m.FieldCleared(moderationaudit.FieldAnswer) {
+		fields = append(fields, moderationaudit.FieldAnswer)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModerationAuditMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModerationAuditMutation) ClearField(name string) error {
+	switch name {
+	case moderationaudit.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case moderationaudit.FieldMergedIntoID:
+		m.ClearMergedIntoID()
+		return nil
+	case moderationaudit.FieldPreviousContent:
+		m.ClearPreviousContent()
+		return nil
+	case moderationaudit.FieldContent:
+		m.ClearContent()
+		return nil
+	case moderationaudit.FieldNote:
+		m.ClearNote()
+		return nil
+	case moderationaudit.FieldPreviousAnswer:
+		m.ClearPreviousAnswer()
+		return nil
+	case moderationaudit.FieldAnswer:
+		m.ClearAnswer()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAudit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModerationAuditMutation) ResetField(name string) error {
+	switch name {
+	case moderationaudit.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case moderationaudit.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case moderationaudit.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case moderationaudit.FieldQuestionID:
+		m.ResetQuestionID()
+		return nil
+	case moderationaudit.FieldModeratorID:
+		m.ResetModeratorID()
+		return nil
+	case moderationaudit.FieldAction:
+		m.ResetAction()
+		return nil
+	case moderationaudit.FieldPreviousStatus:
+		m.ResetPreviousStatus()
+		return nil
+	case moderationaudit.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case moderationaudit.FieldMergedIntoID:
+		m.ResetMergedIntoID()
+		return nil
+	case moderationaudit.FieldPreviousContent:
+		m.ResetPreviousContent()
+		return nil
+	case moderationaudit.FieldContent:
+		m.ResetContent()
+		return nil
+	case moderationaudit.FieldNote:
+		m.ResetNote()
+		return nil
+	case moderationaudit.FieldPreviousAnswer:
+		m.ResetPreviousAnswer()
+		return nil
+	case moderationaudit.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAudit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModerationAuditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModerationAuditMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModerationAuditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModerationAuditMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModerationAuditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModerationAuditMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModerationAuditMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ModerationAudit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModerationAuditMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ModerationAudit edge %s", name)
+}
+
+// PublicQuestionMutation represents an operation that mutates the PublicQuestion nodes in the graph.
+type PublicQuestionMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	deleted_at          *time.Time
+	position            *string
+	experience          *string
+	language            *string
+	content             *string
+	answer              *string
+	content_hash        *string
+	occurrences         *int
+	addoccurrences      *int
+	first_seen_at       *time.Time
+	last_seen_at        *time.Time
+	status              *irelia.ModerationStatus
+	addstatus           *irelia.ModerationStatus
+	merged_into_id      *int
+	addmerged_into_id   *int
+	moderated_by        *uint64
+	addmoderated_by     *int64
+	moderated_at        *time.Time
+	answer_generator    *string
+	answer_version      *string
+	answer_generated_at *time.Time
+	answer_attempts     *int32
+	addanswer_attempts  *int32
+	answer_locked_by    *string
+	answer_locked_until *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*PublicQuestion, error)
+	predicates          []predicate.PublicQuestion
+}
+
+var _ ent.Mutation = (*PublicQuestionMutation)(nil)
+
+// publicquestionOption allows management of the mutation configuration using functional options.
+type publicquestionOption func(*PublicQuestionMutation)
+
+// newPublicQuestionMutation creates new mutation for the PublicQuestion entity.
+func newPublicQuestionMutation(c config, op Op, opts ...publicquestionOption) *PublicQuestionMutation {
+	m := &PublicQuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePublicQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPublicQuestionID sets the ID field of the mutation.
+func withPublicQuestionID(id int) publicquestionOption {
+	return func(m *PublicQuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PublicQuestion
+		)
+		m.oldValue = func(ctx context.Context) (*PublicQuestion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PublicQuestion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPublicQuestion sets the old PublicQuestion of the mutation.
+func withPublicQuestion(node *PublicQuestion) publicquestionOption {
+	return func(m *PublicQuestionMutation) {
+		m.oldValue = func(context.Context) (*PublicQuestion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PublicQuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PublicQuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PublicQuestionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PublicQuestionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PublicQuestion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PublicQuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PublicQuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PublicQuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PublicQuestionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PublicQuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PublicQuestionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PublicQuestionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PublicQuestionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PublicQuestionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[publicquestion.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PublicQuestionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PublicQuestionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, publicquestion.FieldDeletedAt)
+}
+
+// SetPosition sets the "position" field.
+func (m *PublicQuestionMutation) SetPosition(s string) {
+	m.position = &s
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *PublicQuestionMutation) Position() (r string, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldPosition(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *PublicQuestionMutation) ResetPosition() {
+	m.position = nil
+}
+
+// SetExperience sets the "experience" field.
+func (m *PublicQuestionMutation) SetExperience(s string) {
+	m.experience = &s
+}
+
+// Experience returns the value of the "experience" field in the mutation.
+func (m *PublicQuestionMutation) Experience() (r string, exists bool) {
+	v := m.experience
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExperience returns the old "experience" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldExperience(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExperience is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExperience requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExperience: %w", err)
+	}
+	return oldValue.Experience, nil
+}
+
+// ResetExperience resets all changes to the "experience" field.
+func (m *PublicQuestionMutation) ResetExperience() {
+	m.experience = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *PublicQuestionMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *PublicQuestionMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *PublicQuestionMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetContent sets the "content" field.
+func (m *PublicQuestionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *PublicQuestionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *PublicQuestionMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *PublicQuestionMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *PublicQuestionMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (m *PublicQuestionMutation) ClearAnswer() {
+	m.answer = nil
+	m.clearedFields[publicquestion.FieldAnswer] = struct{}{}
+}
+
+// AnswerCleared returns if the "answer" field was cleared in this mutation.
+func (m *PublicQuestionMutation) AnswerCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldAnswer]
+	return ok
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *PublicQuestionMutation) ResetAnswer() {
+	m.answer = nil
+	delete(m.clearedFields, publicquestion.FieldAnswer)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *PublicQuestionMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *PublicQuestionMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldContentHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *PublicQuestionMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[publicquestion.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *PublicQuestionMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *PublicQuestionMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, publicquestion.FieldContentHash)
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (m *PublicQuestionMutation) SetOccurrences(i int) {
+	m.occurrences = &i
+	m.addoccurrences = nil
+}
+
+// Occurrences returns the value of the "occurrences" field in the mutation.
+func (m *PublicQuestionMutation) Occurrences() (r int, exists bool) {
+	v := m.occurrences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOccurrences returns the old "occurrences" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldOccurrences(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccurrences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccurrences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccurrences: %w", err)
+	}
+	return oldValue.Occurrences, nil
+}
+
+// AddOccurrences adds i to the "occurrences" field.
+func (m *PublicQuestionMutation) AddOccurrences(i int) {
+	if m.addoccurrences != nil {
+		*m.addoccurrences += i
+	} else {
+		m.addoccurrences = &i
+	}
+}
+
+// AddedOccurrences returns the value that was added to the "occurrences" field in this mutation.
+func (m *PublicQuestionMutation) AddedOccurrences() (r int, exists bool) {
+	v := m.addoccurrences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOccurrences resets all changes to the "occurrences" field.
+func (m *PublicQuestionMutation) ResetOccurrences() {
+	m.occurrences = nil
+	m.addoccurrences = nil
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (m *PublicQuestionMutation) SetFirstSeenAt(t time.Time) {
+	m.first_seen_at = &t
+}
+
+// FirstSeenAt returns the value of the "first_seen_at" field in the mutation.
+func (m *PublicQuestionMutation) FirstSeenAt() (r time.Time, exists bool) {
+	v := m.first_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstSeenAt returns the old "first_seen_at" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldFirstSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstSeenAt: %w", err)
+	}
+	return oldValue.FirstSeenAt, nil
+}
+
+// ClearFirstSeenAt clears the value of the "first_seen_at" field.
+func (m *PublicQuestionMutation) ClearFirstSeenAt() {
+	m.first_seen_at = nil
+	m.clearedFields[publicquestion.FieldFirstSeenAt] = struct{}{}
+}
+
+// FirstSeenAtCleared returns if the "first_seen_at" field was cleared in this mutation.
+func (m *PublicQuestionMutation) FirstSeenAtCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldFirstSeenAt]
+	return ok
+}
+
+// ResetFirstSeenAt resets all changes to the "first_seen_at" field.
+func (m *PublicQuestionMutation) ResetFirstSeenAt() {
+	m.first_seen_at = nil
+	delete(m.clearedFields, publicquestion.FieldFirstSeenAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *PublicQuestionMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *PublicQuestionMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *PublicQuestionMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[publicquestion.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *PublicQuestionMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *PublicQuestionMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, publicquestion.FieldLastSeenAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *PublicQuestionMutation) SetStatus(is irelia.ModerationStatus) {
+	m.status = &is
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PublicQuestionMutation) Status() (r irelia.ModerationStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldStatus(ctx context.Context) (v irelia.ModerationStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds is to the "status" field.
+func (m *PublicQuestionMutation) AddStatus(is irelia.ModerationStatus) {
+	if m.addstatus != nil {
+		*m.addstatus += is
+	} else {
+		m.addstatus = &is
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *PublicQuestionMutation) AddedStatus() (r irelia.ModerationStatus, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PublicQuestionMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (m *PublicQuestionMutation) SetMergedIntoID(i int) {
+	m.merged_into_id = &i
+	m.addmerged_into_id = nil
+}
+
+// MergedIntoID returns the value of the "merged_into_id" field in the mutation.
+func (m *PublicQuestionMutation) MergedIntoID() (r int, exists bool) {
+	v := m.merged_into_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMergedIntoID returns the old "merged_into_id" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldMergedIntoID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMergedIntoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMergedIntoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMergedIntoID: %w", err)
+	}
+	return oldValue.MergedIntoID, nil
+}
+
+// AddMergedIntoID adds i to the "merged_into_id" field.
+func (m *PublicQuestionMutation) AddMergedIntoID(i int) {
+	if m.addmerged_into_id != nil {
+		*m.addmerged_into_id += i
+	} else {
+		m.addmerged_into_id = &i
+	}
+}
+
+// AddedMergedIntoID returns the value that was added to the "merged_into_id" field in this mutation.
+func (m *PublicQuestionMutation) AddedMergedIntoID() (r int, exists bool) {
+	v := m.addmerged_into_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (m *PublicQuestionMutation) ClearMergedIntoID() {
+	m.merged_into_id = nil
+	m.addmerged_into_id = nil
+	m.clearedFields[publicquestion.FieldMergedIntoID] = struct{}{}
+}
+
+// MergedIntoIDCleared returns if the "merged_into_id" field was cleared in this mutation.
+func (m *PublicQuestionMutation) MergedIntoIDCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldMergedIntoID]
+	return ok
+}
+
+// ResetMergedIntoID resets all changes to the "merged_into_id" field.
+func (m *PublicQuestionMutation) ResetMergedIntoID() {
+	m.merged_into_id = nil
+	m.addmerged_into_id = nil
+	delete(m.clearedFields, publicquestion.FieldMergedIntoID)
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (m *PublicQuestionMutation) SetModeratedBy(u uint64) {
+	m.moderated_by = &u
+	m.addmoderated_by = nil
+}
+
+// ModeratedBy returns the value of the "moderated_by" field in the mutation.
+func (m *PublicQuestionMutation) ModeratedBy() (r uint64, exists bool) {
+	v := m.moderated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModeratedBy returns the old "moderated_by" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldModeratedBy(ctx context.Context) (v *uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModeratedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModeratedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModeratedBy: %w", err)
+	}
+	return oldValue.ModeratedBy, nil
+}
+
+// AddModeratedBy adds u to the "moderated_by" field.
+func (m *PublicQuestionMutation) AddModeratedBy(u int64) {
+	if m.addmoderated_by != nil {
+		*m.addmoderated_by += u
+	} else {
+		m.addmoderated_by = &u
+	}
+}
+
+// AddedModeratedBy returns the value that was added to the "moderated_by" field in this mutation.
+func (m *PublicQuestionMutation) AddedModeratedBy() (r int64, exists bool) {
+	v := m.addmoderated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearModeratedBy clears the value of the "moderated_by" field.
+func (m *PublicQuestionMutation) ClearModeratedBy() {
+	m.moderated_by = nil
+	m.addmoderated_by = nil
+	m.clearedFields[publicquestion.FieldModeratedBy] = struct{}{}
+}
+
+// ModeratedByCleared returns if the "moderated_by" field was cleared in this mutation.
+func (m *PublicQuestionMutation) ModeratedByCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldModeratedBy]
+	return ok
+}
+
+// ResetModeratedBy resets all changes to the "moderated_by" field.
+func (m *PublicQuestionMutation) ResetModeratedBy() {
+	m.moderated_by = nil
+	m.addmoderated_by = nil
+	delete(m.clearedFields, publicquestion.FieldModeratedBy)
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (m *PublicQuestionMutation) SetModeratedAt(t time.Time) {
+	m.moderated_at = &t
+}
+
+// ModeratedAt returns the value of the "moderated_at" field in the mutation.
+func (m *PublicQuestionMutation) ModeratedAt() (r time.Time, exists bool) {
+	v := m.moderated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModeratedAt returns the old "moderated_at" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldModeratedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModeratedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModeratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModeratedAt: %w", err)
+	}
+	return oldValue.ModeratedAt, nil
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (m *PublicQuestionMutation) ClearModeratedAt() {
+	m.moderated_at = nil
+	m.clearedFields[publicquestion.FieldModeratedAt] = struct{}{}
+}
+
+// ModeratedAtCleared returns if the "moderated_at" field was cleared in this mutation.
+func (m *PublicQuestionMutation) ModeratedAtCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldModeratedAt]
+	return ok
+}
+
+// ResetModeratedAt resets all changes to the "moderated_at" field.
+func (m *PublicQuestionMutation) ResetModeratedAt() {
+	m.moderated_at = nil
+	delete(m.clearedFields, publicquestion.FieldModeratedAt)
+}
+
+// SetAnswerGenerator sets the "answer_generator" field.
+func (m *PublicQuestionMutation) SetAnswerGenerator(s string) {
+	m.answer_generator = &s
+}
+
+// AnswerGenerator returns the value of the "answer_generator" field in the mutation.
+func (m *PublicQuestionMutation) AnswerGenerator() (r string, exists bool) {
+	v := m.answer_generator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerGenerator returns the old "answer_generator" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldAnswerGenerator(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerGenerator is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerGenerator requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerGenerator: %w", err)
+	}
+	return oldValue.AnswerGenerator, nil
+}
+
+// ClearAnswerGenerator clears the value of the "answer_generator" field.
+func (m *PublicQuestionMutation) ClearAnswerGenerator() {
+	m.answer_generator = nil
+	m.clearedFields[publicquestion.FieldAnswerGenerator] = struct{}{}
+}
+
+// AnswerGeneratorCleared returns if the "answer_generator" field was cleared in this mutation.
+func (m *PublicQuestionMutation) AnswerGeneratorCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldAnswerGenerator]
+	return ok
+}
+
+// ResetAnswerGenerator resets all changes to the "answer_generator" field.
+func (m *PublicQuestionMutation) ResetAnswerGenerator() {
+	m.answer_generator = nil
+	delete(m.clearedFields, publicquestion.FieldAnswerGenerator)
+}
+
+// SetAnswerVersion sets the "answer_version" field.
+func (m *PublicQuestionMutation) SetAnswerVersion(s string) {
+	m.answer_version = &s
+}
+
+// AnswerVersion returns the value of the "answer_version" field in the mutation.
+func (m *PublicQuestionMutation) AnswerVersion() (r string, exists bool) {
+	v := m.answer_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerVersion returns the old "answer_version" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldAnswerVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerVersion: %w", err)
+	}
+	return oldValue.AnswerVersion, nil
+}
+
+// ClearAnswerVersion clears the value of the "answer_version" field.
+func (m *PublicQuestionMutation) ClearAnswerVersion() {
+	m.answer_version = nil
+	m.clearedFields[publicquestion.FieldAnswerVersion] = struct{}{}
+}
+
+// AnswerVersionCleared returns if the "answer_version" field was cleared in this mutation.
+func (m *PublicQuestionMutation) AnswerVersionCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldAnswerVersion]
+	return ok
+}
+
+// ResetAnswerVersion resets all changes to the "answer_version" field.
+func (m *PublicQuestionMutation) ResetAnswerVersion() {
+	m.answer_version = nil
+	delete(m.clearedFields, publicquestion.FieldAnswerVersion)
+}
+
+// SetAnswerGeneratedAt sets the "answer_generated_at" field.
+func (m *PublicQuestionMutation) SetAnswerGeneratedAt(t time.Time) {
+	m.answer_generated_at = &t
+}
+
+// AnswerGeneratedAt returns the value of the "answer_generated_at" field in the mutation.
+func (m *PublicQuestionMutation) AnswerGeneratedAt() (r time.Time, exists bool) {
+	v := m.answer_generated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerGeneratedAt returns the old "answer_generated_at" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldAnswerGeneratedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerGeneratedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerGeneratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerGeneratedAt: %w", err)
+	}
+	return oldValue.AnswerGeneratedAt, nil
+}
+
+// ClearAnswerGeneratedAt clears the value of the "answer_generated_at" field.
+func (m *PublicQuestionMutation) ClearAnswerGeneratedAt() {
+	m.answer_generated_at = nil
+	m.clearedFields[publicquestion.FieldAnswerGeneratedAt] = struct{}{}
+}
+
+// AnswerGeneratedAtCleared returns if the "answer_generated_at" field was cleared in this mutation.
+func (m *PublicQuestionMutation) AnswerGeneratedAtCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldAnswerGeneratedAt]
+	return ok
+}
+
+// ResetAnswerGeneratedAt resets all changes to the "answer_generated_at" field.
+func (m *PublicQuestionMutation) ResetAnswerGeneratedAt() {
+	m.answer_generated_at = nil
+	delete(m.clearedFields, publicquestion.FieldAnswerGeneratedAt)
+}
+
+// SetAnswerAttempts sets the "answer_attempts" field.
+func (m *PublicQuestionMutation) SetAnswerAttempts(i int32) {
+	m.answer_attempts = &i
+	m.addanswer_attempts = nil
+}
+
+// AnswerAttempts returns the value of the "answer_attempts" field in the mutation.
+func (m *PublicQuestionMutation) AnswerAttempts() (r int32, exists bool) {
+	v := m.answer_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerAttempts returns the old "answer_attempts" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldAnswerAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerAttempts: %w", err)
+	}
+	return oldValue.AnswerAttempts, nil
+}
+
+// AddAnswerAttempts adds i to the "answer_attempts" field.
+func (m *PublicQuestionMutation) AddAnswerAttempts(i int32) {
+	if m.addanswer_attempts != nil {
+		*m.addanswer_attempts += i
+	} else {
+		m.addanswer_attempts = &i
+	}
+}
+
+// AddedAnswerAttempts returns the value that was added to the "answer_attempts" field in this mutation.
+func (m *PublicQuestionMutation) AddedAnswerAttempts() (r int32, exists bool) {
+	v := m.addanswer_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAnswerAttempts resets all changes to the "answer_attempts" field.
+func (m *PublicQuestionMutation) ResetAnswerAttempts() {
+	m.answer_attempts = nil
+	m.addanswer_attempts = nil
+}
+
+// SetAnswerLockedBy sets the "answer_locked_by" field.
+func (m *PublicQuestionMutation) SetAnswerLockedBy(s string) {
+	m.answer_locked_by = &s
+}
+
+// AnswerLockedBy returns the value of the "answer_locked_by" field in the mutation.
+func (m *PublicQuestionMutation) AnswerLockedBy() (r string, exists bool) {
+	v := m.answer_locked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerLockedBy returns the old "answer_locked_by" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldAnswerLockedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerLockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerLockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerLockedBy: %w", err)
+	}
+	return oldValue.AnswerLockedBy, nil
+}
+
+// ClearAnswerLockedBy clears the value of the "answer_locked_by" field.
+func (m *PublicQuestionMutation) ClearAnswerLockedBy() {
+	m.answer_locked_by = nil
+	m.clearedFields[publicquestion.FieldAnswerLockedBy] = struct{}{}
+}
+
+// AnswerLockedByCleared returns if the "answer_locked_by" field was cleared in this mutation.
+func (m *PublicQuestionMutation) AnswerLockedByCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldAnswerLockedBy]
+	return ok
+}
+
+// ResetAnswerLockedBy resets all changes to the "answer_locked_by" field.
+func (m *PublicQuestionMutation) ResetAnswerLockedBy() {
+	m.answer_locked_by = nil
+	delete(m.clearedFields, publicquestion.FieldAnswerLockedBy)
+}
+
+// SetAnswerLockedUntil sets the "answer_locked_until" field.
+func (m *PublicQuestionMutation) SetAnswerLockedUntil(t time.Time) {
+	m.answer_locked_until = &t
+}
+
+// AnswerLockedUntil returns the value of the "answer_locked_until" field in the mutation.
+func (m *PublicQuestionMutation) AnswerLockedUntil() (r time.Time, exists bool) {
+	v := m.answer_locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerLockedUntil returns the old "answer_locked_until" field's value of the PublicQuestion entity.
+// If the PublicQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicQuestionMutation) OldAnswerLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerLockedUntil: %w", err)
+	}
+	return oldValue.AnswerLockedUntil, nil
+}
+
+// ClearAnswerLockedUntil clears the value of the "answer_locked_until" field.
+func (m *PublicQuestionMutation) ClearAnswerLockedUntil() {
+	m.answer_locked_until = nil
+	m.clearedFields[publicquestion.FieldAnswerLockedUntil] = struct{}{}
+}
+
+// AnswerLockedUntilCleared returns if the "answer_locked_until" field was cleared in this mutation.
+func (m *PublicQuestionMutation) AnswerLockedUntilCleared() bool {
+	_, ok := m.clearedFields[publicquestion.FieldAnswerLockedUntil]
+	return ok
+}
+
+// ResetAnswerLockedUntil resets all changes to the "answer_locked_until" field.
+func (m *PublicQuestionMutation) ResetAnswerLockedUntil() {
+	m.answer_locked_until = nil
+	delete(m.clearedFields, publicquestion.FieldAnswerLockedUntil)
+}
+
+// Where appends a list predicates to the PublicQuestionMutation builder.
+func (m *PublicQuestionMutation) Where(ps ...predicate.PublicQuestion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PublicQuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PublicQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PublicQuestion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PublicQuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PublicQuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PublicQuestion).
+func (m *PublicQuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PublicQuestionMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.created_at != nil {
+		fields = append(fields, publicquestion.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, publicquestion.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, publicquestion.FieldDeletedAt)
+	}
+	if m.position != nil {
+		fields = append(fields, publicquestion.FieldPosition)
+	}
+	if m.experience != nil {
+		fields = append(fields, publicquestion.FieldExperience)
+	}
+	if m.language != nil {
+		fields = append(fields, publicquestion.FieldLanguage)
+	}
+	if m.content != nil {
+		fields = append(fields, publicquestion.FieldContent)
+	}
+	if m.answer != nil {
+		fields = append(fields, publicquestion.FieldAnswer)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, publicquestion.FieldContentHash)
+	}
+	if m.occurrences != nil {
+		fields = append(fields, publicquestion.FieldOccurrences)
+	}
+	if m.first_seen_at != nil {
+		fields = append(fields, publicquestion.FieldFirstSeenAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, publicquestion.FieldLastSeenAt)
+	}
+	if m.status != nil {
+		fields = append(fields, publicquestion.FieldStatus)
+	}
+	if m.merged_into_id != nil {
+		fields = append(fields, publicquestion.FieldMergedIntoID)
+	}
+	if m.moderated_by != nil {
+		fields = append(fields, publicquestion.FieldModeratedBy)
+	}
+	if m.moderated_at != nil {
+		fields = append(fields, publicquestion.FieldModeratedAt)
+	}
+	if m.answer_generator != nil {
+		fields = append(fields, publicquestion.FieldAnswerGenerator)
+	}
+	if m.answer_version != nil {
+		fields = append(fields, publicquestion.FieldAnswerVersion)
+	}
+	if m.answer_generated_at != nil {
+		fields = append(fields, publicquestion.FieldAnswerGeneratedAt)
+	}
+	if m.answer_attempts != nil {
+		fields = append(fields, publicquestion.FieldAnswerAttempts)
+	}
+	if m.answer_locked_by != nil {
+		fields = append(fields, publicquestion.FieldAnswerLockedBy)
+	}
+	if m.answer_locked_until != nil {
+		fields = append(fields, publicquestion.FieldAnswerLockedUntil)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PublicQuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case publicquestion.FieldCreatedAt:
+		return m.CreatedAt()
+	case publicquestion.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case publicquestion.FieldDeletedAt:
+		return m.DeletedAt()
+	case publicquestion.FieldPosition:
+		return m.Position()
+	case publicquestion.FieldExperience:
+		return m.Experience()
+	case publicquestion.FieldLanguage:
+		return m.Language()
+	case publicquestion.FieldContent:
+		return m.Content()
+	case publicquestion.FieldAnswer:
+		return m.Answer()
+	case publicquestion.FieldContentHash:
+		return m.ContentHash()
+	case publicquestion.FieldOccurrences:
+		return m.Occurrences()
+	case publicquestion.FieldFirstSeenAt:
+		return m.FirstSeenAt()
+	case publicquestion.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case publicquestion.FieldStatus:
+		return m.Status()
+	case publicquestion.FieldMergedIntoID:
+		return m.MergedIntoID()
+	case publicquestion.FieldModeratedBy:
+		return m.ModeratedBy()
+	case publicquestion.FieldModeratedAt:
+		return m.ModeratedAt()
+	case publicquestion.FieldAnswerGenerator:
+		return m.AnswerGenerator()
+	case publicquestion.FieldAnswerVersion:
+		return m.AnswerVersion()
+	case publicquestion.FieldAnswerGeneratedAt:
+		return m.AnswerGeneratedAt()
+	case publicquestion.FieldAnswerAttempts:
+		return m.AnswerAttempts()
+	case publicquestion.FieldAnswerLockedBy:
+		return m.AnswerLockedBy()
+	case publicquestion.FieldAnswerLockedUntil:
+		return m.AnswerLockedUntil()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PublicQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case publicquestion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case publicquestion.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case publicquestion.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case publicquestion.FieldPosition:
+		return m.OldPosition(ctx)
+	case publicquestion.FieldExperience:
+		return m.OldExperience(ctx)
+	case publicquestion.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case publicquestion.FieldContent:
+		return m.OldContent(ctx)
+	case publicquestion.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case publicquestion.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case publicquestion.FieldOccurrences:
+		return m.OldOccurrences(ctx)
+	case publicquestion.FieldFirstSeenAt:
+		return m.OldFirstSeenAt(ctx)
+	case publicquestion.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case publicquestion.FieldStatus:
+		return m.OldStatus(ctx)
+	case publicquestion.FieldMergedIntoID:
+		return m.OldMergedIntoID(ctx)
+	case publicquestion.FieldModeratedBy:
+		return m.OldModeratedBy(ctx)
+	case publicquestion.FieldModeratedAt:
+		return m.OldModeratedAt(ctx)
+	case publicquestion.FieldAnswerGenerator:
+		return m.OldAnswerGenerator(ctx)
+	case publicquestion.FieldAnswerVersion:
+		return m.OldAnswerVersion(ctx)
+	case publicquestion.FieldAnswerGeneratedAt:
+		return m.OldAnswerGeneratedAt(ctx)
+	case publicquestion.FieldAnswerAttempts:
+		return m.OldAnswerAttempts(ctx)
+	case publicquestion.FieldAnswerLockedBy:
+		return m.OldAnswerLockedBy(ctx)
+	case publicquestion.FieldAnswerLockedUntil:
+		return m.OldAnswerLockedUntil(ctx)
+	}
+	return nil, fmt.Errorf("unknown PublicQuestion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PublicQuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case publicquestion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case publicquestion.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case publicquestion.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case publicquestion.FieldPosition:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case publicquestion.FieldExperience:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExperience(v)
+		return nil
+	case publicquestion.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case publicquestion.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case publicquestion.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case publicquestion.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case publicquestion.FieldOccurrences:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurrences(v)
+		return nil
+	case publicquestion.FieldFirstSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstSeenAt(v)
+		return nil
+	case publicquestion.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case publicquestion.FieldStatus:
+		v, ok := value.(irelia.ModerationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case publicquestion.FieldMergedIntoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMergedIntoID(v)
+		return nil
+	case publicquestion.FieldModeratedBy:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModeratedBy(v)
+		return nil
+	case publicquestion.FieldModeratedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModeratedAt(v)
+		return nil
+	case publicquestion.FieldAnswerGenerator:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerGenerator(v)
+		return nil
+	case publicquestion.FieldAnswerVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerVersion(v)
+		return nil
+	case publicquestion.FieldAnswerGeneratedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerGeneratedAt(v)
+		return nil
+	case publicquestion.FieldAnswerAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerAttempts(v)
+		return nil
+	case publicquestion.FieldAnswerLockedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerLockedBy(v)
+		return nil
+	case publicquestion.FieldAnswerLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerLockedUntil(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PublicQuestion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PublicQuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addoccurrences != nil {
+		fields = append(fields, publicquestion.FieldOccurrences)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, publicquestion.FieldStatus)
+	}
+	if m.addmerged_into_id != nil {
+		fields = append(fields, publicquestion.FieldMergedIntoID)
+	}
+	if m.addmoderated_by != nil {
+		fields = append(fields, publicquestion.FieldModeratedBy)
+	}
+	if m.addanswer_attempts != nil {
+		fields = append(fields, publicquestion.FieldAnswerAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PublicQuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case publicquestion.FieldOccurrences:
+		return m.AddedOccurrences()
+	case publicquestion.FieldStatus:
+		return m.AddedStatus()
+	case publicquestion.FieldMergedIntoID:
+		return m.AddedMergedIntoID()
+	case publicquestion.FieldModeratedBy:
+		return m.AddedModeratedBy()
+	case publicquestion.FieldAnswerAttempts:
+		return m.AddedAnswerAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PublicQuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case publicquestion.FieldOccurrences:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOccurrences(v)
+		return nil
+	case publicquestion.FieldStatus:
+		v, ok := value.(irelia.ModerationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case publicquestion.FieldMergedIntoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMergedIntoID(v)
+		return nil
+	case publicquestion.FieldModeratedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModeratedBy(v)
+		return nil
+	case publicquestion.FieldAnswerAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnswerAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PublicQuestion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PublicQuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(publicquestion.FieldDeletedAt) {
+		fields = append(fields, publicquestion.FieldDeletedAt)
+	}
+	if m.FieldCleared(publicquestion.FieldAnswer) {
+		fields = append(fields, publicquestion.FieldAnswer)
+	}
+	if m.FieldCleared(publicquestion.FieldContentHash) {
+		fields = append(fields, publicquestion.FieldContentHash)
+	}
+	if m.FieldCleared(publicquestion.FieldFirstSeenAt) {
+		fields = append(fields, publicquestion.FieldFirstSeenAt)
+	}
+	if m.FieldCleared(publicquestion.FieldLastSeenAt) {
+		fields = append(fields, publicquestion.FieldLastSeenAt)
+	}
+	if m.FieldCleared(publicquestion.FieldMergedIntoID) {
+		fields = append(fields, publicquestion.FieldMergedIntoID)
+	}
+	if m.FieldCleared(publicquestion.FieldModeratedBy) {
+		fields = append(fields, publicquestion.FieldModeratedBy)
+	}
+	if m.FieldCleared(publicquestion.FieldModeratedAt) {
+		fields = append(fields, publicquestion.FieldModeratedAt)
+	}
+	if m.FieldCleared(publicquestion.FieldAnswerGenerator) {
+		fields = append(fields, publicquestion.FieldAnswerGenerator)
+	}
+	if m.FieldCleared(publicquestion.FieldAnswerVersion) {
+		fields = append(fields, publicquestion.FieldAnswerVersion)
+	}
+	if m.FieldCleared(publicquestion.FieldAnswerGeneratedAt) {
+		fields = append(fields, publicquestion.FieldAnswerGeneratedAt)
+	}
+	if m.FieldCleared(publicquestion.FieldAnswerLockedBy) {
+		fields = append(fields, publicquestion.FieldAnswerLockedBy)
+	}
+	if m.FieldCleared(publicquestion.FieldAnswerLockedUntil) {
+		fields = append(fields, publicquestion.FieldAnswerLockedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PublicQuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PublicQuestionMutation) ClearField(name string) error {
+	switch name {
+	case publicquestion.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case publicquestion.FieldAnswer:
+		m.ClearAnswer()
+		return nil
+	case publicquestion.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	case publicquestion.FieldFirstSeenAt:
+		m.ClearFirstSeenAt()
+		return nil
+	case publicquestion.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	case publicquestion.FieldMergedIntoID:
+		m.ClearMergedIntoID()
+		return nil
+	case publicquestion.FieldModeratedBy:
+		m.ClearModeratedBy()
+		return nil
+	case publicquestion.FieldModeratedAt:
+		m.ClearModeratedAt()
+		return nil
+	case publicquestion.FieldAnswerGenerator:
+		m.ClearAnswerGenerator()
+		return nil
+	case publicquestion.FieldAnswerVersion:
+		m.ClearAnswerVersion()
+		return nil
+	case publicquestion.FieldAnswerGeneratedAt:
+		m.ClearAnswerGeneratedAt()
+		return nil
+	case publicquestion.FieldAnswerLockedBy:
+		m.ClearAnswerLockedBy()
+		return nil
+	case publicquestion.FieldAnswerLockedUntil:
+		m.ClearAnswerLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown PublicQuestion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PublicQuestionMutation) ResetField(name string) error {
+	switch name {
+	case publicquestion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case publicquestion.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case publicquestion.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case publicquestion.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case publicquestion.FieldExperience:
+		m.ResetExperience()
+		return nil
+	case publicquestion.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case publicquestion.FieldContent:
+		m.ResetContent()
+		return nil
+	case publicquestion.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case publicquestion.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case publicquestion.FieldOccurrences:
+		m.ResetOccurrences()
+		return nil
+	case publicquestion.FieldFirstSeenAt:
+		m.ResetFirstSeenAt()
+		return nil
+	case publicquestion.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case publicquestion.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case publicquestion.FieldMergedIntoID:
+		m.ResetMergedIntoID()
+		return nil
+	case publicquestion.FieldModeratedBy:
+		m.ResetModeratedBy()
+		return nil
+	case publicquestion.FieldModeratedAt:
+		m.ResetModeratedAt()
+		return nil
+	case publicquestion.FieldAnswerGenerator:
+		m.ResetAnswerGenerator()
+		return nil
+	case publicquestion.FieldAnswerVersion:
+		m.ResetAnswerVersion()
+		return nil
+	case publicquestion.FieldAnswerGeneratedAt:
+		m.ResetAnswerGeneratedAt()
+		return nil
+	case publicquestion.FieldAnswerAttempts:
+		m.ResetAnswerAttempts()
+		return nil
+	case publicquestion.FieldAnswerLockedBy:
+		m.ResetAnswerLockedBy()
+		return nil
+	case publicquestion.FieldAnswerLockedUntil:
+		m.ResetAnswerLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown PublicQuestion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PublicQuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PublicQuestionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PublicQuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PublicQuestionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PublicQuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PublicQuestionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PublicQuestionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PublicQuestion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PublicQuestionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PublicQuestion edge %s", name)
+}
+
+// QuestionMutation represents an operation that mutates the Question nodes in the graph.
+type QuestionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	created_at        *time.Time
+	updated_at        *time.Time
+	deleted_at        *time.Time
+	question_index    *int32
+	addquestion_index *int32
+	sub_index         *int32
+	addsub_index      *int32
+	content           *string
+	audio             *string
+	audio_key         *string
+	lipsync           **irelia.LipSyncData
+	audio_pending     *bool
+	answer            *string
+	record_proof      *string
+	comment           *string
+	score             *string
+	status            *irelia.QuestionStatus
+	addstatus         *irelia.QuestionStatus
+	deadline_at       *time.Time
+	clearedFields     map[string]struct{}
+	interview         *string
+	clearedinterview  bool
+	done              bool
+	oldValue          func(context.Context) (*Question, error)
+	predicates        []predicate.Question
+}
+
+var _ ent.Mutation = (*QuestionMutation)(nil)
+
+// questionOption allows management of the mutation configuration using functional options.
+type questionOption func(*QuestionMutation)
+
+// newQuestionMutation creates new mutation for the Question entity.
+func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
+	m := &QuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionID sets the ID field of the mutation.
+func withQuestionID(id int) questionOption {
+	return func(m *QuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Question
+		)
+		m.oldValue = func(ctx context.Context) (*Question, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Question.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestion sets the old Question of the mutation.
+func withQuestion(node *Question) questionOption {
+	return func(m *QuestionMutation) {
+		m.oldValue = func(context.Context) (*Question, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuestionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuestionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *QuestionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *QuestionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *QuestionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[question.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *QuestionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[question.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *QuestionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, question.FieldDeletedAt)
+}
+
+// SetInterviewID sets the "interview_id" field.
+func (m *QuestionMutation) SetInterviewID(s string) {
+	m.interview = &s
+}
+
+// InterviewID returns the value of the "interview_id" field in the mutation.
+func (m *QuestionMutation) InterviewID() (r string, exists bool) {
+	v := m.interview
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterviewID returns the old "interview_id" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldInterviewID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterviewID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterviewID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterviewID: %w", err)
+	}
+	return oldValue.InterviewID, nil
+}
+
+// ResetInterviewID resets all changes to the "interview_id" field.
+func (m *QuestionMutation) ResetInterviewID() {
+	m.interview = nil
+}
+
+// SetQuestionIndex sets the "question_index" field.
+func (m *QuestionMutation) SetQuestionIndex(i int32) {
+	m.question_index = &i
+	m.addquestion_index = nil
+}
+
+// QuestionIndex returns the value of the "question_index" field in the mutation.
+func (m *QuestionMutation) QuestionIndex() (r int32, exists bool) {
+	v := m.question_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionIndex returns the old "question_index" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldQuestionIndex(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionIndex: %w", err)
+	}
+	return oldValue.QuestionIndex, nil
+}
+
+// AddQuestionIndex adds i to the "question_index" field.
+func (m *QuestionMutation) AddQuestionIndex(i int32) {
+	if m.addquestion_index != nil {
+		*m.addquestion_index += i
+	} else {
+		m.addquestion_index = &i
+	}
+}
+
+// AddedQuestionIndex returns the value that was added to the "question_index" field in this mutation.
+func (m *QuestionMutation) AddedQuestionIndex() (r int32, exists bool) {
+	v := m.addquestion_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuestionIndex resets all changes to the "question_index" field.
+func (m *QuestionMutation) ResetQuestionIndex() {
+	m.question_index = nil
+	m.addquestion_index = nil
+}
+
+// SetSubIndex sets the "sub_index" field.
+func (m *QuestionMutation) SetSubIndex(i int32) {
+	m.sub_index = &i
+	m.addsub_index = nil
+}
+
+// SubIndex returns the value of the "sub_index" field in the mutation.
+func (m *QuestionMutation) SubIndex() (r int32, exists bool) {
+	v := m.sub_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubIndex returns the old "sub_index" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldSubIndex(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubIndex: %w", err)
+	}
+	return oldValue.SubIndex, nil
+}
+
+// AddSubIndex adds i to the "sub_index" field.
+func (m *QuestionMutation) AddSubIndex(i int32) {
+	if m.addsub_index != nil {
+		*m.addsub_index += i
+	} else {
+		m.addsub_index = &i
+	}
+}
+
+// AddedSubIndex returns the value that was added to the "sub_index" field in this mutation.
+func (m *QuestionMutation) AddedSubIndex() (r int32, exists bool) {
+	v := m.addsub_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubIndex resets all changes to the "sub_index" field.
+func (m *QuestionMutation) ResetSubIndex() {
+	m.sub_index = nil
+	m.addsub_index = nil
+}
+
+// SetContent sets the "content" field.
+func (m *QuestionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *QuestionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *QuestionMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetAudio sets the "audio" field.
+func (m *QuestionMutation) SetAudio(s string) {
+	m.audio = &s
+}
+
+// Audio returns the value of the "audio" field in the mutation.
+func (m *QuestionMutation) Audio() (r string, exists bool) {
+	v := m.audio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudio returns the old "audio" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldAudio(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudio: %w", err)
+	}
+	return oldValue.Audio, nil
+}
+
+// ClearAudio clears the value of the "audio" field.
+func (m *QuestionMutation) ClearAudio() {
+	m.audio = nil
+	m.clearedFields[question.FieldAudio] = struct{}{}
+}
+
+// AudioCleared returns if the "audio" field was cleared in this mutation.
+func (m *QuestionMutation) AudioCleared() bool {
+	_, ok := m.clearedFields[question.FieldAudio]
+	return ok
+}
+
+// ResetAudio resets all changes to the "audio" field.
+func (m *QuestionMutation) ResetAudio() {
+	m.audio = nil
+	delete(m.clearedFields, question.FieldAudio)
+}
+
+// SetAudioKey sets the "audio_key" field.
+func (m *QuestionMutation) SetAudioKey(s string) {
+	m.audio_key = &s
+}
+
+// AudioKey returns the value of the "audio_key" field in the mutation.
+func (m *QuestionMutation) AudioKey() (r string, exists bool) {
+	v := m.audio_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudioKey returns the old "audio_key" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldAudioKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudioKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudioKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudioKey: %w", err)
+	}
+	return oldValue.AudioKey, nil
+}
+
+// ClearAudioKey clears the value of the "audio_key" field.
+func (m *QuestionMutation) ClearAudioKey() {
+	m.audio_key = nil
+	m.clearedFields[question.FieldAudioKey] = struct{}{}
+}
+
+// AudioKeyCleared returns if the "audio_key" field was cleared in this mutation.
+func (m *QuestionMutation) AudioKeyCleared() bool {
+	_, ok := m.clearedFields[question.FieldAudioKey]
+	return ok
+}
+
+// ResetAudioKey resets all changes to the "audio_key" field.
+func (m *QuestionMutation) ResetAudioKey() {
+	m.audio_key = nil
+	delete(m.clearedFields, question.FieldAudioKey)
+}
+
+// SetLipsync sets the "lipsync" field.
+func (m *QuestionMutation) SetLipsync(isd *irelia.LipSyncData) {
+	m.lipsync = &isd
+}
+
+// Lipsync returns the value of the "lipsync" field in the mutation.
+func (m *QuestionMutation) Lipsync() (r *irelia.LipSyncData, exists bool) {
+	v := m.lipsync
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLipsync returns the old "lipsync" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldLipsync(ctx context.Context) (v *irelia.LipSyncData, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLipsync is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLipsync requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLipsync: %w", err)
+	}
+	return oldValue.Lipsync, nil
+}
+
+// ClearLipsync clears the value of the "lipsync" field.
+func (m *QuestionMutation) ClearLipsync() {
+	m.lipsync = nil
+	m.clearedFields[question.FieldLipsync] = struct{}{}
+}
+
+// LipsyncCleared returns if the "lipsync" field was cleared in this mutation.
+func (m *QuestionMutation) LipsyncCleared() bool {
+	_, ok := m.clearedFields[question.FieldLipsync]
+	return ok
+}
+
+// ResetLipsync resets all changes to the "lipsync" field.
+func (m *QuestionMutation) ResetLipsync() {
+	m.lipsync = nil
+	delete(m.clearedFields, question.FieldLipsync)
+}
+
+// SetAudioPending sets the "audio_pending" field.
+func (m *QuestionMutation) SetAudioPending(b bool) {
+	m.audio_pending = &b
+}
+
+// AudioPending returns the value of the "audio_pending" field in the mutation.
+func (m *QuestionMutation) AudioPending() (r bool, exists bool) {
+	v := m.audio_pending
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudioPending returns the old "audio_pending" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldAudioPending(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudioPending is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudioPending requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudioPending: %w", err)
+	}
+	return oldValue.AudioPending, nil
+}
+
+// ResetAudioPending resets all changes to the "audio_pending" field.
+func (m *QuestionMutation) ResetAudioPending() {
+	m.audio_pending = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *QuestionMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *QuestionMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (m *QuestionMutation) ClearAnswer() {
+	m.answer = nil
+	m.clearedFields[question.FieldAnswer] = struct{}{}
+}
+
+// AnswerCleared returns if the "answer" field was cleared in this mutation.
+func (m *QuestionMutation) AnswerCleared() bool {
+	_, ok := m.clearedFields[question.FieldAnswer]
+	return ok
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *QuestionMutation) ResetAnswer() {
+	m.answer = nil
+	delete(m.clearedFields, question.FieldAnswer)
+}
+
+// SetRecordProof sets the "record_proof" field.
+func (m *QuestionMutation) SetRecordProof(s string) {
+	m.record_proof = &s
+}
+
+// RecordProof returns the value of the "record_proof" field in the mutation.
+func (m *QuestionMutation) RecordProof() (r string, exists bool) {
+	v := m.record_proof
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecordProof returns the old "record_proof" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldRecordProof(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecordProof is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecordProof requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecordProof: %w", err)
+	}
+	return oldValue.RecordProof, nil
+}
+
+// ClearRecordProof clears the value of the "record_proof" field.
+func (m *QuestionMutation) ClearRecordProof() {
+	m.record_proof = nil
+	m.clearedFields[question.FieldRecordProof] = struct{}{}
+}
+
+// RecordProofCleared returns if the "record_proof" field was cleared in this mutation.
+func (m *QuestionMutation) RecordProofCleared() bool {
+	_, ok := m.clearedFields[question.FieldRecordProof]
+	return ok
+}
+
+// ResetRecordProof resets all changes to the "record_proof" field.
+func (m *QuestionMutation) ResetRecordProof() {
+	m.record_proof = nil
+	delete(m.clearedFields, question.FieldRecordProof)
+}
+
+// SetComment sets the "comment" field.
+func (m *QuestionMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *QuestionMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *QuestionMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[question.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *QuestionMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[question.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *QuestionMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, question.FieldComment)
+}
+
+// SetScore sets the "score" field.
+func (m *QuestionMutation) SetScore(s string) {
+	m.score = &s
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *QuestionMutation) Score() (r string, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldScore(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// ClearScore clears the value of the "score" field.
+func (m *QuestionMutation) ClearScore() {
+	m.score = nil
+	m.clearedFields[question.FieldScore] = struct{}{}
+}
+
+// ScoreCleared returns if the "score" field was cleared in this mutation.
+func (m *QuestionMutation) ScoreCleared() bool {
+	_, ok := m.clearedFields[question.FieldScore]
+	return ok
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *QuestionMutation) ResetScore() {
+	m.score = nil
+	delete(m.clearedFields, question.FieldScore)
+}
+
+// SetStatus sets the "status" field.
+func (m *QuestionMutation) SetStatus(is irelia.QuestionStatus) {
+	m.status = &is
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *QuestionMutation) Status() (r irelia.QuestionStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldStatus(ctx context.Context) (v irelia.QuestionStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds is to the "status" field.
+func (m *QuestionMutation) AddStatus(is irelia.QuestionStatus) {
+	if m.addstatus != nil {
+		*m.addstatus += is
+	} else {
+		m.addstatus = &is
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *QuestionMutation) AddedStatus() (r irelia.QuestionStatus, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *QuestionMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetDeadlineAt sets the "deadline_at" field.
+func (m *QuestionMutation) SetDeadlineAt(t time.Time) {
+	m.deadline_at = &t
+}
+
+// DeadlineAt returns the value of the "deadline_at" field in the mutation.
+func (m *QuestionMutation) DeadlineAt() (r time.Time, exists bool) {
+	v := m.deadline_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadlineAt returns the old "deadline_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldDeadlineAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadlineAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadlineAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadlineAt: %w", err)
+	}
+	return oldValue.DeadlineAt, nil
+}
+
+// ClearDeadlineAt clears the value of the "deadline_at" field.
+func (m *QuestionMutation) ClearDeadlineAt() {
+	m.deadline_at = nil
+	m.clearedFields[question.FieldDeadlineAt] = struct{}{}
+}
+
+// DeadlineAtCleared returns if the "deadline_at" field was cleared in this mutation.
+func (m *QuestionMutation) DeadlineAtCleared() bool {
+	_, ok := m.clearedFields[question.FieldDeadlineAt]
+	return ok
+}
+
+// ResetDeadlineAt resets all changes to the "deadline_at" field.
+func (m *QuestionMutation) ResetDeadlineAt() {
+	m.deadline_at = nil
+	delete(m.clearedFields, question.FieldDeadlineAt)
+}
+
+// ClearInterview clears the "interview" edge to the Interview entity.
+func (m *QuestionMutation) ClearInterview() {
+	m.clearedinterview = true
+	m.clearedFields[question.FieldInterviewID] = struct{}{}
+}
+
+// InterviewCleared reports if the "interview" edge to the Interview entity was cleared.
+func (m *QuestionMutation) InterviewCleared() bool {
+	return m.clearedinterview
+}
+
+// InterviewIDs returns the "interview" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InterviewID instead. It exists only for internal usage by the builders.
+func (m *QuestionMutation) InterviewIDs() (ids []string) {
+	if id := m.interview; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInterview resets all changes to the "interview" edge.
+func (m *QuestionMutation) ResetInterview() {
+	m.interview = nil
+	m.clearedinterview = false
+}
+
+// Where appends a list predicates to the QuestionMutation builder.
+func (m *QuestionMutation) Where(ps ...predicate.Question) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Question, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Question).
+func (m *QuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.created_at != nil {
+		fields = append(fields, question.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, question.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, question.FieldDeletedAt)
+	}
+	if m.interview != nil {
+		fields = append(fields, question.FieldInterviewID)
+	}
+	if m.question_index != nil {
+		fields = append(fields, question.FieldQuestionIndex)
+	}
+	if m.sub_index != nil {
+		fields = append(fields, question.FieldSubIndex)
+	}
+	if m.content != nil {
+		fields = append(fields, question.FieldContent)
+	}
+	if m.audio != nil {
+		fields = append(fields, question.FieldAudio)
+	}
+	if m.audio_key != nil {
+		fields = append(fields, question.FieldAudioKey)
+	}
+	if m.lipsync != nil {
+		fields = append(fields, question.FieldLipsync)
+	}
+	if m.audio_pending != nil {
+		fields = append(fields, question.FieldAudioPending)
+	}
+	if m.answer != nil {
+		fields = append(fields, question.FieldAnswer)
+	}
+	if m.record_proof != nil {
+		fields = append(fields, question.FieldRecordProof)
+	}
+	if m.comment != nil {
+		fields = append(fields, question.FieldComment)
+	}
+	if m.score != nil {
+		fields = append(fields, question.FieldScore)
+	}
+	if m.status != nil {
+		fields = append(fields, question.FieldStatus)
+	}
+	if m.deadline_at != nil {
+		fields = append(fields, question.FieldDeadlineAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldCreatedAt:
+		return m.CreatedAt()
+	case question.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case question.FieldDeletedAt:
+		return m.DeletedAt()
+	case question.FieldInterviewID:
+		return m.InterviewID()
+	case question.FieldQuestionIndex:
+		return m.QuestionIndex()
+	case question.FieldSubIndex:
+		return m.SubIndex()
+	case question.FieldContent:
+		return m.Content()
+	case question.FieldAudio:
+		return m.Audio()
+	case question.FieldAudioKey:
+		return m.AudioKey()
+	case question.FieldLipsync:
+		return m.Lipsync()
+	case question.FieldAudioPending:
+		return m.AudioPending()
+	case question.FieldAnswer:
+		return m.Answer()
+	case question.FieldRecordProof:
+		return m.RecordProof()
+	case question.FieldComment:
+		return m.Comment()
+	case question.FieldScore:
+		return m.Score()
+	case question.FieldStatus:
+		return m.Status()
+	case question.FieldDeadlineAt:
+		return m.DeadlineAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case question.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case question.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case question.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case question.FieldInterviewID:
+		return m.OldInterviewID(ctx)
+	case question.FieldQuestionIndex:
+		return m.OldQuestionIndex(ctx)
+	case question.FieldSubIndex:
+		return m.OldSubIndex(ctx)
+	case question.FieldContent:
+		return m.OldContent(ctx)
+	case question.FieldAudio:
+		return m.OldAudio(ctx)
+	case question.FieldAudioKey:
+		return m.OldAudioKey(ctx)
+	case question.FieldLipsync:
+		return m.OldLipsync(ctx)
+	case question.FieldAudioPending:
+		return m.OldAudioPending(ctx)
+	case question.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case question.FieldRecordProof:
+		return m.OldRecordProof(ctx)
+	case question.FieldComment:
+		return m.OldComment(ctx)
+	case question.FieldScore:
+		return m.OldScore(ctx)
+	case question.FieldStatus:
+		return m.OldStatus(ctx)
+	case question.FieldDeadlineAt:
+		return m.OldDeadlineAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Question field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case question.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case question.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case question.FieldInterviewID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterviewID(v)
+		return nil
+	case question.FieldQuestionIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionIndex(v)
+		return nil
+	case question.FieldSubIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubIndex(v)
+		return nil
+	case question.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case question.FieldAudio:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudio(v)
+		return nil
+	case question.FieldAudioKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudioKey(v)
+		return nil
+	case question.FieldLipsync:
+		v, ok := value.(*irelia.LipSyncData)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLipsync(v)
+		return nil
+	case question.FieldAudioPending:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudioPending(v)
+		return nil
+	case question.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case question.FieldRecordProof:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecordProof(v)
+		return nil
+	case question.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case question.FieldScore:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case question.FieldStatus:
+		v, ok := value.(irelia.QuestionStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case question.FieldDeadlineAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadlineAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addquestion_index != nil {
+		fields = append(fields, question.FieldQuestionIndex)
+	}
+	if m.addsub_index != nil {
+		fields = append(fields, question.FieldSubIndex)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, question.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldQuestionIndex:
+		return m.AddedQuestionIndex()
+	case question.FieldSubIndex:
+		return m.AddedSubIndex()
+	case question.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldQuestionIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestionIndex(v)
+		return nil
+	case question.FieldSubIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubIndex(v)
+		return nil
+	case question.FieldStatus:
+		v, ok := value.(irelia.QuestionStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(question.FieldDeletedAt) {
+		fields = append(fields, question.FieldDeletedAt)
+	}
+	if m.FieldCleared(question.FieldAudio) {
+		fields = append(fields, question.FieldAudio)
+	}
+	if m.FieldCleared(question.FieldAudioKey) {
+		fields = append(fields, question.FieldAudioKey)
+	}
+	if m.FieldCleared(question.FieldLipsync) {
+		fields = append(fields, question.FieldLipsync)
+	}
+	if m.FieldCleared(question.FieldAnswer) {
+		fields = append(fields, question.FieldAnswer)
+	}
+	if m.FieldCleared(question.FieldRecordProof) {
+		fields = append(fields, question.FieldRecordProof)
+	}
+	if m.FieldCleared(question.FieldComment) {
+		fields = append(fields, question.FieldComment)
+	}
+	if m.FieldCleared(question.FieldScore) {
+		fields = append(fields, question.FieldScore)
+	}
+	if m.FieldCleared(question.FieldDeadlineAt) {
+		fields = append(fields, question.FieldDeadlineAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionMutation) ClearField(name string) error {
+	switch name {
+	case question.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case question.FieldAudio:
+		m.ClearAudio()
+		return nil
+	case question.FieldAudioKey:
+		m.ClearAudioKey()
+		return nil
+	case question.FieldLipsync:
+		m.ClearLipsync()
+		return nil
+	case question.FieldAnswer:
+		m.ClearAnswer()
+		return nil
+	case question.FieldRecordProof:
+		m.ClearRecordProof()
+		return nil
+	case question.FieldComment:
+		m.ClearComment()
+		return nil
+	case question.FieldScore:
+		m.ClearScore()
+		return nil
+	case question.FieldDeadlineAt:
+		m.ClearDeadlineAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Question nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionMutation) ResetField(name string) error {
+	switch name {
+	case question.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case question.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case question.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case question.FieldInterviewID:
+		m.ResetInterviewID()
+		return nil
+	case question.FieldQuestionIndex:
+		m.ResetQuestionIndex()
+		return nil
+	case question.FieldSubIndex:
+		m.ResetSubIndex()
+		return nil
+	case question.FieldContent:
+		m.ResetContent()
+		return nil
+	case question.FieldAudio:
+		m.ResetAudio()
+		return nil
+	case question.FieldAudioKey:
+		m.ResetAudioKey()
+		return nil
+	case question.FieldLipsync:
+		m.ResetLipsync()
+		return nil
+	case question.FieldAudioPending:
+		m.ResetAudioPending()
+		return nil
+	case question.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case question.FieldRecordProof:
+		m.ResetRecordProof()
+		return nil
+	case question.FieldComment:
+		m.ResetComment()
+		return nil
+	case question.FieldScore:
+		m.ResetScore()
+		return nil
+	case question.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case question.FieldDeadlineAt:
+		m.ResetDeadlineAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.interview != nil {
+		edges = append(edges, question.EdgeInterview)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeInterview:
+		if id := m.interview; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedinterview {
+		edges = append(edges, question.EdgeInterview)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case question.EdgeInterview:
+		return m.clearedinterview
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case question.EdgeInterview:
+		m.ClearInterview()
+		return nil
+	}
+	return fmt.Errorf("unknown Question unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case question.EdgeInterview:
+		m.ResetInterview()
+		return nil
+	}
+	return fmt.Errorf("unknown Question edge %s", name)
+}
+
+// QuotaMutation represents an operation that mutates the Quota nodes in the graph.
+type QuotaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	user_id       *uint64
+	adduser_id    *int64
+	day           *time.Time
+	interviews    *int32
+	addinterviews *int32
+	questions     *int32
+	addquestions  *int32
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Quota, error)
+	predicates    []predicate.Quota
+}
+
+var _ ent.Mutation = (*QuotaMutation)(nil)
+
+// quotaOption allows management of the mutation configuration using functional options.
+type quotaOption func(*QuotaMutation)
+
+// newQuotaMutation creates new mutation for the Quota entity.
+func newQuotaMutation(c config, op Op, opts ...quotaOption) *QuotaMutation {
+	m := &QuotaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuota,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuotaID sets the ID field of the mutation.
+func withQuotaID(id int) quotaOption {
+	return func(m *QuotaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Quota
+		)
+		m.oldValue = func(ctx context.Context) (*Quota, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Quota.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuota sets the old Quota of the mutation.
+func withQuota(node *Quota) quotaOption {
+	return func(m *QuotaMutation) {
+		m.oldValue = func(context.Context) (*Quota, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuotaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuotaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuotaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuotaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Quota.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuotaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuotaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuotaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuotaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuotaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	if m.language != nil {
-		fields = append(fields, publicquestion.FieldLanguage)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.content != nil {
-		fields = append(fields, publicquestion.FieldContent)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.answer != nil {
-		fields = append(fields, publicquestion.FieldAnswer)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuotaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *QuotaMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *QuotaMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *QuotaMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[quota.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *QuotaMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[quota.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *QuotaMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, quota.FieldDeletedAt)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *QuotaMutation) SetUserID(u uint64) {
+	m.user_id = &u
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *QuotaMutation) UserID() (r uint64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldUserID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds u to the "user_id" field.
+func (m *QuotaMutation) AddUserID(u int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += u
+	} else {
+		m.adduser_id = &u
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *QuotaMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *QuotaMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetDay sets the "day" field.
+func (m *QuotaMutation) SetDay(t time.Time) {
+	m.day = &t
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *QuotaMutation) Day() (r time.Time, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldDay(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *QuotaMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetInterviews sets the "interviews" field.
+func (m *QuotaMutation) SetInterviews(i int32) {
+	m.interviews = &i
+	m.addinterviews = nil
+}
+
+// Interviews returns the value of the "interviews" field in the mutation.
+func (m *QuotaMutation) Interviews() (r int32, exists bool) {
+	v := m.interviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterviews returns the old "interviews" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldInterviews(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterviews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterviews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterviews: %w", err)
+	}
+	return oldValue.Interviews, nil
+}
+
+// AddInterviews adds i to the "interviews" field.
+func (m *QuotaMutation) AddInterviews(i int32) {
+	if m.addinterviews != nil {
+		*m.addinterviews += i
+	} else {
+		m.addinterviews = &i
+	}
+}
+
+// AddedInterviews returns the value that was added to the "interviews" field in this mutation.
+func (m *QuotaMutation) AddedInterviews() (r int32, exists bool) {
+	v := m.addinterviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInterviews resets all changes to the "interviews" field.
+func (m *QuotaMutation) ResetInterviews() {
+	m.interviews = nil
+	m.addinterviews = nil
+}
+
+// SetQuestions sets the "questions" field.
+func (m *QuotaMutation) SetQuestions(i int32) {
+	m.questions = &i
+	m.addquestions = nil
+}
+
+// Questions returns the value of the "questions" field in the mutation.
+func (m *QuotaMutation) Questions() (r int32, exists bool) {
+	v := m.questions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestions returns the old "questions" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldQuestions(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestions: %w", err)
+	}
+	return oldValue.Questions, nil
+}
+
+// AddQuestions adds i to the "questions" field.
+func (m *QuotaMutation) AddQuestions(i int32) {
+	if m.addquestions != nil {
+		*m.addquestions += i
+	} else {
+		m.addquestions = &i
+	}
+}
+
+// AddedQuestions returns the value that was added to the "questions" field in this mutation.
+func (m *QuotaMutation) AddedQuestions() (r int32, exists bool) {
+	v := m.addquestions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuestions resets all changes to the "questions" field.
+func (m *QuotaMutation) ResetQuestions() {
+	m.questions = nil
+	m.addquestions = nil
+}
+
+// Where appends a list predicates to the QuotaMutation builder.
+func (m *QuotaMutation) Where(ps ...predicate.Quota) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuotaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuotaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Quota, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuotaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuotaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Quota).
+func (m *QuotaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuotaMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, quota.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, quota.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, quota.FieldDeletedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, quota.FieldUserID)
+	}
+	if m.day != nil {
+		fields = append(fields, quota.FieldDay)
+	}
+	if m.interviews != nil {
+		fields = append(fields, quota.FieldInterviews)
+	}
+	if m.questions != nil {
+		fields = append(fields, quota.FieldQuestions)
 	}
 	return fields
 }
@@ -2926,272 +9648,311 @@ func (m *PublicQuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PublicQuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *QuotaMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case publicquestion.FieldCreatedAt:
+	case quota.FieldCreatedAt:
 		return m.CreatedAt()
-	case publicquestion.FieldUpdatedAt:
+	case quota.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case publicquestion.FieldPosition:
-		return m.Position()
-	case publicquestion.FieldExperience:
-		return m.Experience()
-	case publicquestion.FieldLanguage:
-		return m.Language()
-	case publicquestion.FieldContent:
-		return m.Content()
-	case publicquestion.FieldAnswer:
-		return m.Answer()
+	case quota.FieldDeletedAt:
+		return m.DeletedAt()
+	case quota.FieldUserID:
+		return m.UserID()
+	case quota.FieldDay:
+		return m.Day()
+	case quota.FieldInterviews:
+		return m.Interviews()
+	case quota.FieldQuestions:
+		return m.Questions()
 	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PublicQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case publicquestion.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case publicquestion.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case publicquestion.FieldPosition:
-		return m.OldPosition(ctx)
-	case publicquestion.FieldExperience:
-		return m.OldExperience(ctx)
-	case publicquestion.FieldLanguage:
-		return m.OldLanguage(ctx)
-	case publicquestion.FieldContent:
-		return m.OldContent(ctx)
-	case publicquestion.FieldAnswer:
-		return m.OldAnswer(ctx)
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuotaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quota.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case quota.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case quota.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case quota.FieldUserID:
+		return m.OldUserID(ctx)
+	case quota.FieldDay:
+		return m.OldDay(ctx)
+	case quota.FieldInterviews:
+		return m.OldInterviews(ctx)
+	case quota.FieldQuestions:
+		return m.OldQuestions(ctx)
 	}
-	return nil, fmt.Errorf("unknown PublicQuestion field %s", name)
+	return nil, fmt.Errorf("unknown Quota field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PublicQuestionMutation) SetField(name string, value ent.Value) error {
+func (m *QuotaMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case publicquestion.FieldCreatedAt:
+	case quota.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case publicquestion.FieldUpdatedAt:
+	case quota.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case publicquestion.FieldPosition:
-		v, ok := value.(string)
+	case quota.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPosition(v)
+		m.SetDeletedAt(v)
 		return nil
-	case publicquestion.FieldExperience:
-		v, ok := value.(string)
+	case quota.FieldUserID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExperience(v)
+		m.SetUserID(v)
 		return nil
-	case publicquestion.FieldLanguage:
-		v, ok := value.(string)
+	case quota.FieldDay:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLanguage(v)
+		m.SetDay(v)
 		return nil
-	case publicquestion.FieldContent:
-		v, ok := value.(string)
+	case quota.FieldInterviews:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetInterviews(v)
 		return nil
-	case publicquestion.FieldAnswer:
-		v, ok := value.(string)
+	case quota.FieldQuestions:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnswer(v)
+		m.SetQuestions(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PublicQuestion field %s", name)
+	return fmt.Errorf("unknown Quota field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PublicQuestionMutation) AddedFields() []string {
-	return nil
+func (m *QuotaMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, quota.FieldUserID)
+	}
+	if m.addinterviews != nil {
+		fields = append(fields, quota.FieldInterviews)
+	}
+	if m.addquestions != nil {
+		fields = append(fields, quota.FieldQuestions)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PublicQuestionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *QuotaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quota.FieldUserID:
+		return m.AddedUserID()
+	case quota.FieldInterviews:
+		return m.AddedInterviews()
+	case quota.FieldQuestions:
+		return m.AddedQuestions()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PublicQuestionMutation) AddField(name string, value ent.Value) error {
+func (m *QuotaMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case quota.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case quota.FieldInterviews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInterviews(v)
+		return nil
+	case quota.FieldQuestions:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestions(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PublicQuestion numeric field %s", name)
+	return fmt.Errorf("unknown Quota numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PublicQuestionMutation) ClearedFields() []string {
+func (m *QuotaMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(publicquestion.FieldAnswer) {
-		fields = append(fields, publicquestion.FieldAnswer)
+	if m.FieldCleared(quota.FieldDeletedAt) {
+		fields = append(fields, quota.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PublicQuestionMutation) FieldCleared(name string) bool {
+func (m *QuotaMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PublicQuestionMutation) ClearField(name string) error {
+func (m *QuotaMutation) ClearField(name string) error {
 	switch name {
-	case publicquestion.FieldAnswer:
-		m.ClearAnswer()
+	case quota.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PublicQuestion nullable field %s", name)
+	return fmt.Errorf("unknown Quota nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PublicQuestionMutation) ResetField(name string) error {
+func (m *QuotaMutation) ResetField(name string) error {
 	switch name {
-	case publicquestion.FieldCreatedAt:
+	case quota.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case publicquestion.FieldUpdatedAt:
+	case quota.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case publicquestion.FieldPosition:
-		m.ResetPosition()
+	case quota.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case publicquestion.FieldExperience:
-		m.ResetExperience()
+	case quota.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case publicquestion.FieldLanguage:
-		m.ResetLanguage()
+	case quota.FieldDay:
+		m.ResetDay()
 		return nil
-	case publicquestion.FieldContent:
-		m.ResetContent()
+	case quota.FieldInterviews:
+		m.ResetInterviews()
 		return nil
-	case publicquestion.FieldAnswer:
-		m.ResetAnswer()
+	case quota.FieldQuestions:
+		m.ResetQuestions()
 		return nil
 	}
-	return fmt.Errorf("unknown PublicQuestion field %s", name)
+	return fmt.Errorf("unknown Quota field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PublicQuestionMutation) AddedEdges() []string {
+func (m *QuotaMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PublicQuestionMutation) AddedIDs(name string) []ent.Value {
+func (m *QuotaMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PublicQuestionMutation) RemovedEdges() []string {
+func (m *QuotaMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PublicQuestionMutation) RemovedIDs(name string) []ent.Value {
+func (m *QuotaMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PublicQuestionMutation) ClearedEdges() []string {
+func (m *QuotaMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PublicQuestionMutation) EdgeCleared(name string) bool {
+func (m *QuotaMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PublicQuestionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PublicQuestion unique edge %s", name)
+func (m *QuotaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Quota unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PublicQuestionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PublicQuestion edge %s", name)
+func (m *QuotaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Quota edge %s", name)
 }
 
-// QuestionMutation represents an operation that mutates the Question nodes in the graph.
-type QuestionMutation struct {
+// UsageMutation represents an operation that mutates the Usage nodes in the graph.
+type UsageMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
 	created_at        *time.Time
 	updated_at        *time.Time
-	question_index    *int32
-	addquestion_index *int32
-	content           *string
-	audio             *string
-	lipsync           **irelia.LipSyncData
-	answer            *string
-	record_proof      *string
-	comment           *string
-	score             *string
-	status            *irelia.QuestionStatus
-	addstatus         *irelia.QuestionStatus
+	deleted_at        *time.Time
+	user_id           *uint64
+	adduser_id        *int64
+	interview_id      *string
+	upstream          *string
+	operation         *string
+	latency_ms        *int64
+	addlatency_ms     *int64
+	request_bytes     *int
+	addrequest_bytes  *int
+	response_bytes    *int
+	addresponse_bytes *int
+	success           *bool
 	clearedFields     map[string]struct{}
-	interview         *string
-	clearedinterview  bool
 	done              bool
-	oldValue          func(context.Context) (*Question, error)
-	predicates        []predicate.Question
+	oldValue          func(context.Context) (*Usage, error)
+	predicates        []predicate.Usage
 }
 
-var _ ent.Mutation = (*QuestionMutation)(nil)
+var _ ent.Mutation = (*UsageMutation)(nil)
 
-// questionOption allows management of the mutation configuration using functional options.
-type questionOption func(*QuestionMutation)
+// usageOption allows management of the mutation configuration using functional options.
+type usageOption func(*UsageMutation)
 
-// newQuestionMutation creates new mutation for the Question entity.
-func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
-	m := &QuestionMutation{
+// newUsageMutation creates new mutation for the Usage entity.
+func newUsageMutation(c config, op Op, opts ...usageOption) *UsageMutation {
+	m := &UsageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuestion,
+		typ:           TypeUsage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3200,20 +9961,20 @@ func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutat
 	return m
 }
 
-// withQuestionID sets the ID field of the mutation.
-func withQuestionID(id int) questionOption {
-	return func(m *QuestionMutation) {
+// withUsageID sets the ID field of the mutation.
+func withUsageID(id int) usageOption {
+	return func(m *UsageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Question
+			value *Usage
 		)
-		m.oldValue = func(ctx context.Context) (*Question, error) {
+		m.oldValue = func(ctx context.Context) (*Usage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Question.Get(ctx, id)
+					value, err = m.Client().Usage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3222,10 +9983,10 @@ func withQuestionID(id int) questionOption {
 	}
 }
 
-// withQuestion sets the old Question of the mutation.
-func withQuestion(node *Question) questionOption {
-	return func(m *QuestionMutation) {
-		m.oldValue = func(context.Context) (*Question, error) {
+// withUsage sets the old Usage of the mutation.
+func withUsage(node *Usage) usageOption {
+	return func(m *UsageMutation) {
+		m.oldValue = func(context.Context) (*Usage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3234,7 +9995,7 @@ func withQuestion(node *Question) questionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestionMutation) Client() *Client {
+func (m UsageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3242,7 +10003,7 @@ func (m QuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuestionMutation) Tx() (*Tx, error) {
+func (m UsageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3253,7 +10014,7 @@ func (m QuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuestionMutation) ID() (id int, exists bool) {
+func (m *UsageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3264,7 +10025,7 @@ func (m *QuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UsageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3273,19 +10034,19 @@ func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Usage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *QuestionMutation) SetCreatedAt(t time.Time) {
+func (m *UsageMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QuestionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UsageMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3293,10 +10054,10 @@ func (m *QuestionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3311,17 +10072,17 @@ func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QuestionMutation) ResetCreatedAt() {
+func (m *UsageMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *QuestionMutation) SetUpdatedAt(t time.Time) {
+func (m *UsageMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *QuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UsageMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3329,10 +10090,10 @@ func (m *QuestionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3347,524 +10108,449 @@ func (m *QuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *QuestionMutation) ResetUpdatedAt() {
+func (m *UsageMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetInterviewID sets the "interview_id" field.
-func (m *QuestionMutation) SetInterviewID(s string) {
-	m.interview = &s
-}
-
-// InterviewID returns the value of the "interview_id" field in the mutation.
-func (m *QuestionMutation) InterviewID() (r string, exists bool) {
-	v := m.interview
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldInterviewID returns the old "interview_id" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldInterviewID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInterviewID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInterviewID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInterviewID: %w", err)
-	}
-	return oldValue.InterviewID, nil
-}
-
-// ResetInterviewID resets all changes to the "interview_id" field.
-func (m *QuestionMutation) ResetInterviewID() {
-	m.interview = nil
-}
-
-// SetQuestionIndex sets the "question_index" field.
-func (m *QuestionMutation) SetQuestionIndex(i int32) {
-	m.question_index = &i
-	m.addquestion_index = nil
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UsageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// QuestionIndex returns the value of the "question_index" field in the mutation.
-func (m *QuestionMutation) QuestionIndex() (r int32, exists bool) {
-	v := m.question_index
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UsageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestionIndex returns the old "question_index" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldQuestionIndex(ctx context.Context) (v int32, err error) {
+func (m *UsageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionIndex requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionIndex: %w", err)
-	}
-	return oldValue.QuestionIndex, nil
-}
-
-// AddQuestionIndex adds i to the "question_index" field.
-func (m *QuestionMutation) AddQuestionIndex(i int32) {
-	if m.addquestion_index != nil {
-		*m.addquestion_index += i
-	} else {
-		m.addquestion_index = &i
-	}
-}
-
-// AddedQuestionIndex returns the value that was added to the "question_index" field in this mutation.
-func (m *QuestionMutation) AddedQuestionIndex() (r int32, exists bool) {
-	v := m.addquestion_index
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return *v, true
-}
-
-// ResetQuestionIndex resets all changes to the "question_index" field.
-func (m *QuestionMutation) ResetQuestionIndex() {
-	m.question_index = nil
-	m.addquestion_index = nil
-}
-
-// SetContent sets the "content" field.
-func (m *QuestionMutation) SetContent(s string) {
-	m.content = &s
+	return oldValue.DeletedAt, nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *QuestionMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UsageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[usage.FieldDeletedAt] = struct{}{}
 }
 
-// OldContent returns the old "content" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
-	}
-	return oldValue.Content, nil
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UsageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[usage.FieldDeletedAt]
+	return ok
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *QuestionMutation) ResetContent() {
-	m.content = nil
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UsageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, usage.FieldDeletedAt)
 }
 
-// SetAudio sets the "audio" field.
-func (m *QuestionMutation) SetAudio(s string) {
-	m.audio = &s
+// SetUserID sets the "user_id" field.
+func (m *UsageMutation) SetUserID(u uint64) {
+	m.user_id = &u
+	m.adduser_id = nil
 }
 
-// Audio returns the value of the "audio" field in the mutation.
-func (m *QuestionMutation) Audio() (r string, exists bool) {
-	v := m.audio
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsageMutation) UserID() (r uint64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAudio returns the old "audio" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldAudio(ctx context.Context) (v string, err error) {
+func (m *UsageMutation) OldUserID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAudio is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAudio requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAudio: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Audio, nil
+	return oldValue.UserID, nil
 }
 
-// ClearAudio clears the value of the "audio" field.
-func (m *QuestionMutation) ClearAudio() {
-	m.audio = nil
-	m.clearedFields[question.FieldAudio] = struct{}{}
+// AddUserID adds u to the "user_id" field.
+func (m *UsageMutation) AddUserID(u int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += u
+	} else {
+		m.adduser_id = &u
+	}
 }
 
-// AudioCleared returns if the "audio" field was cleared in this mutation.
-func (m *QuestionMutation) AudioCleared() bool {
-	_, ok := m.clearedFields[question.FieldAudio]
-	return ok
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *UsageMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAudio resets all changes to the "audio" field.
-func (m *QuestionMutation) ResetAudio() {
-	m.audio = nil
-	delete(m.clearedFields, question.FieldAudio)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsageMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetLipsync sets the "lipsync" field.
-func (m *QuestionMutation) SetLipsync(isd *irelia.LipSyncData) {
-	m.lipsync = &isd
+// SetInterviewID sets the "interview_id" field.
+func (m *UsageMutation) SetInterviewID(s string) {
+	m.interview_id = &s
 }
 
-// Lipsync returns the value of the "lipsync" field in the mutation.
-func (m *QuestionMutation) Lipsync() (r *irelia.LipSyncData, exists bool) {
-	v := m.lipsync
+// InterviewID returns the value of the "interview_id" field in the mutation.
+func (m *UsageMutation) InterviewID() (r string, exists bool) {
+	v := m.interview_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLipsync returns the old "lipsync" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldInterviewID returns the old "interview_id" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldLipsync(ctx context.Context) (v *irelia.LipSyncData, err error) {
+func (m *UsageMutation) OldInterviewID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLipsync is only allowed on UpdateOne operations")
+		return v, errors.New("OldInterviewID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLipsync requires an ID field in the mutation")
+		return v, errors.New("OldInterviewID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLipsync: %w", err)
+		return v, fmt.Errorf("querying old value for OldInterviewID: %w", err)
 	}
-	return oldValue.Lipsync, nil
+	return oldValue.InterviewID, nil
 }
 
-// ClearLipsync clears the value of the "lipsync" field.
-func (m *QuestionMutation) ClearLipsync() {
-	m.lipsync = nil
-	m.clearedFields[question.FieldLipsync] = struct{}{}
+// ClearInterviewID clears the value of the "interview_id" field.
+func (m *UsageMutation) ClearInterviewID() {
+	m.interview_id = nil
+	m.clearedFields[usage.FieldInterviewID] = struct{}{}
 }
 
-// LipsyncCleared returns if the "lipsync" field was cleared in this mutation.
-func (m *QuestionMutation) LipsyncCleared() bool {
-	_, ok := m.clearedFields[question.FieldLipsync]
+// InterviewIDCleared returns if the "interview_id" field was cleared in this mutation.
+func (m *UsageMutation) InterviewIDCleared() bool {
+	_, ok := m.clearedFields[usage.FieldInterviewID]
 	return ok
 }
 
-// ResetLipsync resets all changes to the "lipsync" field.
-func (m *QuestionMutation) ResetLipsync() {
-	m.lipsync = nil
-	delete(m.clearedFields, question.FieldLipsync)
+// ResetInterviewID resets all changes to the "interview_id" field.
+func (m *UsageMutation) ResetInterviewID() {
+	m.interview_id = nil
+	delete(m.clearedFields, usage.FieldInterviewID)
 }
 
-// SetAnswer sets the "answer" field.
-func (m *QuestionMutation) SetAnswer(s string) {
-	m.answer = &s
+// SetUpstream sets the "upstream" field.
+func (m *UsageMutation) SetUpstream(s string) {
+	m.upstream = &s
 }
 
-// Answer returns the value of the "answer" field in the mutation.
-func (m *QuestionMutation) Answer() (r string, exists bool) {
-	v := m.answer
+// Upstream returns the value of the "upstream" field in the mutation.
+func (m *UsageMutation) Upstream() (r string, exists bool) {
+	v := m.upstream
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnswer returns the old "answer" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldUpstream returns the old "upstream" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+func (m *UsageMutation) OldUpstream(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpstream is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswer requires an ID field in the mutation")
+		return v, errors.New("OldUpstream requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpstream: %w", err)
 	}
-	return oldValue.Answer, nil
-}
-
-// ClearAnswer clears the value of the "answer" field.
-func (m *QuestionMutation) ClearAnswer() {
-	m.answer = nil
-	m.clearedFields[question.FieldAnswer] = struct{}{}
-}
-
-// AnswerCleared returns if the "answer" field was cleared in this mutation.
-func (m *QuestionMutation) AnswerCleared() bool {
-	_, ok := m.clearedFields[question.FieldAnswer]
-	return ok
+	return oldValue.Upstream, nil
 }
 
-// ResetAnswer resets all changes to the "answer" field.
-func (m *QuestionMutation) ResetAnswer() {
-	m.answer = nil
-	delete(m.clearedFields, question.FieldAnswer)
+// ResetUpstream resets all changes to the "upstream" field.
+func (m *UsageMutation) ResetUpstream() {
+	m.upstream = nil
 }
 
-// SetRecordProof sets the "record_proof" field.
-func (m *QuestionMutation) SetRecordProof(s string) {
-	m.record_proof = &s
+// SetOperation sets the "operation" field.
+func (m *UsageMutation) SetOperation(s string) {
+	m.operation = &s
 }
 
-// RecordProof returns the value of the "record_proof" field in the mutation.
-func (m *QuestionMutation) RecordProof() (r string, exists bool) {
-	v := m.record_proof
+// Operation returns the value of the "operation" field in the mutation.
+func (m *UsageMutation) Operation() (r string, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRecordProof returns the old "record_proof" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldRecordProof(ctx context.Context) (v string, err error) {
+func (m *UsageMutation) OldOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRecordProof is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRecordProof requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRecordProof: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.RecordProof, nil
-}
-
-// ClearRecordProof clears the value of the "record_proof" field.
-func (m *QuestionMutation) ClearRecordProof() {
-	m.record_proof = nil
-	m.clearedFields[question.FieldRecordProof] = struct{}{}
-}
-
-// RecordProofCleared returns if the "record_proof" field was cleared in this mutation.
-func (m *QuestionMutation) RecordProofCleared() bool {
-	_, ok := m.clearedFields[question.FieldRecordProof]
-	return ok
+	return oldValue.Operation, nil
 }
 
-// ResetRecordProof resets all changes to the "record_proof" field.
-func (m *QuestionMutation) ResetRecordProof() {
-	m.record_proof = nil
-	delete(m.clearedFields, question.FieldRecordProof)
+// ResetOperation resets all changes to the "operation" field.
+func (m *UsageMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// SetComment sets the "comment" field.
-func (m *QuestionMutation) SetComment(s string) {
-	m.comment = &s
+// SetLatencyMs sets the "latency_ms" field.
+func (m *UsageMutation) SetLatencyMs(i int64) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
 }
 
-// Comment returns the value of the "comment" field in the mutation.
-func (m *QuestionMutation) Comment() (r string, exists bool) {
-	v := m.comment
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *UsageMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latency_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComment returns the old "comment" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldLatencyMs returns the old "latency_ms" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldComment(ctx context.Context) (v string, err error) {
+func (m *UsageMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComment requires an ID field in the mutation")
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
 	}
-	return oldValue.Comment, nil
+	return oldValue.LatencyMs, nil
 }
 
-// ClearComment clears the value of the "comment" field.
-func (m *QuestionMutation) ClearComment() {
-	m.comment = nil
-	m.clearedFields[question.FieldComment] = struct{}{}
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *UsageMutation) AddLatencyMs(i int64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
 }
 
-// CommentCleared returns if the "comment" field was cleared in this mutation.
-func (m *QuestionMutation) CommentCleared() bool {
-	_, ok := m.clearedFields[question.FieldComment]
-	return ok
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *UsageMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetComment resets all changes to the "comment" field.
-func (m *QuestionMutation) ResetComment() {
-	m.comment = nil
-	delete(m.clearedFields, question.FieldComment)
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *UsageMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
 }
 
-// SetScore sets the "score" field.
-func (m *QuestionMutation) SetScore(s string) {
-	m.score = &s
+// SetRequestBytes sets the "request_bytes" field.
+func (m *UsageMutation) SetRequestBytes(i int) {
+	m.request_bytes = &i
+	m.addrequest_bytes = nil
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *QuestionMutation) Score() (r string, exists bool) {
-	v := m.score
+// RequestBytes returns the value of the "request_bytes" field in the mutation.
+func (m *UsageMutation) RequestBytes() (r int, exists bool) {
+	v := m.request_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScore returns the old "score" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestBytes returns the old "request_bytes" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldScore(ctx context.Context) (v string, err error) {
+func (m *UsageMutation) OldRequestBytes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
+		return v, errors.New("OldRequestBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestBytes: %w", err)
 	}
-	return oldValue.Score, nil
+	return oldValue.RequestBytes, nil
 }
 
-// ClearScore clears the value of the "score" field.
-func (m *QuestionMutation) ClearScore() {
-	m.score = nil
-	m.clearedFields[question.FieldScore] = struct{}{}
+// AddRequestBytes adds i to the "request_bytes" field.
+func (m *UsageMutation) AddRequestBytes(i int) {
+	if m.addrequest_bytes != nil {
+		*m.addrequest_bytes += i
+	} else {
+		m.addrequest_bytes = &i
+	}
 }
 
-// ScoreCleared returns if the "score" field was cleared in this mutation.
-func (m *QuestionMutation) ScoreCleared() bool {
-	_, ok := m.clearedFields[question.FieldScore]
-	return ok
+// AddedRequestBytes returns the value that was added to the "request_bytes" field in this mutation.
+func (m *UsageMutation) AddedRequestBytes() (r int, exists bool) {
+	v := m.addrequest_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *QuestionMutation) ResetScore() {
-	m.score = nil
-	delete(m.clearedFields, question.FieldScore)
+// ResetRequestBytes resets all changes to the "request_bytes" field.
+func (m *UsageMutation) ResetRequestBytes() {
+	m.request_bytes = nil
+	m.addrequest_bytes = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *QuestionMutation) SetStatus(is irelia.QuestionStatus) {
-	m.status = &is
-	m.addstatus = nil
+// SetResponseBytes sets the "response_bytes" field.
+func (m *UsageMutation) SetResponseBytes(i int) {
+	m.response_bytes = &i
+	m.addresponse_bytes = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *QuestionMutation) Status() (r irelia.QuestionStatus, exists bool) {
-	v := m.status
+// ResponseBytes returns the value of the "response_bytes" field in the mutation.
+func (m *UsageMutation) ResponseBytes() (r int, exists bool) {
+	v := m.response_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseBytes returns the old "response_bytes" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldStatus(ctx context.Context) (v irelia.QuestionStatus, err error) {
+func (m *UsageMutation) OldResponseBytes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldResponseBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseBytes: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.ResponseBytes, nil
 }
 
-// AddStatus adds is to the "status" field.
-func (m *QuestionMutation) AddStatus(is irelia.QuestionStatus) {
-	if m.addstatus != nil {
-		*m.addstatus += is
+// AddResponseBytes adds i to the "response_bytes" field.
+func (m *UsageMutation) AddResponseBytes(i int) {
+	if m.addresponse_bytes != nil {
+		*m.addresponse_bytes += i
 	} else {
-		m.addstatus = &is
+		m.addresponse_bytes = &i
 	}
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *QuestionMutation) AddedStatus() (r irelia.QuestionStatus, exists bool) {
-	v := m.addstatus
+// AddedResponseBytes returns the value that was added to the "response_bytes" field in this mutation.
+func (m *UsageMutation) AddedResponseBytes() (r int, exists bool) {
+	v := m.addresponse_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *QuestionMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
+// ResetResponseBytes resets all changes to the "response_bytes" field.
+func (m *UsageMutation) ResetResponseBytes() {
+	m.response_bytes = nil
+	m.addresponse_bytes = nil
 }
 
-// ClearInterview clears the "interview" edge to the Interview entity.
-func (m *QuestionMutation) ClearInterview() {
-	m.clearedinterview = true
-	m.clearedFields[question.FieldInterviewID] = struct{}{}
+// SetSuccess sets the "success" field.
+func (m *UsageMutation) SetSuccess(b bool) {
+	m.success = &b
 }
 
-// InterviewCleared reports if the "interview" edge to the Interview entity was cleared.
-func (m *QuestionMutation) InterviewCleared() bool {
-	return m.clearedinterview
+// Success returns the value of the "success" field in the mutation.
+func (m *UsageMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// InterviewIDs returns the "interview" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// InterviewID instead. It exists only for internal usage by the builders.
-func (m *QuestionMutation) InterviewIDs() (ids []string) {
-	if id := m.interview; id != nil {
-		ids = append(ids, *id)
+// OldSuccess returns the old "success" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
 }
 
-// ResetInterview resets all changes to the "interview" edge.
-func (m *QuestionMutation) ResetInterview() {
-	m.interview = nil
-	m.clearedinterview = false
+// ResetSuccess resets all changes to the "success" field.
+func (m *UsageMutation) ResetSuccess() {
+	m.success = nil
 }
 
-// Where appends a list predicates to the QuestionMutation builder.
-func (m *QuestionMutation) Where(ps ...predicate.Question) {
+// Where appends a list predicates to the UsageMutation builder.
+func (m *UsageMutation) Where(ps ...predicate.Usage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Question, len(ps))
+func (m *UsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Usage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3872,60 +10558,57 @@ func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuestionMutation) Op() Op {
+func (m *UsageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestionMutation) SetOp(op Op) {
+func (m *UsageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Question).
-func (m *QuestionMutation) Type() string {
+// Type returns the node type of this mutation (Usage).
+func (m *UsageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestionMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *UsageMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, question.FieldCreatedAt)
+		fields = append(fields, usage.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, question.FieldUpdatedAt)
-	}
-	if m.interview != nil {
-		fields = append(fields, question.FieldInterviewID)
+		fields = append(fields, usage.FieldUpdatedAt)
 	}
-	if m.question_index != nil {
-		fields = append(fields, question.FieldQuestionIndex)
+	if m.deleted_at != nil {
+		fields = append(fields, usage.FieldDeletedAt)
 	}
-	if m.content != nil {
-		fields = append(fields, question.FieldContent)
+	if m.user_id != nil {
+		fields = append(fields, usage.FieldUserID)
 	}
-	if m.audio != nil {
-		fields = append(fields, question.FieldAudio)
+	if m.interview_id != nil {
+		fields = append(fields, usage.FieldInterviewID)
 	}
-	if m.lipsync != nil {
-		fields = append(fields, question.FieldLipsync)
+	if m.upstream != nil {
+		fields = append(fields, usage.FieldUpstream)
 	}
-	if m.answer != nil {
-		fields = append(fields, question.FieldAnswer)
+	if m.operation != nil {
+		fields = append(fields, usage.FieldOperation)
 	}
-	if m.record_proof != nil {
-		fields = append(fields, question.FieldRecordProof)
+	if m.latency_ms != nil {
+		fields = append(fields, usage.FieldLatencyMs)
 	}
-	if m.comment != nil {
-		fields = append(fields, question.FieldComment)
+	if m.request_bytes != nil {
+		fields = append(fields, usage.FieldRequestBytes)
 	}
-	if m.score != nil {
-		fields = append(fields, question.FieldScore)
+	if m.response_bytes != nil {
+		fields = append(fields, usage.FieldResponseBytes)
 	}
-	if m.status != nil {
-		fields = append(fields, question.FieldStatus)
+	if m.success != nil {
+		fields = append(fields, usage.FieldSuccess)
 	}
 	return fields
 }
@@ -3933,32 +10616,30 @@ func (m *QuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *UsageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case question.FieldCreatedAt:
+	case usage.FieldCreatedAt:
 		return m.CreatedAt()
-	case question.FieldUpdatedAt:
+	case usage.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case question.FieldInterviewID:
+	case usage.FieldDeletedAt:
+		return m.DeletedAt()
+	case usage.FieldUserID:
+		return m.UserID()
+	case usage.FieldInterviewID:
 		return m.InterviewID()
-	case question.FieldQuestionIndex:
-		return m.QuestionIndex()
-	case question.FieldContent:
-		return m.Content()
-	case question.FieldAudio:
-		return m.Audio()
-	case question.FieldLipsync:
-		return m.Lipsync()
-	case question.FieldAnswer:
-		return m.Answer()
-	case question.FieldRecordProof:
-		return m.RecordProof()
-	case question.FieldComment:
-		return m.Comment()
-	case question.FieldScore:
-		return m.Score()
-	case question.FieldStatus:
-		return m.Status()
+	case usage.FieldUpstream:
+		return m.Upstream()
+	case usage.FieldOperation:
+		return m.Operation()
+	case usage.FieldLatencyMs:
+		return m.LatencyMs()
+	case usage.FieldRequestBytes:
+		return m.RequestBytes()
+	case usage.FieldResponseBytes:
+		return m.ResponseBytes()
+	case usage.FieldSuccess:
+		return m.Success()
 	}
 	return nil, false
 }
@@ -3966,138 +10647,135 @@ func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case question.FieldCreatedAt:
+	case usage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case question.FieldUpdatedAt:
+	case usage.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case question.FieldInterviewID:
+	case usage.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case usage.FieldUserID:
+		return m.OldUserID(ctx)
+	case usage.FieldInterviewID:
 		return m.OldInterviewID(ctx)
-	case question.FieldQuestionIndex:
-		return m.OldQuestionIndex(ctx)
-	case question.FieldContent:
-		return m.OldContent(ctx)
-	case question.FieldAudio:
-		return m.OldAudio(ctx)
-	case question.FieldLipsync:
-		return m.OldLipsync(ctx)
-	case question.FieldAnswer:
-		return m.OldAnswer(ctx)
-	case question.FieldRecordProof:
-		return m.OldRecordProof(ctx)
-	case question.FieldComment:
-		return m.OldComment(ctx)
-	case question.FieldScore:
-		return m.OldScore(ctx)
-	case question.FieldStatus:
-		return m.OldStatus(ctx)
-	}
-	return nil, fmt.Errorf("unknown Question field %s", name)
+	case usage.FieldUpstream:
+		return m.OldUpstream(ctx)
+	case usage.FieldOperation:
+		return m.OldOperation(ctx)
+	case usage.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case usage.FieldRequestBytes:
+		return m.OldRequestBytes(ctx)
+	case usage.FieldResponseBytes:
+		return m.OldResponseBytes(ctx)
+	case usage.FieldSuccess:
+		return m.OldSuccess(ctx)
+	}
+	return nil, fmt.Errorf("unknown Usage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+func (m *UsageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case question.FieldCreatedAt:
+	case usage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case question.FieldUpdatedAt:
+	case usage.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case question.FieldInterviewID:
-		v, ok := value.(string)
+	case usage.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInterviewID(v)
+		m.SetDeletedAt(v)
 		return nil
-	case question.FieldQuestionIndex:
-		v, ok := value.(int32)
+	case usage.FieldUserID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestionIndex(v)
+		m.SetUserID(v)
 		return nil
-	case question.FieldContent:
+	case usage.FieldInterviewID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetInterviewID(v)
 		return nil
-	case question.FieldAudio:
+	case usage.FieldUpstream:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAudio(v)
-		return nil
-	case question.FieldLipsync:
-		v, ok := value.(*irelia.LipSyncData)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLipsync(v)
+		m.SetUpstream(v)
 		return nil
-	case question.FieldAnswer:
+	case usage.FieldOperation:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnswer(v)
+		m.SetOperation(v)
 		return nil
-	case question.FieldRecordProof:
-		v, ok := value.(string)
+	case usage.FieldLatencyMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRecordProof(v)
+		m.SetLatencyMs(v)
 		return nil
-	case question.FieldComment:
-		v, ok := value.(string)
+	case usage.FieldRequestBytes:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetComment(v)
+		m.SetRequestBytes(v)
 		return nil
-	case question.FieldScore:
-		v, ok := value.(string)
+	case usage.FieldResponseBytes:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScore(v)
+		m.SetResponseBytes(v)
 		return nil
-	case question.FieldStatus:
-		v, ok := value.(irelia.QuestionStatus)
+	case usage.FieldSuccess:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetSuccess(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Question field %s", name)
+	return fmt.Errorf("unknown Usage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestionMutation) AddedFields() []string {
+func (m *UsageMutation) AddedFields() []string {
 	var fields []string
-	if m.addquestion_index != nil {
-		fields = append(fields, question.FieldQuestionIndex)
+	if m.adduser_id != nil {
+		fields = append(fields, usage.FieldUserID)
 	}
-	if m.addstatus != nil {
-		fields = append(fields, question.FieldStatus)
+	if m.addlatency_ms != nil {
+		fields = append(fields, usage.FieldLatencyMs)
+	}
+	if m.addrequest_bytes != nil {
+		fields = append(fields, usage.FieldRequestBytes)
+	}
+	if m.addresponse_bytes != nil {
+		fields = append(fields, usage.FieldResponseBytes)
 	}
 	return fields
 }
@@ -4105,12 +10783,16 @@ func (m *QuestionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case question.FieldQuestionIndex:
-		return m.AddedQuestionIndex()
-	case question.FieldStatus:
-		return m.AddedStatus()
+	case usage.FieldUserID:
+		return m.AddedUserID()
+	case usage.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	case usage.FieldRequestBytes:
+		return m.AddedRequestBytes()
+	case usage.FieldResponseBytes:
+		return m.AddedResponseBytes()
 	}
 	return nil, false
 }
@@ -4118,198 +10800,159 @@ func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+func (m *UsageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case question.FieldQuestionIndex:
-		v, ok := value.(int32)
+	case usage.FieldUserID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuestionIndex(v)
+		m.AddUserID(v)
 		return nil
-	case question.FieldStatus:
-		v, ok := value.(irelia.QuestionStatus)
+	case usage.FieldLatencyMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatus(v)
+		m.AddLatencyMs(v)
+		return nil
+	case usage.FieldRequestBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequestBytes(v)
+		return nil
+	case usage.FieldResponseBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseBytes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Question numeric field %s", name)
+	return fmt.Errorf("unknown Usage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestionMutation) ClearedFields() []string {
+func (m *UsageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(question.FieldAudio) {
-		fields = append(fields, question.FieldAudio)
-	}
-	if m.FieldCleared(question.FieldLipsync) {
-		fields = append(fields, question.FieldLipsync)
-	}
-	if m.FieldCleared(question.FieldAnswer) {
-		fields = append(fields, question.FieldAnswer)
+	if m.FieldCleared(usage.FieldDeletedAt) {
+		fields = append(fields, usage.FieldDeletedAt)
 	}
-	if m.FieldCleared(question.FieldRecordProof) {
-		fields = append(fields, question.FieldRecordProof)
-	}
-	if m.FieldCleared(question.FieldComment) {
-		fields = append(fields, question.FieldComment)
-	}
-	if m.FieldCleared(question.FieldScore) {
-		fields = append(fields, question.FieldScore)
+	if m.FieldCleared(usage.FieldInterviewID) {
+		fields = append(fields, usage.FieldInterviewID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuestionMutation) FieldCleared(name string) bool {
+func (m *UsageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestionMutation) ClearField(name string) error {
+func (m *UsageMutation) ClearField(name string) error {
 	switch name {
-	case question.FieldAudio:
-		m.ClearAudio()
-		return nil
-	case question.FieldLipsync:
-		m.ClearLipsync()
-		return nil
-	case question.FieldAnswer:
-		m.ClearAnswer()
-		return nil
-	case question.FieldRecordProof:
-		m.ClearRecordProof()
-		return nil
-	case question.FieldComment:
-		m.ClearComment()
+	case usage.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
-	case question.FieldScore:
-		m.ClearScore()
+	case usage.FieldInterviewID:
+		m.ClearInterviewID()
 		return nil
 	}
-	return fmt.Errorf("unknown Question nullable field %s", name)
+	return fmt.Errorf("unknown Usage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestionMutation) ResetField(name string) error {
+func (m *UsageMutation) ResetField(name string) error {
 	switch name {
-	case question.FieldCreatedAt:
+	case usage.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case question.FieldUpdatedAt:
+	case usage.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case question.FieldInterviewID:
-		m.ResetInterviewID()
-		return nil
-	case question.FieldQuestionIndex:
-		m.ResetQuestionIndex()
+	case usage.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case question.FieldContent:
-		m.ResetContent()
+	case usage.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case question.FieldAudio:
-		m.ResetAudio()
+	case usage.FieldInterviewID:
+		m.ResetInterviewID()
 		return nil
-	case question.FieldLipsync:
-		m.ResetLipsync()
+	case usage.FieldUpstream:
+		m.ResetUpstream()
 		return nil
-	case question.FieldAnswer:
-		m.ResetAnswer()
+	case usage.FieldOperation:
+		m.ResetOperation()
 		return nil
-	case question.FieldRecordProof:
-		m.ResetRecordProof()
+	case usage.FieldLatencyMs:
+		m.ResetLatencyMs()
 		return nil
-	case question.FieldComment:
-		m.ResetComment()
+	case usage.FieldRequestBytes:
+		m.ResetRequestBytes()
 		return nil
-	case question.FieldScore:
-		m.ResetScore()
+	case usage.FieldResponseBytes:
+		m.ResetResponseBytes()
 		return nil
-	case question.FieldStatus:
-		m.ResetStatus()
+	case usage.FieldSuccess:
+		m.ResetSuccess()
 		return nil
 	}
-	return fmt.Errorf("unknown Question field %s", name)
+	return fmt.Errorf("unknown Usage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.interview != nil {
-		edges = append(edges, question.EdgeInterview)
-	}
+func (m *UsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case question.EdgeInterview:
-		if id := m.interview; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *UsageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+func (m *UsageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedinterview {
-		edges = append(edges, question.EdgeInterview)
-	}
+func (m *UsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuestionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case question.EdgeInterview:
-		return m.clearedinterview
-	}
+func (m *UsageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuestionMutation) ClearEdge(name string) error {
-	switch name {
-	case question.EdgeInterview:
-		m.ClearInterview()
-		return nil
-	}
-	return fmt.Errorf("unknown Question unique edge %s", name)
+func (m *UsageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Usage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuestionMutation) ResetEdge(name string) error {
-	switch name {
-	case question.EdgeInterview:
-		m.ResetInterview()
-		return nil
-	}
-	return fmt.Errorf("unknown Question edge %s", name)
+func (m *UsageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Usage edge %s", name)
 }
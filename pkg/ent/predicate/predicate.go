@@ -12,8 +12,20 @@ type Interview func(*sql.Selector)
 // InterviewFavorite is the predicate function for interviewfavorite builders.
 type InterviewFavorite func(*sql.Selector)
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// ModerationAudit is the predicate function for moderationaudit builders.
+type ModerationAudit func(*sql.Selector)
+
 // PublicQuestion is the predicate function for publicquestion builders.
 type PublicQuestion func(*sql.Selector)
 
 // Question is the predicate function for question builders.
 type Question func(*sql.Selector)
+
+// Quota is the predicate function for quota builders.
+type Quota func(*sql.Selector)
+
+// Usage is the predicate function for usage builders.
+type Usage func(*sql.Selector)
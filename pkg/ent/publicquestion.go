@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	irelia "irelia/api"
 	"irelia/pkg/ent/publicquestion"
 	"strings"
 	"time"
@@ -21,6 +22,8 @@ type PublicQuestion struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Position holds the value of the "position" field.
 	Position string `json:"position,omitempty"`
 	// Experience holds the value of the "experience" field.
@@ -30,8 +33,36 @@ type PublicQuestion struct {
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
 	// Answer holds the value of the "answer" field.
-	Answer       string `json:"answer,omitempty"`
-	selectValues sql.SelectValues
+	Answer string `json:"answer,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash *string `json:"content_hash,omitempty"`
+	// Occurrences holds the value of the "occurrences" field.
+	Occurrences int `json:"occurrences,omitempty"`
+	// FirstSeenAt holds the value of the "first_seen_at" field.
+	FirstSeenAt time.Time `json:"first_seen_at,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status irelia.ModerationStatus `json:"status,omitempty"`
+	// MergedIntoID holds the value of the "merged_into_id" field.
+	MergedIntoID *int `json:"merged_into_id,omitempty"`
+	// ModeratedBy holds the value of the "moderated_by" field.
+	ModeratedBy *uint64 `json:"moderated_by,omitempty"`
+	// ModeratedAt holds the value of the "moderated_at" field.
+	ModeratedAt *time.Time `json:"moderated_at,omitempty"`
+	// AnswerGenerator holds the value of the "answer_generator" field.
+	AnswerGenerator string `json:"answer_generator,omitempty"`
+	// AnswerVersion holds the value of the "answer_version" field.
+	AnswerVersion string `json:"answer_version,omitempty"`
+	// AnswerGeneratedAt holds the value of the "answer_generated_at" field.
+	AnswerGeneratedAt *time.Time `json:"answer_generated_at,omitempty"`
+	// AnswerAttempts holds the value of the "answer_attempts" field.
+	AnswerAttempts int32 `json:"answer_attempts,omitempty"`
+	// AnswerLockedBy holds the value of the "answer_locked_by" field.
+	AnswerLockedBy string `json:"answer_locked_by,omitempty"`
+	// AnswerLockedUntil holds the value of the "answer_locked_until" field.
+	AnswerLockedUntil *time.Time `json:"answer_locked_until,omitempty"`
+	selectValues      sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -39,11 +70,11 @@ func (*PublicQuestion) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case publicquestion.FieldID:
+		case publicquestion.FieldID, publicquestion.FieldOccurrences, publicquestion.FieldStatus, publicquestion.FieldMergedIntoID, publicquestion.FieldModeratedBy, publicquestion.FieldAnswerAttempts:
 			values[i] = new(sql.NullInt64)
-		case publicquestion.FieldPosition, publicquestion.FieldExperience, publicquestion.FieldLanguage, publicquestion.FieldContent, publicquestion.FieldAnswer:
+		case publicquestion.FieldPosition, publicquestion.FieldExperience, publicquestion.FieldLanguage, publicquestion.FieldContent, publicquestion.FieldAnswer, publicquestion.FieldContentHash, publicquestion.FieldAnswerGenerator, publicquestion.FieldAnswerVersion, publicquestion.FieldAnswerLockedBy:
 			values[i] = new(sql.NullString)
-		case publicquestion.FieldCreatedAt, publicquestion.FieldUpdatedAt:
+		case publicquestion.FieldCreatedAt, publicquestion.FieldUpdatedAt, publicquestion.FieldDeletedAt, publicquestion.FieldFirstSeenAt, publicquestion.FieldLastSeenAt, publicquestion.FieldModeratedAt, publicquestion.FieldAnswerGeneratedAt, publicquestion.FieldAnswerLockedUntil:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -78,6 +109,13 @@ func (pq *PublicQuestion) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pq.UpdatedAt = value.Time
 			}
+		case publicquestion.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pq.DeletedAt = new(time.Time)
+				*pq.DeletedAt = value.Time
+			}
 		case publicquestion.FieldPosition:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field position", values[i])
@@ -108,6 +146,96 @@ func (pq *PublicQuestion) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pq.Answer = value.String
 			}
+		case publicquestion.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				pq.ContentHash = new(string)
+				*pq.ContentHash = value.String
+			}
+		case publicquestion.FieldOccurrences:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field occurrences", values[i])
+			} else if value.Valid {
+				pq.Occurrences = int(value.Int64)
+			}
+		case publicquestion.FieldFirstSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen_at", values[i])
+			} else if value.Valid {
+				pq.FirstSeenAt = value.Time
+			}
+		case publicquestion.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				pq.LastSeenAt = value.Time
+			}
+		case publicquestion.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pq.Status = irelia.ModerationStatus(value.Int64)
+			}
+		case publicquestion.FieldMergedIntoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_into_id", values[i])
+			} else if value.Valid {
+				pq.MergedIntoID = new(int)
+				*pq.MergedIntoID = int(value.Int64)
+			}
+		case publicquestion.FieldModeratedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field moderated_by", values[i])
+			} else if value.Valid {
+				pq.ModeratedBy = new(uint64)
+				*pq.ModeratedBy = uint64(value.Int64)
+			}
+		case publicquestion.FieldModeratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field moderated_at", values[i])
+			} else if value.Valid {
+				pq.ModeratedAt = new(time.Time)
+				*pq.ModeratedAt = value.Time
+			}
+		case publicquestion.FieldAnswerGenerator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_generator", values[i])
+			} else if value.Valid {
+				pq.AnswerGenerator = value.String
+			}
+		case publicquestion.FieldAnswerVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_version", values[i])
+			} else if value.Valid {
+				pq.AnswerVersion = value.String
+			}
+		case publicquestion.FieldAnswerGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_generated_at", values[i])
+			} else if value.Valid {
+				pq.AnswerGeneratedAt = new(time.Time)
+				*pq.AnswerGeneratedAt = value.Time
+			}
+		case publicquestion.FieldAnswerAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_attempts", values[i])
+			} else if value.Valid {
+				pq.AnswerAttempts = int32(value.Int64)
+			}
+		case publicquestion.FieldAnswerLockedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_locked_by", values[i])
+			} else if value.Valid {
+				pq.AnswerLockedBy = value.String
+			}
+		case publicquestion.FieldAnswerLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_locked_until", values[i])
+			} else if value.Valid {
+				pq.AnswerLockedUntil = new(time.Time)
+				*pq.AnswerLockedUntil = value.Time
+			}
 		default:
 			pq.selectValues.Set(columns[i], values[i])
 		}
@@ -150,6 +278,11 @@ func (pq *PublicQuestion) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(pq.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := pq.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("position=")
 	builder.WriteString(pq.Position)
 	builder.WriteString(", ")
@@ -164,6 +297,60 @@ func (pq *PublicQuestion) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("answer=")
 	builder.WriteString(pq.Answer)
+	builder.WriteString(", ")
+	if v := pq.ContentHash; v != nil {
+		builder.WriteString("content_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("occurrences=")
+	builder.WriteString(fmt.Sprintf("%v", pq.Occurrences))
+	builder.WriteString(", ")
+	builder.WriteString("first_seen_at=")
+	builder.WriteString(pq.FirstSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(pq.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pq.Status))
+	builder.WriteString(", ")
+	if v := pq.MergedIntoID; v != nil {
+		builder.WriteString("merged_into_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pq.ModeratedBy; v != nil {
+		builder.WriteString("moderated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pq.ModeratedAt; v != nil {
+		builder.WriteString("moderated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("answer_generator=")
+	builder.WriteString(pq.AnswerGenerator)
+	builder.WriteString(", ")
+	builder.WriteString("answer_version=")
+	builder.WriteString(pq.AnswerVersion)
+	builder.WriteString(", ")
+	if v := pq.AnswerGeneratedAt; v != nil {
+		builder.WriteString("answer_generated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("answer_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", pq.AnswerAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("answer_locked_by=")
+	builder.WriteString(pq.AnswerLockedBy)
+	builder.WriteString(", ")
+	if v := pq.AnswerLockedUntil; v != nil {
+		builder.WriteString("answer_locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
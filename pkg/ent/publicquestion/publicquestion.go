@@ -3,6 +3,7 @@
 package publicquestion
 
 import (
+	irelia "irelia/api"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -17,6 +18,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldPosition holds the string denoting the position field in the database.
 	FieldPosition = "position"
 	// FieldExperience holds the string denoting the experience field in the database.
@@ -27,6 +30,34 @@ const (
 	FieldContent = "content"
 	// FieldAnswer holds the string denoting the answer field in the database.
 	FieldAnswer = "answer"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldOccurrences holds the string denoting the occurrences field in the database.
+	FieldOccurrences = "occurrences"
+	// FieldFirstSeenAt holds the string denoting the first_seen_at field in the database.
+	FieldFirstSeenAt = "first_seen_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMergedIntoID holds the string denoting the merged_into_id field in the database.
+	FieldMergedIntoID = "merged_into_id"
+	// FieldModeratedBy holds the string denoting the moderated_by field in the database.
+	FieldModeratedBy = "moderated_by"
+	// FieldModeratedAt holds the string denoting the moderated_at field in the database.
+	FieldModeratedAt = "moderated_at"
+	// FieldAnswerGenerator holds the string denoting the answer_generator field in the database.
+	FieldAnswerGenerator = "answer_generator"
+	// FieldAnswerVersion holds the string denoting the answer_version field in the database.
+	FieldAnswerVersion = "answer_version"
+	// FieldAnswerGeneratedAt holds the string denoting the answer_generated_at field in the database.
+	FieldAnswerGeneratedAt = "answer_generated_at"
+	// FieldAnswerAttempts holds the string denoting the answer_attempts field in the database.
+	FieldAnswerAttempts = "answer_attempts"
+	// FieldAnswerLockedBy holds the string denoting the answer_locked_by field in the database.
+	FieldAnswerLockedBy = "answer_locked_by"
+	// FieldAnswerLockedUntil holds the string denoting the answer_locked_until field in the database.
+	FieldAnswerLockedUntil = "answer_locked_until"
 	// Table holds the table name of the publicquestion in the database.
 	Table = "public_questions"
 )
@@ -36,11 +67,26 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldPosition,
 	FieldExperience,
 	FieldLanguage,
 	FieldContent,
 	FieldAnswer,
+	FieldContentHash,
+	FieldOccurrences,
+	FieldFirstSeenAt,
+	FieldLastSeenAt,
+	FieldStatus,
+	FieldMergedIntoID,
+	FieldModeratedBy,
+	FieldModeratedAt,
+	FieldAnswerGenerator,
+	FieldAnswerVersion,
+	FieldAnswerGeneratedAt,
+	FieldAnswerAttempts,
+	FieldAnswerLockedBy,
+	FieldAnswerLockedUntil,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -68,6 +114,18 @@ var (
 	LanguageValidator func(string) error
 	// ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	ContentValidator func(string) error
+	// ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	ContentHashValidator func(string) error
+	// DefaultOccurrences holds the default value on creation for the "occurrences" field.
+	DefaultOccurrences int
+	// DefaultFirstSeenAt holds the default value on creation for the "first_seen_at" field.
+	DefaultFirstSeenAt func() time.Time
+	// DefaultLastSeenAt holds the default value on creation for the "last_seen_at" field.
+	DefaultLastSeenAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus irelia.ModerationStatus
+	// DefaultAnswerAttempts holds the default value on creation for the "answer_attempts" field.
+	DefaultAnswerAttempts int32
 )
 
 // OrderOption defines the ordering options for the PublicQuestion queries.
@@ -88,6 +146,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByPosition orders the results by the position field.
 func ByPosition(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPosition, opts...).ToFunc()
@@ -112,3 +175,73 @@ func ByContent(opts ...sql.OrderTermOption) OrderOption {
 func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
 }
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByOccurrences orders the results by the occurrences field.
+func ByOccurrences(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurrences, opts...).ToFunc()
+}
+
+// ByFirstSeenAt orders the results by the first_seen_at field.
+func ByFirstSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeenAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMergedIntoID orders the results by the merged_into_id field.
+func ByMergedIntoID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedIntoID, opts...).ToFunc()
+}
+
+// ByModeratedBy orders the results by the moderated_by field.
+func ByModeratedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModeratedBy, opts...).ToFunc()
+}
+
+// ByModeratedAt orders the results by the moderated_at field.
+func ByModeratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModeratedAt, opts...).ToFunc()
+}
+
+// ByAnswerGenerator orders the results by the answer_generator field.
+func ByAnswerGenerator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerGenerator, opts...).ToFunc()
+}
+
+// ByAnswerVersion orders the results by the answer_version field.
+func ByAnswerVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerVersion, opts...).ToFunc()
+}
+
+// ByAnswerGeneratedAt orders the results by the answer_generated_at field.
+func ByAnswerGeneratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerGeneratedAt, opts...).ToFunc()
+}
+
+// ByAnswerAttempts orders the results by the answer_attempts field.
+func ByAnswerAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerAttempts, opts...).ToFunc()
+}
+
+// ByAnswerLockedBy orders the results by the answer_locked_by field.
+func ByAnswerLockedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerLockedBy, opts...).ToFunc()
+}
+
+// ByAnswerLockedUntil orders the results by the answer_locked_until field.
+func ByAnswerLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerLockedUntil, opts...).ToFunc()
+}
@@ -3,6 +3,7 @@
 package publicquestion
 
 import (
+	irelia "irelia/api"
 	"irelia/pkg/ent/predicate"
 	"time"
 
@@ -64,6 +65,11 @@ func UpdatedAt(v time.Time) predicate.PublicQuestion {
 	return predicate.PublicQuestion(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
 func Position(v string) predicate.PublicQuestion {
 	return predicate.PublicQuestion(sql.FieldEQ(FieldPosition, v))
@@ -89,6 +95,77 @@ func Answer(v string) predicate.PublicQuestion {
 	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswer, v))
 }
 
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldContentHash, v))
+}
+
+// Occurrences applies equality check predicate on the "occurrences" field. It's identical to OccurrencesEQ.
+func Occurrences(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldOccurrences, v))
+}
+
+// FirstSeenAt applies equality check predicate on the "first_seen_at" field. It's identical to FirstSeenAtEQ.
+func FirstSeenAt(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v irelia.ModerationStatus) predicate.PublicQuestion {
+	vc := int32(v)
+	return predicate.PublicQuestion(sql.FieldEQ(FieldStatus, vc))
+}
+
+// MergedIntoID applies equality check predicate on the "merged_into_id" field. It's identical to MergedIntoIDEQ.
+func MergedIntoID(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldMergedIntoID, v))
+}
+
+// ModeratedBy applies equality check predicate on the "moderated_by" field. It's identical to ModeratedByEQ.
+func ModeratedBy(v uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldModeratedBy, v))
+}
+
+// ModeratedAt applies equality check predicate on the "moderated_at" field. It's identical to ModeratedAtEQ.
+func ModeratedAt(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldModeratedAt, v))
+}
+
+// AnswerGenerator applies equality check predicate on the "answer_generator" field. It's identical to AnswerGeneratorEQ.
+func AnswerGenerator(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerGenerator, v))
+}
+
+// AnswerVersion applies equality check predicate on the "answer_version" field. It's identical to AnswerVersionEQ.
+func AnswerVersion(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerVersion, v))
+}
+
+// AnswerGeneratedAt applies equality check predicate on the "answer_generated_at" field. It's identical to AnswerGeneratedAtEQ.
+func AnswerGeneratedAt(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerGeneratedAt, v))
+}
+
+// AnswerAttempts applies equality check predicate on the "answer_attempts" field. It's identical to AnswerAttemptsEQ.
+func AnswerAttempts(v int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerAttempts, v))
+}
+
+// AnswerLockedBy applies equality check predicate on the "answer_locked_by" field. It's identical to AnswerLockedByEQ.
+func AnswerLockedBy(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedUntil applies equality check predicate on the "answer_locked_until" field. It's identical to AnswerLockedUntilEQ.
+func AnswerLockedUntil(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerLockedUntil, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PublicQuestion {
 	return predicate.PublicQuestion(sql.FieldEQ(FieldCreatedAt, v))
@@ -169,6 +246,56 @@ func UpdatedAtLTE(v time.Time) predicate.PublicQuestion {
 	return predicate.PublicQuestion(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // PositionEQ applies the EQ predicate on the "position" field.
 func PositionEQ(v string) predicate.PublicQuestion {
 	return predicate.PublicQuestion(sql.FieldEQ(FieldPosition, v))
@@ -504,6 +631,790 @@ func AnswerContainsFold(v string) predicate.PublicQuestion {
 	return predicate.PublicQuestion(sql.FieldContainsFold(FieldAnswer, v))
 }
 
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// OccurrencesEQ applies the EQ predicate on the "occurrences" field.
+func OccurrencesEQ(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldOccurrences, v))
+}
+
+// OccurrencesNEQ applies the NEQ predicate on the "occurrences" field.
+func OccurrencesNEQ(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldOccurrences, v))
+}
+
+// OccurrencesIn applies the In predicate on the "occurrences" field.
+func OccurrencesIn(vs ...int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldOccurrences, vs...))
+}
+
+// OccurrencesNotIn applies the NotIn predicate on the "occurrences" field.
+func OccurrencesNotIn(vs ...int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldOccurrences, vs...))
+}
+
+// OccurrencesGT applies the GT predicate on the "occurrences" field.
+func OccurrencesGT(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldOccurrences, v))
+}
+
+// OccurrencesGTE applies the GTE predicate on the "occurrences" field.
+func OccurrencesGTE(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldOccurrences, v))
+}
+
+// OccurrencesLT applies the LT predicate on the "occurrences" field.
+func OccurrencesLT(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldOccurrences, v))
+}
+
+// OccurrencesLTE applies the LTE predicate on the "occurrences" field.
+func OccurrencesLTE(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldOccurrences, v))
+}
+
+// FirstSeenAtEQ applies the EQ predicate on the "first_seen_at" field.
+func FirstSeenAtEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtNEQ applies the NEQ predicate on the "first_seen_at" field.
+func FirstSeenAtNEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtIn applies the In predicate on the "first_seen_at" field.
+func FirstSeenAtIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtNotIn applies the NotIn predicate on the "first_seen_at" field.
+func FirstSeenAtNotIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtGT applies the GT predicate on the "first_seen_at" field.
+func FirstSeenAtGT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtGTE applies the GTE predicate on the "first_seen_at" field.
+func FirstSeenAtGTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLT applies the LT predicate on the "first_seen_at" field.
+func FirstSeenAtLT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLTE applies the LTE predicate on the "first_seen_at" field.
+func FirstSeenAtLTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtIsNil applies the IsNil predicate on the "first_seen_at" field.
+func FirstSeenAtIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldFirstSeenAt))
+}
+
+// FirstSeenAtNotNil applies the NotNil predicate on the "first_seen_at" field.
+func FirstSeenAtNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldFirstSeenAt))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldLastSeenAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v irelia.ModerationStatus) predicate.PublicQuestion {
+	vc := int32(v)
+	return predicate.PublicQuestion(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v irelia.ModerationStatus) predicate.PublicQuestion {
+	vc := int32(v)
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...irelia.ModerationStatus) predicate.PublicQuestion {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.PublicQuestion(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...irelia.ModerationStatus) predicate.PublicQuestion {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int32(vs[i])
+	}
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v irelia.ModerationStatus) predicate.PublicQuestion {
+	vc := int32(v)
+	return predicate.PublicQuestion(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v irelia.ModerationStatus) predicate.PublicQuestion {
+	vc := int32(v)
+	return predicate.PublicQuestion(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v irelia.ModerationStatus) predicate.PublicQuestion {
+	vc := int32(v)
+	return predicate.PublicQuestion(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v irelia.ModerationStatus) predicate.PublicQuestion {
+	vc := int32(v)
+	return predicate.PublicQuestion(sql.FieldLTE(FieldStatus, vc))
+}
+
+// MergedIntoIDEQ applies the EQ predicate on the "merged_into_id" field.
+func MergedIntoIDEQ(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDNEQ applies the NEQ predicate on the "merged_into_id" field.
+func MergedIntoIDNEQ(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDIn applies the In predicate on the "merged_into_id" field.
+func MergedIntoIDIn(vs ...int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldMergedIntoID, vs...))
+}
+
+// MergedIntoIDNotIn applies the NotIn predicate on the "merged_into_id" field.
+func MergedIntoIDNotIn(vs ...int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldMergedIntoID, vs...))
+}
+
+// MergedIntoIDGT applies the GT predicate on the "merged_into_id" field.
+func MergedIntoIDGT(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDGTE applies the GTE predicate on the "merged_into_id" field.
+func MergedIntoIDGTE(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDLT applies the LT predicate on the "merged_into_id" field.
+func MergedIntoIDLT(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDLTE applies the LTE predicate on the "merged_into_id" field.
+func MergedIntoIDLTE(v int) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDIsNil applies the IsNil predicate on the "merged_into_id" field.
+func MergedIntoIDIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldMergedIntoID))
+}
+
+// MergedIntoIDNotNil applies the NotNil predicate on the "merged_into_id" field.
+func MergedIntoIDNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldMergedIntoID))
+}
+
+// ModeratedByEQ applies the EQ predicate on the "moderated_by" field.
+func ModeratedByEQ(v uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldModeratedBy, v))
+}
+
+// ModeratedByNEQ applies the NEQ predicate on the "moderated_by" field.
+func ModeratedByNEQ(v uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldModeratedBy, v))
+}
+
+// ModeratedByIn applies the In predicate on the "moderated_by" field.
+func ModeratedByIn(vs ...uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldModeratedBy, vs...))
+}
+
+// ModeratedByNotIn applies the NotIn predicate on the "moderated_by" field.
+func ModeratedByNotIn(vs ...uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldModeratedBy, vs...))
+}
+
+// ModeratedByGT applies the GT predicate on the "moderated_by" field.
+func ModeratedByGT(v uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldModeratedBy, v))
+}
+
+// ModeratedByGTE applies the GTE predicate on the "moderated_by" field.
+func ModeratedByGTE(v uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldModeratedBy, v))
+}
+
+// ModeratedByLT applies the LT predicate on the "moderated_by" field.
+func ModeratedByLT(v uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldModeratedBy, v))
+}
+
+// ModeratedByLTE applies the LTE predicate on the "moderated_by" field.
+func ModeratedByLTE(v uint64) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldModeratedBy, v))
+}
+
+// ModeratedByIsNil applies the IsNil predicate on the "moderated_by" field.
+func ModeratedByIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldModeratedBy))
+}
+
+// ModeratedByNotNil applies the NotNil predicate on the "moderated_by" field.
+func ModeratedByNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldModeratedBy))
+}
+
+// ModeratedAtEQ applies the EQ predicate on the "moderated_at" field.
+func ModeratedAtEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldModeratedAt, v))
+}
+
+// ModeratedAtNEQ applies the NEQ predicate on the "moderated_at" field.
+func ModeratedAtNEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldModeratedAt, v))
+}
+
+// ModeratedAtIn applies the In predicate on the "moderated_at" field.
+func ModeratedAtIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldModeratedAt, vs...))
+}
+
+// ModeratedAtNotIn applies the NotIn predicate on the "moderated_at" field.
+func ModeratedAtNotIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldModeratedAt, vs...))
+}
+
+// ModeratedAtGT applies the GT predicate on the "moderated_at" field.
+func ModeratedAtGT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldModeratedAt, v))
+}
+
+// ModeratedAtGTE applies the GTE predicate on the "moderated_at" field.
+func ModeratedAtGTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldModeratedAt, v))
+}
+
+// ModeratedAtLT applies the LT predicate on the "moderated_at" field.
+func ModeratedAtLT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldModeratedAt, v))
+}
+
+// ModeratedAtLTE applies the LTE predicate on the "moderated_at" field.
+func ModeratedAtLTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldModeratedAt, v))
+}
+
+// ModeratedAtIsNil applies the IsNil predicate on the "moderated_at" field.
+func ModeratedAtIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldModeratedAt))
+}
+
+// ModeratedAtNotNil applies the NotNil predicate on the "moderated_at" field.
+func ModeratedAtNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldModeratedAt))
+}
+
+// AnswerGeneratorEQ applies the EQ predicate on the "answer_generator" field.
+func AnswerGeneratorEQ(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorNEQ applies the NEQ predicate on the "answer_generator" field.
+func AnswerGeneratorNEQ(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorIn applies the In predicate on the "answer_generator" field.
+func AnswerGeneratorIn(vs ...string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldAnswerGenerator, vs...))
+}
+
+// AnswerGeneratorNotIn applies the NotIn predicate on the "answer_generator" field.
+func AnswerGeneratorNotIn(vs ...string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldAnswerGenerator, vs...))
+}
+
+// AnswerGeneratorGT applies the GT predicate on the "answer_generator" field.
+func AnswerGeneratorGT(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorGTE applies the GTE predicate on the "answer_generator" field.
+func AnswerGeneratorGTE(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorLT applies the LT predicate on the "answer_generator" field.
+func AnswerGeneratorLT(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorLTE applies the LTE predicate on the "answer_generator" field.
+func AnswerGeneratorLTE(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorContains applies the Contains predicate on the "answer_generator" field.
+func AnswerGeneratorContains(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldContains(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorHasPrefix applies the HasPrefix predicate on the "answer_generator" field.
+func AnswerGeneratorHasPrefix(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldHasPrefix(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorHasSuffix applies the HasSuffix predicate on the "answer_generator" field.
+func AnswerGeneratorHasSuffix(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldHasSuffix(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorIsNil applies the IsNil predicate on the "answer_generator" field.
+func AnswerGeneratorIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldAnswerGenerator))
+}
+
+// AnswerGeneratorNotNil applies the NotNil predicate on the "answer_generator" field.
+func AnswerGeneratorNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldAnswerGenerator))
+}
+
+// AnswerGeneratorEqualFold applies the EqualFold predicate on the "answer_generator" field.
+func AnswerGeneratorEqualFold(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEqualFold(FieldAnswerGenerator, v))
+}
+
+// AnswerGeneratorContainsFold applies the ContainsFold predicate on the "answer_generator" field.
+func AnswerGeneratorContainsFold(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldContainsFold(FieldAnswerGenerator, v))
+}
+
+// AnswerVersionEQ applies the EQ predicate on the "answer_version" field.
+func AnswerVersionEQ(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerVersion, v))
+}
+
+// AnswerVersionNEQ applies the NEQ predicate on the "answer_version" field.
+func AnswerVersionNEQ(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldAnswerVersion, v))
+}
+
+// AnswerVersionIn applies the In predicate on the "answer_version" field.
+func AnswerVersionIn(vs ...string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldAnswerVersion, vs...))
+}
+
+// AnswerVersionNotIn applies the NotIn predicate on the "answer_version" field.
+func AnswerVersionNotIn(vs ...string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldAnswerVersion, vs...))
+}
+
+// AnswerVersionGT applies the GT predicate on the "answer_version" field.
+func AnswerVersionGT(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldAnswerVersion, v))
+}
+
+// AnswerVersionGTE applies the GTE predicate on the "answer_version" field.
+func AnswerVersionGTE(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldAnswerVersion, v))
+}
+
+// AnswerVersionLT applies the LT predicate on the "answer_version" field.
+func AnswerVersionLT(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldAnswerVersion, v))
+}
+
+// AnswerVersionLTE applies the LTE predicate on the "answer_version" field.
+func AnswerVersionLTE(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldAnswerVersion, v))
+}
+
+// AnswerVersionContains applies the Contains predicate on the "answer_version" field.
+func AnswerVersionContains(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldContains(FieldAnswerVersion, v))
+}
+
+// AnswerVersionHasPrefix applies the HasPrefix predicate on the "answer_version" field.
+func AnswerVersionHasPrefix(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldHasPrefix(FieldAnswerVersion, v))
+}
+
+// AnswerVersionHasSuffix applies the HasSuffix predicate on the "answer_version" field.
+func AnswerVersionHasSuffix(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldHasSuffix(FieldAnswerVersion, v))
+}
+
+// AnswerVersionIsNil applies the IsNil predicate on the "answer_version" field.
+func AnswerVersionIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldAnswerVersion))
+}
+
+// AnswerVersionNotNil applies the NotNil predicate on the "answer_version" field.
+func AnswerVersionNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldAnswerVersion))
+}
+
+// AnswerVersionEqualFold applies the EqualFold predicate on the "answer_version" field.
+func AnswerVersionEqualFold(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEqualFold(FieldAnswerVersion, v))
+}
+
+// AnswerVersionContainsFold applies the ContainsFold predicate on the "answer_version" field.
+func AnswerVersionContainsFold(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldContainsFold(FieldAnswerVersion, v))
+}
+
+// AnswerGeneratedAtEQ applies the EQ predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerGeneratedAt, v))
+}
+
+// AnswerGeneratedAtNEQ applies the NEQ predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtNEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldAnswerGeneratedAt, v))
+}
+
+// AnswerGeneratedAtIn applies the In predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldAnswerGeneratedAt, vs...))
+}
+
+// AnswerGeneratedAtNotIn applies the NotIn predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtNotIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldAnswerGeneratedAt, vs...))
+}
+
+// AnswerGeneratedAtGT applies the GT predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtGT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldAnswerGeneratedAt, v))
+}
+
+// AnswerGeneratedAtGTE applies the GTE predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtGTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldAnswerGeneratedAt, v))
+}
+
+// AnswerGeneratedAtLT applies the LT predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtLT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldAnswerGeneratedAt, v))
+}
+
+// AnswerGeneratedAtLTE applies the LTE predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtLTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldAnswerGeneratedAt, v))
+}
+
+// AnswerGeneratedAtIsNil applies the IsNil predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldAnswerGeneratedAt))
+}
+
+// AnswerGeneratedAtNotNil applies the NotNil predicate on the "answer_generated_at" field.
+func AnswerGeneratedAtNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldAnswerGeneratedAt))
+}
+
+// AnswerAttemptsEQ applies the EQ predicate on the "answer_attempts" field.
+func AnswerAttemptsEQ(v int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerAttempts, v))
+}
+
+// AnswerAttemptsNEQ applies the NEQ predicate on the "answer_attempts" field.
+func AnswerAttemptsNEQ(v int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldAnswerAttempts, v))
+}
+
+// AnswerAttemptsIn applies the In predicate on the "answer_attempts" field.
+func AnswerAttemptsIn(vs ...int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldAnswerAttempts, vs...))
+}
+
+// AnswerAttemptsNotIn applies the NotIn predicate on the "answer_attempts" field.
+func AnswerAttemptsNotIn(vs ...int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldAnswerAttempts, vs...))
+}
+
+// AnswerAttemptsGT applies the GT predicate on the "answer_attempts" field.
+func AnswerAttemptsGT(v int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldAnswerAttempts, v))
+}
+
+// AnswerAttemptsGTE applies the GTE predicate on the "answer_attempts" field.
+func AnswerAttemptsGTE(v int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldAnswerAttempts, v))
+}
+
+// AnswerAttemptsLT applies the LT predicate on the "answer_attempts" field.
+func AnswerAttemptsLT(v int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldAnswerAttempts, v))
+}
+
+// AnswerAttemptsLTE applies the LTE predicate on the "answer_attempts" field.
+func AnswerAttemptsLTE(v int32) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldAnswerAttempts, v))
+}
+
+// AnswerLockedByEQ applies the EQ predicate on the "answer_locked_by" field.
+func AnswerLockedByEQ(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByNEQ applies the NEQ predicate on the "answer_locked_by" field.
+func AnswerLockedByNEQ(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByIn applies the In predicate on the "answer_locked_by" field.
+func AnswerLockedByIn(vs ...string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldAnswerLockedBy, vs...))
+}
+
+// AnswerLockedByNotIn applies the NotIn predicate on the "answer_locked_by" field.
+func AnswerLockedByNotIn(vs ...string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldAnswerLockedBy, vs...))
+}
+
+// AnswerLockedByGT applies the GT predicate on the "answer_locked_by" field.
+func AnswerLockedByGT(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByGTE applies the GTE predicate on the "answer_locked_by" field.
+func AnswerLockedByGTE(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByLT applies the LT predicate on the "answer_locked_by" field.
+func AnswerLockedByLT(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByLTE applies the LTE predicate on the "answer_locked_by" field.
+func AnswerLockedByLTE(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByContains applies the Contains predicate on the "answer_locked_by" field.
+func AnswerLockedByContains(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldContains(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByHasPrefix applies the HasPrefix predicate on the "answer_locked_by" field.
+func AnswerLockedByHasPrefix(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldHasPrefix(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByHasSuffix applies the HasSuffix predicate on the "answer_locked_by" field.
+func AnswerLockedByHasSuffix(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldHasSuffix(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByIsNil applies the IsNil predicate on the "answer_locked_by" field.
+func AnswerLockedByIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldAnswerLockedBy))
+}
+
+// AnswerLockedByNotNil applies the NotNil predicate on the "answer_locked_by" field.
+func AnswerLockedByNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldAnswerLockedBy))
+}
+
+// AnswerLockedByEqualFold applies the EqualFold predicate on the "answer_locked_by" field.
+func AnswerLockedByEqualFold(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEqualFold(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedByContainsFold applies the ContainsFold predicate on the "answer_locked_by" field.
+func AnswerLockedByContainsFold(v string) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldContainsFold(FieldAnswerLockedBy, v))
+}
+
+// AnswerLockedUntilEQ applies the EQ predicate on the "answer_locked_until" field.
+func AnswerLockedUntilEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldEQ(FieldAnswerLockedUntil, v))
+}
+
+// AnswerLockedUntilNEQ applies the NEQ predicate on the "answer_locked_until" field.
+func AnswerLockedUntilNEQ(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNEQ(FieldAnswerLockedUntil, v))
+}
+
+// AnswerLockedUntilIn applies the In predicate on the "answer_locked_until" field.
+func AnswerLockedUntilIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIn(FieldAnswerLockedUntil, vs...))
+}
+
+// AnswerLockedUntilNotIn applies the NotIn predicate on the "answer_locked_until" field.
+func AnswerLockedUntilNotIn(vs ...time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotIn(FieldAnswerLockedUntil, vs...))
+}
+
+// AnswerLockedUntilGT applies the GT predicate on the "answer_locked_until" field.
+func AnswerLockedUntilGT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGT(FieldAnswerLockedUntil, v))
+}
+
+// AnswerLockedUntilGTE applies the GTE predicate on the "answer_locked_until" field.
+func AnswerLockedUntilGTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldGTE(FieldAnswerLockedUntil, v))
+}
+
+// AnswerLockedUntilLT applies the LT predicate on the "answer_locked_until" field.
+func AnswerLockedUntilLT(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLT(FieldAnswerLockedUntil, v))
+}
+
+// AnswerLockedUntilLTE applies the LTE predicate on the "answer_locked_until" field.
+func AnswerLockedUntilLTE(v time.Time) predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldLTE(FieldAnswerLockedUntil, v))
+}
+
+// AnswerLockedUntilIsNil applies the IsNil predicate on the "answer_locked_until" field.
+func AnswerLockedUntilIsNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldIsNull(FieldAnswerLockedUntil))
+}
+
+// AnswerLockedUntilNotNil applies the NotNil predicate on the "answer_locked_until" field.
+func AnswerLockedUntilNotNil() predicate.PublicQuestion {
+	return predicate.PublicQuestion(sql.FieldNotNull(FieldAnswerLockedUntil))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PublicQuestion) predicate.PublicQuestion {
 	return predicate.PublicQuestion(sql.AndPredicates(predicates...))
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	irelia "irelia/api"
 	"irelia/pkg/ent/publicquestion"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -18,6 +20,7 @@ type PublicQuestionCreate struct {
 	config
 	mutation *PublicQuestionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -48,6 +51,20 @@ func (pqc *PublicQuestionCreate) SetNillableUpdatedAt(t *time.Time) *PublicQuest
 	return pqc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pqc *PublicQuestionCreate) SetDeletedAt(t time.Time) *PublicQuestionCreate {
+	pqc.mutation.SetDeletedAt(t)
+	return pqc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableDeletedAt(t *time.Time) *PublicQuestionCreate {
+	if t != nil {
+		pqc.SetDeletedAt(*t)
+	}
+	return pqc
+}
+
 // SetPosition sets the "position" field.
 func (pqc *PublicQuestionCreate) SetPosition(s string) *PublicQuestionCreate {
 	pqc.mutation.SetPosition(s)
@@ -86,6 +103,202 @@ func (pqc *PublicQuestionCreate) SetNillableAnswer(s *string) *PublicQuestionCre
 	return pqc
 }
 
+// SetContentHash sets the "content_hash" field.
+func (pqc *PublicQuestionCreate) SetContentHash(s string) *PublicQuestionCreate {
+	pqc.mutation.SetContentHash(s)
+	return pqc
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableContentHash(s *string) *PublicQuestionCreate {
+	if s != nil {
+		pqc.SetContentHash(*s)
+	}
+	return pqc
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (pqc *PublicQuestionCreate) SetOccurrences(i int) *PublicQuestionCreate {
+	pqc.mutation.SetOccurrences(i)
+	return pqc
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableOccurrences(i *int) *PublicQuestionCreate {
+	if i != nil {
+		pqc.SetOccurrences(*i)
+	}
+	return pqc
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (pqc *PublicQuestionCreate) SetFirstSeenAt(t time.Time) *PublicQuestionCreate {
+	pqc.mutation.SetFirstSeenAt(t)
+	return pqc
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableFirstSeenAt(t *time.Time) *PublicQuestionCreate {
+	if t != nil {
+		pqc.SetFirstSeenAt(*t)
+	}
+	return pqc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (pqc *PublicQuestionCreate) SetLastSeenAt(t time.Time) *PublicQuestionCreate {
+	pqc.mutation.SetLastSeenAt(t)
+	return pqc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableLastSeenAt(t *time.Time) *PublicQuestionCreate {
+	if t != nil {
+		pqc.SetLastSeenAt(*t)
+	}
+	return pqc
+}
+
+// SetStatus sets the "status" field.
+func (pqc *PublicQuestionCreate) SetStatus(is irelia.ModerationStatus) *PublicQuestionCreate {
+	pqc.mutation.SetStatus(is)
+	return pqc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableStatus(is *irelia.ModerationStatus) *PublicQuestionCreate {
+	if is != nil {
+		pqc.SetStatus(*is)
+	}
+	return pqc
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (pqc *PublicQuestionCreate) SetMergedIntoID(i int) *PublicQuestionCreate {
+	pqc.mutation.SetMergedIntoID(i)
+	return pqc
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableMergedIntoID(i *int) *PublicQuestionCreate {
+	if i != nil {
+		pqc.SetMergedIntoID(*i)
+	}
+	return pqc
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (pqc *PublicQuestionCreate) SetModeratedBy(u uint64) *PublicQuestionCreate {
+	pqc.mutation.SetModeratedBy(u)
+	return pqc
+}
+
+// SetNillableModeratedBy sets the "moderated_by" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableModeratedBy(u *uint64) *PublicQuestionCreate {
+	if u != nil {
+		pqc.SetModeratedBy(*u)
+	}
+	return pqc
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (pqc *PublicQuestionCreate) SetModeratedAt(t time.Time) *PublicQuestionCreate {
+	pqc.mutation.SetModeratedAt(t)
+	return pqc
+}
+
+// SetNillableModeratedAt sets the "moderated_at" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableModeratedAt(t *time.Time) *PublicQuestionCreate {
+	if t != nil {
+		pqc.SetModeratedAt(*t)
+	}
+	return pqc
+}
+
+// SetAnswerGenerator sets the "answer_generator" field.
+func (pqc *PublicQuestionCreate) SetAnswerGenerator(s string) *PublicQuestionCreate {
+	pqc.mutation.SetAnswerGenerator(s)
+	return pqc
+}
+
+// SetNillableAnswerGenerator sets the "answer_generator" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableAnswerGenerator(s *string) *PublicQuestionCreate {
+	if s != nil {
+		pqc.SetAnswerGenerator(*s)
+	}
+	return pqc
+}
+
+// SetAnswerVersion sets the "answer_version" field.
+func (pqc *PublicQuestionCreate) SetAnswerVersion(s string) *PublicQuestionCreate {
+	pqc.mutation.SetAnswerVersion(s)
+	return pqc
+}
+
+// SetNillableAnswerVersion sets the "answer_version" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableAnswerVersion(s *string) *PublicQuestionCreate {
+	if s != nil {
+		pqc.SetAnswerVersion(*s)
+	}
+	return pqc
+}
+
+// SetAnswerGeneratedAt sets the "answer_generated_at" field.
+func (pqc *PublicQuestionCreate) SetAnswerGeneratedAt(t time.Time) *PublicQuestionCreate {
+	pqc.mutation.SetAnswerGeneratedAt(t)
+	return pqc
+}
+
+// SetNillableAnswerGeneratedAt sets the "answer_generated_at" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableAnswerGeneratedAt(t *time.Time) *PublicQuestionCreate {
+	if t != nil {
+		pqc.SetAnswerGeneratedAt(*t)
+	}
+	return pqc
+}
+
+// SetAnswerAttempts sets the "answer_attempts" field.
+func (pqc *PublicQuestionCreate) SetAnswerAttempts(i int32) *PublicQuestionCreate {
+	pqc.mutation.SetAnswerAttempts(i)
+	return pqc
+}
+
+// SetNillableAnswerAttempts sets the "answer_attempts" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableAnswerAttempts(i *int32) *PublicQuestionCreate {
+	if i != nil {
+		pqc.SetAnswerAttempts(*i)
+	}
+	return pqc
+}
+
+// SetAnswerLockedBy sets the "answer_locked_by" field.
+func (pqc *PublicQuestionCreate) SetAnswerLockedBy(s string) *PublicQuestionCreate {
+	pqc.mutation.SetAnswerLockedBy(s)
+	return pqc
+}
+
+// SetNillableAnswerLockedBy sets the "answer_locked_by" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableAnswerLockedBy(s *string) *PublicQuestionCreate {
+	if s != nil {
+		pqc.SetAnswerLockedBy(*s)
+	}
+	return pqc
+}
+
+// SetAnswerLockedUntil sets the "answer_locked_until" field.
+func (pqc *PublicQuestionCreate) SetAnswerLockedUntil(t time.Time) *PublicQuestionCreate {
+	pqc.mutation.SetAnswerLockedUntil(t)
+	return pqc
+}
+
+// SetNillableAnswerLockedUntil sets the "answer_locked_until" field if the given value is not nil.
+func (pqc *PublicQuestionCreate) SetNillableAnswerLockedUntil(t *time.Time) *PublicQuestionCreate {
+	if t != nil {
+		pqc.SetAnswerLockedUntil(*t)
+	}
+	return pqc
+}
+
 // Mutation returns the PublicQuestionMutation object of the builder.
 func (pqc *PublicQuestionCreate) Mutation() *PublicQuestionMutation {
 	return pqc.mutation
@@ -129,6 +342,26 @@ func (pqc *PublicQuestionCreate) defaults() {
 		v := publicquestion.DefaultUpdatedAt()
 		pqc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pqc.mutation.Occurrences(); !ok {
+		v := publicquestion.DefaultOccurrences
+		pqc.mutation.SetOccurrences(v)
+	}
+	if _, ok := pqc.mutation.FirstSeenAt(); !ok {
+		v := publicquestion.DefaultFirstSeenAt()
+		pqc.mutation.SetFirstSeenAt(v)
+	}
+	if _, ok := pqc.mutation.LastSeenAt(); !ok {
+		v := publicquestion.DefaultLastSeenAt()
+		pqc.mutation.SetLastSeenAt(v)
+	}
+	if _, ok := pqc.mutation.Status(); !ok {
+		v := publicquestion.DefaultStatus
+		pqc.mutation.SetStatus(v)
+	}
+	if _, ok := pqc.mutation.AnswerAttempts(); !ok {
+		v := publicquestion.DefaultAnswerAttempts
+		pqc.mutation.SetAnswerAttempts(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -171,6 +404,20 @@ func (pqc *PublicQuestionCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PublicQuestion.content": %w`, err)}
 		}
 	}
+	if v, ok := pqc.mutation.ContentHash(); ok {
+		if err := publicquestion.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "PublicQuestion.content_hash": %w`, err)}
+		}
+	}
+	if _, ok := pqc.mutation.Occurrences(); !ok {
+		return &ValidationError{Name: "occurrences", err: errors.New(`ent: missing required field "PublicQuestion.occurrences"`)}
+	}
+	if _, ok := pqc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PublicQuestion.status"`)}
+	}
+	if _, ok := pqc.mutation.AnswerAttempts(); !ok {
+		return &ValidationError{Name: "answer_attempts", err: errors.New(`ent: missing required field "PublicQuestion.answer_attempts"`)}
+	}
 	return nil
 }
 
@@ -197,6 +444,7 @@ func (pqc *PublicQuestionCreate) createSpec() (*PublicQuestion, *sqlgraph.Create
 		_node = &PublicQuestion{config: pqc.config}
 		_spec = sqlgraph.NewCreateSpec(publicquestion.Table, sqlgraph.NewFieldSpec(publicquestion.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = pqc.conflict
 	if value, ok := pqc.mutation.CreatedAt(); ok {
 		_spec.SetField(publicquestion.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -205,6 +453,10 @@ func (pqc *PublicQuestionCreate) createSpec() (*PublicQuestion, *sqlgraph.Create
 		_spec.SetField(publicquestion.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := pqc.mutation.DeletedAt(); ok {
+		_spec.SetField(publicquestion.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := pqc.mutation.Position(); ok {
 		_spec.SetField(publicquestion.FieldPosition, field.TypeString, value)
 		_node.Position = value
@@ -225,93 +477,1589 @@ func (pqc *PublicQuestionCreate) createSpec() (*PublicQuestion, *sqlgraph.Create
 		_spec.SetField(publicquestion.FieldAnswer, field.TypeString, value)
 		_node.Answer = value
 	}
+	if value, ok := pqc.mutation.ContentHash(); ok {
+		_spec.SetField(publicquestion.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = &value
+	}
+	if value, ok := pqc.mutation.Occurrences(); ok {
+		_spec.SetField(publicquestion.FieldOccurrences, field.TypeInt, value)
+		_node.Occurrences = value
+	}
+	if value, ok := pqc.mutation.FirstSeenAt(); ok {
+		_spec.SetField(publicquestion.FieldFirstSeenAt, field.TypeTime, value)
+		_node.FirstSeenAt = value
+	}
+	if value, ok := pqc.mutation.LastSeenAt(); ok {
+		_spec.SetField(publicquestion.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := pqc.mutation.Status(); ok {
+		_spec.SetField(publicquestion.FieldStatus, field.TypeInt32, value)
+		_node.Status = value
+	}
+	if value, ok := pqc.mutation.MergedIntoID(); ok {
+		_spec.SetField(publicquestion.FieldMergedIntoID, field.TypeInt, value)
+		_node.MergedIntoID = &value
+	}
+	if value, ok := pqc.mutation.ModeratedBy(); ok {
+		_spec.SetField(publicquestion.FieldModeratedBy, field.TypeUint64, value)
+		_node.ModeratedBy = &value
+	}
+	if value, ok := pqc.mutation.ModeratedAt(); ok {
+		_spec.SetField(publicquestion.FieldModeratedAt, field.TypeTime, value)
+		_node.ModeratedAt = &value
+	}
+	if value, ok := pqc.mutation.AnswerGenerator(); ok {
+		_spec.SetField(publicquestion.FieldAnswerGenerator, field.TypeString, value)
+		_node.AnswerGenerator = value
+	}
+	if value, ok := pqc.mutation.AnswerVersion(); ok {
+		_spec.SetField(publicquestion.FieldAnswerVersion, field.TypeString, value)
+		_node.AnswerVersion = value
+	}
+	if value, ok := pqc.mutation.AnswerGeneratedAt(); ok {
+		_spec.SetField(publicquestion.FieldAnswerGeneratedAt, field.TypeTime, value)
+		_node.AnswerGeneratedAt = &value
+	}
+	if value, ok := pqc.mutation.AnswerAttempts(); ok {
+		_spec.SetField(publicquestion.FieldAnswerAttempts, field.TypeInt32, value)
+		_node.AnswerAttempts = value
+	}
+	if value, ok := pqc.mutation.AnswerLockedBy(); ok {
+		_spec.SetField(publicquestion.FieldAnswerLockedBy, field.TypeString, value)
+		_node.AnswerLockedBy = value
+	}
+	if value, ok := pqc.mutation.AnswerLockedUntil(); ok {
+		_spec.SetField(publicquestion.FieldAnswerLockedUntil, field.TypeTime, value)
+		_node.AnswerLockedUntil = &value
+	}
 	return _node, _spec
 }
 
-// PublicQuestionCreateBulk is the builder for creating many PublicQuestion entities in bulk.
-type PublicQuestionCreateBulk struct {
-	config
-	err      error
-	builders []*PublicQuestionCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PublicQuestion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PublicQuestionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pqc *PublicQuestionCreate) OnConflict(opts ...sql.ConflictOption) *PublicQuestionUpsertOne {
+	pqc.conflict = opts
+	return &PublicQuestionUpsertOne{
+		create: pqc,
+	}
 }
 
-// Save creates the PublicQuestion entities in the database.
-func (pqcb *PublicQuestionCreateBulk) Save(ctx context.Context) ([]*PublicQuestion, error) {
-	if pqcb.err != nil {
-		return nil, pqcb.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PublicQuestion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pqc *PublicQuestionCreate) OnConflictColumns(columns ...string) *PublicQuestionUpsertOne {
+	pqc.conflict = append(pqc.conflict, sql.ConflictColumns(columns...))
+	return &PublicQuestionUpsertOne{
+		create: pqc,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(pqcb.builders))
-	nodes := make([]*PublicQuestion, len(pqcb.builders))
-	mutators := make([]Mutator, len(pqcb.builders))
-	for i := range pqcb.builders {
-		func(i int, root context.Context) {
-			builder := pqcb.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*PublicQuestionMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, pqcb.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, pqcb.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// PublicQuestionUpsertOne is the builder for "upsert"-ing
+	//  one PublicQuestion node.
+	PublicQuestionUpsertOne struct {
+		create *PublicQuestionCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, pqcb.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// PublicQuestionUpsert is the "OnConflict" setter.
+	PublicQuestionUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublicQuestionUpsert) SetUpdatedAt(v time.Time) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldUpdatedAt, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (pqcb *PublicQuestionCreateBulk) SaveX(ctx context.Context) []*PublicQuestion {
-	v, err := pqcb.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateUpdatedAt() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldUpdatedAt)
+	return u
 }
 
-// Exec executes the query.
-func (pqcb *PublicQuestionCreateBulk) Exec(ctx context.Context) error {
-	_, err := pqcb.Save(ctx)
-	return err
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PublicQuestionUpsert) SetDeletedAt(v time.Time) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldDeletedAt, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (pqcb *PublicQuestionCreateBulk) ExecX(ctx context.Context) {
-	if err := pqcb.Exec(ctx); err != nil {
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateDeletedAt() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PublicQuestionUpsert) ClearDeletedAt() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldDeletedAt)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *PublicQuestionUpsert) SetPosition(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdatePosition() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldPosition)
+	return u
+}
+
+// SetExperience sets the "experience" field.
+func (u *PublicQuestionUpsert) SetExperience(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldExperience, v)
+	return u
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateExperience() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldExperience)
+	return u
+}
+
+// SetLanguage sets the "language" field.
+func (u *PublicQuestionUpsert) SetLanguage(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldLanguage, v)
+	return u
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateLanguage() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldLanguage)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *PublicQuestionUpsert) SetContent(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateContent() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldContent)
+	return u
+}
+
+// SetAnswer sets the "answer" field.
+func (u *PublicQuestionUpsert) SetAnswer(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldAnswer, v)
+	return u
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateAnswer() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldAnswer)
+	return u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (u *PublicQuestionUpsert) ClearAnswer() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldAnswer)
+	return u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *PublicQuestionUpsert) SetContentHash(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateContentHash() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldContentHash)
+	return u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *PublicQuestionUpsert) ClearContentHash() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldContentHash)
+	return u
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (u *PublicQuestionUpsert) SetOccurrences(v int) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldOccurrences, v)
+	return u
+}
+
+// UpdateOccurrences sets the "occurrences" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateOccurrences() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldOccurrences)
+	return u
+}
+
+// AddOccurrences adds v to the "occurrences" field.
+func (u *PublicQuestionUpsert) AddOccurrences(v int) *PublicQuestionUpsert {
+	u.Add(publicquestion.FieldOccurrences, v)
+	return u
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (u *PublicQuestionUpsert) SetFirstSeenAt(v time.Time) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldFirstSeenAt, v)
+	return u
+}
+
+// UpdateFirstSeenAt sets the "first_seen_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateFirstSeenAt() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldFirstSeenAt)
+	return u
+}
+
+// ClearFirstSeenAt clears the value of the "first_seen_at" field.
+func (u *PublicQuestionUpsert) ClearFirstSeenAt() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldFirstSeenAt)
+	return u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *PublicQuestionUpsert) SetLastSeenAt(v time.Time) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldLastSeenAt, v)
+	return u
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateLastSeenAt() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldLastSeenAt)
+	return u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *PublicQuestionUpsert) ClearLastSeenAt() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldLastSeenAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PublicQuestionUpsert) SetStatus(v irelia.ModerationStatus) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateStatus() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *PublicQuestionUpsert) AddStatus(v irelia.ModerationStatus) *PublicQuestionUpsert {
+	u.Add(publicquestion.FieldStatus, v)
+	return u
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (u *PublicQuestionUpsert) SetMergedIntoID(v int) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldMergedIntoID, v)
+	return u
+}
+
+// UpdateMergedIntoID sets the "merged_into_id" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateMergedIntoID() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldMergedIntoID)
+	return u
+}
+
+// AddMergedIntoID adds v to the "merged_into_id" field.
+func (u *PublicQuestionUpsert) AddMergedIntoID(v int) *PublicQuestionUpsert {
+	u.Add(publicquestion.FieldMergedIntoID, v)
+	return u
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (u *PublicQuestionUpsert) ClearMergedIntoID() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldMergedIntoID)
+	return u
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (u *PublicQuestionUpsert) SetModeratedBy(v uint64) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldModeratedBy, v)
+	return u
+}
+
+// UpdateModeratedBy sets the "moderated_by" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateModeratedBy() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldModeratedBy)
+	return u
+}
+
+// AddModeratedBy adds v to the "moderated_by" field.
+func (u *PublicQuestionUpsert) AddModeratedBy(v uint64) *PublicQuestionUpsert {
+	u.Add(publicquestion.FieldModeratedBy, v)
+	return u
+}
+
+// ClearModeratedBy clears the value of the "moderated_by" field.
+func (u *PublicQuestionUpsert) ClearModeratedBy() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldModeratedBy)
+	return u
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (u *PublicQuestionUpsert) SetModeratedAt(v time.Time) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldModeratedAt, v)
+	return u
+}
+
+// UpdateModeratedAt sets the "moderated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateModeratedAt() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldModeratedAt)
+	return u
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (u *PublicQuestionUpsert) ClearModeratedAt() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldModeratedAt)
+	return u
+}
+
+// SetAnswerGenerator sets the "answer_generator" field.
+func (u *PublicQuestionUpsert) SetAnswerGenerator(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldAnswerGenerator, v)
+	return u
+}
+
+// UpdateAnswerGenerator sets the "answer_generator" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateAnswerGenerator() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldAnswerGenerator)
+	return u
+}
+
+// ClearAnswerGenerator clears the value of the "answer_generator" field.
+func (u *PublicQuestionUpsert) ClearAnswerGenerator() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldAnswerGenerator)
+	return u
+}
+
+// SetAnswerVersion sets the "answer_version" field.
+func (u *PublicQuestionUpsert) SetAnswerVersion(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldAnswerVersion, v)
+	return u
+}
+
+// UpdateAnswerVersion sets the "answer_version" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateAnswerVersion() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldAnswerVersion)
+	return u
+}
+
+// ClearAnswerVersion clears the value of the "answer_version" field.
+func (u *PublicQuestionUpsert) ClearAnswerVersion() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldAnswerVersion)
+	return u
+}
+
+// SetAnswerGeneratedAt sets the "answer_generated_at" field.
+func (u *PublicQuestionUpsert) SetAnswerGeneratedAt(v time.Time) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldAnswerGeneratedAt, v)
+	return u
+}
+
+// UpdateAnswerGeneratedAt sets the "answer_generated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateAnswerGeneratedAt() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldAnswerGeneratedAt)
+	return u
+}
+
+// ClearAnswerGeneratedAt clears the value of the "answer_generated_at" field.
+func (u *PublicQuestionUpsert) ClearAnswerGeneratedAt() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldAnswerGeneratedAt)
+	return u
+}
+
+// SetAnswerAttempts sets the "answer_attempts" field.
+func (u *PublicQuestionUpsert) SetAnswerAttempts(v int32) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldAnswerAttempts, v)
+	return u
+}
+
+// UpdateAnswerAttempts sets the "answer_attempts" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateAnswerAttempts() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldAnswerAttempts)
+	return u
+}
+
+// AddAnswerAttempts adds v to the "answer_attempts" field.
+func (u *PublicQuestionUpsert) AddAnswerAttempts(v int32) *PublicQuestionUpsert {
+	u.Add(publicquestion.FieldAnswerAttempts, v)
+	return u
+}
+
+// SetAnswerLockedBy sets the "answer_locked_by" field.
+func (u *PublicQuestionUpsert) SetAnswerLockedBy(v string) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldAnswerLockedBy, v)
+	return u
+}
+
+// UpdateAnswerLockedBy sets the "answer_locked_by" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateAnswerLockedBy() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldAnswerLockedBy)
+	return u
+}
+
+// ClearAnswerLockedBy clears the value of the "answer_locked_by" field.
+func (u *PublicQuestionUpsert) ClearAnswerLockedBy() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldAnswerLockedBy)
+	return u
+}
+
+// SetAnswerLockedUntil sets the "answer_locked_until" field.
+func (u *PublicQuestionUpsert) SetAnswerLockedUntil(v time.Time) *PublicQuestionUpsert {
+	u.Set(publicquestion.FieldAnswerLockedUntil, v)
+	return u
+}
+
+// UpdateAnswerLockedUntil sets the "answer_locked_until" field to the value that was provided on create.
+func (u *PublicQuestionUpsert) UpdateAnswerLockedUntil() *PublicQuestionUpsert {
+	u.SetExcluded(publicquestion.FieldAnswerLockedUntil)
+	return u
+}
+
+// ClearAnswerLockedUntil clears the value of the "answer_locked_until" field.
+func (u *PublicQuestionUpsert) ClearAnswerLockedUntil() *PublicQuestionUpsert {
+	u.SetNull(publicquestion.FieldAnswerLockedUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PublicQuestion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PublicQuestionUpsertOne) UpdateNewValues() *PublicQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(publicquestion.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PublicQuestion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PublicQuestionUpsertOne) Ignore() *PublicQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PublicQuestionUpsertOne) DoNothing() *PublicQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PublicQuestionCreate.OnConflict
+// documentation for more info.
+func (u *PublicQuestionUpsertOne) Update(set func(*PublicQuestionUpsert)) *PublicQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PublicQuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublicQuestionUpsertOne) SetUpdatedAt(v time.Time) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateUpdatedAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PublicQuestionUpsertOne) SetDeletedAt(v time.Time) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateDeletedAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PublicQuestionUpsertOne) ClearDeletedAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *PublicQuestionUpsertOne) SetPosition(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdatePosition() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetExperience sets the "experience" field.
+func (u *PublicQuestionUpsertOne) SetExperience(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetExperience(v)
+	})
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateExperience() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateExperience()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *PublicQuestionUpsertOne) SetLanguage(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateLanguage() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *PublicQuestionUpsertOne) SetContent(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateContent() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetAnswer sets the "answer" field.
+func (u *PublicQuestionUpsertOne) SetAnswer(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswer(v)
+	})
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateAnswer() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswer()
+	})
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (u *PublicQuestionUpsertOne) ClearAnswer() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswer()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *PublicQuestionUpsertOne) SetContentHash(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateContentHash() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *PublicQuestionUpsertOne) ClearContentHash() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearContentHash()
+	})
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (u *PublicQuestionUpsertOne) SetOccurrences(v int) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetOccurrences(v)
+	})
+}
+
+// AddOccurrences adds v to the "occurrences" field.
+func (u *PublicQuestionUpsertOne) AddOccurrences(v int) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddOccurrences(v)
+	})
+}
+
+// UpdateOccurrences sets the "occurrences" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateOccurrences() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateOccurrences()
+	})
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (u *PublicQuestionUpsertOne) SetFirstSeenAt(v time.Time) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetFirstSeenAt(v)
+	})
+}
+
+// UpdateFirstSeenAt sets the "first_seen_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateFirstSeenAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateFirstSeenAt()
+	})
+}
+
+// ClearFirstSeenAt clears the value of the "first_seen_at" field.
+func (u *PublicQuestionUpsertOne) ClearFirstSeenAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearFirstSeenAt()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *PublicQuestionUpsertOne) SetLastSeenAt(v time.Time) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateLastSeenAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *PublicQuestionUpsertOne) ClearLastSeenAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PublicQuestionUpsertOne) SetStatus(v irelia.ModerationStatus) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *PublicQuestionUpsertOne) AddStatus(v irelia.ModerationStatus) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateStatus() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (u *PublicQuestionUpsertOne) SetMergedIntoID(v int) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetMergedIntoID(v)
+	})
+}
+
+// AddMergedIntoID adds v to the "merged_into_id" field.
+func (u *PublicQuestionUpsertOne) AddMergedIntoID(v int) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddMergedIntoID(v)
+	})
+}
+
+// UpdateMergedIntoID sets the "merged_into_id" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateMergedIntoID() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateMergedIntoID()
+	})
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (u *PublicQuestionUpsertOne) ClearMergedIntoID() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearMergedIntoID()
+	})
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (u *PublicQuestionUpsertOne) SetModeratedBy(v uint64) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetModeratedBy(v)
+	})
+}
+
+// AddModeratedBy adds v to the "moderated_by" field.
+func (u *PublicQuestionUpsertOne) AddModeratedBy(v uint64) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddModeratedBy(v)
+	})
+}
+
+// UpdateModeratedBy sets the "moderated_by" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateModeratedBy() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateModeratedBy()
+	})
+}
+
+// ClearModeratedBy clears the value of the "moderated_by" field.
+func (u *PublicQuestionUpsertOne) ClearModeratedBy() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearModeratedBy()
+	})
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (u *PublicQuestionUpsertOne) SetModeratedAt(v time.Time) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetModeratedAt(v)
+	})
+}
+
+// UpdateModeratedAt sets the "moderated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateModeratedAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateModeratedAt()
+	})
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (u *PublicQuestionUpsertOne) ClearModeratedAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearModeratedAt()
+	})
+}
+
+// SetAnswerGenerator sets the "answer_generator" field.
+func (u *PublicQuestionUpsertOne) SetAnswerGenerator(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerGenerator(v)
+	})
+}
+
+// UpdateAnswerGenerator sets the "answer_generator" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateAnswerGenerator() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerGenerator()
+	})
+}
+
+// ClearAnswerGenerator clears the value of the "answer_generator" field.
+func (u *PublicQuestionUpsertOne) ClearAnswerGenerator() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerGenerator()
+	})
+}
+
+// SetAnswerVersion sets the "answer_version" field.
+func (u *PublicQuestionUpsertOne) SetAnswerVersion(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerVersion(v)
+	})
+}
+
+// UpdateAnswerVersion sets the "answer_version" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateAnswerVersion() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerVersion()
+	})
+}
+
+// ClearAnswerVersion clears the value of the "answer_version" field.
+func (u *PublicQuestionUpsertOne) ClearAnswerVersion() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerVersion()
+	})
+}
+
+// SetAnswerGeneratedAt sets the "answer_generated_at" field.
+func (u *PublicQuestionUpsertOne) SetAnswerGeneratedAt(v time.Time) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerGeneratedAt(v)
+	})
+}
+
+// UpdateAnswerGeneratedAt sets the "answer_generated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateAnswerGeneratedAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerGeneratedAt()
+	})
+}
+
+// ClearAnswerGeneratedAt clears the value of the "answer_generated_at" field.
+func (u *PublicQuestionUpsertOne) ClearAnswerGeneratedAt() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerGeneratedAt()
+	})
+}
+
+// SetAnswerAttempts sets the "answer_attempts" field.
+func (u *PublicQuestionUpsertOne) SetAnswerAttempts(v int32) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerAttempts(v)
+	})
+}
+
+// AddAnswerAttempts adds v to the "answer_attempts" field.
+func (u *PublicQuestionUpsertOne) AddAnswerAttempts(v int32) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddAnswerAttempts(v)
+	})
+}
+
+// UpdateAnswerAttempts sets the "answer_attempts" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateAnswerAttempts() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerAttempts()
+	})
+}
+
+// SetAnswerLockedBy sets the "answer_locked_by" field.
+func (u *PublicQuestionUpsertOne) SetAnswerLockedBy(v string) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerLockedBy(v)
+	})
+}
+
+// UpdateAnswerLockedBy sets the "answer_locked_by" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateAnswerLockedBy() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerLockedBy()
+	})
+}
+
+// ClearAnswerLockedBy clears the value of the "answer_locked_by" field.
+func (u *PublicQuestionUpsertOne) ClearAnswerLockedBy() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerLockedBy()
+	})
+}
+
+// SetAnswerLockedUntil sets the "answer_locked_until" field.
+func (u *PublicQuestionUpsertOne) SetAnswerLockedUntil(v time.Time) *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerLockedUntil(v)
+	})
+}
+
+// UpdateAnswerLockedUntil sets the "answer_locked_until" field to the value that was provided on create.
+func (u *PublicQuestionUpsertOne) UpdateAnswerLockedUntil() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerLockedUntil()
+	})
+}
+
+// ClearAnswerLockedUntil clears the value of the "answer_locked_until" field.
+func (u *PublicQuestionUpsertOne) ClearAnswerLockedUntil() *PublicQuestionUpsertOne {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *PublicQuestionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PublicQuestionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PublicQuestionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PublicQuestionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PublicQuestionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PublicQuestionCreateBulk is the builder for creating many PublicQuestion entities in bulk.
+type PublicQuestionCreateBulk struct {
+	config
+	err      error
+	builders []*PublicQuestionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PublicQuestion entities in the database.
+func (pqcb *PublicQuestionCreateBulk) Save(ctx context.Context) ([]*PublicQuestion, error) {
+	if pqcb.err != nil {
+		return nil, pqcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pqcb.builders))
+	nodes := make([]*PublicQuestion, len(pqcb.builders))
+	mutators := make([]Mutator, len(pqcb.builders))
+	for i := range pqcb.builders {
+		func(i int, root context.Context) {
+			builder := pqcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PublicQuestionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pqcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pqcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pqcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pqcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pqcb *PublicQuestionCreateBulk) SaveX(ctx context.Context) []*PublicQuestion {
+	v, err := pqcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pqcb *PublicQuestionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pqcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pqcb *PublicQuestionCreateBulk) ExecX(ctx context.Context) {
+	if err := pqcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PublicQuestion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PublicQuestionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pqcb *PublicQuestionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PublicQuestionUpsertBulk {
+	pqcb.conflict = opts
+	return &PublicQuestionUpsertBulk{
+		create: pqcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PublicQuestion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pqcb *PublicQuestionCreateBulk) OnConflictColumns(columns ...string) *PublicQuestionUpsertBulk {
+	pqcb.conflict = append(pqcb.conflict, sql.ConflictColumns(columns...))
+	return &PublicQuestionUpsertBulk{
+		create: pqcb,
+	}
+}
+
+// PublicQuestionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PublicQuestion nodes.
+type PublicQuestionUpsertBulk struct {
+	create *PublicQuestionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PublicQuestion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PublicQuestionUpsertBulk) UpdateNewValues() *PublicQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(publicquestion.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PublicQuestion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PublicQuestionUpsertBulk) Ignore() *PublicQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PublicQuestionUpsertBulk) DoNothing() *PublicQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PublicQuestionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PublicQuestionUpsertBulk) Update(set func(*PublicQuestionUpsert)) *PublicQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PublicQuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublicQuestionUpsertBulk) SetUpdatedAt(v time.Time) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateUpdatedAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PublicQuestionUpsertBulk) SetDeletedAt(v time.Time) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateDeletedAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PublicQuestionUpsertBulk) ClearDeletedAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *PublicQuestionUpsertBulk) SetPosition(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdatePosition() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetExperience sets the "experience" field.
+func (u *PublicQuestionUpsertBulk) SetExperience(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetExperience(v)
+	})
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateExperience() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateExperience()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *PublicQuestionUpsertBulk) SetLanguage(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateLanguage() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *PublicQuestionUpsertBulk) SetContent(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateContent() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetAnswer sets the "answer" field.
+func (u *PublicQuestionUpsertBulk) SetAnswer(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswer(v)
+	})
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateAnswer() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswer()
+	})
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (u *PublicQuestionUpsertBulk) ClearAnswer() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswer()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *PublicQuestionUpsertBulk) SetContentHash(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateContentHash() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *PublicQuestionUpsertBulk) ClearContentHash() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearContentHash()
+	})
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (u *PublicQuestionUpsertBulk) SetOccurrences(v int) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetOccurrences(v)
+	})
+}
+
+// AddOccurrences adds v to the "occurrences" field.
+func (u *PublicQuestionUpsertBulk) AddOccurrences(v int) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddOccurrences(v)
+	})
+}
+
+// UpdateOccurrences sets the "occurrences" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateOccurrences() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateOccurrences()
+	})
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (u *PublicQuestionUpsertBulk) SetFirstSeenAt(v time.Time) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetFirstSeenAt(v)
+	})
+}
+
+// UpdateFirstSeenAt sets the "first_seen_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateFirstSeenAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateFirstSeenAt()
+	})
+}
+
+// ClearFirstSeenAt clears the value of the "first_seen_at" field.
+func (u *PublicQuestionUpsertBulk) ClearFirstSeenAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearFirstSeenAt()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *PublicQuestionUpsertBulk) SetLastSeenAt(v time.Time) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateLastSeenAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *PublicQuestionUpsertBulk) ClearLastSeenAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PublicQuestionUpsertBulk) SetStatus(v irelia.ModerationStatus) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *PublicQuestionUpsertBulk) AddStatus(v irelia.ModerationStatus) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateStatus() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (u *PublicQuestionUpsertBulk) SetMergedIntoID(v int) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetMergedIntoID(v)
+	})
+}
+
+// AddMergedIntoID adds v to the "merged_into_id" field.
+func (u *PublicQuestionUpsertBulk) AddMergedIntoID(v int) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddMergedIntoID(v)
+	})
+}
+
+// UpdateMergedIntoID sets the "merged_into_id" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateMergedIntoID() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateMergedIntoID()
+	})
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (u *PublicQuestionUpsertBulk) ClearMergedIntoID() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearMergedIntoID()
+	})
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (u *PublicQuestionUpsertBulk) SetModeratedBy(v uint64) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetModeratedBy(v)
+	})
+}
+
+// AddModeratedBy adds v to the "moderated_by" field.
+func (u *PublicQuestionUpsertBulk) AddModeratedBy(v uint64) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddModeratedBy(v)
+	})
+}
+
+// UpdateModeratedBy sets the "moderated_by" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateModeratedBy() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateModeratedBy()
+	})
+}
+
+// ClearModeratedBy clears the value of the "moderated_by" field.
+func (u *PublicQuestionUpsertBulk) ClearModeratedBy() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearModeratedBy()
+	})
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (u *PublicQuestionUpsertBulk) SetModeratedAt(v time.Time) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetModeratedAt(v)
+	})
+}
+
+// UpdateModeratedAt sets the "moderated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateModeratedAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateModeratedAt()
+	})
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (u *PublicQuestionUpsertBulk) ClearModeratedAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearModeratedAt()
+	})
+}
+
+// SetAnswerGenerator sets the "answer_generator" field.
+func (u *PublicQuestionUpsertBulk) SetAnswerGenerator(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerGenerator(v)
+	})
+}
+
+// UpdateAnswerGenerator sets the "answer_generator" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateAnswerGenerator() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerGenerator()
+	})
+}
+
+// ClearAnswerGenerator clears the value of the "answer_generator" field.
+func (u *PublicQuestionUpsertBulk) ClearAnswerGenerator() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerGenerator()
+	})
+}
+
+// SetAnswerVersion sets the "answer_version" field.
+func (u *PublicQuestionUpsertBulk) SetAnswerVersion(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerVersion(v)
+	})
+}
+
+// UpdateAnswerVersion sets the "answer_version" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateAnswerVersion() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerVersion()
+	})
+}
+
+// ClearAnswerVersion clears the value of the "answer_version" field.
+func (u *PublicQuestionUpsertBulk) ClearAnswerVersion() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerVersion()
+	})
+}
+
+// SetAnswerGeneratedAt sets the "answer_generated_at" field.
+func (u *PublicQuestionUpsertBulk) SetAnswerGeneratedAt(v time.Time) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerGeneratedAt(v)
+	})
+}
+
+// UpdateAnswerGeneratedAt sets the "answer_generated_at" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateAnswerGeneratedAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerGeneratedAt()
+	})
+}
+
+// ClearAnswerGeneratedAt clears the value of the "answer_generated_at" field.
+func (u *PublicQuestionUpsertBulk) ClearAnswerGeneratedAt() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerGeneratedAt()
+	})
+}
+
+// SetAnswerAttempts sets the "answer_attempts" field.
+func (u *PublicQuestionUpsertBulk) SetAnswerAttempts(v int32) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerAttempts(v)
+	})
+}
+
+// AddAnswerAttempts adds v to the "answer_attempts" field.
+func (u *PublicQuestionUpsertBulk) AddAnswerAttempts(v int32) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.AddAnswerAttempts(v)
+	})
+}
+
+// UpdateAnswerAttempts sets the "answer_attempts" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateAnswerAttempts() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerAttempts()
+	})
+}
+
+// SetAnswerLockedBy sets the "answer_locked_by" field.
+func (u *PublicQuestionUpsertBulk) SetAnswerLockedBy(v string) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerLockedBy(v)
+	})
+}
+
+// UpdateAnswerLockedBy sets the "answer_locked_by" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateAnswerLockedBy() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerLockedBy()
+	})
+}
+
+// ClearAnswerLockedBy clears the value of the "answer_locked_by" field.
+func (u *PublicQuestionUpsertBulk) ClearAnswerLockedBy() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerLockedBy()
+	})
+}
+
+// SetAnswerLockedUntil sets the "answer_locked_until" field.
+func (u *PublicQuestionUpsertBulk) SetAnswerLockedUntil(v time.Time) *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.SetAnswerLockedUntil(v)
+	})
+}
+
+// UpdateAnswerLockedUntil sets the "answer_locked_until" field to the value that was provided on create.
+func (u *PublicQuestionUpsertBulk) UpdateAnswerLockedUntil() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.UpdateAnswerLockedUntil()
+	})
+}
+
+// ClearAnswerLockedUntil clears the value of the "answer_locked_until" field.
+func (u *PublicQuestionUpsertBulk) ClearAnswerLockedUntil() *PublicQuestionUpsertBulk {
+	return u.Update(func(s *PublicQuestionUpsert) {
+		s.ClearAnswerLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *PublicQuestionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PublicQuestionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PublicQuestionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PublicQuestionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	irelia "irelia/api"
 	"irelia/pkg/ent/predicate"
 	"irelia/pkg/ent/publicquestion"
 	"time"
@@ -34,6 +35,26 @@ func (pqu *PublicQuestionUpdate) SetUpdatedAt(t time.Time) *PublicQuestionUpdate
 	return pqu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pqu *PublicQuestionUpdate) SetDeletedAt(t time.Time) *PublicQuestionUpdate {
+	pqu.mutation.SetDeletedAt(t)
+	return pqu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableDeletedAt(t *time.Time) *PublicQuestionUpdate {
+	if t != nil {
+		pqu.SetDeletedAt(*t)
+	}
+	return pqu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pqu *PublicQuestionUpdate) ClearDeletedAt() *PublicQuestionUpdate {
+	pqu.mutation.ClearDeletedAt()
+	return pqu
+}
+
 // SetPosition sets the "position" field.
 func (pqu *PublicQuestionUpdate) SetPosition(s string) *PublicQuestionUpdate {
 	pqu.mutation.SetPosition(s)
@@ -110,6 +131,303 @@ func (pqu *PublicQuestionUpdate) ClearAnswer() *PublicQuestionUpdate {
 	return pqu
 }
 
+// SetContentHash sets the "content_hash" field.
+func (pqu *PublicQuestionUpdate) SetContentHash(s string) *PublicQuestionUpdate {
+	pqu.mutation.SetContentHash(s)
+	return pqu
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableContentHash(s *string) *PublicQuestionUpdate {
+	if s != nil {
+		pqu.SetContentHash(*s)
+	}
+	return pqu
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (pqu *PublicQuestionUpdate) ClearContentHash() *PublicQuestionUpdate {
+	pqu.mutation.ClearContentHash()
+	return pqu
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (pqu *PublicQuestionUpdate) SetOccurrences(i int) *PublicQuestionUpdate {
+	pqu.mutation.ResetOccurrences()
+	pqu.mutation.SetOccurrences(i)
+	return pqu
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableOccurrences(i *int) *PublicQuestionUpdate {
+	if i != nil {
+		pqu.SetOccurrences(*i)
+	}
+	return pqu
+}
+
+// AddOccurrences adds i to the "occurrences" field.
+func (pqu *PublicQuestionUpdate) AddOccurrences(i int) *PublicQuestionUpdate {
+	pqu.mutation.AddOccurrences(i)
+	return pqu
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (pqu *PublicQuestionUpdate) SetFirstSeenAt(t time.Time) *PublicQuestionUpdate {
+	pqu.mutation.SetFirstSeenAt(t)
+	return pqu
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableFirstSeenAt(t *time.Time) *PublicQuestionUpdate {
+	if t != nil {
+		pqu.SetFirstSeenAt(*t)
+	}
+	return pqu
+}
+
+// ClearFirstSeenAt clears the value of the "first_seen_at" field.
+func (pqu *PublicQuestionUpdate) ClearFirstSeenAt() *PublicQuestionUpdate {
+	pqu.mutation.ClearFirstSeenAt()
+	return pqu
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (pqu *PublicQuestionUpdate) SetLastSeenAt(t time.Time) *PublicQuestionUpdate {
+	pqu.mutation.SetLastSeenAt(t)
+	return pqu
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableLastSeenAt(t *time.Time) *PublicQuestionUpdate {
+	if t != nil {
+		pqu.SetLastSeenAt(*t)
+	}
+	return pqu
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (pqu *PublicQuestionUpdate) ClearLastSeenAt() *PublicQuestionUpdate {
+	pqu.mutation.ClearLastSeenAt()
+	return pqu
+}
+
+// SetStatus sets the "status" field.
+func (pqu *PublicQuestionUpdate) SetStatus(is irelia.ModerationStatus) *PublicQuestionUpdate {
+	pqu.mutation.ResetStatus()
+	pqu.mutation.SetStatus(is)
+	return pqu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableStatus(is *irelia.ModerationStatus) *PublicQuestionUpdate {
+	if is != nil {
+		pqu.SetStatus(*is)
+	}
+	return pqu
+}
+
+// AddStatus adds is to the "status" field.
+func (pqu *PublicQuestionUpdate) AddStatus(is irelia.ModerationStatus) *PublicQuestionUpdate {
+	pqu.mutation.AddStatus(is)
+	return pqu
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (pqu *PublicQuestionUpdate) SetMergedIntoID(i int) *PublicQuestionUpdate {
+	pqu.mutation.ResetMergedIntoID()
+	pqu.mutation.SetMergedIntoID(i)
+	return pqu
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableMergedIntoID(i *int) *PublicQuestionUpdate {
+	if i != nil {
+		pqu.SetMergedIntoID(*i)
+	}
+	return pqu
+}
+
+// AddMergedIntoID adds i to the "merged_into_id" field.
+func (pqu *PublicQuestionUpdate) AddMergedIntoID(i int) *PublicQuestionUpdate {
+	pqu.mutation.AddMergedIntoID(i)
+	return pqu
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (pqu *PublicQuestionUpdate) ClearMergedIntoID() *PublicQuestionUpdate {
+	pqu.mutation.ClearMergedIntoID()
+	return pqu
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (pqu *PublicQuestionUpdate) SetModeratedBy(u uint64) *PublicQuestionUpdate {
+	pqu.mutation.ResetModeratedBy()
+	pqu.mutation.SetModeratedBy(u)
+	return pqu
+}
+
+// SetNillableModeratedBy sets the "moderated_by" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableModeratedBy(u *uint64) *PublicQuestionUpdate {
+	if u != nil {
+		pqu.SetModeratedBy(*u)
+	}
+	return pqu
+}
+
+// AddModeratedBy adds u to the "moderated_by" field.
+func (pqu *PublicQuestionUpdate) AddModeratedBy(u int64) *PublicQuestionUpdate {
+	pqu.mutation.AddModeratedBy(u)
+	return pqu
+}
+
+// ClearModeratedBy clears the value of the "moderated_by" field.
+func (pqu *PublicQuestionUpdate) ClearModeratedBy() *PublicQuestionUpdate {
+	pqu.mutation.ClearModeratedBy()
+	return pqu
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (pqu *PublicQuestionUpdate) SetModeratedAt(t time.Time) *PublicQuestionUpdate {
+	pqu.mutation.SetModeratedAt(t)
+	return pqu
+}
+
+// SetNillableModeratedAt sets the "moderated_at" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableModeratedAt(t *time.Time) *PublicQuestionUpdate {
+	if t != nil {
+		pqu.SetModeratedAt(*t)
+	}
+	return pqu
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (pqu *PublicQuestionUpdate) ClearModeratedAt() *PublicQuestionUpdate {
+	pqu.mutation.ClearModeratedAt()
+	return pqu
+}
+
+// SetAnswerGenerator sets the "answer_generator" field.
+func (pqu *PublicQuestionUpdate) SetAnswerGenerator(s string) *PublicQuestionUpdate {
+	pqu.mutation.SetAnswerGenerator(s)
+	return pqu
+}
+
+// SetNillableAnswerGenerator sets the "answer_generator" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableAnswerGenerator(s *string) *PublicQuestionUpdate {
+	if s != nil {
+		pqu.SetAnswerGenerator(*s)
+	}
+	return pqu
+}
+
+// ClearAnswerGenerator clears the value of the "answer_generator" field.
+func (pqu *PublicQuestionUpdate) ClearAnswerGenerator() *PublicQuestionUpdate {
+	pqu.mutation.ClearAnswerGenerator()
+	return pqu
+}
+
+// SetAnswerVersion sets the "answer_version" field.
+func (pqu *PublicQuestionUpdate) SetAnswerVersion(s string) *PublicQuestionUpdate {
+	pqu.mutation.SetAnswerVersion(s)
+	return pqu
+}
+
+// SetNillableAnswerVersion sets the "answer_version" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableAnswerVersion(s *string) *PublicQuestionUpdate {
+	if s != nil {
+		pqu.SetAnswerVersion(*s)
+	}
+	return pqu
+}
+
+// ClearAnswerVersion clears the value of the "answer_version" field.
+func (pqu *PublicQuestionUpdate) ClearAnswerVersion() *PublicQuestionUpdate {
+	pqu.mutation.ClearAnswerVersion()
+	return pqu
+}
+
+// SetAnswerGeneratedAt sets the "answer_generated_at" field.
+func (pqu *PublicQuestionUpdate) SetAnswerGeneratedAt(t time.Time) *PublicQuestionUpdate {
+	pqu.mutation.SetAnswerGeneratedAt(t)
+	return pqu
+}
+
+// SetNillableAnswerGeneratedAt sets the "answer_generated_at" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableAnswerGeneratedAt(t *time.Time) *PublicQuestionUpdate {
+	if t != nil {
+		pqu.SetAnswerGeneratedAt(*t)
+	}
+	return pqu
+}
+
+// ClearAnswerGeneratedAt clears the value of the "answer_generated_at" field.
+func (pqu *PublicQuestionUpdate) ClearAnswerGeneratedAt() *PublicQuestionUpdate {
+	pqu.mutation.ClearAnswerGeneratedAt()
+	return pqu
+}
+
+// SetAnswerAttempts sets the "answer_attempts" field.
+func (pqu *PublicQuestionUpdate) SetAnswerAttempts(i int32) *PublicQuestionUpdate {
+	pqu.mutation.ResetAnswerAttempts()
+	pqu.mutation.SetAnswerAttempts(i)
+	return pqu
+}
+
+// SetNillableAnswerAttempts sets the "answer_attempts" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableAnswerAttempts(i *int32) *PublicQuestionUpdate {
+	if i != nil {
+		pqu.SetAnswerAttempts(*i)
+	}
+	return pqu
+}
+
+// AddAnswerAttempts adds i to the "answer_attempts" field.
+func (pqu *PublicQuestionUpdate) AddAnswerAttempts(i int32) *PublicQuestionUpdate {
+	pqu.mutation.AddAnswerAttempts(i)
+	return pqu
+}
+
+// SetAnswerLockedBy sets the "answer_locked_by" field.
+func (pqu *PublicQuestionUpdate) SetAnswerLockedBy(s string) *PublicQuestionUpdate {
+	pqu.mutation.SetAnswerLockedBy(s)
+	return pqu
+}
+
+// SetNillableAnswerLockedBy sets the "answer_locked_by" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableAnswerLockedBy(s *string) *PublicQuestionUpdate {
+	if s != nil {
+		pqu.SetAnswerLockedBy(*s)
+	}
+	return pqu
+}
+
+// ClearAnswerLockedBy clears the value of the "answer_locked_by" field.
+func (pqu *PublicQuestionUpdate) ClearAnswerLockedBy() *PublicQuestionUpdate {
+	pqu.mutation.ClearAnswerLockedBy()
+	return pqu
+}
+
+// SetAnswerLockedUntil sets the "answer_locked_until" field.
+func (pqu *PublicQuestionUpdate) SetAnswerLockedUntil(t time.Time) *PublicQuestionUpdate {
+	pqu.mutation.SetAnswerLockedUntil(t)
+	return pqu
+}
+
+// SetNillableAnswerLockedUntil sets the "answer_locked_until" field if the given value is not nil.
+func (pqu *PublicQuestionUpdate) SetNillableAnswerLockedUntil(t *time.Time) *PublicQuestionUpdate {
+	if t != nil {
+		pqu.SetAnswerLockedUntil(*t)
+	}
+	return pqu
+}
+
+// ClearAnswerLockedUntil clears the value of the "answer_locked_until" field.
+func (pqu *PublicQuestionUpdate) ClearAnswerLockedUntil() *PublicQuestionUpdate {
+	pqu.mutation.ClearAnswerLockedUntil()
+	return pqu
+}
+
 // Mutation returns the PublicQuestionMutation object of the builder.
 func (pqu *PublicQuestionUpdate) Mutation() *PublicQuestionMutation {
 	return pqu.mutation
@@ -173,6 +491,11 @@ func (pqu *PublicQuestionUpdate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PublicQuestion.content": %w`, err)}
 		}
 	}
+	if v, ok := pqu.mutation.ContentHash(); ok {
+		if err := publicquestion.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "PublicQuestion.content_hash": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -191,6 +514,12 @@ func (pqu *PublicQuestionUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := pqu.mutation.UpdatedAt(); ok {
 		_spec.SetField(publicquestion.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := pqu.mutation.DeletedAt(); ok {
+		_spec.SetField(publicquestion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pqu.mutation.DeletedAtCleared() {
+		_spec.ClearField(publicquestion.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := pqu.mutation.Position(); ok {
 		_spec.SetField(publicquestion.FieldPosition, field.TypeString, value)
 	}
@@ -209,6 +538,96 @@ func (pqu *PublicQuestionUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if pqu.mutation.AnswerCleared() {
 		_spec.ClearField(publicquestion.FieldAnswer, field.TypeString)
 	}
+	if value, ok := pqu.mutation.ContentHash(); ok {
+		_spec.SetField(publicquestion.FieldContentHash, field.TypeString, value)
+	}
+	if pqu.mutation.ContentHashCleared() {
+		_spec.ClearField(publicquestion.FieldContentHash, field.TypeString)
+	}
+	if value, ok := pqu.mutation.Occurrences(); ok {
+		_spec.SetField(publicquestion.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := pqu.mutation.AddedOccurrences(); ok {
+		_spec.AddField(publicquestion.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := pqu.mutation.FirstSeenAt(); ok {
+		_spec.SetField(publicquestion.FieldFirstSeenAt, field.TypeTime, value)
+	}
+	if pqu.mutation.FirstSeenAtCleared() {
+		_spec.ClearField(publicquestion.FieldFirstSeenAt, field.TypeTime)
+	}
+	if value, ok := pqu.mutation.LastSeenAt(); ok {
+		_spec.SetField(publicquestion.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if pqu.mutation.LastSeenAtCleared() {
+		_spec.ClearField(publicquestion.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := pqu.mutation.Status(); ok {
+		_spec.SetField(publicquestion.FieldStatus, field.TypeInt32, value)
+	}
+	if value, ok := pqu.mutation.AddedStatus(); ok {
+		_spec.AddField(publicquestion.FieldStatus, field.TypeInt32, value)
+	}
+	if value, ok := pqu.mutation.MergedIntoID(); ok {
+		_spec.SetField(publicquestion.FieldMergedIntoID, field.TypeInt, value)
+	}
+	if value, ok := pqu.mutation.AddedMergedIntoID(); ok {
+		_spec.AddField(publicquestion.FieldMergedIntoID, field.TypeInt, value)
+	}
+	if pqu.mutation.MergedIntoIDCleared() {
+		_spec.ClearField(publicquestion.FieldMergedIntoID, field.TypeInt)
+	}
+	if value, ok := pqu.mutation.ModeratedBy(); ok {
+		_spec.SetField(publicquestion.FieldModeratedBy, field.TypeUint64, value)
+	}
+	if value, ok := pqu.mutation.AddedModeratedBy(); ok {
+		_spec.AddField(publicquestion.FieldModeratedBy, field.TypeUint64, value)
+	}
+	if pqu.mutation.ModeratedByCleared() {
+		_spec.ClearField(publicquestion.FieldModeratedBy, field.TypeUint64)
+	}
+	if value, ok := pqu.mutation.ModeratedAt(); ok {
+		_spec.SetField(publicquestion.FieldModeratedAt, field.TypeTime, value)
+	}
+	if pqu.mutation.ModeratedAtCleared() {
+		_spec.ClearField(publicquestion.FieldModeratedAt, field.TypeTime)
+	}
+	if value, ok := pqu.mutation.AnswerGenerator(); ok {
+		_spec.SetField(publicquestion.FieldAnswerGenerator, field.TypeString, value)
+	}
+	if pqu.mutation.AnswerGeneratorCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerGenerator, field.TypeString)
+	}
+	if value, ok := pqu.mutation.AnswerVersion(); ok {
+		_spec.SetField(publicquestion.FieldAnswerVersion, field.TypeString, value)
+	}
+	if pqu.mutation.AnswerVersionCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerVersion, field.TypeString)
+	}
+	if value, ok := pqu.mutation.AnswerGeneratedAt(); ok {
+		_spec.SetField(publicquestion.FieldAnswerGeneratedAt, field.TypeTime, value)
+	}
+	if pqu.mutation.AnswerGeneratedAtCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerGeneratedAt, field.TypeTime)
+	}
+	if value, ok := pqu.mutation.AnswerAttempts(); ok {
+		_spec.SetField(publicquestion.FieldAnswerAttempts, field.TypeInt32, value)
+	}
+	if value, ok := pqu.mutation.AddedAnswerAttempts(); ok {
+		_spec.AddField(publicquestion.FieldAnswerAttempts, field.TypeInt32, value)
+	}
+	if value, ok := pqu.mutation.AnswerLockedBy(); ok {
+		_spec.SetField(publicquestion.FieldAnswerLockedBy, field.TypeString, value)
+	}
+	if pqu.mutation.AnswerLockedByCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerLockedBy, field.TypeString)
+	}
+	if value, ok := pqu.mutation.AnswerLockedUntil(); ok {
+		_spec.SetField(publicquestion.FieldAnswerLockedUntil, field.TypeTime, value)
+	}
+	if pqu.mutation.AnswerLockedUntilCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerLockedUntil, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pqu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{publicquestion.Label}
@@ -235,6 +654,26 @@ func (pquo *PublicQuestionUpdateOne) SetUpdatedAt(t time.Time) *PublicQuestionUp
 	return pquo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pquo *PublicQuestionUpdateOne) SetDeletedAt(t time.Time) *PublicQuestionUpdateOne {
+	pquo.mutation.SetDeletedAt(t)
+	return pquo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableDeletedAt(t *time.Time) *PublicQuestionUpdateOne {
+	if t != nil {
+		pquo.SetDeletedAt(*t)
+	}
+	return pquo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pquo *PublicQuestionUpdateOne) ClearDeletedAt() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearDeletedAt()
+	return pquo
+}
+
 // SetPosition sets the "position" field.
 func (pquo *PublicQuestionUpdateOne) SetPosition(s string) *PublicQuestionUpdateOne {
 	pquo.mutation.SetPosition(s)
@@ -311,6 +750,303 @@ func (pquo *PublicQuestionUpdateOne) ClearAnswer() *PublicQuestionUpdateOne {
 	return pquo
 }
 
+// SetContentHash sets the "content_hash" field.
+func (pquo *PublicQuestionUpdateOne) SetContentHash(s string) *PublicQuestionUpdateOne {
+	pquo.mutation.SetContentHash(s)
+	return pquo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableContentHash(s *string) *PublicQuestionUpdateOne {
+	if s != nil {
+		pquo.SetContentHash(*s)
+	}
+	return pquo
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (pquo *PublicQuestionUpdateOne) ClearContentHash() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearContentHash()
+	return pquo
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (pquo *PublicQuestionUpdateOne) SetOccurrences(i int) *PublicQuestionUpdateOne {
+	pquo.mutation.ResetOccurrences()
+	pquo.mutation.SetOccurrences(i)
+	return pquo
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableOccurrences(i *int) *PublicQuestionUpdateOne {
+	if i != nil {
+		pquo.SetOccurrences(*i)
+	}
+	return pquo
+}
+
+// AddOccurrences adds i to the "occurrences" field.
+func (pquo *PublicQuestionUpdateOne) AddOccurrences(i int) *PublicQuestionUpdateOne {
+	pquo.mutation.AddOccurrences(i)
+	return pquo
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (pquo *PublicQuestionUpdateOne) SetFirstSeenAt(t time.Time) *PublicQuestionUpdateOne {
+	pquo.mutation.SetFirstSeenAt(t)
+	return pquo
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableFirstSeenAt(t *time.Time) *PublicQuestionUpdateOne {
+	if t != nil {
+		pquo.SetFirstSeenAt(*t)
+	}
+	return pquo
+}
+
+// ClearFirstSeenAt clears the value of the "first_seen_at" field.
+func (pquo *PublicQuestionUpdateOne) ClearFirstSeenAt() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearFirstSeenAt()
+	return pquo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (pquo *PublicQuestionUpdateOne) SetLastSeenAt(t time.Time) *PublicQuestionUpdateOne {
+	pquo.mutation.SetLastSeenAt(t)
+	return pquo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableLastSeenAt(t *time.Time) *PublicQuestionUpdateOne {
+	if t != nil {
+		pquo.SetLastSeenAt(*t)
+	}
+	return pquo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (pquo *PublicQuestionUpdateOne) ClearLastSeenAt() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearLastSeenAt()
+	return pquo
+}
+
+// SetStatus sets the "status" field.
+func (pquo *PublicQuestionUpdateOne) SetStatus(is irelia.ModerationStatus) *PublicQuestionUpdateOne {
+	pquo.mutation.ResetStatus()
+	pquo.mutation.SetStatus(is)
+	return pquo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableStatus(is *irelia.ModerationStatus) *PublicQuestionUpdateOne {
+	if is != nil {
+		pquo.SetStatus(*is)
+	}
+	return pquo
+}
+
+// AddStatus adds is to the "status" field.
+func (pquo *PublicQuestionUpdateOne) AddStatus(is irelia.ModerationStatus) *PublicQuestionUpdateOne {
+	pquo.mutation.AddStatus(is)
+	return pquo
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (pquo *PublicQuestionUpdateOne) SetMergedIntoID(i int) *PublicQuestionUpdateOne {
+	pquo.mutation.ResetMergedIntoID()
+	pquo.mutation.SetMergedIntoID(i)
+	return pquo
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableMergedIntoID(i *int) *PublicQuestionUpdateOne {
+	if i != nil {
+		pquo.SetMergedIntoID(*i)
+	}
+	return pquo
+}
+
+// AddMergedIntoID adds i to the "merged_into_id" field.
+func (pquo *PublicQuestionUpdateOne) AddMergedIntoID(i int) *PublicQuestionUpdateOne {
+	pquo.mutation.AddMergedIntoID(i)
+	return pquo
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (pquo *PublicQuestionUpdateOne) ClearMergedIntoID() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearMergedIntoID()
+	return pquo
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (pquo *PublicQuestionUpdateOne) SetModeratedBy(u uint64) *PublicQuestionUpdateOne {
+	pquo.mutation.ResetModeratedBy()
+	pquo.mutation.SetModeratedBy(u)
+	return pquo
+}
+
+// SetNillableModeratedBy sets the "moderated_by" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableModeratedBy(u *uint64) *PublicQuestionUpdateOne {
+	if u != nil {
+		pquo.SetModeratedBy(*u)
+	}
+	return pquo
+}
+
+// AddModeratedBy adds u to the "moderated_by" field.
+func (pquo *PublicQuestionUpdateOne) AddModeratedBy(u int64) *PublicQuestionUpdateOne {
+	pquo.mutation.AddModeratedBy(u)
+	return pquo
+}
+
+// ClearModeratedBy clears the value of the "moderated_by" field.
+func (pquo *PublicQuestionUpdateOne) ClearModeratedBy() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearModeratedBy()
+	return pquo
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (pquo *PublicQuestionUpdateOne) SetModeratedAt(t time.Time) *PublicQuestionUpdateOne {
+	pquo.mutation.SetModeratedAt(t)
+	return pquo
+}
+
+// SetNillableModeratedAt sets the "moderated_at" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableModeratedAt(t *time.Time) *PublicQuestionUpdateOne {
+	if t != nil {
+		pquo.SetModeratedAt(*t)
+	}
+	return pquo
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (pquo *PublicQuestionUpdateOne) ClearModeratedAt() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearModeratedAt()
+	return pquo
+}
+
+// SetAnswerGenerator sets the "answer_generator" field.
+func (pquo *PublicQuestionUpdateOne) SetAnswerGenerator(s string) *PublicQuestionUpdateOne {
+	pquo.mutation.SetAnswerGenerator(s)
+	return pquo
+}
+
+// SetNillableAnswerGenerator sets the "answer_generator" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableAnswerGenerator(s *string) *PublicQuestionUpdateOne {
+	if s != nil {
+		pquo.SetAnswerGenerator(*s)
+	}
+	return pquo
+}
+
+// ClearAnswerGenerator clears the value of the "answer_generator" field.
+func (pquo *PublicQuestionUpdateOne) ClearAnswerGenerator() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearAnswerGenerator()
+	return pquo
+}
+
+// SetAnswerVersion sets the "answer_version" field.
+func (pquo *PublicQuestionUpdateOne) SetAnswerVersion(s string) *PublicQuestionUpdateOne {
+	pquo.mutation.SetAnswerVersion(s)
+	return pquo
+}
+
+// SetNillableAnswerVersion sets the "answer_version" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableAnswerVersion(s *string) *PublicQuestionUpdateOne {
+	if s != nil {
+		pquo.SetAnswerVersion(*s)
+	}
+	return pquo
+}
+
+// ClearAnswerVersion clears the value of the "answer_version" field.
+func (pquo *PublicQuestionUpdateOne) ClearAnswerVersion() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearAnswerVersion()
+	return pquo
+}
+
+// SetAnswerGeneratedAt sets the "answer_generated_at" field.
+func (pquo *PublicQuestionUpdateOne) SetAnswerGeneratedAt(t time.Time) *PublicQuestionUpdateOne {
+	pquo.mutation.SetAnswerGeneratedAt(t)
+	return pquo
+}
+
+// SetNillableAnswerGeneratedAt sets the "answer_generated_at" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableAnswerGeneratedAt(t *time.Time) *PublicQuestionUpdateOne {
+	if t != nil {
+		pquo.SetAnswerGeneratedAt(*t)
+	}
+	return pquo
+}
+
+// ClearAnswerGeneratedAt clears the value of the "answer_generated_at" field.
+func (pquo *PublicQuestionUpdateOne) ClearAnswerGeneratedAt() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearAnswerGeneratedAt()
+	return pquo
+}
+
+// SetAnswerAttempts sets the "answer_attempts" field.
+func (pquo *PublicQuestionUpdateOne) SetAnswerAttempts(i int32) *PublicQuestionUpdateOne {
+	pquo.mutation.ResetAnswerAttempts()
+	pquo.mutation.SetAnswerAttempts(i)
+	return pquo
+}
+
+// SetNillableAnswerAttempts sets the "answer_attempts" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableAnswerAttempts(i *int32) *PublicQuestionUpdateOne {
+	if i != nil {
+		pquo.SetAnswerAttempts(*i)
+	}
+	return pquo
+}
+
+// AddAnswerAttempts adds i to the "answer_attempts" field.
+func (pquo *PublicQuestionUpdateOne) AddAnswerAttempts(i int32) *PublicQuestionUpdateOne {
+	pquo.mutation.AddAnswerAttempts(i)
+	return pquo
+}
+
+// SetAnswerLockedBy sets the "answer_locked_by" field.
+func (pquo *PublicQuestionUpdateOne) SetAnswerLockedBy(s string) *PublicQuestionUpdateOne {
+	pquo.mutation.SetAnswerLockedBy(s)
+	return pquo
+}
+
+// SetNillableAnswerLockedBy sets the "answer_locked_by" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableAnswerLockedBy(s *string) *PublicQuestionUpdateOne {
+	if s != nil {
+		pquo.SetAnswerLockedBy(*s)
+	}
+	return pquo
+}
+
+// ClearAnswerLockedBy clears the value of the "answer_locked_by" field.
+func (pquo *PublicQuestionUpdateOne) ClearAnswerLockedBy() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearAnswerLockedBy()
+	return pquo
+}
+
+// SetAnswerLockedUntil sets the "answer_locked_until" field.
+func (pquo *PublicQuestionUpdateOne) SetAnswerLockedUntil(t time.Time) *PublicQuestionUpdateOne {
+	pquo.mutation.SetAnswerLockedUntil(t)
+	return pquo
+}
+
+// SetNillableAnswerLockedUntil sets the "answer_locked_until" field if the given value is not nil.
+func (pquo *PublicQuestionUpdateOne) SetNillableAnswerLockedUntil(t *time.Time) *PublicQuestionUpdateOne {
+	if t != nil {
+		pquo.SetAnswerLockedUntil(*t)
+	}
+	return pquo
+}
+
+// ClearAnswerLockedUntil clears the value of the "answer_locked_until" field.
+func (pquo *PublicQuestionUpdateOne) ClearAnswerLockedUntil() *PublicQuestionUpdateOne {
+	pquo.mutation.ClearAnswerLockedUntil()
+	return pquo
+}
+
 // Mutation returns the PublicQuestionMutation object of the builder.
 func (pquo *PublicQuestionUpdateOne) Mutation() *PublicQuestionMutation {
 	return pquo.mutation
@@ -387,6 +1123,11 @@ func (pquo *PublicQuestionUpdateOne) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PublicQuestion.content": %w`, err)}
 		}
 	}
+	if v, ok := pquo.mutation.ContentHash(); ok {
+		if err := publicquestion.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "PublicQuestion.content_hash": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -422,6 +1163,12 @@ func (pquo *PublicQuestionUpdateOne) sqlSave(ctx context.Context) (_node *Public
 	if value, ok := pquo.mutation.UpdatedAt(); ok {
 		_spec.SetField(publicquestion.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := pquo.mutation.DeletedAt(); ok {
+		_spec.SetField(publicquestion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pquo.mutation.DeletedAtCleared() {
+		_spec.ClearField(publicquestion.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := pquo.mutation.Position(); ok {
 		_spec.SetField(publicquestion.FieldPosition, field.TypeString, value)
 	}
@@ -440,6 +1187,96 @@ func (pquo *PublicQuestionUpdateOne) sqlSave(ctx context.Context) (_node *Public
 	if pquo.mutation.AnswerCleared() {
 		_spec.ClearField(publicquestion.FieldAnswer, field.TypeString)
 	}
+	if value, ok := pquo.mutation.ContentHash(); ok {
+		_spec.SetField(publicquestion.FieldContentHash, field.TypeString, value)
+	}
+	if pquo.mutation.ContentHashCleared() {
+		_spec.ClearField(publicquestion.FieldContentHash, field.TypeString)
+	}
+	if value, ok := pquo.mutation.Occurrences(); ok {
+		_spec.SetField(publicquestion.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := pquo.mutation.AddedOccurrences(); ok {
+		_spec.AddField(publicquestion.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := pquo.mutation.FirstSeenAt(); ok {
+		_spec.SetField(publicquestion.FieldFirstSeenAt, field.TypeTime, value)
+	}
+	if pquo.mutation.FirstSeenAtCleared() {
+		_spec.ClearField(publicquestion.FieldFirstSeenAt, field.TypeTime)
+	}
+	if value, ok := pquo.mutation.LastSeenAt(); ok {
+		_spec.SetField(publicquestion.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if pquo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(publicquestion.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := pquo.mutation.Status(); ok {
+		_spec.SetField(publicquestion.FieldStatus, field.TypeInt32, value)
+	}
+	if value, ok := pquo.mutation.AddedStatus(); ok {
+		_spec.AddField(publicquestion.FieldStatus, field.TypeInt32, value)
+	}
+	if value, ok := pquo.mutation.MergedIntoID(); ok {
+		_spec.SetField(publicquestion.FieldMergedIntoID, field.TypeInt, value)
+	}
+	if value, ok := pquo.mutation.AddedMergedIntoID(); ok {
+		_spec.AddField(publicquestion.FieldMergedIntoID, field.TypeInt, value)
+	}
+	if pquo.mutation.MergedIntoIDCleared() {
+		_spec.ClearField(publicquestion.FieldMergedIntoID, field.TypeInt)
+	}
+	if value, ok := pquo.mutation.ModeratedBy(); ok {
+		_spec.SetField(publicquestion.FieldModeratedBy, field.TypeUint64, value)
+	}
+	if value, ok := pquo.mutation.AddedModeratedBy(); ok {
+		_spec.AddField(publicquestion.FieldModeratedBy, field.TypeUint64, value)
+	}
+	if pquo.mutation.ModeratedByCleared() {
+		_spec.ClearField(publicquestion.FieldModeratedBy, field.TypeUint64)
+	}
+	if value, ok := pquo.mutation.ModeratedAt(); ok {
+		_spec.SetField(publicquestion.FieldModeratedAt, field.TypeTime, value)
+	}
+	if pquo.mutation.ModeratedAtCleared() {
+		_spec.ClearField(publicquestion.FieldModeratedAt, field.TypeTime)
+	}
+	if value, ok := pquo.mutation.AnswerGenerator(); ok {
+		_spec.SetField(publicquestion.FieldAnswerGenerator, field.TypeString, value)
+	}
+	if pquo.mutation.AnswerGeneratorCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerGenerator, field.TypeString)
+	}
+	if value, ok := pquo.mutation.AnswerVersion(); ok {
+		_spec.SetField(publicquestion.FieldAnswerVersion, field.TypeString, value)
+	}
+	if pquo.mutation.AnswerVersionCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerVersion, field.TypeString)
+	}
+	if value, ok := pquo.mutation.AnswerGeneratedAt(); ok {
+		_spec.SetField(publicquestion.FieldAnswerGeneratedAt, field.TypeTime, value)
+	}
+	if pquo.mutation.AnswerGeneratedAtCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerGeneratedAt, field.TypeTime)
+	}
+	if value, ok := pquo.mutation.AnswerAttempts(); ok {
+		_spec.SetField(publicquestion.FieldAnswerAttempts, field.TypeInt32, value)
+	}
+	if value, ok := pquo.mutation.AddedAnswerAttempts(); ok {
+		_spec.AddField(publicquestion.FieldAnswerAttempts, field.TypeInt32, value)
+	}
+	if value, ok := pquo.mutation.AnswerLockedBy(); ok {
+		_spec.SetField(publicquestion.FieldAnswerLockedBy, field.TypeString, value)
+	}
+	if pquo.mutation.AnswerLockedByCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerLockedBy, field.TypeString)
+	}
+	if value, ok := pquo.mutation.AnswerLockedUntil(); ok {
+		_spec.SetField(publicquestion.FieldAnswerLockedUntil, field.TypeTime, value)
+	}
+	if pquo.mutation.AnswerLockedUntilCleared() {
+		_spec.ClearField(publicquestion.FieldAnswerLockedUntil, field.TypeTime)
+	}
 	_node = &PublicQuestion{config: pquo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -24,16 +24,24 @@ type Question struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// InterviewID holds the value of the "interview_id" field.
 	InterviewID string `json:"interview_id,omitempty"`
 	// QuestionIndex holds the value of the "question_index" field.
 	QuestionIndex int32 `json:"question_index,omitempty"`
+	// SubIndex holds the value of the "sub_index" field.
+	SubIndex int32 `json:"sub_index,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
 	// Audio holds the value of the "audio" field.
 	Audio string `json:"audio,omitempty"`
+	// AudioKey holds the value of the "audio_key" field.
+	AudioKey string `json:"audio_key,omitempty"`
 	// Lipsync holds the value of the "lipsync" field.
 	Lipsync *irelia.LipSyncData `json:"lipsync,omitempty"`
+	// AudioPending holds the value of the "audio_pending" field.
+	AudioPending bool `json:"audio_pending,omitempty"`
 	// Answer holds the value of the "answer" field.
 	Answer string `json:"answer,omitempty"`
 	// RecordProof holds the value of the "record_proof" field.
@@ -44,6 +52,8 @@ type Question struct {
 	Score string `json:"score,omitempty"`
 	// Status holds the value of the "status" field.
 	Status irelia.QuestionStatus `json:"status,omitempty"`
+	// DeadlineAt holds the value of the "deadline_at" field.
+	DeadlineAt *time.Time `json:"deadline_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QuestionQuery when eager-loading is set.
 	Edges        QuestionEdges `json:"edges"`
@@ -77,11 +87,13 @@ func (*Question) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case question.FieldLipsync:
 			values[i] = new([]byte)
-		case question.FieldID, question.FieldQuestionIndex, question.FieldStatus:
+		case question.FieldAudioPending:
+			values[i] = new(sql.NullBool)
+		case question.FieldID, question.FieldQuestionIndex, question.FieldSubIndex, question.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case question.FieldInterviewID, question.FieldContent, question.FieldAudio, question.FieldAnswer, question.FieldRecordProof, question.FieldComment, question.FieldScore:
+		case question.FieldInterviewID, question.FieldContent, question.FieldAudio, question.FieldAudioKey, question.FieldAnswer, question.FieldRecordProof, question.FieldComment, question.FieldScore:
 			values[i] = new(sql.NullString)
-		case question.FieldCreatedAt, question.FieldUpdatedAt:
+		case question.FieldCreatedAt, question.FieldUpdatedAt, question.FieldDeletedAt, question.FieldDeadlineAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -116,6 +128,13 @@ func (q *Question) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				q.UpdatedAt = value.Time
 			}
+		case question.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				q.DeletedAt = new(time.Time)
+				*q.DeletedAt = value.Time
+			}
 		case question.FieldInterviewID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field interview_id", values[i])
@@ -128,6 +147,12 @@ func (q *Question) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				q.QuestionIndex = int32(value.Int64)
 			}
+		case question.FieldSubIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sub_index", values[i])
+			} else if value.Valid {
+				q.SubIndex = int32(value.Int64)
+			}
 		case question.FieldContent:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field content", values[i])
@@ -140,6 +165,12 @@ func (q *Question) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				q.Audio = value.String
 			}
+		case question.FieldAudioKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_key", values[i])
+			} else if value.Valid {
+				q.AudioKey = value.String
+			}
 		case question.FieldLipsync:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field lipsync", values[i])
@@ -148,6 +179,12 @@ func (q *Question) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field lipsync: %w", err)
 				}
 			}
+		case question.FieldAudioPending:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_pending", values[i])
+			} else if value.Valid {
+				q.AudioPending = value.Bool
+			}
 		case question.FieldAnswer:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field answer", values[i])
@@ -178,6 +215,13 @@ func (q *Question) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				q.Status = irelia.QuestionStatus(value.Int64)
 			}
+		case question.FieldDeadlineAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline_at", values[i])
+			} else if value.Valid {
+				q.DeadlineAt = new(time.Time)
+				*q.DeadlineAt = value.Time
+			}
 		default:
 			q.selectValues.Set(columns[i], values[i])
 		}
@@ -225,21 +269,35 @@ func (q *Question) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(q.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := q.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("interview_id=")
 	builder.WriteString(q.InterviewID)
 	builder.WriteString(", ")
 	builder.WriteString("question_index=")
 	builder.WriteString(fmt.Sprintf("%v", q.QuestionIndex))
 	builder.WriteString(", ")
+	builder.WriteString("sub_index=")
+	builder.WriteString(fmt.Sprintf("%v", q.SubIndex))
+	builder.WriteString(", ")
 	builder.WriteString("content=")
 	builder.WriteString(q.Content)
 	builder.WriteString(", ")
 	builder.WriteString("audio=")
 	builder.WriteString(q.Audio)
 	builder.WriteString(", ")
+	builder.WriteString("audio_key=")
+	builder.WriteString(q.AudioKey)
+	builder.WriteString(", ")
 	builder.WriteString("lipsync=")
 	builder.WriteString(fmt.Sprintf("%v", q.Lipsync))
 	builder.WriteString(", ")
+	builder.WriteString("audio_pending=")
+	builder.WriteString(fmt.Sprintf("%v", q.AudioPending))
+	builder.WriteString(", ")
 	builder.WriteString("answer=")
 	builder.WriteString(q.Answer)
 	builder.WriteString(", ")
@@ -254,6 +312,11 @@ func (q *Question) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", q.Status))
+	builder.WriteString(", ")
+	if v := q.DeadlineAt; v != nil {
+		builder.WriteString("deadline_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
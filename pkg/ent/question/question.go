@@ -18,16 +18,24 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldInterviewID holds the string denoting the interview_id field in the database.
 	FieldInterviewID = "interview_id"
 	// FieldQuestionIndex holds the string denoting the question_index field in the database.
 	FieldQuestionIndex = "question_index"
+	// FieldSubIndex holds the string denoting the sub_index field in the database.
+	FieldSubIndex = "sub_index"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
 	// FieldAudio holds the string denoting the audio field in the database.
 	FieldAudio = "audio"
+	// FieldAudioKey holds the string denoting the audio_key field in the database.
+	FieldAudioKey = "audio_key"
 	// FieldLipsync holds the string denoting the lipsync field in the database.
 	FieldLipsync = "lipsync"
+	// FieldAudioPending holds the string denoting the audio_pending field in the database.
+	FieldAudioPending = "audio_pending"
 	// FieldAnswer holds the string denoting the answer field in the database.
 	FieldAnswer = "answer"
 	// FieldRecordProof holds the string denoting the record_proof field in the database.
@@ -38,6 +46,8 @@ const (
 	FieldScore = "score"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldDeadlineAt holds the string denoting the deadline_at field in the database.
+	FieldDeadlineAt = "deadline_at"
 	// EdgeInterview holds the string denoting the interview edge name in mutations.
 	EdgeInterview = "interview"
 	// Table holds the table name of the question in the database.
@@ -56,16 +66,21 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldInterviewID,
 	FieldQuestionIndex,
+	FieldSubIndex,
 	FieldContent,
 	FieldAudio,
+	FieldAudioKey,
 	FieldLipsync,
+	FieldAudioPending,
 	FieldAnswer,
 	FieldRecordProof,
 	FieldComment,
 	FieldScore,
 	FieldStatus,
+	FieldDeadlineAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -87,8 +102,12 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// InterviewIDValidator is a validator for the "interview_id" field. It is called by the builders before save.
 	InterviewIDValidator func(string) error
+	// DefaultSubIndex holds the default value on creation for the "sub_index" field.
+	DefaultSubIndex int32
 	// ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	ContentValidator func(string) error
+	// DefaultAudioPending holds the default value on creation for the "audio_pending" field.
+	DefaultAudioPending bool
 )
 
 // OrderOption defines the ordering options for the Question queries.
@@ -109,6 +128,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByInterviewID orders the results by the interview_id field.
 func ByInterviewID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInterviewID, opts...).ToFunc()
@@ -119,6 +143,11 @@ func ByQuestionIndex(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuestionIndex, opts...).ToFunc()
 }
 
+// BySubIndex orders the results by the sub_index field.
+func BySubIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubIndex, opts...).ToFunc()
+}
+
 // ByContent orders the results by the content field.
 func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
@@ -129,6 +158,16 @@ func ByAudio(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAudio, opts...).ToFunc()
 }
 
+// ByAudioKey orders the results by the audio_key field.
+func ByAudioKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioKey, opts...).ToFunc()
+}
+
+// ByAudioPending orders the results by the audio_pending field.
+func ByAudioPending(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioPending, opts...).ToFunc()
+}
+
 // ByAnswer orders the results by the answer field.
 func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
@@ -154,6 +193,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByDeadlineAt orders the results by the deadline_at field.
+func ByDeadlineAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeadlineAt, opts...).ToFunc()
+}
+
 // ByInterviewField orders the results by interview field.
 func ByInterviewField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
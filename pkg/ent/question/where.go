@@ -66,6 +66,11 @@ func UpdatedAt(v time.Time) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // InterviewID applies equality check predicate on the "interview_id" field. It's identical to InterviewIDEQ.
 func InterviewID(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldInterviewID, v))
@@ -76,6 +81,11 @@ func QuestionIndex(v int32) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldQuestionIndex, v))
 }
 
+// SubIndex applies equality check predicate on the "sub_index" field. It's identical to SubIndexEQ.
+func SubIndex(v int32) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldSubIndex, v))
+}
+
 // Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
 func Content(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldContent, v))
@@ -86,6 +96,16 @@ func Audio(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldAudio, v))
 }
 
+// AudioKey applies equality check predicate on the "audio_key" field. It's identical to AudioKeyEQ.
+func AudioKey(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldAudioKey, v))
+}
+
+// AudioPending applies equality check predicate on the "audio_pending" field. It's identical to AudioPendingEQ.
+func AudioPending(v bool) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldAudioPending, v))
+}
+
 // Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
 func Answer(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldAnswer, v))
@@ -112,6 +132,11 @@ func Status(v irelia.QuestionStatus) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldStatus, vc))
 }
 
+// DeadlineAt applies equality check predicate on the "deadline_at" field. It's identical to DeadlineAtEQ.
+func DeadlineAt(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldDeadlineAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldCreatedAt, v))
@@ -192,6 +217,56 @@ func UpdatedAtLTE(v time.Time) predicate.Question {
 	return predicate.Question(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // InterviewIDEQ applies the EQ predicate on the "interview_id" field.
 func InterviewIDEQ(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldInterviewID, v))
@@ -297,6 +372,46 @@ func QuestionIndexLTE(v int32) predicate.Question {
 	return predicate.Question(sql.FieldLTE(FieldQuestionIndex, v))
 }
 
+// SubIndexEQ applies the EQ predicate on the "sub_index" field.
+func SubIndexEQ(v int32) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldSubIndex, v))
+}
+
+// SubIndexNEQ applies the NEQ predicate on the "sub_index" field.
+func SubIndexNEQ(v int32) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldSubIndex, v))
+}
+
+// SubIndexIn applies the In predicate on the "sub_index" field.
+func SubIndexIn(vs ...int32) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldSubIndex, vs...))
+}
+
+// SubIndexNotIn applies the NotIn predicate on the "sub_index" field.
+func SubIndexNotIn(vs ...int32) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldSubIndex, vs...))
+}
+
+// SubIndexGT applies the GT predicate on the "sub_index" field.
+func SubIndexGT(v int32) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldSubIndex, v))
+}
+
+// SubIndexGTE applies the GTE predicate on the "sub_index" field.
+func SubIndexGTE(v int32) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldSubIndex, v))
+}
+
+// SubIndexLT applies the LT predicate on the "sub_index" field.
+func SubIndexLT(v int32) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldSubIndex, v))
+}
+
+// SubIndexLTE applies the LTE predicate on the "sub_index" field.
+func SubIndexLTE(v int32) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldSubIndex, v))
+}
+
 // ContentEQ applies the EQ predicate on the "content" field.
 func ContentEQ(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldContent, v))
@@ -437,6 +552,81 @@ func AudioContainsFold(v string) predicate.Question {
 	return predicate.Question(sql.FieldContainsFold(FieldAudio, v))
 }
 
+// AudioKeyEQ applies the EQ predicate on the "audio_key" field.
+func AudioKeyEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldAudioKey, v))
+}
+
+// AudioKeyNEQ applies the NEQ predicate on the "audio_key" field.
+func AudioKeyNEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldAudioKey, v))
+}
+
+// AudioKeyIn applies the In predicate on the "audio_key" field.
+func AudioKeyIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldAudioKey, vs...))
+}
+
+// AudioKeyNotIn applies the NotIn predicate on the "audio_key" field.
+func AudioKeyNotIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldAudioKey, vs...))
+}
+
+// AudioKeyGT applies the GT predicate on the "audio_key" field.
+func AudioKeyGT(v string) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldAudioKey, v))
+}
+
+// AudioKeyGTE applies the GTE predicate on the "audio_key" field.
+func AudioKeyGTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldAudioKey, v))
+}
+
+// AudioKeyLT applies the LT predicate on the "audio_key" field.
+func AudioKeyLT(v string) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldAudioKey, v))
+}
+
+// AudioKeyLTE applies the LTE predicate on the "audio_key" field.
+func AudioKeyLTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldAudioKey, v))
+}
+
+// AudioKeyContains applies the Contains predicate on the "audio_key" field.
+func AudioKeyContains(v string) predicate.Question {
+	return predicate.Question(sql.FieldContains(FieldAudioKey, v))
+}
+
+// AudioKeyHasPrefix applies the HasPrefix predicate on the "audio_key" field.
+func AudioKeyHasPrefix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasPrefix(FieldAudioKey, v))
+}
+
+// AudioKeyHasSuffix applies the HasSuffix predicate on the "audio_key" field.
+func AudioKeyHasSuffix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasSuffix(FieldAudioKey, v))
+}
+
+// AudioKeyIsNil applies the IsNil predicate on the "audio_key" field.
+func AudioKeyIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldAudioKey))
+}
+
+// AudioKeyNotNil applies the NotNil predicate on the "audio_key" field.
+func AudioKeyNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldAudioKey))
+}
+
+// AudioKeyEqualFold applies the EqualFold predicate on the "audio_key" field.
+func AudioKeyEqualFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldEqualFold(FieldAudioKey, v))
+}
+
+// AudioKeyContainsFold applies the ContainsFold predicate on the "audio_key" field.
+func AudioKeyContainsFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldContainsFold(FieldAudioKey, v))
+}
+
 // LipsyncIsNil applies the IsNil predicate on the "lipsync" field.
 func LipsyncIsNil() predicate.Question {
 	return predicate.Question(sql.FieldIsNull(FieldLipsync))
@@ -447,6 +637,16 @@ func LipsyncNotNil() predicate.Question {
 	return predicate.Question(sql.FieldNotNull(FieldLipsync))
 }
 
+// AudioPendingEQ applies the EQ predicate on the "audio_pending" field.
+func AudioPendingEQ(v bool) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldAudioPending, v))
+}
+
+// AudioPendingNEQ applies the NEQ predicate on the "audio_pending" field.
+func AudioPendingNEQ(v bool) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldAudioPending, v))
+}
+
 // AnswerEQ applies the EQ predicate on the "answer" field.
 func AnswerEQ(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldAnswer, v))
@@ -801,6 +1001,56 @@ func StatusLTE(v irelia.QuestionStatus) predicate.Question {
 	return predicate.Question(sql.FieldLTE(FieldStatus, vc))
 }
 
+// DeadlineAtEQ applies the EQ predicate on the "deadline_at" field.
+func DeadlineAtEQ(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldDeadlineAt, v))
+}
+
+// DeadlineAtNEQ applies the NEQ predicate on the "deadline_at" field.
+func DeadlineAtNEQ(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldDeadlineAt, v))
+}
+
+// DeadlineAtIn applies the In predicate on the "deadline_at" field.
+func DeadlineAtIn(vs ...time.Time) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldDeadlineAt, vs...))
+}
+
+// DeadlineAtNotIn applies the NotIn predicate on the "deadline_at" field.
+func DeadlineAtNotIn(vs ...time.Time) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldDeadlineAt, vs...))
+}
+
+// DeadlineAtGT applies the GT predicate on the "deadline_at" field.
+func DeadlineAtGT(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldDeadlineAt, v))
+}
+
+// DeadlineAtGTE applies the GTE predicate on the "deadline_at" field.
+func DeadlineAtGTE(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldDeadlineAt, v))
+}
+
+// DeadlineAtLT applies the LT predicate on the "deadline_at" field.
+func DeadlineAtLT(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldDeadlineAt, v))
+}
+
+// DeadlineAtLTE applies the LTE predicate on the "deadline_at" field.
+func DeadlineAtLTE(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldDeadlineAt, v))
+}
+
+// DeadlineAtIsNil applies the IsNil predicate on the "deadline_at" field.
+func DeadlineAtIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldDeadlineAt))
+}
+
+// DeadlineAtNotNil applies the NotNil predicate on the "deadline_at" field.
+func DeadlineAtNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldDeadlineAt))
+}
+
 // HasInterview applies the HasEdge predicate on the "interview" edge.
 func HasInterview() predicate.Question {
 	return predicate.Question(func(s *sql.Selector) {
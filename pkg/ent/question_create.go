@@ -11,6 +11,7 @@ import (
 	"irelia/pkg/ent/question"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +21,7 @@ type QuestionCreate struct {
 	config
 	mutation *QuestionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -50,6 +52,20 @@ func (qc *QuestionCreate) SetNillableUpdatedAt(t *time.Time) *QuestionCreate {
 	return qc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (qc *QuestionCreate) SetDeletedAt(t time.Time) *QuestionCreate {
+	qc.mutation.SetDeletedAt(t)
+	return qc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableDeletedAt(t *time.Time) *QuestionCreate {
+	if t != nil {
+		qc.SetDeletedAt(*t)
+	}
+	return qc
+}
+
 // SetInterviewID sets the "interview_id" field.
 func (qc *QuestionCreate) SetInterviewID(s string) *QuestionCreate {
 	qc.mutation.SetInterviewID(s)
@@ -62,6 +78,20 @@ func (qc *QuestionCreate) SetQuestionIndex(i int32) *QuestionCreate {
 	return qc
 }
 
+// SetSubIndex sets the "sub_index" field.
+func (qc *QuestionCreate) SetSubIndex(i int32) *QuestionCreate {
+	qc.mutation.SetSubIndex(i)
+	return qc
+}
+
+// SetNillableSubIndex sets the "sub_index" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableSubIndex(i *int32) *QuestionCreate {
+	if i != nil {
+		qc.SetSubIndex(*i)
+	}
+	return qc
+}
+
 // SetContent sets the "content" field.
 func (qc *QuestionCreate) SetContent(s string) *QuestionCreate {
 	qc.mutation.SetContent(s)
@@ -82,12 +112,40 @@ func (qc *QuestionCreate) SetNillableAudio(s *string) *QuestionCreate {
 	return qc
 }
 
+// SetAudioKey sets the "audio_key" field.
+func (qc *QuestionCreate) SetAudioKey(s string) *QuestionCreate {
+	qc.mutation.SetAudioKey(s)
+	return qc
+}
+
+// SetNillableAudioKey sets the "audio_key" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableAudioKey(s *string) *QuestionCreate {
+	if s != nil {
+		qc.SetAudioKey(*s)
+	}
+	return qc
+}
+
 // SetLipsync sets the "lipsync" field.
 func (qc *QuestionCreate) SetLipsync(isd *irelia.LipSyncData) *QuestionCreate {
 	qc.mutation.SetLipsync(isd)
 	return qc
 }
 
+// SetAudioPending sets the "audio_pending" field.
+func (qc *QuestionCreate) SetAudioPending(b bool) *QuestionCreate {
+	qc.mutation.SetAudioPending(b)
+	return qc
+}
+
+// SetNillableAudioPending sets the "audio_pending" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableAudioPending(b *bool) *QuestionCreate {
+	if b != nil {
+		qc.SetAudioPending(*b)
+	}
+	return qc
+}
+
 // SetAnswer sets the "answer" field.
 func (qc *QuestionCreate) SetAnswer(s string) *QuestionCreate {
 	qc.mutation.SetAnswer(s)
@@ -150,6 +208,20 @@ func (qc *QuestionCreate) SetStatus(is irelia.QuestionStatus) *QuestionCreate {
 	return qc
 }
 
+// SetDeadlineAt sets the "deadline_at" field.
+func (qc *QuestionCreate) SetDeadlineAt(t time.Time) *QuestionCreate {
+	qc.mutation.SetDeadlineAt(t)
+	return qc
+}
+
+// SetNillableDeadlineAt sets the "deadline_at" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableDeadlineAt(t *time.Time) *QuestionCreate {
+	if t != nil {
+		qc.SetDeadlineAt(*t)
+	}
+	return qc
+}
+
 // SetInterview sets the "interview" edge to the Interview entity.
 func (qc *QuestionCreate) SetInterview(i *Interview) *QuestionCreate {
 	return qc.SetInterviewID(i.ID)
@@ -198,6 +270,14 @@ func (qc *QuestionCreate) defaults() {
 		v := question.DefaultUpdatedAt()
 		qc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := qc.mutation.SubIndex(); !ok {
+		v := question.DefaultSubIndex
+		qc.mutation.SetSubIndex(v)
+	}
+	if _, ok := qc.mutation.AudioPending(); !ok {
+		v := question.DefaultAudioPending
+		qc.mutation.SetAudioPending(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -219,6 +299,9 @@ func (qc *QuestionCreate) check() error {
 	if _, ok := qc.mutation.QuestionIndex(); !ok {
 		return &ValidationError{Name: "question_index", err: errors.New(`ent: missing required field "Question.question_index"`)}
 	}
+	if _, ok := qc.mutation.SubIndex(); !ok {
+		return &ValidationError{Name: "sub_index", err: errors.New(`ent: missing required field "Question.sub_index"`)}
+	}
 	if _, ok := qc.mutation.Content(); !ok {
 		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Question.content"`)}
 	}
@@ -227,6 +310,9 @@ func (qc *QuestionCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Question.content": %w`, err)}
 		}
 	}
+	if _, ok := qc.mutation.AudioPending(); !ok {
+		return &ValidationError{Name: "audio_pending", err: errors.New(`ent: missing required field "Question.audio_pending"`)}
+	}
 	if _, ok := qc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Question.status"`)}
 	}
@@ -259,6 +345,7 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_node = &Question{config: qc.config}
 		_spec = sqlgraph.NewCreateSpec(question.Table, sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = qc.conflict
 	if value, ok := qc.mutation.CreatedAt(); ok {
 		_spec.SetField(question.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -267,10 +354,18 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_spec.SetField(question.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := qc.mutation.DeletedAt(); ok {
+		_spec.SetField(question.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := qc.mutation.QuestionIndex(); ok {
 		_spec.SetField(question.FieldQuestionIndex, field.TypeInt32, value)
 		_node.QuestionIndex = value
 	}
+	if value, ok := qc.mutation.SubIndex(); ok {
+		_spec.SetField(question.FieldSubIndex, field.TypeInt32, value)
+		_node.SubIndex = value
+	}
 	if value, ok := qc.mutation.Content(); ok {
 		_spec.SetField(question.FieldContent, field.TypeString, value)
 		_node.Content = value
@@ -279,10 +374,18 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_spec.SetField(question.FieldAudio, field.TypeString, value)
 		_node.Audio = value
 	}
+	if value, ok := qc.mutation.AudioKey(); ok {
+		_spec.SetField(question.FieldAudioKey, field.TypeString, value)
+		_node.AudioKey = value
+	}
 	if value, ok := qc.mutation.Lipsync(); ok {
 		_spec.SetField(question.FieldLipsync, field.TypeJSON, value)
 		_node.Lipsync = value
 	}
+	if value, ok := qc.mutation.AudioPending(); ok {
+		_spec.SetField(question.FieldAudioPending, field.TypeBool, value)
+		_node.AudioPending = value
+	}
 	if value, ok := qc.mutation.Answer(); ok {
 		_spec.SetField(question.FieldAnswer, field.TypeString, value)
 		_node.Answer = value
@@ -303,6 +406,10 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_spec.SetField(question.FieldStatus, field.TypeInt32, value)
 		_node.Status = value
 	}
+	if value, ok := qc.mutation.DeadlineAt(); ok {
+		_spec.SetField(question.FieldDeadlineAt, field.TypeTime, value)
+		_node.DeadlineAt = &value
+	}
 	if nodes := qc.mutation.InterviewIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -323,11 +430,616 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Question.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (qc *QuestionCreate) OnConflict(opts ...sql.ConflictOption) *QuestionUpsertOne {
+	qc.conflict = opts
+	return &QuestionUpsertOne{
+		create: qc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qc *QuestionCreate) OnConflictColumns(columns ...string) *QuestionUpsertOne {
+	qc.conflict = append(qc.conflict, sql.ConflictColumns(columns...))
+	return &QuestionUpsertOne{
+		create: qc,
+	}
+}
+
+type (
+	// QuestionUpsertOne is the builder for "upsert"-ing
+	//  one Question node.
+	QuestionUpsertOne struct {
+		create *QuestionCreate
+	}
+
+	// QuestionUpsert is the "OnConflict" setter.
+	QuestionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionUpsert) SetUpdatedAt(v time.Time) *QuestionUpsert {
+	u.Set(question.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateUpdatedAt() *QuestionUpsert {
+	u.SetExcluded(question.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *QuestionUpsert) SetDeletedAt(v time.Time) *QuestionUpsert {
+	u.Set(question.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateDeletedAt() *QuestionUpsert {
+	u.SetExcluded(question.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *QuestionUpsert) ClearDeletedAt() *QuestionUpsert {
+	u.SetNull(question.FieldDeletedAt)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *QuestionUpsert) SetContent(v string) *QuestionUpsert {
+	u.Set(question.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateContent() *QuestionUpsert {
+	u.SetExcluded(question.FieldContent)
+	return u
+}
+
+// SetAudio sets the "audio" field.
+func (u *QuestionUpsert) SetAudio(v string) *QuestionUpsert {
+	u.Set(question.FieldAudio, v)
+	return u
+}
+
+// UpdateAudio sets the "audio" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateAudio() *QuestionUpsert {
+	u.SetExcluded(question.FieldAudio)
+	return u
+}
+
+// ClearAudio clears the value of the "audio" field.
+func (u *QuestionUpsert) ClearAudio() *QuestionUpsert {
+	u.SetNull(question.FieldAudio)
+	return u
+}
+
+// SetAudioKey sets the "audio_key" field.
+func (u *QuestionUpsert) SetAudioKey(v string) *QuestionUpsert {
+	u.Set(question.FieldAudioKey, v)
+	return u
+}
+
+// UpdateAudioKey sets the "audio_key" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateAudioKey() *QuestionUpsert {
+	u.SetExcluded(question.FieldAudioKey)
+	return u
+}
+
+// ClearAudioKey clears the value of the "audio_key" field.
+func (u *QuestionUpsert) ClearAudioKey() *QuestionUpsert {
+	u.SetNull(question.FieldAudioKey)
+	return u
+}
+
+// SetLipsync sets the "lipsync" field.
+func (u *QuestionUpsert) SetLipsync(v *irelia.LipSyncData) *QuestionUpsert {
+	u.Set(question.FieldLipsync, v)
+	return u
+}
+
+// UpdateLipsync sets the "lipsync" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateLipsync() *QuestionUpsert {
+	u.SetExcluded(question.FieldLipsync)
+	return u
+}
+
+// ClearLipsync clears the value of the "lipsync" field.
+func (u *QuestionUpsert) ClearLipsync() *QuestionUpsert {
+	u.SetNull(question.FieldLipsync)
+	return u
+}
+
+// SetAudioPending sets the "audio_pending" field.
+func (u *QuestionUpsert) SetAudioPending(v bool) *QuestionUpsert {
+	u.Set(question.FieldAudioPending, v)
+	return u
+}
+
+// UpdateAudioPending sets the "audio_pending" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateAudioPending() *QuestionUpsert {
+	u.SetExcluded(question.FieldAudioPending)
+	return u
+}
+
+// SetAnswer sets the "answer" field.
+func (u *QuestionUpsert) SetAnswer(v string) *QuestionUpsert {
+	u.Set(question.FieldAnswer, v)
+	return u
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateAnswer() *QuestionUpsert {
+	u.SetExcluded(question.FieldAnswer)
+	return u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (u *QuestionUpsert) ClearAnswer() *QuestionUpsert {
+	u.SetNull(question.FieldAnswer)
+	return u
+}
+
+// SetRecordProof sets the "record_proof" field.
+func (u *QuestionUpsert) SetRecordProof(v string) *QuestionUpsert {
+	u.Set(question.FieldRecordProof, v)
+	return u
+}
+
+// UpdateRecordProof sets the "record_proof" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateRecordProof() *QuestionUpsert {
+	u.SetExcluded(question.FieldRecordProof)
+	return u
+}
+
+// ClearRecordProof clears the value of the "record_proof" field.
+func (u *QuestionUpsert) ClearRecordProof() *QuestionUpsert {
+	u.SetNull(question.FieldRecordProof)
+	return u
+}
+
+// SetComment sets the "comment" field.
+func (u *QuestionUpsert) SetComment(v string) *QuestionUpsert {
+	u.Set(question.FieldComment, v)
+	return u
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateComment() *QuestionUpsert {
+	u.SetExcluded(question.FieldComment)
+	return u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (u *QuestionUpsert) ClearComment() *QuestionUpsert {
+	u.SetNull(question.FieldComment)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *QuestionUpsert) SetScore(v string) *QuestionUpsert {
+	u.Set(question.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateScore() *QuestionUpsert {
+	u.SetExcluded(question.FieldScore)
+	return u
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *QuestionUpsert) ClearScore() *QuestionUpsert {
+	u.SetNull(question.FieldScore)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestionUpsert) SetStatus(v irelia.QuestionStatus) *QuestionUpsert {
+	u.Set(question.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateStatus() *QuestionUpsert {
+	u.SetExcluded(question.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *QuestionUpsert) AddStatus(v irelia.QuestionStatus) *QuestionUpsert {
+	u.Add(question.FieldStatus, v)
+	return u
+}
+
+// SetDeadlineAt sets the "deadline_at" field.
+func (u *QuestionUpsert) SetDeadlineAt(v time.Time) *QuestionUpsert {
+	u.Set(question.FieldDeadlineAt, v)
+	return u
+}
+
+// UpdateDeadlineAt sets the "deadline_at" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateDeadlineAt() *QuestionUpsert {
+	u.SetExcluded(question.FieldDeadlineAt)
+	return u
+}
+
+// ClearDeadlineAt clears the value of the "deadline_at" field.
+func (u *QuestionUpsert) ClearDeadlineAt() *QuestionUpsert {
+	u.SetNull(question.FieldDeadlineAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuestionUpsertOne) UpdateNewValues() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(question.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.InterviewID(); exists {
+			s.SetIgnore(question.FieldInterviewID)
+		}
+		if _, exists := u.create.mutation.QuestionIndex(); exists {
+			s.SetIgnore(question.FieldQuestionIndex)
+		}
+		if _, exists := u.create.mutation.SubIndex(); exists {
+			s.SetIgnore(question.FieldSubIndex)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionUpsertOne) Ignore() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionUpsertOne) DoNothing() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionCreate.OnConflict
+// documentation for more info.
+func (u *QuestionUpsertOne) Update(set func(*QuestionUpsert)) *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionUpsertOne) SetUpdatedAt(v time.Time) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateUpdatedAt() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *QuestionUpsertOne) SetDeletedAt(v time.Time) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateDeletedAt() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *QuestionUpsertOne) ClearDeletedAt() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *QuestionUpsertOne) SetContent(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateContent() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetAudio sets the "audio" field.
+func (u *QuestionUpsertOne) SetAudio(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAudio(v)
+	})
+}
+
+// UpdateAudio sets the "audio" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateAudio() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAudio()
+	})
+}
+
+// ClearAudio clears the value of the "audio" field.
+func (u *QuestionUpsertOne) ClearAudio() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearAudio()
+	})
+}
+
+// SetAudioKey sets the "audio_key" field.
+func (u *QuestionUpsertOne) SetAudioKey(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAudioKey(v)
+	})
+}
+
+// UpdateAudioKey sets the "audio_key" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateAudioKey() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAudioKey()
+	})
+}
+
+// ClearAudioKey clears the value of the "audio_key" field.
+func (u *QuestionUpsertOne) ClearAudioKey() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearAudioKey()
+	})
+}
+
+// SetLipsync sets the "lipsync" field.
+func (u *QuestionUpsertOne) SetLipsync(v *irelia.LipSyncData) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetLipsync(v)
+	})
+}
+
+// UpdateLipsync sets the "lipsync" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateLipsync() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateLipsync()
+	})
+}
+
+// ClearLipsync clears the value of the "lipsync" field.
+func (u *QuestionUpsertOne) ClearLipsync() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearLipsync()
+	})
+}
+
+// SetAudioPending sets the "audio_pending" field.
+func (u *QuestionUpsertOne) SetAudioPending(v bool) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAudioPending(v)
+	})
+}
+
+// UpdateAudioPending sets the "audio_pending" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateAudioPending() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAudioPending()
+	})
+}
+
+// SetAnswer sets the "answer" field.
+func (u *QuestionUpsertOne) SetAnswer(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAnswer(v)
+	})
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateAnswer() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAnswer()
+	})
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (u *QuestionUpsertOne) ClearAnswer() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearAnswer()
+	})
+}
+
+// SetRecordProof sets the "record_proof" field.
+func (u *QuestionUpsertOne) SetRecordProof(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetRecordProof(v)
+	})
+}
+
+// UpdateRecordProof sets the "record_proof" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateRecordProof() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateRecordProof()
+	})
+}
+
+// ClearRecordProof clears the value of the "record_proof" field.
+func (u *QuestionUpsertOne) ClearRecordProof() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearRecordProof()
+	})
+}
+
+// SetComment sets the "comment" field.
+func (u *QuestionUpsertOne) SetComment(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetComment(v)
+	})
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateComment() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateComment()
+	})
+}
+
+// ClearComment clears the value of the "comment" field.
+func (u *QuestionUpsertOne) ClearComment() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearComment()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *QuestionUpsertOne) SetScore(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateScore() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *QuestionUpsertOne) ClearScore() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearScore()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestionUpsertOne) SetStatus(v irelia.QuestionStatus) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *QuestionUpsertOne) AddStatus(v irelia.QuestionStatus) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateStatus() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDeadlineAt sets the "deadline_at" field.
+func (u *QuestionUpsertOne) SetDeadlineAt(v time.Time) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetDeadlineAt(v)
+	})
+}
+
+// UpdateDeadlineAt sets the "deadline_at" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateDeadlineAt() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateDeadlineAt()
+	})
+}
+
+// ClearDeadlineAt clears the value of the "deadline_at" field.
+func (u *QuestionUpsertOne) ClearDeadlineAt() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearDeadlineAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // QuestionCreateBulk is the builder for creating many Question entities in bulk.
 type QuestionCreateBulk struct {
 	config
 	err      error
 	builders []*QuestionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Question entities in the database.
@@ -357,6 +1069,7 @@ func (qcb *QuestionCreateBulk) Save(ctx context.Context) ([]*Question, error) {
 					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -410,3 +1123,375 @@ func (qcb *QuestionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Question.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (qcb *QuestionCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionUpsertBulk {
+	qcb.conflict = opts
+	return &QuestionUpsertBulk{
+		create: qcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qcb *QuestionCreateBulk) OnConflictColumns(columns ...string) *QuestionUpsertBulk {
+	qcb.conflict = append(qcb.conflict, sql.ConflictColumns(columns...))
+	return &QuestionUpsertBulk{
+		create: qcb,
+	}
+}
+
+// QuestionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Question nodes.
+type QuestionUpsertBulk struct {
+	create *QuestionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuestionUpsertBulk) UpdateNewValues() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(question.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.InterviewID(); exists {
+				s.SetIgnore(question.FieldInterviewID)
+			}
+			if _, exists := b.mutation.QuestionIndex(); exists {
+				s.SetIgnore(question.FieldQuestionIndex)
+			}
+			if _, exists := b.mutation.SubIndex(); exists {
+				s.SetIgnore(question.FieldSubIndex)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionUpsertBulk) Ignore() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionUpsertBulk) DoNothing() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionUpsertBulk) Update(set func(*QuestionUpsert)) *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionUpsertBulk) SetUpdatedAt(v time.Time) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateUpdatedAt() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *QuestionUpsertBulk) SetDeletedAt(v time.Time) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateDeletedAt() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *QuestionUpsertBulk) ClearDeletedAt() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *QuestionUpsertBulk) SetContent(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateContent() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetAudio sets the "audio" field.
+func (u *QuestionUpsertBulk) SetAudio(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAudio(v)
+	})
+}
+
+// UpdateAudio sets the "audio" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateAudio() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAudio()
+	})
+}
+
+// ClearAudio clears the value of the "audio" field.
+func (u *QuestionUpsertBulk) ClearAudio() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearAudio()
+	})
+}
+
+// SetAudioKey sets the "audio_key" field.
+func (u *QuestionUpsertBulk) SetAudioKey(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAudioKey(v)
+	})
+}
+
+// UpdateAudioKey sets the "audio_key" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateAudioKey() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAudioKey()
+	})
+}
+
+// ClearAudioKey clears the value of the "audio_key" field.
+func (u *QuestionUpsertBulk) ClearAudioKey() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearAudioKey()
+	})
+}
+
+// SetLipsync sets the "lipsync" field.
+func (u *QuestionUpsertBulk) SetLipsync(v *irelia.LipSyncData) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetLipsync(v)
+	})
+}
+
+// UpdateLipsync sets the "lipsync" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateLipsync() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateLipsync()
+	})
+}
+
+// ClearLipsync clears the value of the "lipsync" field.
+func (u *QuestionUpsertBulk) ClearLipsync() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearLipsync()
+	})
+}
+
+// SetAudioPending sets the "audio_pending" field.
+func (u *QuestionUpsertBulk) SetAudioPending(v bool) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAudioPending(v)
+	})
+}
+
+// UpdateAudioPending sets the "audio_pending" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateAudioPending() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAudioPending()
+	})
+}
+
+// SetAnswer sets the "answer" field.
+func (u *QuestionUpsertBulk) SetAnswer(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAnswer(v)
+	})
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateAnswer() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAnswer()
+	})
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (u *QuestionUpsertBulk) ClearAnswer() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearAnswer()
+	})
+}
+
+// SetRecordProof sets the "record_proof" field.
+func (u *QuestionUpsertBulk) SetRecordProof(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetRecordProof(v)
+	})
+}
+
+// UpdateRecordProof sets the "record_proof" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateRecordProof() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateRecordProof()
+	})
+}
+
+// ClearRecordProof clears the value of the "record_proof" field.
+func (u *QuestionUpsertBulk) ClearRecordProof() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearRecordProof()
+	})
+}
+
+// SetComment sets the "comment" field.
+func (u *QuestionUpsertBulk) SetComment(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetComment(v)
+	})
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateComment() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateComment()
+	})
+}
+
+// ClearComment clears the value of the "comment" field.
+func (u *QuestionUpsertBulk) ClearComment() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearComment()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *QuestionUpsertBulk) SetScore(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateScore() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *QuestionUpsertBulk) ClearScore() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearScore()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestionUpsertBulk) SetStatus(v irelia.QuestionStatus) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *QuestionUpsertBulk) AddStatus(v irelia.QuestionStatus) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateStatus() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDeadlineAt sets the "deadline_at" field.
+func (u *QuestionUpsertBulk) SetDeadlineAt(v time.Time) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetDeadlineAt(v)
+	})
+}
+
+// UpdateDeadlineAt sets the "deadline_at" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateDeadlineAt() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateDeadlineAt()
+	})
+}
+
+// ClearDeadlineAt clears the value of the "deadline_at" field.
+func (u *QuestionUpsertBulk) ClearDeadlineAt() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearDeadlineAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -35,6 +35,26 @@ func (qu *QuestionUpdate) SetUpdatedAt(t time.Time) *QuestionUpdate {
 	return qu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (qu *QuestionUpdate) SetDeletedAt(t time.Time) *QuestionUpdate {
+	qu.mutation.SetDeletedAt(t)
+	return qu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableDeletedAt(t *time.Time) *QuestionUpdate {
+	if t != nil {
+		qu.SetDeletedAt(*t)
+	}
+	return qu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (qu *QuestionUpdate) ClearDeletedAt() *QuestionUpdate {
+	qu.mutation.ClearDeletedAt()
+	return qu
+}
+
 // SetContent sets the "content" field.
 func (qu *QuestionUpdate) SetContent(s string) *QuestionUpdate {
 	qu.mutation.SetContent(s)
@@ -69,6 +89,26 @@ func (qu *QuestionUpdate) ClearAudio() *QuestionUpdate {
 	return qu
 }
 
+// SetAudioKey sets the "audio_key" field.
+func (qu *QuestionUpdate) SetAudioKey(s string) *QuestionUpdate {
+	qu.mutation.SetAudioKey(s)
+	return qu
+}
+
+// SetNillableAudioKey sets the "audio_key" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableAudioKey(s *string) *QuestionUpdate {
+	if s != nil {
+		qu.SetAudioKey(*s)
+	}
+	return qu
+}
+
+// ClearAudioKey clears the value of the "audio_key" field.
+func (qu *QuestionUpdate) ClearAudioKey() *QuestionUpdate {
+	qu.mutation.ClearAudioKey()
+	return qu
+}
+
 // SetLipsync sets the "lipsync" field.
 func (qu *QuestionUpdate) SetLipsync(isd *irelia.LipSyncData) *QuestionUpdate {
 	qu.mutation.SetLipsync(isd)
@@ -81,6 +121,20 @@ func (qu *QuestionUpdate) ClearLipsync() *QuestionUpdate {
 	return qu
 }
 
+// SetAudioPending sets the "audio_pending" field.
+func (qu *QuestionUpdate) SetAudioPending(b bool) *QuestionUpdate {
+	qu.mutation.SetAudioPending(b)
+	return qu
+}
+
+// SetNillableAudioPending sets the "audio_pending" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableAudioPending(b *bool) *QuestionUpdate {
+	if b != nil {
+		qu.SetAudioPending(*b)
+	}
+	return qu
+}
+
 // SetAnswer sets the "answer" field.
 func (qu *QuestionUpdate) SetAnswer(s string) *QuestionUpdate {
 	qu.mutation.SetAnswer(s)
@@ -182,6 +236,26 @@ func (qu *QuestionUpdate) AddStatus(is irelia.QuestionStatus) *QuestionUpdate {
 	return qu
 }
 
+// SetDeadlineAt sets the "deadline_at" field.
+func (qu *QuestionUpdate) SetDeadlineAt(t time.Time) *QuestionUpdate {
+	qu.mutation.SetDeadlineAt(t)
+	return qu
+}
+
+// SetNillableDeadlineAt sets the "deadline_at" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableDeadlineAt(t *time.Time) *QuestionUpdate {
+	if t != nil {
+		qu.SetDeadlineAt(*t)
+	}
+	return qu
+}
+
+// ClearDeadlineAt clears the value of the "deadline_at" field.
+func (qu *QuestionUpdate) ClearDeadlineAt() *QuestionUpdate {
+	qu.mutation.ClearDeadlineAt()
+	return qu
+}
+
 // Mutation returns the QuestionMutation object of the builder.
 func (qu *QuestionUpdate) Mutation() *QuestionMutation {
 	return qu.mutation
@@ -251,6 +325,12 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := qu.mutation.UpdatedAt(); ok {
 		_spec.SetField(question.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := qu.mutation.DeletedAt(); ok {
+		_spec.SetField(question.FieldDeletedAt, field.TypeTime, value)
+	}
+	if qu.mutation.DeletedAtCleared() {
+		_spec.ClearField(question.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := qu.mutation.Content(); ok {
 		_spec.SetField(question.FieldContent, field.TypeString, value)
 	}
@@ -260,12 +340,21 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if qu.mutation.AudioCleared() {
 		_spec.ClearField(question.FieldAudio, field.TypeString)
 	}
+	if value, ok := qu.mutation.AudioKey(); ok {
+		_spec.SetField(question.FieldAudioKey, field.TypeString, value)
+	}
+	if qu.mutation.AudioKeyCleared() {
+		_spec.ClearField(question.FieldAudioKey, field.TypeString)
+	}
 	if value, ok := qu.mutation.Lipsync(); ok {
 		_spec.SetField(question.FieldLipsync, field.TypeJSON, value)
 	}
 	if qu.mutation.LipsyncCleared() {
 		_spec.ClearField(question.FieldLipsync, field.TypeJSON)
 	}
+	if value, ok := qu.mutation.AudioPending(); ok {
+		_spec.SetField(question.FieldAudioPending, field.TypeBool, value)
+	}
 	if value, ok := qu.mutation.Answer(); ok {
 		_spec.SetField(question.FieldAnswer, field.TypeString, value)
 	}
@@ -296,6 +385,12 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := qu.mutation.AddedStatus(); ok {
 		_spec.AddField(question.FieldStatus, field.TypeInt32, value)
 	}
+	if value, ok := qu.mutation.DeadlineAt(); ok {
+		_spec.SetField(question.FieldDeadlineAt, field.TypeTime, value)
+	}
+	if qu.mutation.DeadlineAtCleared() {
+		_spec.ClearField(question.FieldDeadlineAt, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{question.Label}
@@ -322,6 +417,26 @@ func (quo *QuestionUpdateOne) SetUpdatedAt(t time.Time) *QuestionUpdateOne {
 	return quo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (quo *QuestionUpdateOne) SetDeletedAt(t time.Time) *QuestionUpdateOne {
+	quo.mutation.SetDeletedAt(t)
+	return quo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableDeletedAt(t *time.Time) *QuestionUpdateOne {
+	if t != nil {
+		quo.SetDeletedAt(*t)
+	}
+	return quo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (quo *QuestionUpdateOne) ClearDeletedAt() *QuestionUpdateOne {
+	quo.mutation.ClearDeletedAt()
+	return quo
+}
+
 // SetContent sets the "content" field.
 func (quo *QuestionUpdateOne) SetContent(s string) *QuestionUpdateOne {
 	quo.mutation.SetContent(s)
@@ -356,6 +471,26 @@ func (quo *QuestionUpdateOne) ClearAudio() *QuestionUpdateOne {
 	return quo
 }
 
+// SetAudioKey sets the "audio_key" field.
+func (quo *QuestionUpdateOne) SetAudioKey(s string) *QuestionUpdateOne {
+	quo.mutation.SetAudioKey(s)
+	return quo
+}
+
+// SetNillableAudioKey sets the "audio_key" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableAudioKey(s *string) *QuestionUpdateOne {
+	if s != nil {
+		quo.SetAudioKey(*s)
+	}
+	return quo
+}
+
+// ClearAudioKey clears the value of the "audio_key" field.
+func (quo *QuestionUpdateOne) ClearAudioKey() *QuestionUpdateOne {
+	quo.mutation.ClearAudioKey()
+	return quo
+}
+
 // SetLipsync sets the "lipsync" field.
 func (quo *QuestionUpdateOne) SetLipsync(isd *irelia.LipSyncData) *QuestionUpdateOne {
 	quo.mutation.SetLipsync(isd)
@@ -368,6 +503,20 @@ func (quo *QuestionUpdateOne) ClearLipsync() *QuestionUpdateOne {
 	return quo
 }
 
+// SetAudioPending sets the "audio_pending" field.
+func (quo *QuestionUpdateOne) SetAudioPending(b bool) *QuestionUpdateOne {
+	quo.mutation.SetAudioPending(b)
+	return quo
+}
+
+// SetNillableAudioPending sets the "audio_pending" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableAudioPending(b *bool) *QuestionUpdateOne {
+	if b != nil {
+		quo.SetAudioPending(*b)
+	}
+	return quo
+}
+
 // SetAnswer sets the "answer" field.
 func (quo *QuestionUpdateOne) SetAnswer(s string) *QuestionUpdateOne {
 	quo.mutation.SetAnswer(s)
@@ -469,6 +618,26 @@ func (quo *QuestionUpdateOne) AddStatus(is irelia.QuestionStatus) *QuestionUpdat
 	return quo
 }
 
+// SetDeadlineAt sets the "deadline_at" field.
+func (quo *QuestionUpdateOne) SetDeadlineAt(t time.Time) *QuestionUpdateOne {
+	quo.mutation.SetDeadlineAt(t)
+	return quo
+}
+
+// SetNillableDeadlineAt sets the "deadline_at" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableDeadlineAt(t *time.Time) *QuestionUpdateOne {
+	if t != nil {
+		quo.SetDeadlineAt(*t)
+	}
+	return quo
+}
+
+// ClearDeadlineAt clears the value of the "deadline_at" field.
+func (quo *QuestionUpdateOne) ClearDeadlineAt() *QuestionUpdateOne {
+	quo.mutation.ClearDeadlineAt()
+	return quo
+}
+
 // Mutation returns the QuestionMutation object of the builder.
 func (quo *QuestionUpdateOne) Mutation() *QuestionMutation {
 	return quo.mutation
@@ -568,6 +737,12 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 	if value, ok := quo.mutation.UpdatedAt(); ok {
 		_spec.SetField(question.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := quo.mutation.DeletedAt(); ok {
+		_spec.SetField(question.FieldDeletedAt, field.TypeTime, value)
+	}
+	if quo.mutation.DeletedAtCleared() {
+		_spec.ClearField(question.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := quo.mutation.Content(); ok {
 		_spec.SetField(question.FieldContent, field.TypeString, value)
 	}
@@ -577,12 +752,21 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 	if quo.mutation.AudioCleared() {
 		_spec.ClearField(question.FieldAudio, field.TypeString)
 	}
+	if value, ok := quo.mutation.AudioKey(); ok {
+		_spec.SetField(question.FieldAudioKey, field.TypeString, value)
+	}
+	if quo.mutation.AudioKeyCleared() {
+		_spec.ClearField(question.FieldAudioKey, field.TypeString)
+	}
 	if value, ok := quo.mutation.Lipsync(); ok {
 		_spec.SetField(question.FieldLipsync, field.TypeJSON, value)
 	}
 	if quo.mutation.LipsyncCleared() {
 		_spec.ClearField(question.FieldLipsync, field.TypeJSON)
 	}
+	if value, ok := quo.mutation.AudioPending(); ok {
+		_spec.SetField(question.FieldAudioPending, field.TypeBool, value)
+	}
 	if value, ok := quo.mutation.Answer(); ok {
 		_spec.SetField(question.FieldAnswer, field.TypeString, value)
 	}
@@ -613,6 +797,12 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 	if value, ok := quo.mutation.AddedStatus(); ok {
 		_spec.AddField(question.FieldStatus, field.TypeInt32, value)
 	}
+	if value, ok := quo.mutation.DeadlineAt(); ok {
+		_spec.SetField(question.FieldDeadlineAt, field.TypeTime, value)
+	}
+	if quo.mutation.DeadlineAtCleared() {
+		_spec.ClearField(question.FieldDeadlineAt, field.TypeTime)
+	}
 	_node = &Question{config: quo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"irelia/pkg/ent/quota"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Quota is the model entity for the Quota schema.
+type Quota struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day time.Time `json:"day,omitempty"`
+	// Interviews holds the value of the "interviews" field.
+	Interviews int32 `json:"interviews,omitempty"`
+	// Questions holds the value of the "questions" field.
+	Questions    int32 `json:"questions,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quota) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldID, quota.FieldUserID, quota.FieldInterviews, quota.FieldQuestions:
+			values[i] = new(sql.NullInt64)
+		case quota.FieldCreatedAt, quota.FieldUpdatedAt, quota.FieldDeletedAt, quota.FieldDay:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quota fields.
+func (q *Quota) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			q.ID = int(value.Int64)
+		case quota.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				q.CreatedAt = value.Time
+			}
+		case quota.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				q.UpdatedAt = value.Time
+			}
+		case quota.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				q.DeletedAt = new(time.Time)
+				*q.DeletedAt = value.Time
+			}
+		case quota.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				q.UserID = uint64(value.Int64)
+			}
+		case quota.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				q.Day = value.Time
+			}
+		case quota.FieldInterviews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interviews", values[i])
+			} else if value.Valid {
+				q.Interviews = int32(value.Int64)
+			}
+		case quota.FieldQuestions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field questions", values[i])
+			} else if value.Valid {
+				q.Questions = int32(value.Int64)
+			}
+		default:
+			q.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quota.
+// This includes values selected through modifiers, order, etc.
+func (q *Quota) Value(name string) (ent.Value, error) {
+	return q.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Quota.
+// Note that you need to call Quota.Unwrap() before calling this method if this Quota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Quota) Update() *QuotaUpdateOne {
+	return NewQuotaClient(q.config).UpdateOne(q)
+}
+
+// Unwrap unwraps the Quota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Quota) Unwrap() *Quota {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quota is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Quota) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(q.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(q.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := q.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", q.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(q.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("interviews=")
+	builder.WriteString(fmt.Sprintf("%v", q.Interviews))
+	builder.WriteString(", ")
+	builder.WriteString("questions=")
+	builder.WriteString(fmt.Sprintf("%v", q.Questions))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuotaSlice is a parsable slice of Quota.
+type QuotaSlice []*Quota
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quota
+
+import (
+	"irelia/pkg/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUserID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldDay, v))
+}
+
+// Interviews applies equality check predicate on the "interviews" field. It's identical to InterviewsEQ.
+func Interviews(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldInterviews, v))
+}
+
+// Questions applies equality check predicate on the "questions" field. It's identical to QuestionsEQ.
+func Questions(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldQuestions, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint64) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldUserID, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldDay, v))
+}
+
+// InterviewsEQ applies the EQ predicate on the "interviews" field.
+func InterviewsEQ(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldInterviews, v))
+}
+
+// InterviewsNEQ applies the NEQ predicate on the "interviews" field.
+func InterviewsNEQ(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldInterviews, v))
+}
+
+// InterviewsIn applies the In predicate on the "interviews" field.
+func InterviewsIn(vs ...int32) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldInterviews, vs...))
+}
+
+// InterviewsNotIn applies the NotIn predicate on the "interviews" field.
+func InterviewsNotIn(vs ...int32) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldInterviews, vs...))
+}
+
+// InterviewsGT applies the GT predicate on the "interviews" field.
+func InterviewsGT(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldInterviews, v))
+}
+
+// InterviewsGTE applies the GTE predicate on the "interviews" field.
+func InterviewsGTE(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldInterviews, v))
+}
+
+// InterviewsLT applies the LT predicate on the "interviews" field.
+func InterviewsLT(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldInterviews, v))
+}
+
+// InterviewsLTE applies the LTE predicate on the "interviews" field.
+func InterviewsLTE(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldInterviews, v))
+}
+
+// QuestionsEQ applies the EQ predicate on the "questions" field.
+func QuestionsEQ(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldQuestions, v))
+}
+
+// QuestionsNEQ applies the NEQ predicate on the "questions" field.
+func QuestionsNEQ(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldQuestions, v))
+}
+
+// QuestionsIn applies the In predicate on the "questions" field.
+func QuestionsIn(vs ...int32) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldQuestions, vs...))
+}
+
+// QuestionsNotIn applies the NotIn predicate on the "questions" field.
+func QuestionsNotIn(vs ...int32) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldQuestions, vs...))
+}
+
+// QuestionsGT applies the GT predicate on the "questions" field.
+func QuestionsGT(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldQuestions, v))
+}
+
+// QuestionsGTE applies the GTE predicate on the "questions" field.
+func QuestionsGTE(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldQuestions, v))
+}
+
+// QuestionsLT applies the LT predicate on the "questions" field.
+func QuestionsLT(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldQuestions, v))
+}
+
+// QuestionsLTE applies the LTE predicate on the "questions" field.
+func QuestionsLTE(v int32) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldQuestions, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.NotPredicates(p))
+}
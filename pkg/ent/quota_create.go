@@ -0,0 +1,796 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"irelia/pkg/ent/quota"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaCreate is the builder for creating a Quota entity.
+type QuotaCreate struct {
+	config
+	mutation *QuotaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qc *QuotaCreate) SetCreatedAt(t time.Time) *QuotaCreate {
+	qc.mutation.SetCreatedAt(t)
+	return qc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableCreatedAt(t *time.Time) *QuotaCreate {
+	if t != nil {
+		qc.SetCreatedAt(*t)
+	}
+	return qc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qc *QuotaCreate) SetUpdatedAt(t time.Time) *QuotaCreate {
+	qc.mutation.SetUpdatedAt(t)
+	return qc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableUpdatedAt(t *time.Time) *QuotaCreate {
+	if t != nil {
+		qc.SetUpdatedAt(*t)
+	}
+	return qc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (qc *QuotaCreate) SetDeletedAt(t time.Time) *QuotaCreate {
+	qc.mutation.SetDeletedAt(t)
+	return qc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableDeletedAt(t *time.Time) *QuotaCreate {
+	if t != nil {
+		qc.SetDeletedAt(*t)
+	}
+	return qc
+}
+
+// SetUserID sets the "user_id" field.
+func (qc *QuotaCreate) SetUserID(u uint64) *QuotaCreate {
+	qc.mutation.SetUserID(u)
+	return qc
+}
+
+// SetDay sets the "day" field.
+func (qc *QuotaCreate) SetDay(t time.Time) *QuotaCreate {
+	qc.mutation.SetDay(t)
+	return qc
+}
+
+// SetInterviews sets the "interviews" field.
+func (qc *QuotaCreate) SetInterviews(i int32) *QuotaCreate {
+	qc.mutation.SetInterviews(i)
+	return qc
+}
+
+// SetNillableInterviews sets the "interviews" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableInterviews(i *int32) *QuotaCreate {
+	if i != nil {
+		qc.SetInterviews(*i)
+	}
+	return qc
+}
+
+// SetQuestions sets the "questions" field.
+func (qc *QuotaCreate) SetQuestions(i int32) *QuotaCreate {
+	qc.mutation.SetQuestions(i)
+	return qc
+}
+
+// SetNillableQuestions sets the "questions" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableQuestions(i *int32) *QuotaCreate {
+	if i != nil {
+		qc.SetQuestions(*i)
+	}
+	return qc
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (qc *QuotaCreate) Mutation() *QuotaMutation {
+	return qc.mutation
+}
+
+// Save creates the Quota in the database.
+func (qc *QuotaCreate) Save(ctx context.Context) (*Quota, error) {
+	qc.defaults()
+	return withHooks(ctx, qc.sqlSave, qc.mutation, qc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuotaCreate) SaveX(ctx context.Context) *Quota {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuotaCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuotaCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuotaCreate) defaults() {
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		v := quota.DefaultCreatedAt()
+		qc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		v := quota.DefaultUpdatedAt()
+		qc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := qc.mutation.Interviews(); !ok {
+		v := quota.DefaultInterviews
+		qc.mutation.SetInterviews(v)
+	}
+	if _, ok := qc.mutation.Questions(); !ok {
+		v := quota.DefaultQuestions
+		qc.mutation.SetQuestions(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuotaCreate) check() error {
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quota.created_at"`)}
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Quota.updated_at"`)}
+	}
+	if _, ok := qc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Quota.user_id"`)}
+	}
+	if _, ok := qc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "Quota.day"`)}
+	}
+	if _, ok := qc.mutation.Interviews(); !ok {
+		return &ValidationError{Name: "interviews", err: errors.New(`ent: missing required field "Quota.interviews"`)}
+	}
+	if _, ok := qc.mutation.Questions(); !ok {
+		return &ValidationError{Name: "questions", err: errors.New(`ent: missing required field "Quota.questions"`)}
+	}
+	return nil
+}
+
+func (qc *QuotaCreate) sqlSave(ctx context.Context) (*Quota, error) {
+	if err := qc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	qc.mutation.id = &_node.ID
+	qc.mutation.done = true
+	return _node, nil
+}
+
+func (qc *QuotaCreate) createSpec() (*Quota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quota{config: qc.config}
+		_spec = sqlgraph.NewCreateSpec(quota.Table, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = qc.conflict
+	if value, ok := qc.mutation.CreatedAt(); ok {
+		_spec.SetField(quota.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qc.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := qc.mutation.DeletedAt(); ok {
+		_spec.SetField(quota.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := qc.mutation.UserID(); ok {
+		_spec.SetField(quota.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := qc.mutation.Day(); ok {
+		_spec.SetField(quota.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := qc.mutation.Interviews(); ok {
+		_spec.SetField(quota.FieldInterviews, field.TypeInt32, value)
+		_node.Interviews = value
+	}
+	if value, ok := qc.mutation.Questions(); ok {
+		_spec.SetField(quota.FieldQuestions, field.TypeInt32, value)
+		_node.Questions = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Quota.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuotaUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (qc *QuotaCreate) OnConflict(opts ...sql.ConflictOption) *QuotaUpsertOne {
+	qc.conflict = opts
+	return &QuotaUpsertOne{
+		create: qc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qc *QuotaCreate) OnConflictColumns(columns ...string) *QuotaUpsertOne {
+	qc.conflict = append(qc.conflict, sql.ConflictColumns(columns...))
+	return &QuotaUpsertOne{
+		create: qc,
+	}
+}
+
+type (
+	// QuotaUpsertOne is the builder for "upsert"-ing
+	//  one Quota node.
+	QuotaUpsertOne struct {
+		create *QuotaCreate
+	}
+
+	// QuotaUpsert is the "OnConflict" setter.
+	QuotaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuotaUpsert) SetUpdatedAt(v time.Time) *QuotaUpsert {
+	u.Set(quota.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateUpdatedAt() *QuotaUpsert {
+	u.SetExcluded(quota.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *QuotaUpsert) SetDeletedAt(v time.Time) *QuotaUpsert {
+	u.Set(quota.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateDeletedAt() *QuotaUpsert {
+	u.SetExcluded(quota.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *QuotaUpsert) ClearDeletedAt() *QuotaUpsert {
+	u.SetNull(quota.FieldDeletedAt)
+	return u
+}
+
+// SetInterviews sets the "interviews" field.
+func (u *QuotaUpsert) SetInterviews(v int32) *QuotaUpsert {
+	u.Set(quota.FieldInterviews, v)
+	return u
+}
+
+// UpdateInterviews sets the "interviews" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateInterviews() *QuotaUpsert {
+	u.SetExcluded(quota.FieldInterviews)
+	return u
+}
+
+// AddInterviews adds v to the "interviews" field.
+func (u *QuotaUpsert) AddInterviews(v int32) *QuotaUpsert {
+	u.Add(quota.FieldInterviews, v)
+	return u
+}
+
+// SetQuestions sets the "questions" field.
+func (u *QuotaUpsert) SetQuestions(v int32) *QuotaUpsert {
+	u.Set(quota.FieldQuestions, v)
+	return u
+}
+
+// UpdateQuestions sets the "questions" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateQuestions() *QuotaUpsert {
+	u.SetExcluded(quota.FieldQuestions)
+	return u
+}
+
+// AddQuestions adds v to the "questions" field.
+func (u *QuotaUpsert) AddQuestions(v int32) *QuotaUpsert {
+	u.Add(quota.FieldQuestions, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuotaUpsertOne) UpdateNewValues() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(quota.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(quota.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Day(); exists {
+			s.SetIgnore(quota.FieldDay)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuotaUpsertOne) Ignore() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuotaUpsertOne) DoNothing() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuotaCreate.OnConflict
+// documentation for more info.
+func (u *QuotaUpsertOne) Update(set func(*QuotaUpsert)) *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuotaUpsertOne) SetUpdatedAt(v time.Time) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateUpdatedAt() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *QuotaUpsertOne) SetDeletedAt(v time.Time) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateDeletedAt() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *QuotaUpsertOne) ClearDeletedAt() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetInterviews sets the "interviews" field.
+func (u *QuotaUpsertOne) SetInterviews(v int32) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetInterviews(v)
+	})
+}
+
+// AddInterviews adds v to the "interviews" field.
+func (u *QuotaUpsertOne) AddInterviews(v int32) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddInterviews(v)
+	})
+}
+
+// UpdateInterviews sets the "interviews" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateInterviews() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateInterviews()
+	})
+}
+
+// SetQuestions sets the "questions" field.
+func (u *QuotaUpsertOne) SetQuestions(v int32) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetQuestions(v)
+	})
+}
+
+// AddQuestions adds v to the "questions" field.
+func (u *QuotaUpsertOne) AddQuestions(v int32) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddQuestions(v)
+	})
+}
+
+// UpdateQuestions sets the "questions" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateQuestions() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateQuestions()
+	})
+}
+
+// Exec executes the query.
+func (u *QuotaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuotaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuotaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuotaUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuotaUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QuotaCreateBulk is the builder for creating many Quota entities in bulk.
+type QuotaCreateBulk struct {
+	config
+	err      error
+	builders []*QuotaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Quota entities in the database.
+func (qcb *QuotaCreateBulk) Save(ctx context.Context) ([]*Quota, error) {
+	if qcb.err != nil {
+		return nil, qcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Quota, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuotaCreateBulk) SaveX(ctx context.Context) []*Quota {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Quota.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuotaUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (qcb *QuotaCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuotaUpsertBulk {
+	qcb.conflict = opts
+	return &QuotaUpsertBulk{
+		create: qcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qcb *QuotaCreateBulk) OnConflictColumns(columns ...string) *QuotaUpsertBulk {
+	qcb.conflict = append(qcb.conflict, sql.ConflictColumns(columns...))
+	return &QuotaUpsertBulk{
+		create: qcb,
+	}
+}
+
+// QuotaUpsertBulk is the builder for "upsert"-ing
+// a bulk of Quota nodes.
+type QuotaUpsertBulk struct {
+	create *QuotaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuotaUpsertBulk) UpdateNewValues() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(quota.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(quota.FieldUserID)
+			}
+			if _, exists := b.mutation.Day(); exists {
+				s.SetIgnore(quota.FieldDay)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuotaUpsertBulk) Ignore() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuotaUpsertBulk) DoNothing() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuotaCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuotaUpsertBulk) Update(set func(*QuotaUpsert)) *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuotaUpsertBulk) SetUpdatedAt(v time.Time) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateUpdatedAt() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *QuotaUpsertBulk) SetDeletedAt(v time.Time) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateDeletedAt() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *QuotaUpsertBulk) ClearDeletedAt() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetInterviews sets the "interviews" field.
+func (u *QuotaUpsertBulk) SetInterviews(v int32) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetInterviews(v)
+	})
+}
+
+// AddInterviews adds v to the "interviews" field.
+func (u *QuotaUpsertBulk) AddInterviews(v int32) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddInterviews(v)
+	})
+}
+
+// UpdateInterviews sets the "interviews" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateInterviews() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateInterviews()
+	})
+}
+
+// SetQuestions sets the "questions" field.
+func (u *QuotaUpsertBulk) SetQuestions(v int32) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetQuestions(v)
+	})
+}
+
+// AddQuestions adds v to the "questions" field.
+func (u *QuotaUpsertBulk) AddQuestions(v int32) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddQuestions(v)
+	})
+}
+
+// UpdateQuestions sets the "questions" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateQuestions() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateQuestions()
+	})
+}
+
+// Exec executes the query.
+func (u *QuotaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuotaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuotaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuotaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"irelia/pkg/ent/predicate"
+	"irelia/pkg/ent/quota"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaUpdate is the builder for updating Quota entities.
+type QuotaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (qu *QuotaUpdate) Where(ps ...predicate.Quota) *QuotaUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qu *QuotaUpdate) SetUpdatedAt(t time.Time) *QuotaUpdate {
+	qu.mutation.SetUpdatedAt(t)
+	return qu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (qu *QuotaUpdate) SetDeletedAt(t time.Time) *QuotaUpdate {
+	qu.mutation.SetDeletedAt(t)
+	return qu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableDeletedAt(t *time.Time) *QuotaUpdate {
+	if t != nil {
+		qu.SetDeletedAt(*t)
+	}
+	return qu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (qu *QuotaUpdate) ClearDeletedAt() *QuotaUpdate {
+	qu.mutation.ClearDeletedAt()
+	return qu
+}
+
+// SetInterviews sets the "interviews" field.
+func (qu *QuotaUpdate) SetInterviews(i int32) *QuotaUpdate {
+	qu.mutation.ResetInterviews()
+	qu.mutation.SetInterviews(i)
+	return qu
+}
+
+// SetNillableInterviews sets the "interviews" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableInterviews(i *int32) *QuotaUpdate {
+	if i != nil {
+		qu.SetInterviews(*i)
+	}
+	return qu
+}
+
+// AddInterviews adds i to the "interviews" field.
+func (qu *QuotaUpdate) AddInterviews(i int32) *QuotaUpdate {
+	qu.mutation.AddInterviews(i)
+	return qu
+}
+
+// SetQuestions sets the "questions" field.
+func (qu *QuotaUpdate) SetQuestions(i int32) *QuotaUpdate {
+	qu.mutation.ResetQuestions()
+	qu.mutation.SetQuestions(i)
+	return qu
+}
+
+// SetNillableQuestions sets the "questions" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableQuestions(i *int32) *QuotaUpdate {
+	if i != nil {
+		qu.SetQuestions(*i)
+	}
+	return qu
+}
+
+// AddQuestions adds i to the "questions" field.
+func (qu *QuotaUpdate) AddQuestions(i int32) *QuotaUpdate {
+	qu.mutation.AddQuestions(i)
+	return qu
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (qu *QuotaUpdate) Mutation() *QuotaMutation {
+	return qu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuotaUpdate) Save(ctx context.Context) (int, error) {
+	qu.defaults()
+	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuotaUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuotaUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qu *QuotaUpdate) defaults() {
+	if _, ok := qu.mutation.UpdatedAt(); !ok {
+		v := quota.UpdateDefaultUpdatedAt()
+		qu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (qu *QuotaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeInt))
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := qu.mutation.DeletedAt(); ok {
+		_spec.SetField(quota.FieldDeletedAt, field.TypeTime, value)
+	}
+	if qu.mutation.DeletedAtCleared() {
+		_spec.ClearField(quota.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := qu.mutation.Interviews(); ok {
+		_spec.SetField(quota.FieldInterviews, field.TypeInt32, value)
+	}
+	if value, ok := qu.mutation.AddedInterviews(); ok {
+		_spec.AddField(quota.FieldInterviews, field.TypeInt32, value)
+	}
+	if value, ok := qu.mutation.Questions(); ok {
+		_spec.SetField(quota.FieldQuestions, field.TypeInt32, value)
+	}
+	if value, ok := qu.mutation.AddedQuestions(); ok {
+		_spec.AddField(quota.FieldQuestions, field.TypeInt32, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qu.mutation.done = true
+	return n, nil
+}
+
+// QuotaUpdateOne is the builder for updating a single Quota entity.
+type QuotaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (quo *QuotaUpdateOne) SetUpdatedAt(t time.Time) *QuotaUpdateOne {
+	quo.mutation.SetUpdatedAt(t)
+	return quo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (quo *QuotaUpdateOne) SetDeletedAt(t time.Time) *QuotaUpdateOne {
+	quo.mutation.SetDeletedAt(t)
+	return quo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableDeletedAt(t *time.Time) *QuotaUpdateOne {
+	if t != nil {
+		quo.SetDeletedAt(*t)
+	}
+	return quo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (quo *QuotaUpdateOne) ClearDeletedAt() *QuotaUpdateOne {
+	quo.mutation.ClearDeletedAt()
+	return quo
+}
+
+// SetInterviews sets the "interviews" field.
+func (quo *QuotaUpdateOne) SetInterviews(i int32) *QuotaUpdateOne {
+	quo.mutation.ResetInterviews()
+	quo.mutation.SetInterviews(i)
+	return quo
+}
+
+// SetNillableInterviews sets the "interviews" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableInterviews(i *int32) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetInterviews(*i)
+	}
+	return quo
+}
+
+// AddInterviews adds i to the "interviews" field.
+func (quo *QuotaUpdateOne) AddInterviews(i int32) *QuotaUpdateOne {
+	quo.mutation.AddInterviews(i)
+	return quo
+}
+
+// SetQuestions sets the "questions" field.
+func (quo *QuotaUpdateOne) SetQuestions(i int32) *QuotaUpdateOne {
+	quo.mutation.ResetQuestions()
+	quo.mutation.SetQuestions(i)
+	return quo
+}
+
+// SetNillableQuestions sets the "questions" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableQuestions(i *int32) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetQuestions(*i)
+	}
+	return quo
+}
+
+// AddQuestions adds i to the "questions" field.
+func (quo *QuotaUpdateOne) AddQuestions(i int32) *QuotaUpdateOne {
+	quo.mutation.AddQuestions(i)
+	return quo
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (quo *QuotaUpdateOne) Mutation() *QuotaMutation {
+	return quo.mutation
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (quo *QuotaUpdateOne) Where(ps ...predicate.Quota) *QuotaUpdateOne {
+	quo.mutation.Where(ps...)
+	return quo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuotaUpdateOne) Select(field string, fields ...string) *QuotaUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Quota entity.
+func (quo *QuotaUpdateOne) Save(ctx context.Context) (*Quota, error) {
+	quo.defaults()
+	return withHooks(ctx, quo.sqlSave, quo.mutation, quo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuotaUpdateOne) SaveX(ctx context.Context) *Quota {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (quo *QuotaUpdateOne) defaults() {
+	if _, ok := quo.mutation.UpdatedAt(); !ok {
+		v := quota.UpdateDefaultUpdatedAt()
+		quo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (quo *QuotaUpdateOne) sqlSave(ctx context.Context) (_node *Quota, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeInt))
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quota.FieldID)
+		for _, f := range fields {
+			if !quota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := quo.mutation.DeletedAt(); ok {
+		_spec.SetField(quota.FieldDeletedAt, field.TypeTime, value)
+	}
+	if quo.mutation.DeletedAtCleared() {
+		_spec.ClearField(quota.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := quo.mutation.Interviews(); ok {
+		_spec.SetField(quota.FieldInterviews, field.TypeInt32, value)
+	}
+	if value, ok := quo.mutation.AddedInterviews(); ok {
+		_spec.AddField(quota.FieldInterviews, field.TypeInt32, value)
+	}
+	if value, ok := quo.mutation.Questions(); ok {
+		_spec.SetField(quota.FieldQuestions, field.TypeInt32, value)
+	}
+	if value, ok := quo.mutation.AddedQuestions(); ok {
+		_spec.AddField(quota.FieldQuestions, field.TypeInt32, value)
+	}
+	_node = &Quota{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	quo.mutation.done = true
+	return _node, nil
+}
@@ -3,10 +3,15 @@
 package ent
 
 import (
+	irelia "irelia/api"
 	"irelia/pkg/ent/interview"
 	"irelia/pkg/ent/interviewfavorite"
+	"irelia/pkg/ent/job"
+	"irelia/pkg/ent/moderationaudit"
 	"irelia/pkg/ent/publicquestion"
 	"irelia/pkg/ent/question"
+	"irelia/pkg/ent/quota"
+	"irelia/pkg/ent/usage"
 	"irelia/schema"
 	"time"
 )
@@ -46,16 +51,28 @@ func init() {
 	interviewDescSkipCode := interviewFields[9].Descriptor()
 	// interview.DefaultSkipCode holds the default value on creation for the skip_code field.
 	interview.DefaultSkipCode = interviewDescSkipCode.Default.(bool)
+	// interviewDescQuestionTimeLimit is the schema descriptor for question_time_limit field.
+	interviewDescQuestionTimeLimit := interviewFields[10].Descriptor()
+	// interview.DefaultQuestionTimeLimit holds the default value on creation for the question_time_limit field.
+	interview.DefaultQuestionTimeLimit = interviewDescQuestionTimeLimit.Default.(int32)
+	// interviewDescFluencyScoring is the schema descriptor for fluency_scoring field.
+	interviewDescFluencyScoring := interviewFields[11].Descriptor()
+	// interview.DefaultFluencyScoring holds the default value on creation for the fluency_scoring field.
+	interview.DefaultFluencyScoring = interviewDescFluencyScoring.Default.(bool)
+	// interviewDescTextOnly is the schema descriptor for text_only field.
+	interviewDescTextOnly := interviewFields[12].Descriptor()
+	// interview.DefaultTextOnly holds the default value on creation for the text_only field.
+	interview.DefaultTextOnly = interviewDescTextOnly.Default.(bool)
 	// interviewDescTotalQuestions is the schema descriptor for total_questions field.
-	interviewDescTotalQuestions := interviewFields[10].Descriptor()
+	interviewDescTotalQuestions := interviewFields[13].Descriptor()
 	// interview.DefaultTotalQuestions holds the default value on creation for the total_questions field.
 	interview.DefaultTotalQuestions = interviewDescTotalQuestions.Default.(int32)
 	// interviewDescRemainingQuestions is the schema descriptor for remaining_questions field.
-	interviewDescRemainingQuestions := interviewFields[11].Descriptor()
+	interviewDescRemainingQuestions := interviewFields[14].Descriptor()
 	// interview.DefaultRemainingQuestions holds the default value on creation for the remaining_questions field.
 	interview.DefaultRemainingQuestions = interviewDescRemainingQuestions.Default.(int32)
 	// interviewDescOverallScore is the schema descriptor for overall_score field.
-	interviewDescOverallScore := interviewFields[13].Descriptor()
+	interviewDescOverallScore := interviewFields[16].Descriptor()
 	// interview.DefaultOverallScore holds the default value on creation for the overall_score field.
 	interview.DefaultOverallScore = interviewDescOverallScore.Default.(float64)
 	interviewfavoriteMixin := schema.InterviewFavorite{}.Mixin()
@@ -73,6 +90,60 @@ func init() {
 	interviewfavorite.DefaultUpdatedAt = interviewfavoriteDescUpdatedAt.Default.(func() time.Time)
 	// interviewfavorite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	interviewfavorite.UpdateDefaultUpdatedAt = interviewfavoriteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	jobMixin := schema.Job{}.Mixin()
+	jobMixinFields0 := jobMixin[0].Fields()
+	_ = jobMixinFields0
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobMixinFields0[0].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobMixinFields0[1].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobDescInterviewID is the schema descriptor for interview_id field.
+	jobDescInterviewID := jobFields[1].Descriptor()
+	// job.InterviewIDValidator is a validator for the "interview_id" field. It is called by the builders before save.
+	job.InterviewIDValidator = jobDescInterviewID.Validators[0].(func(string) error)
+	// jobDescQuestionIndex is the schema descriptor for question_index field.
+	jobDescQuestionIndex := jobFields[2].Descriptor()
+	// job.DefaultQuestionIndex holds the default value on creation for the question_index field.
+	job.DefaultQuestionIndex = jobDescQuestionIndex.Default.(int32)
+	// jobDescSubIndex is the schema descriptor for sub_index field.
+	jobDescSubIndex := jobFields[3].Descriptor()
+	// job.DefaultSubIndex holds the default value on creation for the sub_index field.
+	job.DefaultSubIndex = jobDescSubIndex.Default.(int32)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[6].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int32)
+	// jobDescMaxAttempts is the schema descriptor for max_attempts field.
+	jobDescMaxAttempts := jobFields[7].Descriptor()
+	// job.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	job.DefaultMaxAttempts = jobDescMaxAttempts.Default.(int32)
+	// jobDescRunAt is the schema descriptor for run_at field.
+	jobDescRunAt := jobFields[8].Descriptor()
+	// job.DefaultRunAt holds the default value on creation for the run_at field.
+	job.DefaultRunAt = jobDescRunAt.Default.(func() time.Time)
+	moderationauditMixin := schema.ModerationAudit{}.Mixin()
+	moderationauditMixinFields0 := moderationauditMixin[0].Fields()
+	_ = moderationauditMixinFields0
+	moderationauditFields := schema.ModerationAudit{}.Fields()
+	_ = moderationauditFields
+	// moderationauditDescCreatedAt is the schema descriptor for created_at field.
+	moderationauditDescCreatedAt := moderationauditMixinFields0[0].Descriptor()
+	// moderationaudit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationaudit.DefaultCreatedAt = moderationauditDescCreatedAt.Default.(func() time.Time)
+	// moderationauditDescUpdatedAt is the schema descriptor for updated_at field.
+	moderationauditDescUpdatedAt := moderationauditMixinFields0[1].Descriptor()
+	// moderationaudit.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	moderationaudit.DefaultUpdatedAt = moderationauditDescUpdatedAt.Default.(func() time.Time)
+	// moderationaudit.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	moderationaudit.UpdateDefaultUpdatedAt = moderationauditDescUpdatedAt.UpdateDefault.(func() time.Time)
 	publicquestionMixin := schema.PublicQuestion{}.Mixin()
 	publicquestionMixinFields0 := publicquestionMixin[0].Fields()
 	_ = publicquestionMixinFields0
@@ -104,6 +175,30 @@ func init() {
 	publicquestionDescContent := publicquestionFields[3].Descriptor()
 	// publicquestion.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	publicquestion.ContentValidator = publicquestionDescContent.Validators[0].(func(string) error)
+	// publicquestionDescContentHash is the schema descriptor for content_hash field.
+	publicquestionDescContentHash := publicquestionFields[5].Descriptor()
+	// publicquestion.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	publicquestion.ContentHashValidator = publicquestionDescContentHash.Validators[0].(func(string) error)
+	// publicquestionDescOccurrences is the schema descriptor for occurrences field.
+	publicquestionDescOccurrences := publicquestionFields[6].Descriptor()
+	// publicquestion.DefaultOccurrences holds the default value on creation for the occurrences field.
+	publicquestion.DefaultOccurrences = publicquestionDescOccurrences.Default.(int)
+	// publicquestionDescFirstSeenAt is the schema descriptor for first_seen_at field.
+	publicquestionDescFirstSeenAt := publicquestionFields[7].Descriptor()
+	// publicquestion.DefaultFirstSeenAt holds the default value on creation for the first_seen_at field.
+	publicquestion.DefaultFirstSeenAt = publicquestionDescFirstSeenAt.Default.(func() time.Time)
+	// publicquestionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	publicquestionDescLastSeenAt := publicquestionFields[8].Descriptor()
+	// publicquestion.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	publicquestion.DefaultLastSeenAt = publicquestionDescLastSeenAt.Default.(func() time.Time)
+	// publicquestionDescStatus is the schema descriptor for status field.
+	publicquestionDescStatus := publicquestionFields[9].Descriptor()
+	// publicquestion.DefaultStatus holds the default value on creation for the status field.
+	publicquestion.DefaultStatus = irelia.ModerationStatus(publicquestionDescStatus.Default.(int32))
+	// publicquestionDescAnswerAttempts is the schema descriptor for answer_attempts field.
+	publicquestionDescAnswerAttempts := publicquestionFields[16].Descriptor()
+	// publicquestion.DefaultAnswerAttempts holds the default value on creation for the answer_attempts field.
+	publicquestion.DefaultAnswerAttempts = publicquestionDescAnswerAttempts.Default.(int32)
 	questionMixin := schema.Question{}.Mixin()
 	questionMixinFields0 := questionMixin[0].Fields()
 	_ = questionMixinFields0
@@ -123,8 +218,70 @@ func init() {
 	questionDescInterviewID := questionFields[0].Descriptor()
 	// question.InterviewIDValidator is a validator for the "interview_id" field. It is called by the builders before save.
 	question.InterviewIDValidator = questionDescInterviewID.Validators[0].(func(string) error)
+	// questionDescSubIndex is the schema descriptor for sub_index field.
+	questionDescSubIndex := questionFields[2].Descriptor()
+	// question.DefaultSubIndex holds the default value on creation for the sub_index field.
+	question.DefaultSubIndex = questionDescSubIndex.Default.(int32)
 	// questionDescContent is the schema descriptor for content field.
-	questionDescContent := questionFields[2].Descriptor()
+	questionDescContent := questionFields[3].Descriptor()
 	// question.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	question.ContentValidator = questionDescContent.Validators[0].(func(string) error)
+	// questionDescAudioPending is the schema descriptor for audio_pending field.
+	questionDescAudioPending := questionFields[7].Descriptor()
+	// question.DefaultAudioPending holds the default value on creation for the audio_pending field.
+	question.DefaultAudioPending = questionDescAudioPending.Default.(bool)
+	quotaMixin := schema.Quota{}.Mixin()
+	quotaMixinFields0 := quotaMixin[0].Fields()
+	_ = quotaMixinFields0
+	quotaFields := schema.Quota{}.Fields()
+	_ = quotaFields
+	// quotaDescCreatedAt is the schema descriptor for created_at field.
+	quotaDescCreatedAt := quotaMixinFields0[0].Descriptor()
+	// quota.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quota.DefaultCreatedAt = quotaDescCreatedAt.Default.(func() time.Time)
+	// quotaDescUpdatedAt is the schema descriptor for updated_at field.
+	quotaDescUpdatedAt := quotaMixinFields0[1].Descriptor()
+	// quota.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quota.DefaultUpdatedAt = quotaDescUpdatedAt.Default.(func() time.Time)
+	// quota.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quota.UpdateDefaultUpdatedAt = quotaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// quotaDescInterviews is the schema descriptor for interviews field.
+	quotaDescInterviews := quotaFields[2].Descriptor()
+	// quota.DefaultInterviews holds the default value on creation for the interviews field.
+	quota.DefaultInterviews = quotaDescInterviews.Default.(int32)
+	// quotaDescQuestions is the schema descriptor for questions field.
+	quotaDescQuestions := quotaFields[3].Descriptor()
+	// quota.DefaultQuestions holds the default value on creation for the questions field.
+	quota.DefaultQuestions = quotaDescQuestions.Default.(int32)
+	usageMixin := schema.Usage{}.Mixin()
+	usageMixinFields0 := usageMixin[0].Fields()
+	_ = usageMixinFields0
+	usageFields := schema.Usage{}.Fields()
+	_ = usageFields
+	// usageDescCreatedAt is the schema descriptor for created_at field.
+	usageDescCreatedAt := usageMixinFields0[0].Descriptor()
+	// usage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usage.DefaultCreatedAt = usageDescCreatedAt.Default.(func() time.Time)
+	// usageDescUpdatedAt is the schema descriptor for updated_at field.
+	usageDescUpdatedAt := usageMixinFields0[1].Descriptor()
+	// usage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usage.DefaultUpdatedAt = usageDescUpdatedAt.Default.(func() time.Time)
+	// usage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usage.UpdateDefaultUpdatedAt = usageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usageDescUpstream is the schema descriptor for upstream field.
+	usageDescUpstream := usageFields[2].Descriptor()
+	// usage.UpstreamValidator is a validator for the "upstream" field. It is called by the builders before save.
+	usage.UpstreamValidator = usageDescUpstream.Validators[0].(func(string) error)
+	// usageDescOperation is the schema descriptor for operation field.
+	usageDescOperation := usageFields[3].Descriptor()
+	// usage.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	usage.OperationValidator = usageDescOperation.Validators[0].(func(string) error)
+	// usageDescRequestBytes is the schema descriptor for request_bytes field.
+	usageDescRequestBytes := usageFields[5].Descriptor()
+	// usage.DefaultRequestBytes holds the default value on creation for the request_bytes field.
+	usage.DefaultRequestBytes = usageDescRequestBytes.Default.(int)
+	// usageDescResponseBytes is the schema descriptor for response_bytes field.
+	usageDescResponseBytes := usageFields[6].Descriptor()
+	// usage.DefaultResponseBytes holds the default value on creation for the response_bytes field.
+	usage.DefaultResponseBytes = usageDescResponseBytes.Default.(int)
 }
@@ -16,10 +16,18 @@ type Tx struct {
 	Interview *InterviewClient
 	// InterviewFavorite is the client for interacting with the InterviewFavorite builders.
 	InterviewFavorite *InterviewFavoriteClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// ModerationAudit is the client for interacting with the ModerationAudit builders.
+	ModerationAudit *ModerationAuditClient
 	// PublicQuestion is the client for interacting with the PublicQuestion builders.
 	PublicQuestion *PublicQuestionClient
 	// Question is the client for interacting with the Question builders.
 	Question *QuestionClient
+	// Quota is the client for interacting with the Quota builders.
+	Quota *QuotaClient
+	// Usage is the client for interacting with the Usage builders.
+	Usage *UsageClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,8 +161,12 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Interview = NewInterviewClient(tx.config)
 	tx.InterviewFavorite = NewInterviewFavoriteClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.ModerationAudit = NewModerationAuditClient(tx.config)
 	tx.PublicQuestion = NewPublicQuestionClient(tx.config)
 	tx.Question = NewQuestionClient(tx.config)
+	tx.Quota = NewQuotaClient(tx.config)
+	tx.Usage = NewUsageClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"irelia/pkg/ent/usage"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Usage is the model entity for the Usage schema.
+type Usage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// InterviewID holds the value of the "interview_id" field.
+	InterviewID string `json:"interview_id,omitempty"`
+	// Upstream holds the value of the "upstream" field.
+	Upstream string `json:"upstream,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation string `json:"operation,omitempty"`
+	// LatencyMs holds the value of the "latency_ms" field.
+	LatencyMs int64 `json:"latency_ms,omitempty"`
+	// RequestBytes holds the value of the "request_bytes" field.
+	RequestBytes int `json:"request_bytes,omitempty"`
+	// ResponseBytes holds the value of the "response_bytes" field.
+	ResponseBytes int `json:"response_bytes,omitempty"`
+	// Success holds the value of the "success" field.
+	Success      bool `json:"success,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Usage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usage.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case usage.FieldID, usage.FieldUserID, usage.FieldLatencyMs, usage.FieldRequestBytes, usage.FieldResponseBytes:
+			values[i] = new(sql.NullInt64)
+		case usage.FieldInterviewID, usage.FieldUpstream, usage.FieldOperation:
+			values[i] = new(sql.NullString)
+		case usage.FieldCreatedAt, usage.FieldUpdatedAt, usage.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Usage fields.
+func (u *Usage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case usage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				u.CreatedAt = value.Time
+			}
+		case usage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				u.UpdatedAt = value.Time
+			}
+		case usage.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
+		case usage.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				u.UserID = uint64(value.Int64)
+			}
+		case usage.FieldInterviewID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field interview_id", values[i])
+			} else if value.Valid {
+				u.InterviewID = value.String
+			}
+		case usage.FieldUpstream:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upstream", values[i])
+			} else if value.Valid {
+				u.Upstream = value.String
+			}
+		case usage.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				u.Operation = value.String
+			}
+		case usage.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ms", values[i])
+			} else if value.Valid {
+				u.LatencyMs = value.Int64
+			}
+		case usage.FieldRequestBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field request_bytes", values[i])
+			} else if value.Valid {
+				u.RequestBytes = int(value.Int64)
+			}
+		case usage.FieldResponseBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_bytes", values[i])
+			} else if value.Valid {
+				u.ResponseBytes = int(value.Int64)
+			}
+		case usage.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				u.Success = value.Bool
+			}
+		default:
+			u.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Usage.
+// This includes values selected through modifiers, order, etc.
+func (u *Usage) Value(name string) (ent.Value, error) {
+	return u.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Usage.
+// Note that you need to call Usage.Unwrap() before calling this method if this Usage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (u *Usage) Update() *UsageUpdateOne {
+	return NewUsageClient(u.config).UpdateOne(u)
+}
+
+// Unwrap unwraps the Usage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (u *Usage) Unwrap() *Usage {
+	_tx, ok := u.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Usage is not a transactional entity")
+	}
+	u.config.driver = _tx.drv
+	return u
+}
+
+// String implements the fmt.Stringer.
+func (u *Usage) String() string {
+	var builder strings.Builder
+	builder.WriteString("Usage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", u.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(u.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", u.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("interview_id=")
+	builder.WriteString(u.InterviewID)
+	builder.WriteString(", ")
+	builder.WriteString("upstream=")
+	builder.WriteString(u.Upstream)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(u.Operation)
+	builder.WriteString(", ")
+	builder.WriteString("latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", u.LatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("request_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", u.RequestBytes))
+	builder.WriteString(", ")
+	builder.WriteString("response_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", u.ResponseBytes))
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", u.Success))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Usages is a parsable slice of Usage.
+type Usages []*Usage
@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the usage type in the database.
+	Label = "usage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldInterviewID holds the string denoting the interview_id field in the database.
+	FieldInterviewID = "interview_id"
+	// FieldUpstream holds the string denoting the upstream field in the database.
+	FieldUpstream = "upstream"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldLatencyMs holds the string denoting the latency_ms field in the database.
+	FieldLatencyMs = "latency_ms"
+	// FieldRequestBytes holds the string denoting the request_bytes field in the database.
+	FieldRequestBytes = "request_bytes"
+	// FieldResponseBytes holds the string denoting the response_bytes field in the database.
+	FieldResponseBytes = "response_bytes"
+	// FieldSuccess holds the string denoting the success field in the database.
+	FieldSuccess = "success"
+	// Table holds the table name of the usage in the database.
+	Table = "usages"
+)
+
+// Columns holds all SQL columns for usage fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldUserID,
+	FieldInterviewID,
+	FieldUpstream,
+	FieldOperation,
+	FieldLatencyMs,
+	FieldRequestBytes,
+	FieldResponseBytes,
+	FieldSuccess,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// UpstreamValidator is a validator for the "upstream" field. It is called by the builders before save.
+	UpstreamValidator func(string) error
+	// OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	OperationValidator func(string) error
+	// DefaultRequestBytes holds the default value on creation for the "request_bytes" field.
+	DefaultRequestBytes int
+	// DefaultResponseBytes holds the default value on creation for the "response_bytes" field.
+	DefaultResponseBytes int
+)
+
+// OrderOption defines the ordering options for the Usage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByInterviewID orders the results by the interview_id field.
+func ByInterviewID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterviewID, opts...).ToFunc()
+}
+
+// ByUpstream orders the results by the upstream field.
+func ByUpstream(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpstream, opts...).ToFunc()
+}
+
+// ByOperation orders the results by the operation field.
+func ByOperation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperation, opts...).ToFunc()
+}
+
+// ByLatencyMs orders the results by the latency_ms field.
+func ByLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyMs, opts...).ToFunc()
+}
+
+// ByRequestBytes orders the results by the request_bytes field.
+func ByRequestBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestBytes, opts...).ToFunc()
+}
+
+// ByResponseBytes orders the results by the response_bytes field.
+func ByResponseBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseBytes, opts...).ToFunc()
+}
+
+// BySuccess orders the results by the success field.
+func BySuccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuccess, opts...).ToFunc()
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usage
+
+import (
+	"irelia/pkg/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUserID, v))
+}
+
+// InterviewID applies equality check predicate on the "interview_id" field. It's identical to InterviewIDEQ.
+func InterviewID(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldInterviewID, v))
+}
+
+// Upstream applies equality check predicate on the "upstream" field. It's identical to UpstreamEQ.
+func Upstream(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUpstream, v))
+}
+
+// Operation applies equality check predicate on the "operation" field. It's identical to OperationEQ.
+func Operation(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldOperation, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// RequestBytes applies equality check predicate on the "request_bytes" field. It's identical to RequestBytesEQ.
+func RequestBytes(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldRequestBytes, v))
+}
+
+// ResponseBytes applies equality check predicate on the "response_bytes" field. It's identical to ResponseBytesEQ.
+func ResponseBytes(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldResponseBytes, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldSuccess, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Usage {
+	return predicate.Usage(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Usage {
+	return predicate.Usage(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint64) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldUserID, v))
+}
+
+// InterviewIDEQ applies the EQ predicate on the "interview_id" field.
+func InterviewIDEQ(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldInterviewID, v))
+}
+
+// InterviewIDNEQ applies the NEQ predicate on the "interview_id" field.
+func InterviewIDNEQ(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldInterviewID, v))
+}
+
+// InterviewIDIn applies the In predicate on the "interview_id" field.
+func InterviewIDIn(vs ...string) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldInterviewID, vs...))
+}
+
+// InterviewIDNotIn applies the NotIn predicate on the "interview_id" field.
+func InterviewIDNotIn(vs ...string) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldInterviewID, vs...))
+}
+
+// InterviewIDGT applies the GT predicate on the "interview_id" field.
+func InterviewIDGT(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldInterviewID, v))
+}
+
+// InterviewIDGTE applies the GTE predicate on the "interview_id" field.
+func InterviewIDGTE(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldInterviewID, v))
+}
+
+// InterviewIDLT applies the LT predicate on the "interview_id" field.
+func InterviewIDLT(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldInterviewID, v))
+}
+
+// InterviewIDLTE applies the LTE predicate on the "interview_id" field.
+func InterviewIDLTE(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldInterviewID, v))
+}
+
+// InterviewIDContains applies the Contains predicate on the "interview_id" field.
+func InterviewIDContains(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldContains(FieldInterviewID, v))
+}
+
+// InterviewIDHasPrefix applies the HasPrefix predicate on the "interview_id" field.
+func InterviewIDHasPrefix(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldHasPrefix(FieldInterviewID, v))
+}
+
+// InterviewIDHasSuffix applies the HasSuffix predicate on the "interview_id" field.
+func InterviewIDHasSuffix(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldHasSuffix(FieldInterviewID, v))
+}
+
+// InterviewIDIsNil applies the IsNil predicate on the "interview_id" field.
+func InterviewIDIsNil() predicate.Usage {
+	return predicate.Usage(sql.FieldIsNull(FieldInterviewID))
+}
+
+// InterviewIDNotNil applies the NotNil predicate on the "interview_id" field.
+func InterviewIDNotNil() predicate.Usage {
+	return predicate.Usage(sql.FieldNotNull(FieldInterviewID))
+}
+
+// InterviewIDEqualFold applies the EqualFold predicate on the "interview_id" field.
+func InterviewIDEqualFold(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEqualFold(FieldInterviewID, v))
+}
+
+// InterviewIDContainsFold applies the ContainsFold predicate on the "interview_id" field.
+func InterviewIDContainsFold(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldContainsFold(FieldInterviewID, v))
+}
+
+// UpstreamEQ applies the EQ predicate on the "upstream" field.
+func UpstreamEQ(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUpstream, v))
+}
+
+// UpstreamNEQ applies the NEQ predicate on the "upstream" field.
+func UpstreamNEQ(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldUpstream, v))
+}
+
+// UpstreamIn applies the In predicate on the "upstream" field.
+func UpstreamIn(vs ...string) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldUpstream, vs...))
+}
+
+// UpstreamNotIn applies the NotIn predicate on the "upstream" field.
+func UpstreamNotIn(vs ...string) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldUpstream, vs...))
+}
+
+// UpstreamGT applies the GT predicate on the "upstream" field.
+func UpstreamGT(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldUpstream, v))
+}
+
+// UpstreamGTE applies the GTE predicate on the "upstream" field.
+func UpstreamGTE(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldUpstream, v))
+}
+
+// UpstreamLT applies the LT predicate on the "upstream" field.
+func UpstreamLT(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldUpstream, v))
+}
+
+// UpstreamLTE applies the LTE predicate on the "upstream" field.
+func UpstreamLTE(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldUpstream, v))
+}
+
+// UpstreamContains applies the Contains predicate on the "upstream" field.
+func UpstreamContains(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldContains(FieldUpstream, v))
+}
+
+// UpstreamHasPrefix applies the HasPrefix predicate on the "upstream" field.
+func UpstreamHasPrefix(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldHasPrefix(FieldUpstream, v))
+}
+
+// UpstreamHasSuffix applies the HasSuffix predicate on the "upstream" field.
+func UpstreamHasSuffix(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldHasSuffix(FieldUpstream, v))
+}
+
+// UpstreamEqualFold applies the EqualFold predicate on the "upstream" field.
+func UpstreamEqualFold(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEqualFold(FieldUpstream, v))
+}
+
+// UpstreamContainsFold applies the ContainsFold predicate on the "upstream" field.
+func UpstreamContainsFold(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldContainsFold(FieldUpstream, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...string) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...string) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// OperationGT applies the GT predicate on the "operation" field.
+func OperationGT(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldOperation, v))
+}
+
+// OperationGTE applies the GTE predicate on the "operation" field.
+func OperationGTE(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldOperation, v))
+}
+
+// OperationLT applies the LT predicate on the "operation" field.
+func OperationLT(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldOperation, v))
+}
+
+// OperationLTE applies the LTE predicate on the "operation" field.
+func OperationLTE(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldOperation, v))
+}
+
+// OperationContains applies the Contains predicate on the "operation" field.
+func OperationContains(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldContains(FieldOperation, v))
+}
+
+// OperationHasPrefix applies the HasPrefix predicate on the "operation" field.
+func OperationHasPrefix(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldHasPrefix(FieldOperation, v))
+}
+
+// OperationHasSuffix applies the HasSuffix predicate on the "operation" field.
+func OperationHasSuffix(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldHasSuffix(FieldOperation, v))
+}
+
+// OperationEqualFold applies the EqualFold predicate on the "operation" field.
+func OperationEqualFold(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldEqualFold(FieldOperation, v))
+}
+
+// OperationContainsFold applies the ContainsFold predicate on the "operation" field.
+func OperationContainsFold(v string) predicate.Usage {
+	return predicate.Usage(sql.FieldContainsFold(FieldOperation, v))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// RequestBytesEQ applies the EQ predicate on the "request_bytes" field.
+func RequestBytesEQ(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldRequestBytes, v))
+}
+
+// RequestBytesNEQ applies the NEQ predicate on the "request_bytes" field.
+func RequestBytesNEQ(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldRequestBytes, v))
+}
+
+// RequestBytesIn applies the In predicate on the "request_bytes" field.
+func RequestBytesIn(vs ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldRequestBytes, vs...))
+}
+
+// RequestBytesNotIn applies the NotIn predicate on the "request_bytes" field.
+func RequestBytesNotIn(vs ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldRequestBytes, vs...))
+}
+
+// RequestBytesGT applies the GT predicate on the "request_bytes" field.
+func RequestBytesGT(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldRequestBytes, v))
+}
+
+// RequestBytesGTE applies the GTE predicate on the "request_bytes" field.
+func RequestBytesGTE(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldRequestBytes, v))
+}
+
+// RequestBytesLT applies the LT predicate on the "request_bytes" field.
+func RequestBytesLT(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldRequestBytes, v))
+}
+
+// RequestBytesLTE applies the LTE predicate on the "request_bytes" field.
+func RequestBytesLTE(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldRequestBytes, v))
+}
+
+// ResponseBytesEQ applies the EQ predicate on the "response_bytes" field.
+func ResponseBytesEQ(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldResponseBytes, v))
+}
+
+// ResponseBytesNEQ applies the NEQ predicate on the "response_bytes" field.
+func ResponseBytesNEQ(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldResponseBytes, v))
+}
+
+// ResponseBytesIn applies the In predicate on the "response_bytes" field.
+func ResponseBytesIn(vs ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldResponseBytes, vs...))
+}
+
+// ResponseBytesNotIn applies the NotIn predicate on the "response_bytes" field.
+func ResponseBytesNotIn(vs ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldResponseBytes, vs...))
+}
+
+// ResponseBytesGT applies the GT predicate on the "response_bytes" field.
+func ResponseBytesGT(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldResponseBytes, v))
+}
+
+// ResponseBytesGTE applies the GTE predicate on the "response_bytes" field.
+func ResponseBytesGTE(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldResponseBytes, v))
+}
+
+// ResponseBytesLT applies the LT predicate on the "response_bytes" field.
+func ResponseBytesLT(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldResponseBytes, v))
+}
+
+// ResponseBytesLTE applies the LTE predicate on the "response_bytes" field.
+func ResponseBytesLTE(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldResponseBytes, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.NotPredicates(p))
+}
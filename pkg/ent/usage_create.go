@@ -0,0 +1,779 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"irelia/pkg/ent/usage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UsageCreate is the builder for creating a Usage entity.
+type UsageCreate struct {
+	config
+	mutation *UsageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uc *UsageCreate) SetCreatedAt(t time.Time) *UsageCreate {
+	uc.mutation.SetCreatedAt(t)
+	return uc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableCreatedAt(t *time.Time) *UsageCreate {
+	if t != nil {
+		uc.SetCreatedAt(*t)
+	}
+	return uc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uc *UsageCreate) SetUpdatedAt(t time.Time) *UsageCreate {
+	uc.mutation.SetUpdatedAt(t)
+	return uc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableUpdatedAt(t *time.Time) *UsageCreate {
+	if t != nil {
+		uc.SetUpdatedAt(*t)
+	}
+	return uc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uc *UsageCreate) SetDeletedAt(t time.Time) *UsageCreate {
+	uc.mutation.SetDeletedAt(t)
+	return uc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableDeletedAt(t *time.Time) *UsageCreate {
+	if t != nil {
+		uc.SetDeletedAt(*t)
+	}
+	return uc
+}
+
+// SetUserID sets the "user_id" field.
+func (uc *UsageCreate) SetUserID(u uint64) *UsageCreate {
+	uc.mutation.SetUserID(u)
+	return uc
+}
+
+// SetInterviewID sets the "interview_id" field.
+func (uc *UsageCreate) SetInterviewID(s string) *UsageCreate {
+	uc.mutation.SetInterviewID(s)
+	return uc
+}
+
+// SetNillableInterviewID sets the "interview_id" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableInterviewID(s *string) *UsageCreate {
+	if s != nil {
+		uc.SetInterviewID(*s)
+	}
+	return uc
+}
+
+// SetUpstream sets the "upstream" field.
+func (uc *UsageCreate) SetUpstream(s string) *UsageCreate {
+	uc.mutation.SetUpstream(s)
+	return uc
+}
+
+// SetOperation sets the "operation" field.
+func (uc *UsageCreate) SetOperation(s string) *UsageCreate {
+	uc.mutation.SetOperation(s)
+	return uc
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (uc *UsageCreate) SetLatencyMs(i int64) *UsageCreate {
+	uc.mutation.SetLatencyMs(i)
+	return uc
+}
+
+// SetRequestBytes sets the "request_bytes" field.
+func (uc *UsageCreate) SetRequestBytes(i int) *UsageCreate {
+	uc.mutation.SetRequestBytes(i)
+	return uc
+}
+
+// SetNillableRequestBytes sets the "request_bytes" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableRequestBytes(i *int) *UsageCreate {
+	if i != nil {
+		uc.SetRequestBytes(*i)
+	}
+	return uc
+}
+
+// SetResponseBytes sets the "response_bytes" field.
+func (uc *UsageCreate) SetResponseBytes(i int) *UsageCreate {
+	uc.mutation.SetResponseBytes(i)
+	return uc
+}
+
+// SetNillableResponseBytes sets the "response_bytes" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableResponseBytes(i *int) *UsageCreate {
+	if i != nil {
+		uc.SetResponseBytes(*i)
+	}
+	return uc
+}
+
+// SetSuccess sets the "success" field.
+func (uc *UsageCreate) SetSuccess(b bool) *UsageCreate {
+	uc.mutation.SetSuccess(b)
+	return uc
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uc *UsageCreate) Mutation() *UsageMutation {
+	return uc.mutation
+}
+
+// Save creates the Usage in the database.
+func (uc *UsageCreate) Save(ctx context.Context) (*Usage, error) {
+	uc.defaults()
+	return withHooks(ctx, uc.sqlSave, uc.mutation, uc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uc *UsageCreate) SaveX(ctx context.Context) *Usage {
+	v, err := uc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uc *UsageCreate) Exec(ctx context.Context) error {
+	_, err := uc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uc *UsageCreate) ExecX(ctx context.Context) {
+	if err := uc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uc *UsageCreate) defaults() {
+	if _, ok := uc.mutation.CreatedAt(); !ok {
+		v := usage.DefaultCreatedAt()
+		uc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		v := usage.DefaultUpdatedAt()
+		uc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := uc.mutation.RequestBytes(); !ok {
+		v := usage.DefaultRequestBytes
+		uc.mutation.SetRequestBytes(v)
+	}
+	if _, ok := uc.mutation.ResponseBytes(); !ok {
+		v := usage.DefaultResponseBytes
+		uc.mutation.SetResponseBytes(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uc *UsageCreate) check() error {
+	if _, ok := uc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Usage.created_at"`)}
+	}
+	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Usage.updated_at"`)}
+	}
+	if _, ok := uc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Usage.user_id"`)}
+	}
+	if _, ok := uc.mutation.Upstream(); !ok {
+		return &ValidationError{Name: "upstream", err: errors.New(`ent: missing required field "Usage.upstream"`)}
+	}
+	if v, ok := uc.mutation.Upstream(); ok {
+		if err := usage.UpstreamValidator(v); err != nil {
+			return &ValidationError{Name: "upstream", err: fmt.Errorf(`ent: validator failed for field "Usage.upstream": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "Usage.operation"`)}
+	}
+	if v, ok := uc.mutation.Operation(); ok {
+		if err := usage.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "Usage.operation": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "Usage.latency_ms"`)}
+	}
+	if _, ok := uc.mutation.RequestBytes(); !ok {
+		return &ValidationError{Name: "request_bytes", err: errors.New(`ent: missing required field "Usage.request_bytes"`)}
+	}
+	if _, ok := uc.mutation.ResponseBytes(); !ok {
+		return &ValidationError{Name: "response_bytes", err: errors.New(`ent: missing required field "Usage.response_bytes"`)}
+	}
+	if _, ok := uc.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "Usage.success"`)}
+	}
+	return nil
+}
+
+func (uc *UsageCreate) sqlSave(ctx context.Context) (*Usage, error) {
+	if err := uc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	uc.mutation.id = &_node.ID
+	uc.mutation.done = true
+	return _node, nil
+}
+
+func (uc *UsageCreate) createSpec() (*Usage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Usage{config: uc.config}
+		_spec = sqlgraph.NewCreateSpec(usage.Table, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = uc.conflict
+	if value, ok := uc.mutation.CreatedAt(); ok {
+		_spec.SetField(usage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uc.mutation.UpdatedAt(); ok {
+		_spec.SetField(usage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := uc.mutation.DeletedAt(); ok {
+		_spec.SetField(usage.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := uc.mutation.UserID(); ok {
+		_spec.SetField(usage.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := uc.mutation.InterviewID(); ok {
+		_spec.SetField(usage.FieldInterviewID, field.TypeString, value)
+		_node.InterviewID = value
+	}
+	if value, ok := uc.mutation.Upstream(); ok {
+		_spec.SetField(usage.FieldUpstream, field.TypeString, value)
+		_node.Upstream = value
+	}
+	if value, ok := uc.mutation.Operation(); ok {
+		_spec.SetField(usage.FieldOperation, field.TypeString, value)
+		_node.Operation = value
+	}
+	if value, ok := uc.mutation.LatencyMs(); ok {
+		_spec.SetField(usage.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := uc.mutation.RequestBytes(); ok {
+		_spec.SetField(usage.FieldRequestBytes, field.TypeInt, value)
+		_node.RequestBytes = value
+	}
+	if value, ok := uc.mutation.ResponseBytes(); ok {
+		_spec.SetField(usage.FieldResponseBytes, field.TypeInt, value)
+		_node.ResponseBytes = value
+	}
+	if value, ok := uc.mutation.Success(); ok {
+		_spec.SetField(usage.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Usage.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uc *UsageCreate) OnConflict(opts ...sql.ConflictOption) *UsageUpsertOne {
+	uc.conflict = opts
+	return &UsageUpsertOne{
+		create: uc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uc *UsageCreate) OnConflictColumns(columns ...string) *UsageUpsertOne {
+	uc.conflict = append(uc.conflict, sql.ConflictColumns(columns...))
+	return &UsageUpsertOne{
+		create: uc,
+	}
+}
+
+type (
+	// UsageUpsertOne is the builder for "upsert"-ing
+	//  one Usage node.
+	UsageUpsertOne struct {
+		create *UsageCreate
+	}
+
+	// UsageUpsert is the "OnConflict" setter.
+	UsageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsageUpsert) SetUpdatedAt(v time.Time) *UsageUpsert {
+	u.Set(usage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateUpdatedAt() *UsageUpsert {
+	u.SetExcluded(usage.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UsageUpsert) SetDeletedAt(v time.Time) *UsageUpsert {
+	u.Set(usage.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateDeletedAt() *UsageUpsert {
+	u.SetExcluded(usage.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UsageUpsert) ClearDeletedAt() *UsageUpsert {
+	u.SetNull(usage.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageUpsertOne) UpdateNewValues() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usage.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(usage.FieldUserID)
+		}
+		if _, exists := u.create.mutation.InterviewID(); exists {
+			s.SetIgnore(usage.FieldInterviewID)
+		}
+		if _, exists := u.create.mutation.Upstream(); exists {
+			s.SetIgnore(usage.FieldUpstream)
+		}
+		if _, exists := u.create.mutation.Operation(); exists {
+			s.SetIgnore(usage.FieldOperation)
+		}
+		if _, exists := u.create.mutation.LatencyMs(); exists {
+			s.SetIgnore(usage.FieldLatencyMs)
+		}
+		if _, exists := u.create.mutation.RequestBytes(); exists {
+			s.SetIgnore(usage.FieldRequestBytes)
+		}
+		if _, exists := u.create.mutation.ResponseBytes(); exists {
+			s.SetIgnore(usage.FieldResponseBytes)
+		}
+		if _, exists := u.create.mutation.Success(); exists {
+			s.SetIgnore(usage.FieldSuccess)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UsageUpsertOne) Ignore() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageUpsertOne) DoNothing() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageCreate.OnConflict
+// documentation for more info.
+func (u *UsageUpsertOne) Update(set func(*UsageUpsert)) *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsageUpsertOne) SetUpdatedAt(v time.Time) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateUpdatedAt() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UsageUpsertOne) SetDeletedAt(v time.Time) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateDeletedAt() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UsageUpsertOne) ClearDeletedAt() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UsageUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UsageUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UsageCreateBulk is the builder for creating many Usage entities in bulk.
+type UsageCreateBulk struct {
+	config
+	err      error
+	builders []*UsageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Usage entities in the database.
+func (ucb *UsageCreateBulk) Save(ctx context.Context) ([]*Usage, error) {
+	if ucb.err != nil {
+		return nil, ucb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
+	nodes := make([]*Usage, len(ucb.builders))
+	mutators := make([]Mutator, len(ucb.builders))
+	for i := range ucb.builders {
+		func(i int, root context.Context) {
+			builder := ucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ucb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucb *UsageCreateBulk) SaveX(ctx context.Context) []*Usage {
+	v, err := ucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucb *UsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := ucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucb *UsageCreateBulk) ExecX(ctx context.Context) {
+	if err := ucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Usage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ucb *UsageCreateBulk) OnConflict(opts ...sql.ConflictOption) *UsageUpsertBulk {
+	ucb.conflict = opts
+	return &UsageUpsertBulk{
+		create: ucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ucb *UsageCreateBulk) OnConflictColumns(columns ...string) *UsageUpsertBulk {
+	ucb.conflict = append(ucb.conflict, sql.ConflictColumns(columns...))
+	return &UsageUpsertBulk{
+		create: ucb,
+	}
+}
+
+// UsageUpsertBulk is the builder for "upsert"-ing
+// a bulk of Usage nodes.
+type UsageUpsertBulk struct {
+	create *UsageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageUpsertBulk) UpdateNewValues() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usage.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(usage.FieldUserID)
+			}
+			if _, exists := b.mutation.InterviewID(); exists {
+				s.SetIgnore(usage.FieldInterviewID)
+			}
+			if _, exists := b.mutation.Upstream(); exists {
+				s.SetIgnore(usage.FieldUpstream)
+			}
+			if _, exists := b.mutation.Operation(); exists {
+				s.SetIgnore(usage.FieldOperation)
+			}
+			if _, exists := b.mutation.LatencyMs(); exists {
+				s.SetIgnore(usage.FieldLatencyMs)
+			}
+			if _, exists := b.mutation.RequestBytes(); exists {
+				s.SetIgnore(usage.FieldRequestBytes)
+			}
+			if _, exists := b.mutation.ResponseBytes(); exists {
+				s.SetIgnore(usage.FieldResponseBytes)
+			}
+			if _, exists := b.mutation.Success(); exists {
+				s.SetIgnore(usage.FieldSuccess)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UsageUpsertBulk) Ignore() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageUpsertBulk) DoNothing() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageCreateBulk.OnConflict
+// documentation for more info.
+func (u *UsageUpsertBulk) Update(set func(*UsageUpsert)) *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsageUpsertBulk) SetUpdatedAt(v time.Time) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateUpdatedAt() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UsageUpsertBulk) SetDeletedAt(v time.Time) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateDeletedAt() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UsageUpsertBulk) ClearDeletedAt() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UsageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"irelia/pkg/ent/predicate"
+	"irelia/pkg/ent/usage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UsageUpdate is the builder for updating Usage entities.
+type UsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageMutation
+}
+
+// Where appends a list predicates to the UsageUpdate builder.
+func (uu *UsageUpdate) Where(ps ...predicate.Usage) *UsageUpdate {
+	uu.mutation.Where(ps...)
+	return uu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uu *UsageUpdate) SetUpdatedAt(t time.Time) *UsageUpdate {
+	uu.mutation.SetUpdatedAt(t)
+	return uu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uu *UsageUpdate) SetDeletedAt(t time.Time) *UsageUpdate {
+	uu.mutation.SetDeletedAt(t)
+	return uu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uu *UsageUpdate) SetNillableDeletedAt(t *time.Time) *UsageUpdate {
+	if t != nil {
+		uu.SetDeletedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uu *UsageUpdate) ClearDeletedAt() *UsageUpdate {
+	uu.mutation.ClearDeletedAt()
+	return uu
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uu *UsageUpdate) Mutation() *UsageMutation {
+	return uu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UsageUpdate) Save(ctx context.Context) (int, error) {
+	uu.defaults()
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UsageUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UsageUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uu *UsageUpdate) defaults() {
+	if _, ok := uu.mutation.UpdatedAt(); !ok {
+		v := usage.UpdateDefaultUpdatedAt()
+		uu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (uu *UsageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usage.Table, usage.Columns, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	if ps := uu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uu.mutation.UpdatedAt(); ok {
+		_spec.SetField(usage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uu.mutation.DeletedAt(); ok {
+		_spec.SetField(usage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletedAtCleared() {
+		_spec.ClearField(usage.FieldDeletedAt, field.TypeTime)
+	}
+	if uu.mutation.InterviewIDCleared() {
+		_spec.ClearField(usage.FieldInterviewID, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UsageUpdateOne is the builder for updating a single Usage entity.
+type UsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UsageUpdateOne) SetUpdatedAt(t time.Time) *UsageUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UsageUpdateOne) SetDeletedAt(t time.Time) *UsageUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UsageUpdateOne) SetNillableDeletedAt(t *time.Time) *UsageUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UsageUpdateOne) ClearDeletedAt() *UsageUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uuo *UsageUpdateOne) Mutation() *UsageMutation {
+	return uuo.mutation
+}
+
+// Where appends a list predicates to the UsageUpdate builder.
+func (uuo *UsageUpdateOne) Where(ps ...predicate.Usage) *UsageUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UsageUpdateOne) Select(field string, fields ...string) *UsageUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated Usage entity.
+func (uuo *UsageUpdateOne) Save(ctx context.Context) (*Usage, error) {
+	uuo.defaults()
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UsageUpdateOne) SaveX(ctx context.Context) *Usage {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UsageUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UsageUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		v := usage.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (uuo *UsageUpdateOne) sqlSave(ctx context.Context) (_node *Usage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usage.Table, usage.Columns, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Usage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usage.FieldID)
+		for _, f := range fields {
+			if !usage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(usage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(usage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(usage.FieldDeletedAt, field.TypeTime)
+	}
+	if uuo.mutation.InterviewIDCleared() {
+		_spec.ClearField(usage.FieldInterviewID, field.TypeString)
+	}
+	_node = &Usage{config: uuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uuo.mutation.done = true
+	return _node, nil
+}
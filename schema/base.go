@@ -20,5 +20,9 @@ func (Base) Fields() []ent.Field {
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		// Soft-deleted records are hidden from reads until they are purged
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
 	}
 }
\ No newline at end of file
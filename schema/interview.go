@@ -32,14 +32,19 @@ func (Interview) Fields() []ent.Field {
         field.JSON("skills", []string{}).Optional(),
         field.JSON("skills_score", []string{}).Optional(),
         field.Bool("skip_code").Default(false),
+        field.Int32("question_time_limit").Default(0),
+        field.Bool("fluency_scoring").Default(false),
+        field.Bool("text_only").Default(false),
         field.Int32("total_questions").Default(10),
         field.Int32("remaining_questions").Default(10),
         field.JSON("total_score", &pb.TotalScore{}).Optional(),
         field.Float("overall_score").Default(0),
+        field.JSON("fluency_result", &pb.ScoreFluencyResponse{}).Optional(),
         field.Text("positive_feedback").Optional(),
         field.Text("actionable_feedback").Optional(),
         field.Text("final_comment").Optional(),
         field.Int32("status").GoType(pb.InterviewStatus(0)),
+        field.Text("failure_reason").Optional(),
     }
 }
 
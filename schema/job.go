@@ -0,0 +1,46 @@
+package schema
+
+import (
+    "time"
+
+    pb "irelia/api"
+
+    "entgo.io/ent"
+    "entgo.io/ent/schema/field"
+    "entgo.io/ent/schema/index"
+)
+
+// Job holds the schema definition for the Job entity.
+type Job struct {
+    ent.Schema
+}
+
+func (Job) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Base{},
+	}
+}
+
+func (Job) Indexes() []ent.Index {
+    return []ent.Index{
+        index.Fields("kind", "interview_id", "question_index", "sub_index").Unique(),
+        index.Fields("status", "run_at"),
+    }
+}
+
+func (Job) Fields() []ent.Field {
+    return []ent.Field{
+        field.Int32("kind").GoType(pb.JobKind(0)).Immutable(),
+        field.String("interview_id").NotEmpty().Immutable(),
+        field.Int32("question_index").Default(0).Immutable(),
+        field.Int32("sub_index").Default(0).Immutable(),
+        field.Uint64("user_id").Immutable(),
+        field.Int32("status").GoType(pb.JobStatus(0)),
+        field.Int32("attempts").Default(0),
+        field.Int32("max_attempts").Default(5),
+        field.Time("run_at").Default(time.Now),
+        field.String("locked_by").Optional(),
+        field.Time("locked_until").Optional().Nillable(),
+        field.Text("last_error").Optional(),
+    }
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+    pb "irelia/api"
+
+    "entgo.io/ent"
+    "entgo.io/ent/schema/field"
+    "entgo.io/ent/schema/index"
+)
+
+// ModerationAudit holds the schema definition for the ModerationAudit entity, one row per moderation action.
+type ModerationAudit struct {
+    ent.Schema
+}
+
+func (ModerationAudit) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Base{},
+	}
+}
+
+func (ModerationAudit) Indexes() []ent.Index {
+    return []ent.Index{
+        index.Fields("question_id", "created_at"),
+        index.Fields("moderator_id", "created_at"),
+    }
+}
+
+func (ModerationAudit) Fields() []ent.Field {
+    return []ent.Field{
+        field.Int("question_id").Immutable(),
+        field.Uint64("moderator_id").Immutable(),
+        field.Int32("action").GoType(pb.ModerationAction(0)).Immutable(),
+        field.Int32("previous_status").GoType(pb.ModerationStatus(0)).Immutable(),
+        field.Int32("status").GoType(pb.ModerationStatus(0)).Immutable(),
+        field.Int("merged_into_id").Optional().Nillable().Immutable(),
+        field.Text("previous_content").Optional().Immutable(), // the content before an edit
+        field.Text("content").Optional().Immutable(),
+        field.Text("note").Optional().Immutable(),
+        field.Text("previous_answer").Optional().Immutable(), // the model answer before an edit
+        field.Text("answer").Optional().Immutable(),
+    }
+}
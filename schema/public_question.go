@@ -1,8 +1,15 @@
 package schema
 
 import (
+    "strconv"
+    "time"
+
+    pb "irelia/api"
+
     "entgo.io/ent"
+    "entgo.io/ent/dialect/entsql"
     "entgo.io/ent/schema/field"
+    "entgo.io/ent/schema/index"
 )
 
 // Question holds the schema definition for the Question entity.
@@ -16,6 +23,14 @@ func (PublicQuestion) Mixin() []ent.Mixin {
 	}
 }
 
+// A question is stored once per language, however often it is generated
+func (PublicQuestion) Indexes() []ent.Index {
+    return []ent.Index{
+        index.Fields("content_hash", "language").Unique(),
+        index.Fields("status"),
+    }
+}
+
 func (PublicQuestion) Fields() []ent.Field {
     return []ent.Field{
         field.String("position").NotEmpty(),
@@ -23,5 +38,26 @@ func (PublicQuestion) Fields() []ent.Field {
 		field.String("language").NotEmpty(),
         field.Text("content").NotEmpty(),
         field.Text("answer").Optional(),
+        // SHA-256 of the normalized content, questions stored before deduplication have none until
+        // `dedupe-public-questions` runs
+        field.String("content_hash").MaxLen(64).Optional().Nillable(),
+        field.Int("occurrences").Default(1),
+        field.Time("first_seen_at").Default(time.Now).Optional(),
+        field.Time("last_seen_at").Default(time.Now).Optional(),
+        // Generated questions wait in the moderation queue, only approved ones are public. Ent inserts every new question
+        // as pending, while the column defaults to approved so that adding it approves the questions stored before moderation
+        field.Int32("status").GoType(pb.ModerationStatus(0)).Default(int32(pb.ModerationStatus_MODERATION_STATUS_PENDING)).
+            Annotations(entsql.Default(strconv.Itoa(int(pb.ModerationStatus_MODERATION_STATUS_APPROVED)))),
+        field.Int("merged_into_id").Optional().Nillable(),
+        field.Uint64("moderated_by").Optional().Nillable(),
+        field.Time("moderated_at").Optional().Nillable(),
+        // Provenance of the model answer, filled in the background by the answer generator
+        field.String("answer_generator").Optional(),
+        field.String("answer_version").Optional(),
+        field.Time("answer_generated_at").Optional().Nillable(),
+        field.Int32("answer_attempts").Default(0), // failed generations, the question is given up on after answers.max_attempts
+        // Lease of the replica generating the model answer, so that every question is generated once
+        field.String("answer_locked_by").Optional(),
+        field.Time("answer_locked_until").Optional().Nillable(),
     }
-}
\ No newline at end of file
+}
@@ -22,7 +22,8 @@ func (Question) Mixin() []ent.Mixin {
 
 func (Question) Indexes() []ent.Index {
     return []ent.Index{
-        index.Fields("interview_id", "question_index").Unique(),
+        index.Fields("interview_id", "question_index", "sub_index").Unique(),
+        index.Fields("status", "deadline_at"),
     }
 }
 
@@ -30,14 +31,18 @@ func (Question) Fields() []ent.Field {
     return []ent.Field{
         field.String("interview_id").NotEmpty().Immutable(),
         field.Int32("question_index").Immutable(),
+        field.Int32("sub_index").Default(0).Immutable(), // 0 for the main question, 1.. for its follow-ups
         field.Text("content").NotEmpty(),
-        field.Text("audio").Optional(),
+        field.Text("audio").Optional(), // inline base64 audio, only used when no blob store is configured
+        field.String("audio_key").Optional(), // content-addressed blob store reference
         field.JSON("lipsync", &pb.LipSyncData{}).Optional(),
+        field.Bool("audio_pending").Default(false), // saved as text while Karma was unavailable, audio is backfilled
         field.Text("answer").Optional(),
         field.Text("record_proof").Optional(),
         field.Text("comment").Optional(),
         field.String("score").Optional(),
         field.Int32("status").GoType(pb.QuestionStatus(0)),
+        field.Time("deadline_at").Optional().Nillable(),
     }
 }
 
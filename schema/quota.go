@@ -0,0 +1,33 @@
+package schema
+
+import (
+    "entgo.io/ent"
+    "entgo.io/ent/schema/field"
+    "entgo.io/ent/schema/index"
+)
+
+// Quota holds the schema definition for the Quota entity, the interviews and questions a user started on a day.
+type Quota struct {
+    ent.Schema
+}
+
+func (Quota) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Base{},
+	}
+}
+
+func (Quota) Indexes() []ent.Index {
+    return []ent.Index{
+        index.Fields("user_id", "day").Unique(),
+    }
+}
+
+func (Quota) Fields() []ent.Field {
+    return []ent.Field{
+        field.Uint64("user_id").Immutable(),
+        field.Time("day").Immutable(),
+        field.Int32("interviews").Default(0),
+        field.Int32("questions").Default(0),
+    }
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+    "entgo.io/ent"
+    "entgo.io/ent/schema/field"
+    "entgo.io/ent/schema/index"
+)
+
+// Usage holds the schema definition for the Usage entity, one row per upstream AI call.
+type Usage struct {
+    ent.Schema
+}
+
+func (Usage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Base{},
+	}
+}
+
+func (Usage) Indexes() []ent.Index {
+    return []ent.Index{
+        index.Fields("user_id", "created_at"),
+        index.Fields("interview_id"),
+    }
+}
+
+func (Usage) Fields() []ent.Field {
+    return []ent.Field{
+        field.Uint64("user_id").Immutable(),
+        field.String("interview_id").Optional().Immutable(),
+        field.String("upstream").NotEmpty().Immutable(),
+        field.String("operation").NotEmpty().Immutable(),
+        field.Int64("latency_ms").Immutable(),
+        field.Int("request_bytes").Default(0).Immutable(),
+        field.Int("response_bytes").Default(0).Immutable(),
+        field.Bool("success").Immutable(),
+    }
+}